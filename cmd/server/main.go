@@ -9,6 +9,7 @@ import (
 	"github.com/shuTwT/hoshikuzu/internal/infra/database"
 	"github.com/shuTwT/hoshikuzu/internal/infra/schedule"
 	"github.com/shuTwT/hoshikuzu/internal/infra/schedule/manager"
+	theme_infra "github.com/shuTwT/hoshikuzu/internal/infra/theme"
 	"github.com/shuTwT/hoshikuzu/internal/router"
 	"github.com/shuTwT/hoshikuzu/pkg"
 	"github.com/shuTwT/hoshikuzu/pkg/config"
@@ -84,7 +85,21 @@ func InitializeApp(assetsRes embed.FS, frontendRes embed.FS) (*fiber.App, func()
 	// 初始化 Redis 客户端
 	rdb, err := database.NewRedisClient(context.Background())
 
+	// 初始化站点知识库向量数据库，失败时知识库功能不可用但不影响启动
+	if _, err := database.NewChromemDB(config.GetString(config.AI_KNOWLEDGE_PATH)); err != nil {
+		slog.Error("初始化站点知识库失败", "error", err.Error())
+	}
+
+	if config.GetBool(config.THEME_DEV) {
+		if err := theme_infra.GetLoader().EnableDevMode(); err != nil {
+			slog.Error("开启主题开发模式失败", "error", err.Error())
+		}
+	}
+
 	cleanup := func() {
+		if err := theme_infra.GetLoader().Close(); err != nil {
+			slog.Error("Failed to close theme watcher", "error", err.Error())
+		}
 		err := db.Close()
 		if err != nil {
 			slog.Error("Failed to close database connection", "error", err.Error())
@@ -100,11 +115,17 @@ func InitializeApp(assetsRes embed.FS, frontendRes embed.FS) (*fiber.App, func()
 
 	pkg.ExtractDefaultTheme(assetsRes)
 
-	serviceMap := pkg.InitializeServices(assetsRes, db, scheduleManager)
+	serviceMap := pkg.InitializeServices(assetsRes, db, rdb, scheduleManager)
 
 	if err := serviceMap.ThemeService.RegisterDefaultTheme(context.Background()); err != nil {
 		slog.Error("Failed to register default theme", "error", err.Error())
 	}
+	if err := serviceMap.PermissionService.InitRolePermissions(context.Background()); err != nil {
+		slog.Error("Failed to init role permissions", "error", err.Error())
+	}
+	if err := serviceMap.UserService.HashLegacyPersonalAccessTokens(context.Background()); err != nil {
+		slog.Error("Failed to hash legacy personal access tokens", "error", err.Error())
+	}
 
 	if !fiber.IsChild() {
 		// 主进程程初始化定时任务
@@ -157,7 +178,7 @@ func InitializeApp(assetsRes embed.FS, frontendRes embed.FS) (*fiber.App, func()
 	app.Use(cors.New())
 
 	handlerMap := handlers.InitHandler(serviceMap, db)
-	router.InitFrontendRes(app, frontendRes, serviceMap)
+	router.InitFrontendRes(app, frontendRes, serviceMap, db)
 	router.Initialize(app, handlerMap, db)
 
 	go func() {
@@ -171,6 +192,8 @@ func InitializeApp(assetsRes embed.FS, frontendRes embed.FS) (*fiber.App, func()
 		}
 		// 启动插件心跳超时检查（30秒周期）
 		serviceMap.PluginService.StartHeartbeatChecker(context.Background())
+		// 启动 Webhook 投递重试（30秒周期）
+		serviceMap.WebHookService.StartRetryWorker(context.Background())
 	}()
 
 	return app, cleanup
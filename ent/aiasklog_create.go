@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
+)
+
+// AIAskLogCreate is the builder for creating a AIAskLog entity.
+type AIAskLogCreate struct {
+	config
+	mutation *AIAskLogMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AIAskLogCreate) SetCreatedAt(v time.Time) *AIAskLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableCreatedAt(v *time.Time) *AIAskLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AIAskLogCreate) SetUpdatedAt(v time.Time) *AIAskLogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableUpdatedAt(v *time.Time) *AIAskLogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetQuestion sets the "question" field.
+func (_c *AIAskLogCreate) SetQuestion(v string) *AIAskLogCreate {
+	_c.mutation.SetQuestion(v)
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *AIAskLogCreate) SetAnswer(v string) *AIAskLogCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableAnswer(v *string) *AIAskLogCreate {
+	if v != nil {
+		_c.SetAnswer(*v)
+	}
+	return _c
+}
+
+// SetCitations sets the "citations" field.
+func (_c *AIAskLogCreate) SetCitations(v []schema.AIChatCitation) *AIAskLogCreate {
+	_c.mutation.SetCitations(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AIAskLogCreate) SetStatus(v aiasklog.Status) *AIAskLogCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *AIAskLogCreate) SetError(v string) *AIAskLogCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableError(v *string) *AIAskLogCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *AIAskLogCreate) SetModel(v string) *AIAskLogCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableModel(v *string) *AIAskLogCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *AIAskLogCreate) SetPromptTokens(v int) *AIAskLogCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillablePromptTokens(v *int) *AIAskLogCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *AIAskLogCreate) SetCompletionTokens(v int) *AIAskLogCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableCompletionTokens(v *int) *AIAskLogCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_c *AIAskLogCreate) SetTotalTokens(v int) *AIAskLogCreate {
+	_c.mutation.SetTotalTokens(v)
+	return _c
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableTotalTokens(v *int) *AIAskLogCreate {
+	if v != nil {
+		_c.SetTotalTokens(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *AIAskLogCreate) SetIP(v string) *AIAskLogCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableIP(v *string) *AIAskLogCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *AIAskLogCreate) SetUserAgent(v string) *AIAskLogCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *AIAskLogCreate) SetNillableUserAgent(v *string) *AIAskLogCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AIAskLogCreate) SetID(v int) *AIAskLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AIAskLogMutation object of the builder.
+func (_c *AIAskLogCreate) Mutation() *AIAskLogMutation {
+	return _c.mutation
+}
+
+// Save creates the AIAskLog in the database.
+func (_c *AIAskLogCreate) Save(ctx context.Context) (*AIAskLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AIAskLogCreate) SaveX(ctx context.Context) *AIAskLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIAskLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIAskLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AIAskLogCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := aiasklog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := aiasklog.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := aiasklog.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := aiasklog.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		v := aiasklog.DefaultTotalTokens
+		_c.mutation.SetTotalTokens(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AIAskLogCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AIAskLog.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AIAskLog.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Question(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required field "AIAskLog.question"`)}
+	}
+	if v, ok := _c.mutation.Question(); ok {
+		if err := aiasklog.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.question": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AIAskLog.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := aiasklog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := aiasklog.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.error": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := aiasklog.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "AIAskLog.prompt_tokens"`)}
+	}
+	if v, ok := _c.mutation.PromptTokens(); ok {
+		if err := aiasklog.PromptTokensValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.prompt_tokens": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "AIAskLog.completion_tokens"`)}
+	}
+	if v, ok := _c.mutation.CompletionTokens(); ok {
+		if err := aiasklog.CompletionTokensValidator(v); err != nil {
+			return &ValidationError{Name: "completion_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.completion_tokens": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "AIAskLog.total_tokens"`)}
+	}
+	if v, ok := _c.mutation.TotalTokens(); ok {
+		if err := aiasklog.TotalTokensValidator(v); err != nil {
+			return &ValidationError{Name: "total_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.total_tokens": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AIAskLogCreate) sqlSave(ctx context.Context) (*AIAskLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AIAskLogCreate) createSpec() (*AIAskLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AIAskLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(aiasklog.Table, sqlgraph.NewFieldSpec(aiasklog.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(aiasklog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(aiasklog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Question(); ok {
+		_spec.SetField(aiasklog.FieldQuestion, field.TypeString, value)
+		_node.Question = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(aiasklog.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.Citations(); ok {
+		_spec.SetField(aiasklog.FieldCitations, field.TypeJSON, value)
+		_node.Citations = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(aiasklog.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(aiasklog.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(aiasklog.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(aiasklog.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(aiasklog.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.TotalTokens(); ok {
+		_spec.SetField(aiasklog.FieldTotalTokens, field.TypeInt, value)
+		_node.TotalTokens = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(aiasklog.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(aiasklog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	return _node, _spec
+}
+
+// AIAskLogCreateBulk is the builder for creating many AIAskLog entities in bulk.
+type AIAskLogCreateBulk struct {
+	config
+	err      error
+	builders []*AIAskLogCreate
+}
+
+// Save creates the AIAskLog entities in the database.
+func (_c *AIAskLogCreateBulk) Save(ctx context.Context) ([]*AIAskLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AIAskLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AIAskLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AIAskLogCreateBulk) SaveX(ctx context.Context) []*AIAskLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIAskLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIAskLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,848 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
+)
+
+// AIAskLogUpdate is the builder for updating AIAskLog entities.
+type AIAskLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AIAskLogMutation
+}
+
+// Where appends a list predicates to the AIAskLogUpdate builder.
+func (_u *AIAskLogUpdate) Where(ps ...predicate.AIAskLog) *AIAskLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AIAskLogUpdate) SetUpdatedAt(v time.Time) *AIAskLogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *AIAskLogUpdate) SetQuestion(v string) *AIAskLogUpdate {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableQuestion(v *string) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *AIAskLogUpdate) SetAnswer(v string) *AIAskLogUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableAnswer(v *string) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *AIAskLogUpdate) ClearAnswer() *AIAskLogUpdate {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
+// SetCitations sets the "citations" field.
+func (_u *AIAskLogUpdate) SetCitations(v []schema.AIChatCitation) *AIAskLogUpdate {
+	_u.mutation.SetCitations(v)
+	return _u
+}
+
+// AppendCitations appends value to the "citations" field.
+func (_u *AIAskLogUpdate) AppendCitations(v []schema.AIChatCitation) *AIAskLogUpdate {
+	_u.mutation.AppendCitations(v)
+	return _u
+}
+
+// ClearCitations clears the value of the "citations" field.
+func (_u *AIAskLogUpdate) ClearCitations() *AIAskLogUpdate {
+	_u.mutation.ClearCitations()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AIAskLogUpdate) SetStatus(v aiasklog.Status) *AIAskLogUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableStatus(v *aiasklog.Status) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *AIAskLogUpdate) SetError(v string) *AIAskLogUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableError(v *string) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *AIAskLogUpdate) ClearError() *AIAskLogUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AIAskLogUpdate) SetModel(v string) *AIAskLogUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableModel(v *string) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *AIAskLogUpdate) ClearModel() *AIAskLogUpdate {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIAskLogUpdate) SetPromptTokens(v int) *AIAskLogUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillablePromptTokens(v *int) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIAskLogUpdate) AddPromptTokens(v int) *AIAskLogUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *AIAskLogUpdate) SetCompletionTokens(v int) *AIAskLogUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableCompletionTokens(v *int) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *AIAskLogUpdate) AddCompletionTokens(v int) *AIAskLogUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *AIAskLogUpdate) SetTotalTokens(v int) *AIAskLogUpdate {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableTotalTokens(v *int) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *AIAskLogUpdate) AddTotalTokens(v int) *AIAskLogUpdate {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AIAskLogUpdate) SetIP(v string) *AIAskLogUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableIP(v *string) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AIAskLogUpdate) ClearIP() *AIAskLogUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AIAskLogUpdate) SetUserAgent(v string) *AIAskLogUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AIAskLogUpdate) SetNillableUserAgent(v *string) *AIAskLogUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *AIAskLogUpdate) ClearUserAgent() *AIAskLogUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// Mutation returns the AIAskLogMutation object of the builder.
+func (_u *AIAskLogUpdate) Mutation() *AIAskLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AIAskLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIAskLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AIAskLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIAskLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AIAskLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := aiasklog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AIAskLogUpdate) check() error {
+	if v, ok := _u.mutation.Question(); ok {
+		if err := aiasklog.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.question": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := aiasklog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := aiasklog.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.error": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := aiasklog.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PromptTokens(); ok {
+		if err := aiasklog.PromptTokensValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.prompt_tokens": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CompletionTokens(); ok {
+		if err := aiasklog.CompletionTokensValidator(v); err != nil {
+			return &ValidationError{Name: "completion_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.completion_tokens": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalTokens(); ok {
+		if err := aiasklog.TotalTokensValidator(v); err != nil {
+			return &ValidationError{Name: "total_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.total_tokens": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AIAskLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aiasklog.Table, aiasklog.Columns, sqlgraph.NewFieldSpec(aiasklog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(aiasklog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(aiasklog.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(aiasklog.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(aiasklog.FieldAnswer, field.TypeString)
+	}
+	if value, ok := _u.mutation.Citations(); ok {
+		_spec.SetField(aiasklog.FieldCitations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCitations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, aiasklog.FieldCitations, value)
+		})
+	}
+	if _u.mutation.CitationsCleared() {
+		_spec.ClearField(aiasklog.FieldCitations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(aiasklog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(aiasklog.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(aiasklog.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(aiasklog.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(aiasklog.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aiasklog.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aiasklog.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(aiasklog.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(aiasklog.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(aiasklog.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(aiasklog.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(aiasklog.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(aiasklog.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(aiasklog.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(aiasklog.FieldUserAgent, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aiasklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AIAskLogUpdateOne is the builder for updating a single AIAskLog entity.
+type AIAskLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AIAskLogMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AIAskLogUpdateOne) SetUpdatedAt(v time.Time) *AIAskLogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *AIAskLogUpdateOne) SetQuestion(v string) *AIAskLogUpdateOne {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableQuestion(v *string) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *AIAskLogUpdateOne) SetAnswer(v string) *AIAskLogUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableAnswer(v *string) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *AIAskLogUpdateOne) ClearAnswer() *AIAskLogUpdateOne {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
+// SetCitations sets the "citations" field.
+func (_u *AIAskLogUpdateOne) SetCitations(v []schema.AIChatCitation) *AIAskLogUpdateOne {
+	_u.mutation.SetCitations(v)
+	return _u
+}
+
+// AppendCitations appends value to the "citations" field.
+func (_u *AIAskLogUpdateOne) AppendCitations(v []schema.AIChatCitation) *AIAskLogUpdateOne {
+	_u.mutation.AppendCitations(v)
+	return _u
+}
+
+// ClearCitations clears the value of the "citations" field.
+func (_u *AIAskLogUpdateOne) ClearCitations() *AIAskLogUpdateOne {
+	_u.mutation.ClearCitations()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AIAskLogUpdateOne) SetStatus(v aiasklog.Status) *AIAskLogUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableStatus(v *aiasklog.Status) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *AIAskLogUpdateOne) SetError(v string) *AIAskLogUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableError(v *string) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *AIAskLogUpdateOne) ClearError() *AIAskLogUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AIAskLogUpdateOne) SetModel(v string) *AIAskLogUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableModel(v *string) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *AIAskLogUpdateOne) ClearModel() *AIAskLogUpdateOne {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIAskLogUpdateOne) SetPromptTokens(v int) *AIAskLogUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillablePromptTokens(v *int) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIAskLogUpdateOne) AddPromptTokens(v int) *AIAskLogUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *AIAskLogUpdateOne) SetCompletionTokens(v int) *AIAskLogUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableCompletionTokens(v *int) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *AIAskLogUpdateOne) AddCompletionTokens(v int) *AIAskLogUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *AIAskLogUpdateOne) SetTotalTokens(v int) *AIAskLogUpdateOne {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableTotalTokens(v *int) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *AIAskLogUpdateOne) AddTotalTokens(v int) *AIAskLogUpdateOne {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AIAskLogUpdateOne) SetIP(v string) *AIAskLogUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableIP(v *string) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AIAskLogUpdateOne) ClearIP() *AIAskLogUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AIAskLogUpdateOne) SetUserAgent(v string) *AIAskLogUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AIAskLogUpdateOne) SetNillableUserAgent(v *string) *AIAskLogUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *AIAskLogUpdateOne) ClearUserAgent() *AIAskLogUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// Mutation returns the AIAskLogMutation object of the builder.
+func (_u *AIAskLogUpdateOne) Mutation() *AIAskLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AIAskLogUpdate builder.
+func (_u *AIAskLogUpdateOne) Where(ps ...predicate.AIAskLog) *AIAskLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AIAskLogUpdateOne) Select(field string, fields ...string) *AIAskLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AIAskLog entity.
+func (_u *AIAskLogUpdateOne) Save(ctx context.Context) (*AIAskLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIAskLogUpdateOne) SaveX(ctx context.Context) *AIAskLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AIAskLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIAskLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AIAskLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := aiasklog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AIAskLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Question(); ok {
+		if err := aiasklog.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.question": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := aiasklog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := aiasklog.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.error": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := aiasklog.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PromptTokens(); ok {
+		if err := aiasklog.PromptTokensValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.prompt_tokens": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CompletionTokens(); ok {
+		if err := aiasklog.CompletionTokensValidator(v); err != nil {
+			return &ValidationError{Name: "completion_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.completion_tokens": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalTokens(); ok {
+		if err := aiasklog.TotalTokensValidator(v); err != nil {
+			return &ValidationError{Name: "total_tokens", err: fmt.Errorf(`ent: validator failed for field "AIAskLog.total_tokens": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AIAskLogUpdateOne) sqlSave(ctx context.Context) (_node *AIAskLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aiasklog.Table, aiasklog.Columns, sqlgraph.NewFieldSpec(aiasklog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AIAskLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, aiasklog.FieldID)
+		for _, f := range fields {
+			if !aiasklog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != aiasklog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(aiasklog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(aiasklog.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(aiasklog.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(aiasklog.FieldAnswer, field.TypeString)
+	}
+	if value, ok := _u.mutation.Citations(); ok {
+		_spec.SetField(aiasklog.FieldCitations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCitations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, aiasklog.FieldCitations, value)
+		})
+	}
+	if _u.mutation.CitationsCleared() {
+		_spec.ClearField(aiasklog.FieldCitations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(aiasklog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(aiasklog.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(aiasklog.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(aiasklog.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(aiasklog.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aiasklog.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aiasklog.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(aiasklog.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(aiasklog.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(aiasklog.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(aiasklog.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(aiasklog.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(aiasklog.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(aiasklog.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(aiasklog.FieldUserAgent, field.TypeString)
+	}
+	_node = &AIAskLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aiasklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
 	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 )
 
 // AIChatMessage is the model entity for the AIChatMessage schema.
@@ -30,6 +32,14 @@ type AIChatMessage struct {
 	Content string `json:"content,omitempty"`
 	// 生成该回复的模型
 	Model string `json:"model,omitempty"`
+	// 站点知识模式下回答引用的内容来源
+	Citations []schema.AIChatCitation `json:"citations,omitempty"`
+	// 生成该回复时提交的令牌数，提供商未报告时为估算值
+	PromptTokens int `json:"prompt_tokens,omitempty"`
+	// 该回复的令牌数，提供商未报告时为估算值
+	CompletionTokens int `json:"completion_tokens,omitempty"`
+	// 摘要覆盖到的最后一条消息 ID
+	SummaryUntil *int `json:"summary_until,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AIChatMessageQuery when eager-loading is set.
 	Edges        AIChatMessageEdges `json:"edges"`
@@ -61,7 +71,9 @@ func (*AIChatMessage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case aichatmessage.FieldID, aichatmessage.FieldSessionID:
+		case aichatmessage.FieldCitations:
+			values[i] = new([]byte)
+		case aichatmessage.FieldID, aichatmessage.FieldSessionID, aichatmessage.FieldPromptTokens, aichatmessage.FieldCompletionTokens, aichatmessage.FieldSummaryUntil:
 			values[i] = new(sql.NullInt64)
 		case aichatmessage.FieldRole, aichatmessage.FieldContent, aichatmessage.FieldModel:
 			values[i] = new(sql.NullString)
@@ -124,6 +136,33 @@ func (_m *AIChatMessage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Model = value.String
 			}
+		case aichatmessage.FieldCitations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field citations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Citations); err != nil {
+					return fmt.Errorf("unmarshal field citations: %w", err)
+				}
+			}
+		case aichatmessage.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = int(value.Int64)
+			}
+		case aichatmessage.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionTokens = int(value.Int64)
+			}
+		case aichatmessage.FieldSummaryUntil:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_until", values[i])
+			} else if value.Valid {
+				_m.SummaryUntil = new(int)
+				*_m.SummaryUntil = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -182,6 +221,20 @@ func (_m *AIChatMessage) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("model=")
 	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("citations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Citations))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completion_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletionTokens))
+	builder.WriteString(", ")
+	if v := _m.SummaryUntil; v != nil {
+		builder.WriteString("summary_until=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
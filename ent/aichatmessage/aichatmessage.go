@@ -27,6 +27,14 @@ const (
 	FieldContent = "content"
 	// FieldModel holds the string denoting the model field in the database.
 	FieldModel = "model"
+	// FieldCitations holds the string denoting the citations field in the database.
+	FieldCitations = "citations"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldSummaryUntil holds the string denoting the summary_until field in the database.
+	FieldSummaryUntil = "summary_until"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
 	// Table holds the table name of the aichatmessage in the database.
@@ -49,6 +57,10 @@ var Columns = []string{
 	FieldRole,
 	FieldContent,
 	FieldModel,
+	FieldCitations,
+	FieldPromptTokens,
+	FieldCompletionTokens,
+	FieldSummaryUntil,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,6 +86,14 @@ var (
 	ContentValidator func(string) error
 	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
 	ModelValidator func(string) error
+	// DefaultPromptTokens holds the default value on creation for the "prompt_tokens" field.
+	DefaultPromptTokens int
+	// PromptTokensValidator is a validator for the "prompt_tokens" field. It is called by the builders before save.
+	PromptTokensValidator func(int) error
+	// DefaultCompletionTokens holds the default value on creation for the "completion_tokens" field.
+	DefaultCompletionTokens int
+	// CompletionTokensValidator is a validator for the "completion_tokens" field. It is called by the builders before save.
+	CompletionTokensValidator func(int) error
 )
 
 // Role defines the type for the "role" enum field.
@@ -83,6 +103,7 @@ type Role string
 const (
 	RoleUser      Role = "user"
 	RoleAssistant Role = "assistant"
+	RoleSummary   Role = "summary"
 )
 
 func (r Role) String() string {
@@ -92,7 +113,7 @@ func (r Role) String() string {
 // RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
 func RoleValidator(r Role) error {
 	switch r {
-	case RoleUser, RoleAssistant:
+	case RoleUser, RoleAssistant, RoleSummary:
 		return nil
 	default:
 		return fmt.Errorf("aichatmessage: invalid enum value for role field: %q", r)
@@ -137,6 +158,21 @@ func ByModel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModel, opts...).ToFunc()
 }
 
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completion_tokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// BySummaryUntil orders the results by the summary_until field.
+func BySummaryUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryUntil, opts...).ToFunc()
+}
+
 // BySessionField orders the results by session field.
 func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -80,6 +80,21 @@ func Model(v string) predicate.AIChatMessage {
 	return predicate.AIChatMessage(sql.FieldEQ(FieldModel, v))
 }
 
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completion_tokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// SummaryUntil applies equality check predicate on the "summary_until" field. It's identical to SummaryUntilEQ.
+func SummaryUntil(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldEQ(FieldSummaryUntil, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AIChatMessage {
 	return predicate.AIChatMessage(sql.FieldEQ(FieldCreatedAt, v))
@@ -340,6 +355,146 @@ func ModelContainsFold(v string) predicate.AIChatMessage {
 	return predicate.AIChatMessage(sql.FieldContainsFold(FieldModel, v))
 }
 
+// CitationsIsNil applies the IsNil predicate on the "citations" field.
+func CitationsIsNil() predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldIsNull(FieldCitations))
+}
+
+// CitationsNotNil applies the NotNil predicate on the "citations" field.
+func CitationsNotNil() predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNotNull(FieldCitations))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completion_tokens" field.
+func CompletionTokensEQ(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completion_tokens" field.
+func CompletionTokensNEQ(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completion_tokens" field.
+func CompletionTokensIn(vs ...int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completion_tokens" field.
+func CompletionTokensNotIn(vs ...int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completion_tokens" field.
+func CompletionTokensGT(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completion_tokens" field.
+func CompletionTokensGTE(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completion_tokens" field.
+func CompletionTokensLT(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completion_tokens" field.
+func CompletionTokensLTE(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// SummaryUntilEQ applies the EQ predicate on the "summary_until" field.
+func SummaryUntilEQ(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldEQ(FieldSummaryUntil, v))
+}
+
+// SummaryUntilNEQ applies the NEQ predicate on the "summary_until" field.
+func SummaryUntilNEQ(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNEQ(FieldSummaryUntil, v))
+}
+
+// SummaryUntilIn applies the In predicate on the "summary_until" field.
+func SummaryUntilIn(vs ...int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldIn(FieldSummaryUntil, vs...))
+}
+
+// SummaryUntilNotIn applies the NotIn predicate on the "summary_until" field.
+func SummaryUntilNotIn(vs ...int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNotIn(FieldSummaryUntil, vs...))
+}
+
+// SummaryUntilGT applies the GT predicate on the "summary_until" field.
+func SummaryUntilGT(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldGT(FieldSummaryUntil, v))
+}
+
+// SummaryUntilGTE applies the GTE predicate on the "summary_until" field.
+func SummaryUntilGTE(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldGTE(FieldSummaryUntil, v))
+}
+
+// SummaryUntilLT applies the LT predicate on the "summary_until" field.
+func SummaryUntilLT(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldLT(FieldSummaryUntil, v))
+}
+
+// SummaryUntilLTE applies the LTE predicate on the "summary_until" field.
+func SummaryUntilLTE(v int) predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldLTE(FieldSummaryUntil, v))
+}
+
+// SummaryUntilIsNil applies the IsNil predicate on the "summary_until" field.
+func SummaryUntilIsNil() predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldIsNull(FieldSummaryUntil))
+}
+
+// SummaryUntilNotNil applies the NotNil predicate on the "summary_until" field.
+func SummaryUntilNotNil() predicate.AIChatMessage {
+	return predicate.AIChatMessage(sql.FieldNotNull(FieldSummaryUntil))
+}
+
 // HasSession applies the HasEdge predicate on the "session" edge.
 func HasSession() predicate.AIChatMessage {
 	return predicate.AIChatMessage(func(s *sql.Selector) {
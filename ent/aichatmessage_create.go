@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
 	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 )
 
 // AIChatMessageCreate is the builder for creating a AIChatMessage entity.
@@ -81,6 +82,54 @@ func (_c *AIChatMessageCreate) SetNillableModel(v *string) *AIChatMessageCreate
 	return _c
 }
 
+// SetCitations sets the "citations" field.
+func (_c *AIChatMessageCreate) SetCitations(v []schema.AIChatCitation) *AIChatMessageCreate {
+	_c.mutation.SetCitations(v)
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *AIChatMessageCreate) SetPromptTokens(v int) *AIChatMessageCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *AIChatMessageCreate) SetNillablePromptTokens(v *int) *AIChatMessageCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *AIChatMessageCreate) SetCompletionTokens(v int) *AIChatMessageCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *AIChatMessageCreate) SetNillableCompletionTokens(v *int) *AIChatMessageCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetSummaryUntil sets the "summary_until" field.
+func (_c *AIChatMessageCreate) SetSummaryUntil(v int) *AIChatMessageCreate {
+	_c.mutation.SetSummaryUntil(v)
+	return _c
+}
+
+// SetNillableSummaryUntil sets the "summary_until" field if the given value is not nil.
+func (_c *AIChatMessageCreate) SetNillableSummaryUntil(v *int) *AIChatMessageCreate {
+	if v != nil {
+		_c.SetSummaryUntil(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AIChatMessageCreate) SetID(v int) *AIChatMessageCreate {
 	_c.mutation.SetID(v)
@@ -135,6 +184,14 @@ func (_c *AIChatMessageCreate) defaults() {
 		v := aichatmessage.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := aichatmessage.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := aichatmessage.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -174,6 +231,22 @@ func (_c *AIChatMessageCreate) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.model": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "AIChatMessage.prompt_tokens"`)}
+	}
+	if v, ok := _c.mutation.PromptTokens(); ok {
+		if err := aichatmessage.PromptTokensValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_tokens", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.prompt_tokens": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "AIChatMessage.completion_tokens"`)}
+	}
+	if v, ok := _c.mutation.CompletionTokens(); ok {
+		if err := aichatmessage.CompletionTokensValidator(v); err != nil {
+			return &ValidationError{Name: "completion_tokens", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.completion_tokens": %w`, err)}
+		}
+	}
 	if len(_c.mutation.SessionIDs()) == 0 {
 		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "AIChatMessage.session"`)}
 	}
@@ -229,6 +302,22 @@ func (_c *AIChatMessageCreate) createSpec() (*AIChatMessage, *sqlgraph.CreateSpe
 		_spec.SetField(aichatmessage.FieldModel, field.TypeString, value)
 		_node.Model = value
 	}
+	if value, ok := _c.mutation.Citations(); ok {
+		_spec.SetField(aichatmessage.FieldCitations, field.TypeJSON, value)
+		_node.Citations = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(aichatmessage.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(aichatmessage.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.SummaryUntil(); ok {
+		_spec.SetField(aichatmessage.FieldSummaryUntil, field.TypeInt, value)
+		_node.SummaryUntil = &value
+	}
 	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
 	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
 	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 )
 
 // AIChatMessageUpdate is the builder for updating AIChatMessage entities.
@@ -97,6 +99,93 @@ func (_u *AIChatMessageUpdate) ClearModel() *AIChatMessageUpdate {
 	return _u
 }
 
+// SetCitations sets the "citations" field.
+func (_u *AIChatMessageUpdate) SetCitations(v []schema.AIChatCitation) *AIChatMessageUpdate {
+	_u.mutation.SetCitations(v)
+	return _u
+}
+
+// AppendCitations appends value to the "citations" field.
+func (_u *AIChatMessageUpdate) AppendCitations(v []schema.AIChatCitation) *AIChatMessageUpdate {
+	_u.mutation.AppendCitations(v)
+	return _u
+}
+
+// ClearCitations clears the value of the "citations" field.
+func (_u *AIChatMessageUpdate) ClearCitations() *AIChatMessageUpdate {
+	_u.mutation.ClearCitations()
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIChatMessageUpdate) SetPromptTokens(v int) *AIChatMessageUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIChatMessageUpdate) SetNillablePromptTokens(v *int) *AIChatMessageUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIChatMessageUpdate) AddPromptTokens(v int) *AIChatMessageUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *AIChatMessageUpdate) SetCompletionTokens(v int) *AIChatMessageUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *AIChatMessageUpdate) SetNillableCompletionTokens(v *int) *AIChatMessageUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *AIChatMessageUpdate) AddCompletionTokens(v int) *AIChatMessageUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetSummaryUntil sets the "summary_until" field.
+func (_u *AIChatMessageUpdate) SetSummaryUntil(v int) *AIChatMessageUpdate {
+	_u.mutation.ResetSummaryUntil()
+	_u.mutation.SetSummaryUntil(v)
+	return _u
+}
+
+// SetNillableSummaryUntil sets the "summary_until" field if the given value is not nil.
+func (_u *AIChatMessageUpdate) SetNillableSummaryUntil(v *int) *AIChatMessageUpdate {
+	if v != nil {
+		_u.SetSummaryUntil(*v)
+	}
+	return _u
+}
+
+// AddSummaryUntil adds value to the "summary_until" field.
+func (_u *AIChatMessageUpdate) AddSummaryUntil(v int) *AIChatMessageUpdate {
+	_u.mutation.AddSummaryUntil(v)
+	return _u
+}
+
+// ClearSummaryUntil clears the value of the "summary_until" field.
+func (_u *AIChatMessageUpdate) ClearSummaryUntil() *AIChatMessageUpdate {
+	_u.mutation.ClearSummaryUntil()
+	return _u
+}
+
 // SetSession sets the "session" edge to the AIChatSession entity.
 func (_u *AIChatMessageUpdate) SetSession(v *AIChatSession) *AIChatMessageUpdate {
 	return _u.SetSessionID(v.ID)
@@ -171,6 +260,16 @@ func (_u *AIChatMessageUpdate) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PromptTokens(); ok {
+		if err := aichatmessage.PromptTokensValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_tokens", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.prompt_tokens": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CompletionTokens(); ok {
+		if err := aichatmessage.CompletionTokensValidator(v); err != nil {
+			return &ValidationError{Name: "completion_tokens", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.completion_tokens": %w`, err)}
+		}
+	}
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AIChatMessage.session"`)
 	}
@@ -204,6 +303,38 @@ func (_u *AIChatMessageUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.ModelCleared() {
 		_spec.ClearField(aichatmessage.FieldModel, field.TypeString)
 	}
+	if value, ok := _u.mutation.Citations(); ok {
+		_spec.SetField(aichatmessage.FieldCitations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCitations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, aichatmessage.FieldCitations, value)
+		})
+	}
+	if _u.mutation.CitationsCleared() {
+		_spec.ClearField(aichatmessage.FieldCitations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aichatmessage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aichatmessage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(aichatmessage.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(aichatmessage.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SummaryUntil(); ok {
+		_spec.SetField(aichatmessage.FieldSummaryUntil, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSummaryUntil(); ok {
+		_spec.AddField(aichatmessage.FieldSummaryUntil, field.TypeInt, value)
+	}
+	if _u.mutation.SummaryUntilCleared() {
+		_spec.ClearField(aichatmessage.FieldSummaryUntil, field.TypeInt)
+	}
 	if _u.mutation.SessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -321,6 +452,93 @@ func (_u *AIChatMessageUpdateOne) ClearModel() *AIChatMessageUpdateOne {
 	return _u
 }
 
+// SetCitations sets the "citations" field.
+func (_u *AIChatMessageUpdateOne) SetCitations(v []schema.AIChatCitation) *AIChatMessageUpdateOne {
+	_u.mutation.SetCitations(v)
+	return _u
+}
+
+// AppendCitations appends value to the "citations" field.
+func (_u *AIChatMessageUpdateOne) AppendCitations(v []schema.AIChatCitation) *AIChatMessageUpdateOne {
+	_u.mutation.AppendCitations(v)
+	return _u
+}
+
+// ClearCitations clears the value of the "citations" field.
+func (_u *AIChatMessageUpdateOne) ClearCitations() *AIChatMessageUpdateOne {
+	_u.mutation.ClearCitations()
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIChatMessageUpdateOne) SetPromptTokens(v int) *AIChatMessageUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIChatMessageUpdateOne) SetNillablePromptTokens(v *int) *AIChatMessageUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIChatMessageUpdateOne) AddPromptTokens(v int) *AIChatMessageUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *AIChatMessageUpdateOne) SetCompletionTokens(v int) *AIChatMessageUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *AIChatMessageUpdateOne) SetNillableCompletionTokens(v *int) *AIChatMessageUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *AIChatMessageUpdateOne) AddCompletionTokens(v int) *AIChatMessageUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetSummaryUntil sets the "summary_until" field.
+func (_u *AIChatMessageUpdateOne) SetSummaryUntil(v int) *AIChatMessageUpdateOne {
+	_u.mutation.ResetSummaryUntil()
+	_u.mutation.SetSummaryUntil(v)
+	return _u
+}
+
+// SetNillableSummaryUntil sets the "summary_until" field if the given value is not nil.
+func (_u *AIChatMessageUpdateOne) SetNillableSummaryUntil(v *int) *AIChatMessageUpdateOne {
+	if v != nil {
+		_u.SetSummaryUntil(*v)
+	}
+	return _u
+}
+
+// AddSummaryUntil adds value to the "summary_until" field.
+func (_u *AIChatMessageUpdateOne) AddSummaryUntil(v int) *AIChatMessageUpdateOne {
+	_u.mutation.AddSummaryUntil(v)
+	return _u
+}
+
+// ClearSummaryUntil clears the value of the "summary_until" field.
+func (_u *AIChatMessageUpdateOne) ClearSummaryUntil() *AIChatMessageUpdateOne {
+	_u.mutation.ClearSummaryUntil()
+	return _u
+}
+
 // SetSession sets the "session" edge to the AIChatSession entity.
 func (_u *AIChatMessageUpdateOne) SetSession(v *AIChatSession) *AIChatMessageUpdateOne {
 	return _u.SetSessionID(v.ID)
@@ -408,6 +626,16 @@ func (_u *AIChatMessageUpdateOne) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PromptTokens(); ok {
+		if err := aichatmessage.PromptTokensValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_tokens", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.prompt_tokens": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CompletionTokens(); ok {
+		if err := aichatmessage.CompletionTokensValidator(v); err != nil {
+			return &ValidationError{Name: "completion_tokens", err: fmt.Errorf(`ent: validator failed for field "AIChatMessage.completion_tokens": %w`, err)}
+		}
+	}
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AIChatMessage.session"`)
 	}
@@ -458,6 +686,38 @@ func (_u *AIChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *AIChatMes
 	if _u.mutation.ModelCleared() {
 		_spec.ClearField(aichatmessage.FieldModel, field.TypeString)
 	}
+	if value, ok := _u.mutation.Citations(); ok {
+		_spec.SetField(aichatmessage.FieldCitations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCitations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, aichatmessage.FieldCitations, value)
+		})
+	}
+	if _u.mutation.CitationsCleared() {
+		_spec.ClearField(aichatmessage.FieldCitations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aichatmessage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aichatmessage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(aichatmessage.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(aichatmessage.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SummaryUntil(); ok {
+		_spec.SetField(aichatmessage.FieldSummaryUntil, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSummaryUntil(); ok {
+		_spec.AddField(aichatmessage.FieldSummaryUntil, field.TypeInt, value)
+	}
+	if _u.mutation.SummaryUntilCleared() {
+		_spec.ClearField(aichatmessage.FieldSummaryUntil, field.TypeInt)
+	}
 	if _u.mutation.SessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
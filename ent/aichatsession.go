@@ -26,6 +26,16 @@ type AIChatSession struct {
 	UserID int `json:"user_id,omitempty"`
 	// 会话标题
 	Title string `json:"title,omitempty"`
+	// 选用的模型 ID，为空或模型不可用时使用默认模型
+	ModelID *int `json:"model_id,omitempty"`
+	// 绑定的人设 ID
+	PersonaID *int `json:"persona_id,omitempty"`
+	// 会话系统提示词，优先于人设
+	SystemPrompt string `json:"system_prompt,omitempty"`
+	// 温度覆盖值
+	Temperature *float64 `json:"temperature,omitempty"`
+	// 最大令牌数覆盖值
+	MaxTokens *int `json:"max_tokens,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AIChatSessionQuery when eager-loading is set.
 	Edges        AIChatSessionEdges `json:"edges"`
@@ -68,9 +78,11 @@ func (*AIChatSession) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case aichatsession.FieldID, aichatsession.FieldUserID:
+		case aichatsession.FieldTemperature:
+			values[i] = new(sql.NullFloat64)
+		case aichatsession.FieldID, aichatsession.FieldUserID, aichatsession.FieldModelID, aichatsession.FieldPersonaID, aichatsession.FieldMaxTokens:
 			values[i] = new(sql.NullInt64)
-		case aichatsession.FieldTitle:
+		case aichatsession.FieldTitle, aichatsession.FieldSystemPrompt:
 			values[i] = new(sql.NullString)
 		case aichatsession.FieldCreatedAt, aichatsession.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -119,6 +131,40 @@ func (_m *AIChatSession) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Title = value.String
 			}
+		case aichatsession.FieldModelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				_m.ModelID = new(int)
+				*_m.ModelID = int(value.Int64)
+			}
+		case aichatsession.FieldPersonaID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field persona_id", values[i])
+			} else if value.Valid {
+				_m.PersonaID = new(int)
+				*_m.PersonaID = int(value.Int64)
+			}
+		case aichatsession.FieldSystemPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field system_prompt", values[i])
+			} else if value.Valid {
+				_m.SystemPrompt = value.String
+			}
+		case aichatsession.FieldTemperature:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field temperature", values[i])
+			} else if value.Valid {
+				_m.Temperature = new(float64)
+				*_m.Temperature = value.Float64
+			}
+		case aichatsession.FieldMaxTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_tokens", values[i])
+			} else if value.Valid {
+				_m.MaxTokens = new(int)
+				*_m.MaxTokens = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +222,29 @@ func (_m *AIChatSession) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	if v := _m.ModelID; v != nil {
+		builder.WriteString("model_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PersonaID; v != nil {
+		builder.WriteString("persona_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("system_prompt=")
+	builder.WriteString(_m.SystemPrompt)
+	builder.WriteString(", ")
+	if v := _m.Temperature; v != nil {
+		builder.WriteString("temperature=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxTokens; v != nil {
+		builder.WriteString("max_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
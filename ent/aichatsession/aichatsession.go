@@ -22,6 +22,16 @@ const (
 	FieldUserID = "user_id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldPersonaID holds the string denoting the persona_id field in the database.
+	FieldPersonaID = "persona_id"
+	// FieldSystemPrompt holds the string denoting the system_prompt field in the database.
+	FieldSystemPrompt = "system_prompt"
+	// FieldTemperature holds the string denoting the temperature field in the database.
+	FieldTemperature = "temperature"
+	// FieldMaxTokens holds the string denoting the max_tokens field in the database.
+	FieldMaxTokens = "max_tokens"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
@@ -51,6 +61,11 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldUserID,
 	FieldTitle,
+	FieldModelID,
+	FieldPersonaID,
+	FieldSystemPrompt,
+	FieldTemperature,
+	FieldMaxTokens,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -106,6 +121,31 @@ func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// ByModelID orders the results by the model_id field.
+func ByModelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelID, opts...).ToFunc()
+}
+
+// ByPersonaID orders the results by the persona_id field.
+func ByPersonaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPersonaID, opts...).ToFunc()
+}
+
+// BySystemPrompt orders the results by the system_prompt field.
+func BySystemPrompt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSystemPrompt, opts...).ToFunc()
+}
+
+// ByTemperature orders the results by the temperature field.
+func ByTemperature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemperature, opts...).ToFunc()
+}
+
+// ByMaxTokens orders the results by the max_tokens field.
+func ByMaxTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxTokens, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
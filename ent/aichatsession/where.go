@@ -75,6 +75,31 @@ func Title(v string) predicate.AIChatSession {
 	return predicate.AIChatSession(sql.FieldEQ(FieldTitle, v))
 }
 
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldModelID, v))
+}
+
+// PersonaID applies equality check predicate on the "persona_id" field. It's identical to PersonaIDEQ.
+func PersonaID(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldPersonaID, v))
+}
+
+// SystemPrompt applies equality check predicate on the "system_prompt" field. It's identical to SystemPromptEQ.
+func SystemPrompt(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldSystemPrompt, v))
+}
+
+// Temperature applies equality check predicate on the "temperature" field. It's identical to TemperatureEQ.
+func Temperature(v float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldTemperature, v))
+}
+
+// MaxTokens applies equality check predicate on the "max_tokens" field. It's identical to MaxTokensEQ.
+func MaxTokens(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldMaxTokens, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AIChatSession {
 	return predicate.AIChatSession(sql.FieldEQ(FieldCreatedAt, v))
@@ -240,6 +265,281 @@ func TitleContainsFold(v string) predicate.AIChatSession {
 	return predicate.AIChatSession(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldModelID, v))
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNEQ(FieldModelID, v))
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIn(FieldModelID, vs...))
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotIn(FieldModelID, vs...))
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGT(FieldModelID, v))
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGTE(FieldModelID, v))
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLT(FieldModelID, v))
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLTE(FieldModelID, v))
+}
+
+// ModelIDIsNil applies the IsNil predicate on the "model_id" field.
+func ModelIDIsNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIsNull(FieldModelID))
+}
+
+// ModelIDNotNil applies the NotNil predicate on the "model_id" field.
+func ModelIDNotNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotNull(FieldModelID))
+}
+
+// PersonaIDEQ applies the EQ predicate on the "persona_id" field.
+func PersonaIDEQ(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldPersonaID, v))
+}
+
+// PersonaIDNEQ applies the NEQ predicate on the "persona_id" field.
+func PersonaIDNEQ(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNEQ(FieldPersonaID, v))
+}
+
+// PersonaIDIn applies the In predicate on the "persona_id" field.
+func PersonaIDIn(vs ...int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIn(FieldPersonaID, vs...))
+}
+
+// PersonaIDNotIn applies the NotIn predicate on the "persona_id" field.
+func PersonaIDNotIn(vs ...int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotIn(FieldPersonaID, vs...))
+}
+
+// PersonaIDGT applies the GT predicate on the "persona_id" field.
+func PersonaIDGT(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGT(FieldPersonaID, v))
+}
+
+// PersonaIDGTE applies the GTE predicate on the "persona_id" field.
+func PersonaIDGTE(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGTE(FieldPersonaID, v))
+}
+
+// PersonaIDLT applies the LT predicate on the "persona_id" field.
+func PersonaIDLT(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLT(FieldPersonaID, v))
+}
+
+// PersonaIDLTE applies the LTE predicate on the "persona_id" field.
+func PersonaIDLTE(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLTE(FieldPersonaID, v))
+}
+
+// PersonaIDIsNil applies the IsNil predicate on the "persona_id" field.
+func PersonaIDIsNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIsNull(FieldPersonaID))
+}
+
+// PersonaIDNotNil applies the NotNil predicate on the "persona_id" field.
+func PersonaIDNotNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotNull(FieldPersonaID))
+}
+
+// SystemPromptEQ applies the EQ predicate on the "system_prompt" field.
+func SystemPromptEQ(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldSystemPrompt, v))
+}
+
+// SystemPromptNEQ applies the NEQ predicate on the "system_prompt" field.
+func SystemPromptNEQ(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNEQ(FieldSystemPrompt, v))
+}
+
+// SystemPromptIn applies the In predicate on the "system_prompt" field.
+func SystemPromptIn(vs ...string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIn(FieldSystemPrompt, vs...))
+}
+
+// SystemPromptNotIn applies the NotIn predicate on the "system_prompt" field.
+func SystemPromptNotIn(vs ...string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotIn(FieldSystemPrompt, vs...))
+}
+
+// SystemPromptGT applies the GT predicate on the "system_prompt" field.
+func SystemPromptGT(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGT(FieldSystemPrompt, v))
+}
+
+// SystemPromptGTE applies the GTE predicate on the "system_prompt" field.
+func SystemPromptGTE(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGTE(FieldSystemPrompt, v))
+}
+
+// SystemPromptLT applies the LT predicate on the "system_prompt" field.
+func SystemPromptLT(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLT(FieldSystemPrompt, v))
+}
+
+// SystemPromptLTE applies the LTE predicate on the "system_prompt" field.
+func SystemPromptLTE(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLTE(FieldSystemPrompt, v))
+}
+
+// SystemPromptContains applies the Contains predicate on the "system_prompt" field.
+func SystemPromptContains(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldContains(FieldSystemPrompt, v))
+}
+
+// SystemPromptHasPrefix applies the HasPrefix predicate on the "system_prompt" field.
+func SystemPromptHasPrefix(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldHasPrefix(FieldSystemPrompt, v))
+}
+
+// SystemPromptHasSuffix applies the HasSuffix predicate on the "system_prompt" field.
+func SystemPromptHasSuffix(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldHasSuffix(FieldSystemPrompt, v))
+}
+
+// SystemPromptIsNil applies the IsNil predicate on the "system_prompt" field.
+func SystemPromptIsNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIsNull(FieldSystemPrompt))
+}
+
+// SystemPromptNotNil applies the NotNil predicate on the "system_prompt" field.
+func SystemPromptNotNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotNull(FieldSystemPrompt))
+}
+
+// SystemPromptEqualFold applies the EqualFold predicate on the "system_prompt" field.
+func SystemPromptEqualFold(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEqualFold(FieldSystemPrompt, v))
+}
+
+// SystemPromptContainsFold applies the ContainsFold predicate on the "system_prompt" field.
+func SystemPromptContainsFold(v string) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldContainsFold(FieldSystemPrompt, v))
+}
+
+// TemperatureEQ applies the EQ predicate on the "temperature" field.
+func TemperatureEQ(v float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldTemperature, v))
+}
+
+// TemperatureNEQ applies the NEQ predicate on the "temperature" field.
+func TemperatureNEQ(v float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNEQ(FieldTemperature, v))
+}
+
+// TemperatureIn applies the In predicate on the "temperature" field.
+func TemperatureIn(vs ...float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIn(FieldTemperature, vs...))
+}
+
+// TemperatureNotIn applies the NotIn predicate on the "temperature" field.
+func TemperatureNotIn(vs ...float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotIn(FieldTemperature, vs...))
+}
+
+// TemperatureGT applies the GT predicate on the "temperature" field.
+func TemperatureGT(v float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGT(FieldTemperature, v))
+}
+
+// TemperatureGTE applies the GTE predicate on the "temperature" field.
+func TemperatureGTE(v float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGTE(FieldTemperature, v))
+}
+
+// TemperatureLT applies the LT predicate on the "temperature" field.
+func TemperatureLT(v float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLT(FieldTemperature, v))
+}
+
+// TemperatureLTE applies the LTE predicate on the "temperature" field.
+func TemperatureLTE(v float64) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLTE(FieldTemperature, v))
+}
+
+// TemperatureIsNil applies the IsNil predicate on the "temperature" field.
+func TemperatureIsNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIsNull(FieldTemperature))
+}
+
+// TemperatureNotNil applies the NotNil predicate on the "temperature" field.
+func TemperatureNotNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotNull(FieldTemperature))
+}
+
+// MaxTokensEQ applies the EQ predicate on the "max_tokens" field.
+func MaxTokensEQ(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldEQ(FieldMaxTokens, v))
+}
+
+// MaxTokensNEQ applies the NEQ predicate on the "max_tokens" field.
+func MaxTokensNEQ(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNEQ(FieldMaxTokens, v))
+}
+
+// MaxTokensIn applies the In predicate on the "max_tokens" field.
+func MaxTokensIn(vs ...int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIn(FieldMaxTokens, vs...))
+}
+
+// MaxTokensNotIn applies the NotIn predicate on the "max_tokens" field.
+func MaxTokensNotIn(vs ...int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotIn(FieldMaxTokens, vs...))
+}
+
+// MaxTokensGT applies the GT predicate on the "max_tokens" field.
+func MaxTokensGT(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGT(FieldMaxTokens, v))
+}
+
+// MaxTokensGTE applies the GTE predicate on the "max_tokens" field.
+func MaxTokensGTE(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldGTE(FieldMaxTokens, v))
+}
+
+// MaxTokensLT applies the LT predicate on the "max_tokens" field.
+func MaxTokensLT(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLT(FieldMaxTokens, v))
+}
+
+// MaxTokensLTE applies the LTE predicate on the "max_tokens" field.
+func MaxTokensLTE(v int) predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldLTE(FieldMaxTokens, v))
+}
+
+// MaxTokensIsNil applies the IsNil predicate on the "max_tokens" field.
+func MaxTokensIsNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldIsNull(FieldMaxTokens))
+}
+
+// MaxTokensNotNil applies the NotNil predicate on the "max_tokens" field.
+func MaxTokensNotNil() predicate.AIChatSession {
+	return predicate.AIChatSession(sql.FieldNotNull(FieldMaxTokens))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.AIChatSession {
 	return predicate.AIChatSession(func(s *sql.Selector) {
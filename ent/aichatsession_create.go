@@ -70,6 +70,76 @@ func (_c *AIChatSessionCreate) SetNillableTitle(v *string) *AIChatSessionCreate
 	return _c
 }
 
+// SetModelID sets the "model_id" field.
+func (_c *AIChatSessionCreate) SetModelID(v int) *AIChatSessionCreate {
+	_c.mutation.SetModelID(v)
+	return _c
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (_c *AIChatSessionCreate) SetNillableModelID(v *int) *AIChatSessionCreate {
+	if v != nil {
+		_c.SetModelID(*v)
+	}
+	return _c
+}
+
+// SetPersonaID sets the "persona_id" field.
+func (_c *AIChatSessionCreate) SetPersonaID(v int) *AIChatSessionCreate {
+	_c.mutation.SetPersonaID(v)
+	return _c
+}
+
+// SetNillablePersonaID sets the "persona_id" field if the given value is not nil.
+func (_c *AIChatSessionCreate) SetNillablePersonaID(v *int) *AIChatSessionCreate {
+	if v != nil {
+		_c.SetPersonaID(*v)
+	}
+	return _c
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (_c *AIChatSessionCreate) SetSystemPrompt(v string) *AIChatSessionCreate {
+	_c.mutation.SetSystemPrompt(v)
+	return _c
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (_c *AIChatSessionCreate) SetNillableSystemPrompt(v *string) *AIChatSessionCreate {
+	if v != nil {
+		_c.SetSystemPrompt(*v)
+	}
+	return _c
+}
+
+// SetTemperature sets the "temperature" field.
+func (_c *AIChatSessionCreate) SetTemperature(v float64) *AIChatSessionCreate {
+	_c.mutation.SetTemperature(v)
+	return _c
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (_c *AIChatSessionCreate) SetNillableTemperature(v *float64) *AIChatSessionCreate {
+	if v != nil {
+		_c.SetTemperature(*v)
+	}
+	return _c
+}
+
+// SetMaxTokens sets the "max_tokens" field.
+func (_c *AIChatSessionCreate) SetMaxTokens(v int) *AIChatSessionCreate {
+	_c.mutation.SetMaxTokens(v)
+	return _c
+}
+
+// SetNillableMaxTokens sets the "max_tokens" field if the given value is not nil.
+func (_c *AIChatSessionCreate) SetNillableMaxTokens(v *int) *AIChatSessionCreate {
+	if v != nil {
+		_c.SetMaxTokens(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AIChatSessionCreate) SetID(v int) *AIChatSessionCreate {
 	_c.mutation.SetID(v)
@@ -216,6 +286,26 @@ func (_c *AIChatSessionCreate) createSpec() (*AIChatSession, *sqlgraph.CreateSpe
 		_spec.SetField(aichatsession.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.ModelID(); ok {
+		_spec.SetField(aichatsession.FieldModelID, field.TypeInt, value)
+		_node.ModelID = &value
+	}
+	if value, ok := _c.mutation.PersonaID(); ok {
+		_spec.SetField(aichatsession.FieldPersonaID, field.TypeInt, value)
+		_node.PersonaID = &value
+	}
+	if value, ok := _c.mutation.SystemPrompt(); ok {
+		_spec.SetField(aichatsession.FieldSystemPrompt, field.TypeString, value)
+		_node.SystemPrompt = value
+	}
+	if value, ok := _c.mutation.Temperature(); ok {
+		_spec.SetField(aichatsession.FieldTemperature, field.TypeFloat64, value)
+		_node.Temperature = &value
+	}
+	if value, ok := _c.mutation.MaxTokens(); ok {
+		_spec.SetField(aichatsession.FieldMaxTokens, field.TypeInt, value)
+		_node.MaxTokens = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
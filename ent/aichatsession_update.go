@@ -64,6 +64,134 @@ func (_u *AIChatSessionUpdate) SetNillableTitle(v *string) *AIChatSessionUpdate
 	return _u
 }
 
+// SetModelID sets the "model_id" field.
+func (_u *AIChatSessionUpdate) SetModelID(v int) *AIChatSessionUpdate {
+	_u.mutation.ResetModelID()
+	_u.mutation.SetModelID(v)
+	return _u
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (_u *AIChatSessionUpdate) SetNillableModelID(v *int) *AIChatSessionUpdate {
+	if v != nil {
+		_u.SetModelID(*v)
+	}
+	return _u
+}
+
+// AddModelID adds value to the "model_id" field.
+func (_u *AIChatSessionUpdate) AddModelID(v int) *AIChatSessionUpdate {
+	_u.mutation.AddModelID(v)
+	return _u
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (_u *AIChatSessionUpdate) ClearModelID() *AIChatSessionUpdate {
+	_u.mutation.ClearModelID()
+	return _u
+}
+
+// SetPersonaID sets the "persona_id" field.
+func (_u *AIChatSessionUpdate) SetPersonaID(v int) *AIChatSessionUpdate {
+	_u.mutation.ResetPersonaID()
+	_u.mutation.SetPersonaID(v)
+	return _u
+}
+
+// SetNillablePersonaID sets the "persona_id" field if the given value is not nil.
+func (_u *AIChatSessionUpdate) SetNillablePersonaID(v *int) *AIChatSessionUpdate {
+	if v != nil {
+		_u.SetPersonaID(*v)
+	}
+	return _u
+}
+
+// AddPersonaID adds value to the "persona_id" field.
+func (_u *AIChatSessionUpdate) AddPersonaID(v int) *AIChatSessionUpdate {
+	_u.mutation.AddPersonaID(v)
+	return _u
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (_u *AIChatSessionUpdate) ClearPersonaID() *AIChatSessionUpdate {
+	_u.mutation.ClearPersonaID()
+	return _u
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (_u *AIChatSessionUpdate) SetSystemPrompt(v string) *AIChatSessionUpdate {
+	_u.mutation.SetSystemPrompt(v)
+	return _u
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (_u *AIChatSessionUpdate) SetNillableSystemPrompt(v *string) *AIChatSessionUpdate {
+	if v != nil {
+		_u.SetSystemPrompt(*v)
+	}
+	return _u
+}
+
+// ClearSystemPrompt clears the value of the "system_prompt" field.
+func (_u *AIChatSessionUpdate) ClearSystemPrompt() *AIChatSessionUpdate {
+	_u.mutation.ClearSystemPrompt()
+	return _u
+}
+
+// SetTemperature sets the "temperature" field.
+func (_u *AIChatSessionUpdate) SetTemperature(v float64) *AIChatSessionUpdate {
+	_u.mutation.ResetTemperature()
+	_u.mutation.SetTemperature(v)
+	return _u
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (_u *AIChatSessionUpdate) SetNillableTemperature(v *float64) *AIChatSessionUpdate {
+	if v != nil {
+		_u.SetTemperature(*v)
+	}
+	return _u
+}
+
+// AddTemperature adds value to the "temperature" field.
+func (_u *AIChatSessionUpdate) AddTemperature(v float64) *AIChatSessionUpdate {
+	_u.mutation.AddTemperature(v)
+	return _u
+}
+
+// ClearTemperature clears the value of the "temperature" field.
+func (_u *AIChatSessionUpdate) ClearTemperature() *AIChatSessionUpdate {
+	_u.mutation.ClearTemperature()
+	return _u
+}
+
+// SetMaxTokens sets the "max_tokens" field.
+func (_u *AIChatSessionUpdate) SetMaxTokens(v int) *AIChatSessionUpdate {
+	_u.mutation.ResetMaxTokens()
+	_u.mutation.SetMaxTokens(v)
+	return _u
+}
+
+// SetNillableMaxTokens sets the "max_tokens" field if the given value is not nil.
+func (_u *AIChatSessionUpdate) SetNillableMaxTokens(v *int) *AIChatSessionUpdate {
+	if v != nil {
+		_u.SetMaxTokens(*v)
+	}
+	return _u
+}
+
+// AddMaxTokens adds value to the "max_tokens" field.
+func (_u *AIChatSessionUpdate) AddMaxTokens(v int) *AIChatSessionUpdate {
+	_u.mutation.AddMaxTokens(v)
+	return _u
+}
+
+// ClearMaxTokens clears the value of the "max_tokens" field.
+func (_u *AIChatSessionUpdate) ClearMaxTokens() *AIChatSessionUpdate {
+	_u.mutation.ClearMaxTokens()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *AIChatSessionUpdate) SetUser(v *User) *AIChatSessionUpdate {
 	return _u.SetUserID(v.ID)
@@ -188,6 +316,48 @@ func (_u *AIChatSessionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(aichatsession.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ModelID(); ok {
+		_spec.SetField(aichatsession.FieldModelID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedModelID(); ok {
+		_spec.AddField(aichatsession.FieldModelID, field.TypeInt, value)
+	}
+	if _u.mutation.ModelIDCleared() {
+		_spec.ClearField(aichatsession.FieldModelID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PersonaID(); ok {
+		_spec.SetField(aichatsession.FieldPersonaID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPersonaID(); ok {
+		_spec.AddField(aichatsession.FieldPersonaID, field.TypeInt, value)
+	}
+	if _u.mutation.PersonaIDCleared() {
+		_spec.ClearField(aichatsession.FieldPersonaID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SystemPrompt(); ok {
+		_spec.SetField(aichatsession.FieldSystemPrompt, field.TypeString, value)
+	}
+	if _u.mutation.SystemPromptCleared() {
+		_spec.ClearField(aichatsession.FieldSystemPrompt, field.TypeString)
+	}
+	if value, ok := _u.mutation.Temperature(); ok {
+		_spec.SetField(aichatsession.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTemperature(); ok {
+		_spec.AddField(aichatsession.FieldTemperature, field.TypeFloat64, value)
+	}
+	if _u.mutation.TemperatureCleared() {
+		_spec.ClearField(aichatsession.FieldTemperature, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxTokens(); ok {
+		_spec.SetField(aichatsession.FieldMaxTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokens(); ok {
+		_spec.AddField(aichatsession.FieldMaxTokens, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensCleared() {
+		_spec.ClearField(aichatsession.FieldMaxTokens, field.TypeInt)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -316,6 +486,134 @@ func (_u *AIChatSessionUpdateOne) SetNillableTitle(v *string) *AIChatSessionUpda
 	return _u
 }
 
+// SetModelID sets the "model_id" field.
+func (_u *AIChatSessionUpdateOne) SetModelID(v int) *AIChatSessionUpdateOne {
+	_u.mutation.ResetModelID()
+	_u.mutation.SetModelID(v)
+	return _u
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (_u *AIChatSessionUpdateOne) SetNillableModelID(v *int) *AIChatSessionUpdateOne {
+	if v != nil {
+		_u.SetModelID(*v)
+	}
+	return _u
+}
+
+// AddModelID adds value to the "model_id" field.
+func (_u *AIChatSessionUpdateOne) AddModelID(v int) *AIChatSessionUpdateOne {
+	_u.mutation.AddModelID(v)
+	return _u
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (_u *AIChatSessionUpdateOne) ClearModelID() *AIChatSessionUpdateOne {
+	_u.mutation.ClearModelID()
+	return _u
+}
+
+// SetPersonaID sets the "persona_id" field.
+func (_u *AIChatSessionUpdateOne) SetPersonaID(v int) *AIChatSessionUpdateOne {
+	_u.mutation.ResetPersonaID()
+	_u.mutation.SetPersonaID(v)
+	return _u
+}
+
+// SetNillablePersonaID sets the "persona_id" field if the given value is not nil.
+func (_u *AIChatSessionUpdateOne) SetNillablePersonaID(v *int) *AIChatSessionUpdateOne {
+	if v != nil {
+		_u.SetPersonaID(*v)
+	}
+	return _u
+}
+
+// AddPersonaID adds value to the "persona_id" field.
+func (_u *AIChatSessionUpdateOne) AddPersonaID(v int) *AIChatSessionUpdateOne {
+	_u.mutation.AddPersonaID(v)
+	return _u
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (_u *AIChatSessionUpdateOne) ClearPersonaID() *AIChatSessionUpdateOne {
+	_u.mutation.ClearPersonaID()
+	return _u
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (_u *AIChatSessionUpdateOne) SetSystemPrompt(v string) *AIChatSessionUpdateOne {
+	_u.mutation.SetSystemPrompt(v)
+	return _u
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (_u *AIChatSessionUpdateOne) SetNillableSystemPrompt(v *string) *AIChatSessionUpdateOne {
+	if v != nil {
+		_u.SetSystemPrompt(*v)
+	}
+	return _u
+}
+
+// ClearSystemPrompt clears the value of the "system_prompt" field.
+func (_u *AIChatSessionUpdateOne) ClearSystemPrompt() *AIChatSessionUpdateOne {
+	_u.mutation.ClearSystemPrompt()
+	return _u
+}
+
+// SetTemperature sets the "temperature" field.
+func (_u *AIChatSessionUpdateOne) SetTemperature(v float64) *AIChatSessionUpdateOne {
+	_u.mutation.ResetTemperature()
+	_u.mutation.SetTemperature(v)
+	return _u
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (_u *AIChatSessionUpdateOne) SetNillableTemperature(v *float64) *AIChatSessionUpdateOne {
+	if v != nil {
+		_u.SetTemperature(*v)
+	}
+	return _u
+}
+
+// AddTemperature adds value to the "temperature" field.
+func (_u *AIChatSessionUpdateOne) AddTemperature(v float64) *AIChatSessionUpdateOne {
+	_u.mutation.AddTemperature(v)
+	return _u
+}
+
+// ClearTemperature clears the value of the "temperature" field.
+func (_u *AIChatSessionUpdateOne) ClearTemperature() *AIChatSessionUpdateOne {
+	_u.mutation.ClearTemperature()
+	return _u
+}
+
+// SetMaxTokens sets the "max_tokens" field.
+func (_u *AIChatSessionUpdateOne) SetMaxTokens(v int) *AIChatSessionUpdateOne {
+	_u.mutation.ResetMaxTokens()
+	_u.mutation.SetMaxTokens(v)
+	return _u
+}
+
+// SetNillableMaxTokens sets the "max_tokens" field if the given value is not nil.
+func (_u *AIChatSessionUpdateOne) SetNillableMaxTokens(v *int) *AIChatSessionUpdateOne {
+	if v != nil {
+		_u.SetMaxTokens(*v)
+	}
+	return _u
+}
+
+// AddMaxTokens adds value to the "max_tokens" field.
+func (_u *AIChatSessionUpdateOne) AddMaxTokens(v int) *AIChatSessionUpdateOne {
+	_u.mutation.AddMaxTokens(v)
+	return _u
+}
+
+// ClearMaxTokens clears the value of the "max_tokens" field.
+func (_u *AIChatSessionUpdateOne) ClearMaxTokens() *AIChatSessionUpdateOne {
+	_u.mutation.ClearMaxTokens()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *AIChatSessionUpdateOne) SetUser(v *User) *AIChatSessionUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -470,6 +768,48 @@ func (_u *AIChatSessionUpdateOne) sqlSave(ctx context.Context) (_node *AIChatSes
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(aichatsession.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ModelID(); ok {
+		_spec.SetField(aichatsession.FieldModelID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedModelID(); ok {
+		_spec.AddField(aichatsession.FieldModelID, field.TypeInt, value)
+	}
+	if _u.mutation.ModelIDCleared() {
+		_spec.ClearField(aichatsession.FieldModelID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PersonaID(); ok {
+		_spec.SetField(aichatsession.FieldPersonaID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPersonaID(); ok {
+		_spec.AddField(aichatsession.FieldPersonaID, field.TypeInt, value)
+	}
+	if _u.mutation.PersonaIDCleared() {
+		_spec.ClearField(aichatsession.FieldPersonaID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SystemPrompt(); ok {
+		_spec.SetField(aichatsession.FieldSystemPrompt, field.TypeString, value)
+	}
+	if _u.mutation.SystemPromptCleared() {
+		_spec.ClearField(aichatsession.FieldSystemPrompt, field.TypeString)
+	}
+	if value, ok := _u.mutation.Temperature(); ok {
+		_spec.SetField(aichatsession.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTemperature(); ok {
+		_spec.AddField(aichatsession.FieldTemperature, field.TypeFloat64, value)
+	}
+	if _u.mutation.TemperatureCleared() {
+		_spec.ClearField(aichatsession.FieldTemperature, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxTokens(); ok {
+		_spec.SetField(aichatsession.FieldMaxTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokens(); ok {
+		_spec.AddField(aichatsession.FieldMaxTokens, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensCleared() {
+		_spec.ClearField(aichatsession.FieldMaxTokens, field.TypeInt)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -32,6 +32,8 @@ type AIModel struct {
 	IsEnabled bool `json:"is_enabled,omitempty"`
 	// 排序值，越小越靠前
 	Sort int `json:"sort,omitempty"`
+	// 上下文窗口令牌数，0 表示只使用全局上下文预算
+	ContextWindow int `json:"context_window,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AIModelQuery when eager-loading is set.
 	Edges        AIModelEdges `json:"edges"`
@@ -65,7 +67,7 @@ func (*AIModel) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case aimodel.FieldIsEnabled:
 			values[i] = new(sql.NullBool)
-		case aimodel.FieldID, aimodel.FieldProviderID, aimodel.FieldSort:
+		case aimodel.FieldID, aimodel.FieldProviderID, aimodel.FieldSort, aimodel.FieldContextWindow:
 			values[i] = new(sql.NullInt64)
 		case aimodel.FieldModelName, aimodel.FieldDisplayName:
 			values[i] = new(sql.NullString)
@@ -134,6 +136,12 @@ func (_m *AIModel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Sort = int(value.Int64)
 			}
+		case aimodel.FieldContextWindow:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field context_window", values[i])
+			} else if value.Valid {
+				_m.ContextWindow = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -195,6 +203,9 @@ func (_m *AIModel) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("sort=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteString(", ")
+	builder.WriteString("context_window=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContextWindow))
 	builder.WriteByte(')')
 	return builder.String()
 }
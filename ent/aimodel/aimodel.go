@@ -28,6 +28,8 @@ const (
 	FieldIsEnabled = "is_enabled"
 	// FieldSort holds the string denoting the sort field in the database.
 	FieldSort = "sort"
+	// FieldContextWindow holds the string denoting the context_window field in the database.
+	FieldContextWindow = "context_window"
 	// EdgeProvider holds the string denoting the provider edge name in mutations.
 	EdgeProvider = "provider"
 	// Table holds the table name of the aimodel in the database.
@@ -51,6 +53,7 @@ var Columns = []string{
 	FieldDisplayName,
 	FieldIsEnabled,
 	FieldSort,
+	FieldContextWindow,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +83,10 @@ var (
 	DefaultIsEnabled bool
 	// DefaultSort holds the default value on creation for the "sort" field.
 	DefaultSort int
+	// DefaultContextWindow holds the default value on creation for the "context_window" field.
+	DefaultContextWindow int
+	// ContextWindowValidator is a validator for the "context_window" field. It is called by the builders before save.
+	ContextWindowValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the AIModel queries.
@@ -125,6 +132,11 @@ func BySort(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSort, opts...).ToFunc()
 }
 
+// ByContextWindow orders the results by the context_window field.
+func ByContextWindow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContextWindow, opts...).ToFunc()
+}
+
 // ByProviderField orders the results by provider field.
 func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
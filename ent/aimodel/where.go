@@ -90,6 +90,11 @@ func Sort(v int) predicate.AIModel {
 	return predicate.AIModel(sql.FieldEQ(FieldSort, v))
 }
 
+// ContextWindow applies equality check predicate on the "context_window" field. It's identical to ContextWindowEQ.
+func ContextWindow(v int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldEQ(FieldContextWindow, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AIModel {
 	return predicate.AIModel(sql.FieldEQ(FieldCreatedAt, v))
@@ -380,6 +385,46 @@ func SortLTE(v int) predicate.AIModel {
 	return predicate.AIModel(sql.FieldLTE(FieldSort, v))
 }
 
+// ContextWindowEQ applies the EQ predicate on the "context_window" field.
+func ContextWindowEQ(v int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldEQ(FieldContextWindow, v))
+}
+
+// ContextWindowNEQ applies the NEQ predicate on the "context_window" field.
+func ContextWindowNEQ(v int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldNEQ(FieldContextWindow, v))
+}
+
+// ContextWindowIn applies the In predicate on the "context_window" field.
+func ContextWindowIn(vs ...int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldIn(FieldContextWindow, vs...))
+}
+
+// ContextWindowNotIn applies the NotIn predicate on the "context_window" field.
+func ContextWindowNotIn(vs ...int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldNotIn(FieldContextWindow, vs...))
+}
+
+// ContextWindowGT applies the GT predicate on the "context_window" field.
+func ContextWindowGT(v int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldGT(FieldContextWindow, v))
+}
+
+// ContextWindowGTE applies the GTE predicate on the "context_window" field.
+func ContextWindowGTE(v int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldGTE(FieldContextWindow, v))
+}
+
+// ContextWindowLT applies the LT predicate on the "context_window" field.
+func ContextWindowLT(v int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldLT(FieldContextWindow, v))
+}
+
+// ContextWindowLTE applies the LTE predicate on the "context_window" field.
+func ContextWindowLTE(v int) predicate.AIModel {
+	return predicate.AIModel(sql.FieldLTE(FieldContextWindow, v))
+}
+
 // HasProvider applies the HasEdge predicate on the "provider" edge.
 func HasProvider() predicate.AIModel {
 	return predicate.AIModel(func(s *sql.Selector) {
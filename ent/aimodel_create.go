@@ -103,6 +103,20 @@ func (_c *AIModelCreate) SetNillableSort(v *int) *AIModelCreate {
 	return _c
 }
 
+// SetContextWindow sets the "context_window" field.
+func (_c *AIModelCreate) SetContextWindow(v int) *AIModelCreate {
+	_c.mutation.SetContextWindow(v)
+	return _c
+}
+
+// SetNillableContextWindow sets the "context_window" field if the given value is not nil.
+func (_c *AIModelCreate) SetNillableContextWindow(v *int) *AIModelCreate {
+	if v != nil {
+		_c.SetContextWindow(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AIModelCreate) SetID(v int) *AIModelCreate {
 	_c.mutation.SetID(v)
@@ -165,6 +179,10 @@ func (_c *AIModelCreate) defaults() {
 		v := aimodel.DefaultSort
 		_c.mutation.SetSort(v)
 	}
+	if _, ok := _c.mutation.ContextWindow(); !ok {
+		v := aimodel.DefaultContextWindow
+		_c.mutation.SetContextWindow(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -202,6 +220,14 @@ func (_c *AIModelCreate) check() error {
 	if _, ok := _c.mutation.Sort(); !ok {
 		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "AIModel.sort"`)}
 	}
+	if _, ok := _c.mutation.ContextWindow(); !ok {
+		return &ValidationError{Name: "context_window", err: errors.New(`ent: missing required field "AIModel.context_window"`)}
+	}
+	if v, ok := _c.mutation.ContextWindow(); ok {
+		if err := aimodel.ContextWindowValidator(v); err != nil {
+			return &ValidationError{Name: "context_window", err: fmt.Errorf(`ent: validator failed for field "AIModel.context_window": %w`, err)}
+		}
+	}
 	if len(_c.mutation.ProviderIDs()) == 0 {
 		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "AIModel.provider"`)}
 	}
@@ -261,6 +287,10 @@ func (_c *AIModelCreate) createSpec() (*AIModel, *sqlgraph.CreateSpec) {
 		_spec.SetField(aimodel.FieldSort, field.TypeInt, value)
 		_node.Sort = value
 	}
+	if value, ok := _c.mutation.ContextWindow(); ok {
+		_spec.SetField(aimodel.FieldContextWindow, field.TypeInt, value)
+		_node.ContextWindow = value
+	}
 	if nodes := _c.mutation.ProviderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -118,6 +118,27 @@ func (_u *AIModelUpdate) AddSort(v int) *AIModelUpdate {
 	return _u
 }
 
+// SetContextWindow sets the "context_window" field.
+func (_u *AIModelUpdate) SetContextWindow(v int) *AIModelUpdate {
+	_u.mutation.ResetContextWindow()
+	_u.mutation.SetContextWindow(v)
+	return _u
+}
+
+// SetNillableContextWindow sets the "context_window" field if the given value is not nil.
+func (_u *AIModelUpdate) SetNillableContextWindow(v *int) *AIModelUpdate {
+	if v != nil {
+		_u.SetContextWindow(*v)
+	}
+	return _u
+}
+
+// AddContextWindow adds value to the "context_window" field.
+func (_u *AIModelUpdate) AddContextWindow(v int) *AIModelUpdate {
+	_u.mutation.AddContextWindow(v)
+	return _u
+}
+
 // SetProvider sets the "provider" edge to the AIProvider entity.
 func (_u *AIModelUpdate) SetProvider(v *AIProvider) *AIModelUpdate {
 	return _u.SetProviderID(v.ID)
@@ -187,6 +208,11 @@ func (_u *AIModelUpdate) check() error {
 			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "AIModel.display_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ContextWindow(); ok {
+		if err := aimodel.ContextWindowValidator(v); err != nil {
+			return &ValidationError{Name: "context_window", err: fmt.Errorf(`ent: validator failed for field "AIModel.context_window": %w`, err)}
+		}
+	}
 	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AIModel.provider"`)
 	}
@@ -226,6 +252,12 @@ func (_u *AIModelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSort(); ok {
 		_spec.AddField(aimodel.FieldSort, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ContextWindow(); ok {
+		_spec.SetField(aimodel.FieldContextWindow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedContextWindow(); ok {
+		_spec.AddField(aimodel.FieldContextWindow, field.TypeInt, value)
+	}
 	if _u.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -364,6 +396,27 @@ func (_u *AIModelUpdateOne) AddSort(v int) *AIModelUpdateOne {
 	return _u
 }
 
+// SetContextWindow sets the "context_window" field.
+func (_u *AIModelUpdateOne) SetContextWindow(v int) *AIModelUpdateOne {
+	_u.mutation.ResetContextWindow()
+	_u.mutation.SetContextWindow(v)
+	return _u
+}
+
+// SetNillableContextWindow sets the "context_window" field if the given value is not nil.
+func (_u *AIModelUpdateOne) SetNillableContextWindow(v *int) *AIModelUpdateOne {
+	if v != nil {
+		_u.SetContextWindow(*v)
+	}
+	return _u
+}
+
+// AddContextWindow adds value to the "context_window" field.
+func (_u *AIModelUpdateOne) AddContextWindow(v int) *AIModelUpdateOne {
+	_u.mutation.AddContextWindow(v)
+	return _u
+}
+
 // SetProvider sets the "provider" edge to the AIProvider entity.
 func (_u *AIModelUpdateOne) SetProvider(v *AIProvider) *AIModelUpdateOne {
 	return _u.SetProviderID(v.ID)
@@ -446,6 +499,11 @@ func (_u *AIModelUpdateOne) check() error {
 			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "AIModel.display_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ContextWindow(); ok {
+		if err := aimodel.ContextWindowValidator(v); err != nil {
+			return &ValidationError{Name: "context_window", err: fmt.Errorf(`ent: validator failed for field "AIModel.context_window": %w`, err)}
+		}
+	}
 	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AIModel.provider"`)
 	}
@@ -502,6 +560,12 @@ func (_u *AIModelUpdateOne) sqlSave(ctx context.Context) (_node *AIModel, err er
 	if value, ok := _u.mutation.AddedSort(); ok {
 		_spec.AddField(aimodel.FieldSort, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ContextWindow(); ok {
+		_spec.SetField(aimodel.FieldContextWindow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedContextWindow(); ok {
+		_spec.AddField(aimodel.FieldContextWindow, field.TypeInt, value)
+	}
 	if _u.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shuTwT/hoshikuzu/ent/aipersona"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+)
+
+// AIPersona is the model entity for the AIPersona schema.
+type AIPersona struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 所属用户 ID
+	UserID int `json:"user_id,omitempty"`
+	// 人设名称
+	Name string `json:"name,omitempty"`
+	// 人设说明
+	Description string `json:"description,omitempty"`
+	// 系统提示词
+	SystemPrompt string `json:"system_prompt,omitempty"`
+	// 温度，为空时使用提供商设置
+	Temperature *float64 `json:"temperature,omitempty"`
+	// 最大令牌数，为空时使用提供商设置
+	MaxTokens *int `json:"max_tokens,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AIPersonaQuery when eager-loading is set.
+	Edges        AIPersonaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AIPersonaEdges holds the relations/edges for other nodes in the graph.
+type AIPersonaEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AIPersonaEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AIPersona) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case aipersona.FieldTemperature:
+			values[i] = new(sql.NullFloat64)
+		case aipersona.FieldID, aipersona.FieldUserID, aipersona.FieldMaxTokens:
+			values[i] = new(sql.NullInt64)
+		case aipersona.FieldName, aipersona.FieldDescription, aipersona.FieldSystemPrompt:
+			values[i] = new(sql.NullString)
+		case aipersona.FieldCreatedAt, aipersona.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AIPersona fields.
+func (_m *AIPersona) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case aipersona.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case aipersona.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case aipersona.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case aipersona.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case aipersona.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case aipersona.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case aipersona.FieldSystemPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field system_prompt", values[i])
+			} else if value.Valid {
+				_m.SystemPrompt = value.String
+			}
+		case aipersona.FieldTemperature:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field temperature", values[i])
+			} else if value.Valid {
+				_m.Temperature = new(float64)
+				*_m.Temperature = value.Float64
+			}
+		case aipersona.FieldMaxTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_tokens", values[i])
+			} else if value.Valid {
+				_m.MaxTokens = new(int)
+				*_m.MaxTokens = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AIPersona.
+// This includes values selected through modifiers, order, etc.
+func (_m *AIPersona) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the AIPersona entity.
+func (_m *AIPersona) QueryUser() *UserQuery {
+	return NewAIPersonaClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this AIPersona.
+// Note that you need to call AIPersona.Unwrap() before calling this method if this AIPersona
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AIPersona) Update() *AIPersonaUpdateOne {
+	return NewAIPersonaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AIPersona entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AIPersona) Unwrap() *AIPersona {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AIPersona is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AIPersona) String() string {
+	var builder strings.Builder
+	builder.WriteString("AIPersona(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("system_prompt=")
+	builder.WriteString(_m.SystemPrompt)
+	builder.WriteString(", ")
+	if v := _m.Temperature; v != nil {
+		builder.WriteString("temperature=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxTokens; v != nil {
+		builder.WriteString("max_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AIPersonas is a parsable slice of AIPersona.
+type AIPersonas []*AIPersona
@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package aipersona
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldDescription, v))
+}
+
+// SystemPrompt applies equality check predicate on the "system_prompt" field. It's identical to SystemPromptEQ.
+func SystemPrompt(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldSystemPrompt, v))
+}
+
+// Temperature applies equality check predicate on the "temperature" field. It's identical to TemperatureEQ.
+func Temperature(v float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldTemperature, v))
+}
+
+// MaxTokens applies equality check predicate on the "max_tokens" field. It's identical to MaxTokensEQ.
+func MaxTokens(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldMaxTokens, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SystemPromptEQ applies the EQ predicate on the "system_prompt" field.
+func SystemPromptEQ(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldSystemPrompt, v))
+}
+
+// SystemPromptNEQ applies the NEQ predicate on the "system_prompt" field.
+func SystemPromptNEQ(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldSystemPrompt, v))
+}
+
+// SystemPromptIn applies the In predicate on the "system_prompt" field.
+func SystemPromptIn(vs ...string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldSystemPrompt, vs...))
+}
+
+// SystemPromptNotIn applies the NotIn predicate on the "system_prompt" field.
+func SystemPromptNotIn(vs ...string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldSystemPrompt, vs...))
+}
+
+// SystemPromptGT applies the GT predicate on the "system_prompt" field.
+func SystemPromptGT(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldSystemPrompt, v))
+}
+
+// SystemPromptGTE applies the GTE predicate on the "system_prompt" field.
+func SystemPromptGTE(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldSystemPrompt, v))
+}
+
+// SystemPromptLT applies the LT predicate on the "system_prompt" field.
+func SystemPromptLT(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldSystemPrompt, v))
+}
+
+// SystemPromptLTE applies the LTE predicate on the "system_prompt" field.
+func SystemPromptLTE(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldSystemPrompt, v))
+}
+
+// SystemPromptContains applies the Contains predicate on the "system_prompt" field.
+func SystemPromptContains(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldContains(FieldSystemPrompt, v))
+}
+
+// SystemPromptHasPrefix applies the HasPrefix predicate on the "system_prompt" field.
+func SystemPromptHasPrefix(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldHasPrefix(FieldSystemPrompt, v))
+}
+
+// SystemPromptHasSuffix applies the HasSuffix predicate on the "system_prompt" field.
+func SystemPromptHasSuffix(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldHasSuffix(FieldSystemPrompt, v))
+}
+
+// SystemPromptEqualFold applies the EqualFold predicate on the "system_prompt" field.
+func SystemPromptEqualFold(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEqualFold(FieldSystemPrompt, v))
+}
+
+// SystemPromptContainsFold applies the ContainsFold predicate on the "system_prompt" field.
+func SystemPromptContainsFold(v string) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldContainsFold(FieldSystemPrompt, v))
+}
+
+// TemperatureEQ applies the EQ predicate on the "temperature" field.
+func TemperatureEQ(v float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldTemperature, v))
+}
+
+// TemperatureNEQ applies the NEQ predicate on the "temperature" field.
+func TemperatureNEQ(v float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldTemperature, v))
+}
+
+// TemperatureIn applies the In predicate on the "temperature" field.
+func TemperatureIn(vs ...float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldTemperature, vs...))
+}
+
+// TemperatureNotIn applies the NotIn predicate on the "temperature" field.
+func TemperatureNotIn(vs ...float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldTemperature, vs...))
+}
+
+// TemperatureGT applies the GT predicate on the "temperature" field.
+func TemperatureGT(v float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldTemperature, v))
+}
+
+// TemperatureGTE applies the GTE predicate on the "temperature" field.
+func TemperatureGTE(v float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldTemperature, v))
+}
+
+// TemperatureLT applies the LT predicate on the "temperature" field.
+func TemperatureLT(v float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldTemperature, v))
+}
+
+// TemperatureLTE applies the LTE predicate on the "temperature" field.
+func TemperatureLTE(v float64) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldTemperature, v))
+}
+
+// TemperatureIsNil applies the IsNil predicate on the "temperature" field.
+func TemperatureIsNil() predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIsNull(FieldTemperature))
+}
+
+// TemperatureNotNil applies the NotNil predicate on the "temperature" field.
+func TemperatureNotNil() predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotNull(FieldTemperature))
+}
+
+// MaxTokensEQ applies the EQ predicate on the "max_tokens" field.
+func MaxTokensEQ(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldEQ(FieldMaxTokens, v))
+}
+
+// MaxTokensNEQ applies the NEQ predicate on the "max_tokens" field.
+func MaxTokensNEQ(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNEQ(FieldMaxTokens, v))
+}
+
+// MaxTokensIn applies the In predicate on the "max_tokens" field.
+func MaxTokensIn(vs ...int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIn(FieldMaxTokens, vs...))
+}
+
+// MaxTokensNotIn applies the NotIn predicate on the "max_tokens" field.
+func MaxTokensNotIn(vs ...int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotIn(FieldMaxTokens, vs...))
+}
+
+// MaxTokensGT applies the GT predicate on the "max_tokens" field.
+func MaxTokensGT(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGT(FieldMaxTokens, v))
+}
+
+// MaxTokensGTE applies the GTE predicate on the "max_tokens" field.
+func MaxTokensGTE(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldGTE(FieldMaxTokens, v))
+}
+
+// MaxTokensLT applies the LT predicate on the "max_tokens" field.
+func MaxTokensLT(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLT(FieldMaxTokens, v))
+}
+
+// MaxTokensLTE applies the LTE predicate on the "max_tokens" field.
+func MaxTokensLTE(v int) predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldLTE(FieldMaxTokens, v))
+}
+
+// MaxTokensIsNil applies the IsNil predicate on the "max_tokens" field.
+func MaxTokensIsNil() predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldIsNull(FieldMaxTokens))
+}
+
+// MaxTokensNotNil applies the NotNil predicate on the "max_tokens" field.
+func MaxTokensNotNil() predicate.AIPersona {
+	return predicate.AIPersona(sql.FieldNotNull(FieldMaxTokens))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AIPersona {
+	return predicate.AIPersona(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AIPersona {
+	return predicate.AIPersona(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AIPersona) predicate.AIPersona {
+	return predicate.AIPersona(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AIPersona) predicate.AIPersona {
+	return predicate.AIPersona(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AIPersona) predicate.AIPersona {
+	return predicate.AIPersona(sql.NotPredicates(p))
+}
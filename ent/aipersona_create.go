@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/aipersona"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+)
+
+// AIPersonaCreate is the builder for creating a AIPersona entity.
+type AIPersonaCreate struct {
+	config
+	mutation *AIPersonaMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AIPersonaCreate) SetCreatedAt(v time.Time) *AIPersonaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AIPersonaCreate) SetNillableCreatedAt(v *time.Time) *AIPersonaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AIPersonaCreate) SetUpdatedAt(v time.Time) *AIPersonaCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AIPersonaCreate) SetNillableUpdatedAt(v *time.Time) *AIPersonaCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AIPersonaCreate) SetUserID(v int) *AIPersonaCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AIPersonaCreate) SetName(v string) *AIPersonaCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *AIPersonaCreate) SetDescription(v string) *AIPersonaCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *AIPersonaCreate) SetNillableDescription(v *string) *AIPersonaCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (_c *AIPersonaCreate) SetSystemPrompt(v string) *AIPersonaCreate {
+	_c.mutation.SetSystemPrompt(v)
+	return _c
+}
+
+// SetTemperature sets the "temperature" field.
+func (_c *AIPersonaCreate) SetTemperature(v float64) *AIPersonaCreate {
+	_c.mutation.SetTemperature(v)
+	return _c
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (_c *AIPersonaCreate) SetNillableTemperature(v *float64) *AIPersonaCreate {
+	if v != nil {
+		_c.SetTemperature(*v)
+	}
+	return _c
+}
+
+// SetMaxTokens sets the "max_tokens" field.
+func (_c *AIPersonaCreate) SetMaxTokens(v int) *AIPersonaCreate {
+	_c.mutation.SetMaxTokens(v)
+	return _c
+}
+
+// SetNillableMaxTokens sets the "max_tokens" field if the given value is not nil.
+func (_c *AIPersonaCreate) SetNillableMaxTokens(v *int) *AIPersonaCreate {
+	if v != nil {
+		_c.SetMaxTokens(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AIPersonaCreate) SetID(v int) *AIPersonaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AIPersonaCreate) SetUser(v *User) *AIPersonaCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the AIPersonaMutation object of the builder.
+func (_c *AIPersonaCreate) Mutation() *AIPersonaMutation {
+	return _c.mutation
+}
+
+// Save creates the AIPersona in the database.
+func (_c *AIPersonaCreate) Save(ctx context.Context) (*AIPersona, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AIPersonaCreate) SaveX(ctx context.Context) *AIPersona {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIPersonaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIPersonaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AIPersonaCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := aipersona.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := aipersona.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AIPersonaCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AIPersona.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AIPersona.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AIPersona.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := aipersona.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "AIPersona.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AIPersona.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := aipersona.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AIPersona.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := aipersona.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "AIPersona.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SystemPrompt(); !ok {
+		return &ValidationError{Name: "system_prompt", err: errors.New(`ent: missing required field "AIPersona.system_prompt"`)}
+	}
+	if v, ok := _c.mutation.SystemPrompt(); ok {
+		if err := aipersona.SystemPromptValidator(v); err != nil {
+			return &ValidationError{Name: "system_prompt", err: fmt.Errorf(`ent: validator failed for field "AIPersona.system_prompt": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AIPersona.user"`)}
+	}
+	return nil
+}
+
+func (_c *AIPersonaCreate) sqlSave(ctx context.Context) (*AIPersona, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AIPersonaCreate) createSpec() (*AIPersona, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AIPersona{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(aipersona.Table, sqlgraph.NewFieldSpec(aipersona.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(aipersona.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(aipersona.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(aipersona.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(aipersona.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.SystemPrompt(); ok {
+		_spec.SetField(aipersona.FieldSystemPrompt, field.TypeString, value)
+		_node.SystemPrompt = value
+	}
+	if value, ok := _c.mutation.Temperature(); ok {
+		_spec.SetField(aipersona.FieldTemperature, field.TypeFloat64, value)
+		_node.Temperature = &value
+	}
+	if value, ok := _c.mutation.MaxTokens(); ok {
+		_spec.SetField(aipersona.FieldMaxTokens, field.TypeInt, value)
+		_node.MaxTokens = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aipersona.UserTable,
+			Columns: []string{aipersona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AIPersonaCreateBulk is the builder for creating many AIPersona entities in bulk.
+type AIPersonaCreateBulk struct {
+	config
+	err      error
+	builders []*AIPersonaCreate
+}
+
+// Save creates the AIPersona entities in the database.
+func (_c *AIPersonaCreateBulk) Save(ctx context.Context) ([]*AIPersona, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AIPersona, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AIPersonaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AIPersonaCreateBulk) SaveX(ctx context.Context) []*AIPersona {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIPersonaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIPersonaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
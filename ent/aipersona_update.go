@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/aipersona"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+)
+
+// AIPersonaUpdate is the builder for updating AIPersona entities.
+type AIPersonaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AIPersonaMutation
+}
+
+// Where appends a list predicates to the AIPersonaUpdate builder.
+func (_u *AIPersonaUpdate) Where(ps ...predicate.AIPersona) *AIPersonaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AIPersonaUpdate) SetUpdatedAt(v time.Time) *AIPersonaUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AIPersonaUpdate) SetUserID(v int) *AIPersonaUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AIPersonaUpdate) SetNillableUserID(v *int) *AIPersonaUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AIPersonaUpdate) SetName(v string) *AIPersonaUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AIPersonaUpdate) SetNillableName(v *string) *AIPersonaUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *AIPersonaUpdate) SetDescription(v string) *AIPersonaUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *AIPersonaUpdate) SetNillableDescription(v *string) *AIPersonaUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *AIPersonaUpdate) ClearDescription() *AIPersonaUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (_u *AIPersonaUpdate) SetSystemPrompt(v string) *AIPersonaUpdate {
+	_u.mutation.SetSystemPrompt(v)
+	return _u
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (_u *AIPersonaUpdate) SetNillableSystemPrompt(v *string) *AIPersonaUpdate {
+	if v != nil {
+		_u.SetSystemPrompt(*v)
+	}
+	return _u
+}
+
+// SetTemperature sets the "temperature" field.
+func (_u *AIPersonaUpdate) SetTemperature(v float64) *AIPersonaUpdate {
+	_u.mutation.ResetTemperature()
+	_u.mutation.SetTemperature(v)
+	return _u
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (_u *AIPersonaUpdate) SetNillableTemperature(v *float64) *AIPersonaUpdate {
+	if v != nil {
+		_u.SetTemperature(*v)
+	}
+	return _u
+}
+
+// AddTemperature adds value to the "temperature" field.
+func (_u *AIPersonaUpdate) AddTemperature(v float64) *AIPersonaUpdate {
+	_u.mutation.AddTemperature(v)
+	return _u
+}
+
+// ClearTemperature clears the value of the "temperature" field.
+func (_u *AIPersonaUpdate) ClearTemperature() *AIPersonaUpdate {
+	_u.mutation.ClearTemperature()
+	return _u
+}
+
+// SetMaxTokens sets the "max_tokens" field.
+func (_u *AIPersonaUpdate) SetMaxTokens(v int) *AIPersonaUpdate {
+	_u.mutation.ResetMaxTokens()
+	_u.mutation.SetMaxTokens(v)
+	return _u
+}
+
+// SetNillableMaxTokens sets the "max_tokens" field if the given value is not nil.
+func (_u *AIPersonaUpdate) SetNillableMaxTokens(v *int) *AIPersonaUpdate {
+	if v != nil {
+		_u.SetMaxTokens(*v)
+	}
+	return _u
+}
+
+// AddMaxTokens adds value to the "max_tokens" field.
+func (_u *AIPersonaUpdate) AddMaxTokens(v int) *AIPersonaUpdate {
+	_u.mutation.AddMaxTokens(v)
+	return _u
+}
+
+// ClearMaxTokens clears the value of the "max_tokens" field.
+func (_u *AIPersonaUpdate) ClearMaxTokens() *AIPersonaUpdate {
+	_u.mutation.ClearMaxTokens()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AIPersonaUpdate) SetUser(v *User) *AIPersonaUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AIPersonaMutation object of the builder.
+func (_u *AIPersonaUpdate) Mutation() *AIPersonaMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AIPersonaUpdate) ClearUser() *AIPersonaUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AIPersonaUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIPersonaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AIPersonaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIPersonaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AIPersonaUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := aipersona.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AIPersonaUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := aipersona.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "AIPersona.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := aipersona.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AIPersona.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := aipersona.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "AIPersona.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SystemPrompt(); ok {
+		if err := aipersona.SystemPromptValidator(v); err != nil {
+			return &ValidationError{Name: "system_prompt", err: fmt.Errorf(`ent: validator failed for field "AIPersona.system_prompt": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AIPersona.user"`)
+	}
+	return nil
+}
+
+func (_u *AIPersonaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aipersona.Table, aipersona.Columns, sqlgraph.NewFieldSpec(aipersona.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(aipersona.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(aipersona.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(aipersona.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(aipersona.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SystemPrompt(); ok {
+		_spec.SetField(aipersona.FieldSystemPrompt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Temperature(); ok {
+		_spec.SetField(aipersona.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTemperature(); ok {
+		_spec.AddField(aipersona.FieldTemperature, field.TypeFloat64, value)
+	}
+	if _u.mutation.TemperatureCleared() {
+		_spec.ClearField(aipersona.FieldTemperature, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxTokens(); ok {
+		_spec.SetField(aipersona.FieldMaxTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokens(); ok {
+		_spec.AddField(aipersona.FieldMaxTokens, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensCleared() {
+		_spec.ClearField(aipersona.FieldMaxTokens, field.TypeInt)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aipersona.UserTable,
+			Columns: []string{aipersona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aipersona.UserTable,
+			Columns: []string{aipersona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aipersona.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AIPersonaUpdateOne is the builder for updating a single AIPersona entity.
+type AIPersonaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AIPersonaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AIPersonaUpdateOne) SetUpdatedAt(v time.Time) *AIPersonaUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AIPersonaUpdateOne) SetUserID(v int) *AIPersonaUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AIPersonaUpdateOne) SetNillableUserID(v *int) *AIPersonaUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AIPersonaUpdateOne) SetName(v string) *AIPersonaUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AIPersonaUpdateOne) SetNillableName(v *string) *AIPersonaUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *AIPersonaUpdateOne) SetDescription(v string) *AIPersonaUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *AIPersonaUpdateOne) SetNillableDescription(v *string) *AIPersonaUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *AIPersonaUpdateOne) ClearDescription() *AIPersonaUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (_u *AIPersonaUpdateOne) SetSystemPrompt(v string) *AIPersonaUpdateOne {
+	_u.mutation.SetSystemPrompt(v)
+	return _u
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (_u *AIPersonaUpdateOne) SetNillableSystemPrompt(v *string) *AIPersonaUpdateOne {
+	if v != nil {
+		_u.SetSystemPrompt(*v)
+	}
+	return _u
+}
+
+// SetTemperature sets the "temperature" field.
+func (_u *AIPersonaUpdateOne) SetTemperature(v float64) *AIPersonaUpdateOne {
+	_u.mutation.ResetTemperature()
+	_u.mutation.SetTemperature(v)
+	return _u
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (_u *AIPersonaUpdateOne) SetNillableTemperature(v *float64) *AIPersonaUpdateOne {
+	if v != nil {
+		_u.SetTemperature(*v)
+	}
+	return _u
+}
+
+// AddTemperature adds value to the "temperature" field.
+func (_u *AIPersonaUpdateOne) AddTemperature(v float64) *AIPersonaUpdateOne {
+	_u.mutation.AddTemperature(v)
+	return _u
+}
+
+// ClearTemperature clears the value of the "temperature" field.
+func (_u *AIPersonaUpdateOne) ClearTemperature() *AIPersonaUpdateOne {
+	_u.mutation.ClearTemperature()
+	return _u
+}
+
+// SetMaxTokens sets the "max_tokens" field.
+func (_u *AIPersonaUpdateOne) SetMaxTokens(v int) *AIPersonaUpdateOne {
+	_u.mutation.ResetMaxTokens()
+	_u.mutation.SetMaxTokens(v)
+	return _u
+}
+
+// SetNillableMaxTokens sets the "max_tokens" field if the given value is not nil.
+func (_u *AIPersonaUpdateOne) SetNillableMaxTokens(v *int) *AIPersonaUpdateOne {
+	if v != nil {
+		_u.SetMaxTokens(*v)
+	}
+	return _u
+}
+
+// AddMaxTokens adds value to the "max_tokens" field.
+func (_u *AIPersonaUpdateOne) AddMaxTokens(v int) *AIPersonaUpdateOne {
+	_u.mutation.AddMaxTokens(v)
+	return _u
+}
+
+// ClearMaxTokens clears the value of the "max_tokens" field.
+func (_u *AIPersonaUpdateOne) ClearMaxTokens() *AIPersonaUpdateOne {
+	_u.mutation.ClearMaxTokens()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AIPersonaUpdateOne) SetUser(v *User) *AIPersonaUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AIPersonaMutation object of the builder.
+func (_u *AIPersonaUpdateOne) Mutation() *AIPersonaMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AIPersonaUpdateOne) ClearUser() *AIPersonaUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the AIPersonaUpdate builder.
+func (_u *AIPersonaUpdateOne) Where(ps ...predicate.AIPersona) *AIPersonaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AIPersonaUpdateOne) Select(field string, fields ...string) *AIPersonaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AIPersona entity.
+func (_u *AIPersonaUpdateOne) Save(ctx context.Context) (*AIPersona, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIPersonaUpdateOne) SaveX(ctx context.Context) *AIPersona {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AIPersonaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIPersonaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AIPersonaUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := aipersona.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AIPersonaUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := aipersona.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "AIPersona.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := aipersona.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AIPersona.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := aipersona.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "AIPersona.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SystemPrompt(); ok {
+		if err := aipersona.SystemPromptValidator(v); err != nil {
+			return &ValidationError{Name: "system_prompt", err: fmt.Errorf(`ent: validator failed for field "AIPersona.system_prompt": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AIPersona.user"`)
+	}
+	return nil
+}
+
+func (_u *AIPersonaUpdateOne) sqlSave(ctx context.Context) (_node *AIPersona, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aipersona.Table, aipersona.Columns, sqlgraph.NewFieldSpec(aipersona.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AIPersona.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, aipersona.FieldID)
+		for _, f := range fields {
+			if !aipersona.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != aipersona.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(aipersona.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(aipersona.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(aipersona.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(aipersona.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SystemPrompt(); ok {
+		_spec.SetField(aipersona.FieldSystemPrompt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Temperature(); ok {
+		_spec.SetField(aipersona.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTemperature(); ok {
+		_spec.AddField(aipersona.FieldTemperature, field.TypeFloat64, value)
+	}
+	if _u.mutation.TemperatureCleared() {
+		_spec.ClearField(aipersona.FieldTemperature, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxTokens(); ok {
+		_spec.SetField(aipersona.FieldMaxTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokens(); ok {
+		_spec.AddField(aipersona.FieldMaxTokens, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensCleared() {
+		_spec.ClearField(aipersona.FieldMaxTokens, field.TypeInt)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aipersona.UserTable,
+			Columns: []string{aipersona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aipersona.UserTable,
+			Columns: []string{aipersona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AIPersona{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aipersona.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -29,6 +29,8 @@ type AIProvider struct {
 	BaseURL string `json:"base_url,omitempty"`
 	// AES-GCM 加密后的供应商 API Key，本地服务可留空
 	APIKeyCiphertext string `json:"-"`
+	// 向量嵌入模型名称（如 text-embedding-3-small），为空时该提供商不用于站点知识库
+	EmbeddingModel string `json:"embedding_model,omitempty"`
 	// 温度
 	Temperature float64 `json:"temperature,omitempty"`
 	// 最大输出 Token
@@ -82,7 +84,7 @@ func (*AIProvider) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case aiprovider.FieldID, aiprovider.FieldMaxTokens, aiprovider.FieldSort:
 			values[i] = new(sql.NullInt64)
-		case aiprovider.FieldName, aiprovider.FieldProviderType, aiprovider.FieldBaseURL, aiprovider.FieldAPIKeyCiphertext, aiprovider.FieldRemark:
+		case aiprovider.FieldName, aiprovider.FieldProviderType, aiprovider.FieldBaseURL, aiprovider.FieldAPIKeyCiphertext, aiprovider.FieldEmbeddingModel, aiprovider.FieldRemark:
 			values[i] = new(sql.NullString)
 		case aiprovider.FieldCreatedAt, aiprovider.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -143,6 +145,12 @@ func (_m *AIProvider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.APIKeyCiphertext = value.String
 			}
+		case aiprovider.FieldEmbeddingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_model", values[i])
+			} else if value.Valid {
+				_m.EmbeddingModel = value.String
+			}
 		case aiprovider.FieldTemperature:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field temperature", values[i])
@@ -255,6 +263,9 @@ func (_m *AIProvider) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("api_key_ciphertext=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("embedding_model=")
+	builder.WriteString(_m.EmbeddingModel)
+	builder.WriteString(", ")
 	builder.WriteString("temperature=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Temperature))
 	builder.WriteString(", ")
@@ -26,6 +26,8 @@ const (
 	FieldBaseURL = "base_url"
 	// FieldAPIKeyCiphertext holds the string denoting the api_key_ciphertext field in the database.
 	FieldAPIKeyCiphertext = "api_key_ciphertext"
+	// FieldEmbeddingModel holds the string denoting the embedding_model field in the database.
+	FieldEmbeddingModel = "embedding_model"
 	// FieldTemperature holds the string denoting the temperature field in the database.
 	FieldTemperature = "temperature"
 	// FieldMaxTokens holds the string denoting the max_tokens field in the database.
@@ -66,6 +68,7 @@ var Columns = []string{
 	FieldProviderType,
 	FieldBaseURL,
 	FieldAPIKeyCiphertext,
+	FieldEmbeddingModel,
 	FieldTemperature,
 	FieldMaxTokens,
 	FieldTopP,
@@ -100,6 +103,8 @@ var (
 	ProviderTypeValidator func(string) error
 	// BaseURLValidator is a validator for the "base_url" field. It is called by the builders before save.
 	BaseURLValidator func(string) error
+	// EmbeddingModelValidator is a validator for the "embedding_model" field. It is called by the builders before save.
+	EmbeddingModelValidator func(string) error
 	// DefaultTemperature holds the default value on creation for the "temperature" field.
 	DefaultTemperature float64
 	// DefaultMaxTokens holds the default value on creation for the "max_tokens" field.
@@ -160,6 +165,11 @@ func ByAPIKeyCiphertext(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAPIKeyCiphertext, opts...).ToFunc()
 }
 
+// ByEmbeddingModel orders the results by the embedding_model field.
+func ByEmbeddingModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingModel, opts...).ToFunc()
+}
+
 // ByTemperature orders the results by the temperature field.
 func ByTemperature(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTemperature, opts...).ToFunc()
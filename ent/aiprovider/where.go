@@ -85,6 +85,11 @@ func APIKeyCiphertext(v string) predicate.AIProvider {
 	return predicate.AIProvider(sql.FieldEQ(FieldAPIKeyCiphertext, v))
 }
 
+// EmbeddingModel applies equality check predicate on the "embedding_model" field. It's identical to EmbeddingModelEQ.
+func EmbeddingModel(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
 // Temperature applies equality check predicate on the "temperature" field. It's identical to TemperatureEQ.
 func Temperature(v float64) predicate.AIProvider {
 	return predicate.AIProvider(sql.FieldEQ(FieldTemperature, v))
@@ -480,6 +485,81 @@ func APIKeyCiphertextContainsFold(v string) predicate.AIProvider {
 	return predicate.AIProvider(sql.FieldContainsFold(FieldAPIKeyCiphertext, v))
 }
 
+// EmbeddingModelEQ applies the EQ predicate on the "embedding_model" field.
+func EmbeddingModelEQ(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelNEQ applies the NEQ predicate on the "embedding_model" field.
+func EmbeddingModelNEQ(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldNEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIn applies the In predicate on the "embedding_model" field.
+func EmbeddingModelIn(vs ...string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelNotIn applies the NotIn predicate on the "embedding_model" field.
+func EmbeddingModelNotIn(vs ...string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldNotIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelGT applies the GT predicate on the "embedding_model" field.
+func EmbeddingModelGT(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldGT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelGTE applies the GTE predicate on the "embedding_model" field.
+func EmbeddingModelGTE(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldGTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLT applies the LT predicate on the "embedding_model" field.
+func EmbeddingModelLT(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldLT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLTE applies the LTE predicate on the "embedding_model" field.
+func EmbeddingModelLTE(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldLTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContains applies the Contains predicate on the "embedding_model" field.
+func EmbeddingModelContains(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldContains(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasPrefix applies the HasPrefix predicate on the "embedding_model" field.
+func EmbeddingModelHasPrefix(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldHasPrefix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasSuffix applies the HasSuffix predicate on the "embedding_model" field.
+func EmbeddingModelHasSuffix(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldHasSuffix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIsNil applies the IsNil predicate on the "embedding_model" field.
+func EmbeddingModelIsNil() predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldIsNull(FieldEmbeddingModel))
+}
+
+// EmbeddingModelNotNil applies the NotNil predicate on the "embedding_model" field.
+func EmbeddingModelNotNil() predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldNotNull(FieldEmbeddingModel))
+}
+
+// EmbeddingModelEqualFold applies the EqualFold predicate on the "embedding_model" field.
+func EmbeddingModelEqualFold(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldEqualFold(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContainsFold applies the ContainsFold predicate on the "embedding_model" field.
+func EmbeddingModelContainsFold(v string) predicate.AIProvider {
+	return predicate.AIProvider(sql.FieldContainsFold(FieldEmbeddingModel, v))
+}
+
 // TemperatureEQ applies the EQ predicate on the "temperature" field.
 func TemperatureEQ(v float64) predicate.AIProvider {
 	return predicate.AIProvider(sql.FieldEQ(FieldTemperature, v))
@@ -81,6 +81,20 @@ func (_c *AIProviderCreate) SetNillableAPIKeyCiphertext(v *string) *AIProviderCr
 	return _c
 }
 
+// SetEmbeddingModel sets the "embedding_model" field.
+func (_c *AIProviderCreate) SetEmbeddingModel(v string) *AIProviderCreate {
+	_c.mutation.SetEmbeddingModel(v)
+	return _c
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (_c *AIProviderCreate) SetNillableEmbeddingModel(v *string) *AIProviderCreate {
+	if v != nil {
+		_c.SetEmbeddingModel(*v)
+	}
+	return _c
+}
+
 // SetTemperature sets the "temperature" field.
 func (_c *AIProviderCreate) SetTemperature(v float64) *AIProviderCreate {
 	_c.mutation.SetTemperature(v)
@@ -337,6 +351,11 @@ func (_c *AIProviderCreate) check() error {
 			return &ValidationError{Name: "base_url", err: fmt.Errorf(`ent: validator failed for field "AIProvider.base_url": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.EmbeddingModel(); ok {
+		if err := aiprovider.EmbeddingModelValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_model", err: fmt.Errorf(`ent: validator failed for field "AIProvider.embedding_model": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Temperature(); !ok {
 		return &ValidationError{Name: "temperature", err: errors.New(`ent: missing required field "AIProvider.temperature"`)}
 	}
@@ -427,6 +446,10 @@ func (_c *AIProviderCreate) createSpec() (*AIProvider, *sqlgraph.CreateSpec) {
 		_spec.SetField(aiprovider.FieldAPIKeyCiphertext, field.TypeString, value)
 		_node.APIKeyCiphertext = value
 	}
+	if value, ok := _c.mutation.EmbeddingModel(); ok {
+		_spec.SetField(aiprovider.FieldEmbeddingModel, field.TypeString, value)
+		_node.EmbeddingModel = value
+	}
 	if value, ok := _c.mutation.Temperature(); ok {
 		_spec.SetField(aiprovider.FieldTemperature, field.TypeFloat64, value)
 		_node.Temperature = value
@@ -97,6 +97,26 @@ func (_u *AIProviderUpdate) ClearAPIKeyCiphertext() *AIProviderUpdate {
 	return _u
 }
 
+// SetEmbeddingModel sets the "embedding_model" field.
+func (_u *AIProviderUpdate) SetEmbeddingModel(v string) *AIProviderUpdate {
+	_u.mutation.SetEmbeddingModel(v)
+	return _u
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (_u *AIProviderUpdate) SetNillableEmbeddingModel(v *string) *AIProviderUpdate {
+	if v != nil {
+		_u.SetEmbeddingModel(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (_u *AIProviderUpdate) ClearEmbeddingModel() *AIProviderUpdate {
+	_u.mutation.ClearEmbeddingModel()
+	return _u
+}
+
 // SetTemperature sets the "temperature" field.
 func (_u *AIProviderUpdate) SetTemperature(v float64) *AIProviderUpdate {
 	_u.mutation.ResetTemperature()
@@ -365,6 +385,11 @@ func (_u *AIProviderUpdate) check() error {
 			return &ValidationError{Name: "base_url", err: fmt.Errorf(`ent: validator failed for field "AIProvider.base_url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EmbeddingModel(); ok {
+		if err := aiprovider.EmbeddingModelValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_model", err: fmt.Errorf(`ent: validator failed for field "AIProvider.embedding_model": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MaxTokens(); ok {
 		if err := aiprovider.MaxTokensValidator(v); err != nil {
 			return &ValidationError{Name: "max_tokens", err: fmt.Errorf(`ent: validator failed for field "AIProvider.max_tokens": %w`, err)}
@@ -408,6 +433,12 @@ func (_u *AIProviderUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.APIKeyCiphertextCleared() {
 		_spec.ClearField(aiprovider.FieldAPIKeyCiphertext, field.TypeString)
 	}
+	if value, ok := _u.mutation.EmbeddingModel(); ok {
+		_spec.SetField(aiprovider.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(aiprovider.FieldEmbeddingModel, field.TypeString)
+	}
 	if value, ok := _u.mutation.Temperature(); ok {
 		_spec.SetField(aiprovider.FieldTemperature, field.TypeFloat64, value)
 	}
@@ -589,6 +620,26 @@ func (_u *AIProviderUpdateOne) ClearAPIKeyCiphertext() *AIProviderUpdateOne {
 	return _u
 }
 
+// SetEmbeddingModel sets the "embedding_model" field.
+func (_u *AIProviderUpdateOne) SetEmbeddingModel(v string) *AIProviderUpdateOne {
+	_u.mutation.SetEmbeddingModel(v)
+	return _u
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (_u *AIProviderUpdateOne) SetNillableEmbeddingModel(v *string) *AIProviderUpdateOne {
+	if v != nil {
+		_u.SetEmbeddingModel(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (_u *AIProviderUpdateOne) ClearEmbeddingModel() *AIProviderUpdateOne {
+	_u.mutation.ClearEmbeddingModel()
+	return _u
+}
+
 // SetTemperature sets the "temperature" field.
 func (_u *AIProviderUpdateOne) SetTemperature(v float64) *AIProviderUpdateOne {
 	_u.mutation.ResetTemperature()
@@ -870,6 +921,11 @@ func (_u *AIProviderUpdateOne) check() error {
 			return &ValidationError{Name: "base_url", err: fmt.Errorf(`ent: validator failed for field "AIProvider.base_url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EmbeddingModel(); ok {
+		if err := aiprovider.EmbeddingModelValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_model", err: fmt.Errorf(`ent: validator failed for field "AIProvider.embedding_model": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MaxTokens(); ok {
 		if err := aiprovider.MaxTokensValidator(v); err != nil {
 			return &ValidationError{Name: "max_tokens", err: fmt.Errorf(`ent: validator failed for field "AIProvider.max_tokens": %w`, err)}
@@ -930,6 +986,12 @@ func (_u *AIProviderUpdateOne) sqlSave(ctx context.Context) (_node *AIProvider,
 	if _u.mutation.APIKeyCiphertextCleared() {
 		_spec.ClearField(aiprovider.FieldAPIKeyCiphertext, field.TypeString)
 	}
+	if value, ok := _u.mutation.EmbeddingModel(); ok {
+		_spec.SetField(aiprovider.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(aiprovider.FieldEmbeddingModel, field.TypeString)
+	}
 	if value, ok := _u.mutation.Temperature(); ok {
 		_spec.SetField(aiprovider.FieldTemperature, field.TypeFloat64, value)
 	}
@@ -15,12 +15,15 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
 	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
 	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
 	"github.com/shuTwT/hoshikuzu/ent/aimodel"
+	"github.com/shuTwT/hoshikuzu/ent/aipersona"
 	"github.com/shuTwT/hoshikuzu/ent/aiprovider"
 	"github.com/shuTwT/hoshikuzu/ent/album"
 	"github.com/shuTwT/hoshikuzu/ent/albumphoto"
+	"github.com/shuTwT/hoshikuzu/ent/cartitem"
 	"github.com/shuTwT/hoshikuzu/ent/category"
 	"github.com/shuTwT/hoshikuzu/ent/comment"
 	"github.com/shuTwT/hoshikuzu/ent/coupon"
@@ -32,19 +35,25 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/flinkgroup"
 	"github.com/shuTwT/hoshikuzu/ent/friendcirclerecord"
 	"github.com/shuTwT/hoshikuzu/ent/license"
+	"github.com/shuTwT/hoshikuzu/ent/loginlog"
 	"github.com/shuTwT/hoshikuzu/ent/member"
 	"github.com/shuTwT/hoshikuzu/ent/memberlevel"
 	"github.com/shuTwT/hoshikuzu/ent/menu"
 	"github.com/shuTwT/hoshikuzu/ent/notification"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2accesstoken"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2client"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2code"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2refreshtoken"
+	"github.com/shuTwT/hoshikuzu/ent/orderdelivery"
 	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
 	"github.com/shuTwT/hoshikuzu/ent/personalaccesstoken"
 	"github.com/shuTwT/hoshikuzu/ent/plugin"
 	"github.com/shuTwT/hoshikuzu/ent/post"
 	"github.com/shuTwT/hoshikuzu/ent/postpurchase"
 	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productdeliverable"
+	"github.com/shuTwT/hoshikuzu/ent/productkey"
 	"github.com/shuTwT/hoshikuzu/ent/refreshtoken"
 	"github.com/shuTwT/hoshikuzu/ent/role"
 	"github.com/shuTwT/hoshikuzu/ent/schedulejob"
@@ -53,9 +62,11 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/tag"
 	"github.com/shuTwT/hoshikuzu/ent/theme"
 	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/ent/useridentity"
 	"github.com/shuTwT/hoshikuzu/ent/visitlog"
 	"github.com/shuTwT/hoshikuzu/ent/wallet"
 	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -63,18 +74,24 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AIAskLog is the client for interacting with the AIAskLog builders.
+	AIAskLog *AIAskLogClient
 	// AIChatMessage is the client for interacting with the AIChatMessage builders.
 	AIChatMessage *AIChatMessageClient
 	// AIChatSession is the client for interacting with the AIChatSession builders.
 	AIChatSession *AIChatSessionClient
 	// AIModel is the client for interacting with the AIModel builders.
 	AIModel *AIModelClient
+	// AIPersona is the client for interacting with the AIPersona builders.
+	AIPersona *AIPersonaClient
 	// AIProvider is the client for interacting with the AIProvider builders.
 	AIProvider *AIProviderClient
 	// Album is the client for interacting with the Album builders.
 	Album *AlbumClient
 	// AlbumPhoto is the client for interacting with the AlbumPhoto builders.
 	AlbumPhoto *AlbumPhotoClient
+	// CartItem is the client for interacting with the CartItem builders.
+	CartItem *CartItemClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// Comment is the client for interacting with the Comment builders.
@@ -97,6 +114,8 @@ type Client struct {
 	FriendCircleRecord *FriendCircleRecordClient
 	// License is the client for interacting with the License builders.
 	License *LicenseClient
+	// LoginLog is the client for interacting with the LoginLog builders.
+	LoginLog *LoginLogClient
 	// Member is the client for interacting with the Member builders.
 	Member *MemberClient
 	// MemberLevel is the client for interacting with the MemberLevel builders.
@@ -107,12 +126,18 @@ type Client struct {
 	Notification *NotificationClient
 	// Oauth2AccessToken is the client for interacting with the Oauth2AccessToken builders.
 	Oauth2AccessToken *Oauth2AccessTokenClient
+	// Oauth2Client is the client for interacting with the Oauth2Client builders.
+	Oauth2Client *Oauth2ClientClient
 	// Oauth2Code is the client for interacting with the Oauth2Code builders.
 	Oauth2Code *Oauth2CodeClient
 	// Oauth2RefreshToken is the client for interacting with the Oauth2RefreshToken builders.
 	Oauth2RefreshToken *Oauth2RefreshTokenClient
+	// OrderDelivery is the client for interacting with the OrderDelivery builders.
+	OrderDelivery *OrderDeliveryClient
 	// PayOrder is the client for interacting with the PayOrder builders.
 	PayOrder *PayOrderClient
+	// PayOrderItem is the client for interacting with the PayOrderItem builders.
+	PayOrderItem *PayOrderItemClient
 	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
 	PersonalAccessToken *PersonalAccessTokenClient
 	// Plugin is the client for interacting with the Plugin builders.
@@ -123,6 +148,10 @@ type Client struct {
 	PostPurchase *PostPurchaseClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductDeliverable is the client for interacting with the ProductDeliverable builders.
+	ProductDeliverable *ProductDeliverableClient
+	// ProductKey is the client for interacting with the ProductKey builders.
+	ProductKey *ProductKeyClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
 	// Role is the client for interacting with the Role builders.
@@ -139,12 +168,16 @@ type Client struct {
 	Theme *ThemeClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// VisitLog is the client for interacting with the VisitLog builders.
 	VisitLog *VisitLogClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
 	// WebHook is the client for interacting with the WebHook builders.
 	WebHook *WebHookClient
+	// WebHookDelivery is the client for interacting with the WebHookDelivery builders.
+	WebHookDelivery *WebHookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -156,12 +189,15 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AIAskLog = NewAIAskLogClient(c.config)
 	c.AIChatMessage = NewAIChatMessageClient(c.config)
 	c.AIChatSession = NewAIChatSessionClient(c.config)
 	c.AIModel = NewAIModelClient(c.config)
+	c.AIPersona = NewAIPersonaClient(c.config)
 	c.AIProvider = NewAIProviderClient(c.config)
 	c.Album = NewAlbumClient(c.config)
 	c.AlbumPhoto = NewAlbumPhotoClient(c.config)
+	c.CartItem = NewCartItemClient(c.config)
 	c.Category = NewCategoryClient(c.config)
 	c.Comment = NewCommentClient(c.config)
 	c.Coupon = NewCouponClient(c.config)
@@ -173,19 +209,25 @@ func (c *Client) init() {
 	c.File = NewFileClient(c.config)
 	c.FriendCircleRecord = NewFriendCircleRecordClient(c.config)
 	c.License = NewLicenseClient(c.config)
+	c.LoginLog = NewLoginLogClient(c.config)
 	c.Member = NewMemberClient(c.config)
 	c.MemberLevel = NewMemberLevelClient(c.config)
 	c.Menu = NewMenuClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
 	c.Oauth2AccessToken = NewOauth2AccessTokenClient(c.config)
+	c.Oauth2Client = NewOauth2ClientClient(c.config)
 	c.Oauth2Code = NewOauth2CodeClient(c.config)
 	c.Oauth2RefreshToken = NewOauth2RefreshTokenClient(c.config)
+	c.OrderDelivery = NewOrderDeliveryClient(c.config)
 	c.PayOrder = NewPayOrderClient(c.config)
+	c.PayOrderItem = NewPayOrderItemClient(c.config)
 	c.PersonalAccessToken = NewPersonalAccessTokenClient(c.config)
 	c.Plugin = NewPluginClient(c.config)
 	c.Post = NewPostClient(c.config)
 	c.PostPurchase = NewPostPurchaseClient(c.config)
 	c.Product = NewProductClient(c.config)
+	c.ProductDeliverable = NewProductDeliverableClient(c.config)
+	c.ProductKey = NewProductKeyClient(c.config)
 	c.RefreshToken = NewRefreshTokenClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.ScheduleJob = NewScheduleJobClient(c.config)
@@ -194,9 +236,11 @@ func (c *Client) init() {
 	c.Tag = NewTagClient(c.config)
 	c.Theme = NewThemeClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
 	c.VisitLog = NewVisitLogClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
 	c.WebHook = NewWebHookClient(c.config)
+	c.WebHookDelivery = NewWebHookDeliveryClient(c.config)
 }
 
 type (
@@ -289,12 +333,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                 ctx,
 		config:              cfg,
+		AIAskLog:            NewAIAskLogClient(cfg),
 		AIChatMessage:       NewAIChatMessageClient(cfg),
 		AIChatSession:       NewAIChatSessionClient(cfg),
 		AIModel:             NewAIModelClient(cfg),
+		AIPersona:           NewAIPersonaClient(cfg),
 		AIProvider:          NewAIProviderClient(cfg),
 		Album:               NewAlbumClient(cfg),
 		AlbumPhoto:          NewAlbumPhotoClient(cfg),
+		CartItem:            NewCartItemClient(cfg),
 		Category:            NewCategoryClient(cfg),
 		Comment:             NewCommentClient(cfg),
 		Coupon:              NewCouponClient(cfg),
@@ -306,19 +353,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		File:                NewFileClient(cfg),
 		FriendCircleRecord:  NewFriendCircleRecordClient(cfg),
 		License:             NewLicenseClient(cfg),
+		LoginLog:            NewLoginLogClient(cfg),
 		Member:              NewMemberClient(cfg),
 		MemberLevel:         NewMemberLevelClient(cfg),
 		Menu:                NewMenuClient(cfg),
 		Notification:        NewNotificationClient(cfg),
 		Oauth2AccessToken:   NewOauth2AccessTokenClient(cfg),
+		Oauth2Client:        NewOauth2ClientClient(cfg),
 		Oauth2Code:          NewOauth2CodeClient(cfg),
 		Oauth2RefreshToken:  NewOauth2RefreshTokenClient(cfg),
+		OrderDelivery:       NewOrderDeliveryClient(cfg),
 		PayOrder:            NewPayOrderClient(cfg),
+		PayOrderItem:        NewPayOrderItemClient(cfg),
 		PersonalAccessToken: NewPersonalAccessTokenClient(cfg),
 		Plugin:              NewPluginClient(cfg),
 		Post:                NewPostClient(cfg),
 		PostPurchase:        NewPostPurchaseClient(cfg),
 		Product:             NewProductClient(cfg),
+		ProductDeliverable:  NewProductDeliverableClient(cfg),
+		ProductKey:          NewProductKeyClient(cfg),
 		RefreshToken:        NewRefreshTokenClient(cfg),
 		Role:                NewRoleClient(cfg),
 		ScheduleJob:         NewScheduleJobClient(cfg),
@@ -327,9 +380,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Tag:                 NewTagClient(cfg),
 		Theme:               NewThemeClient(cfg),
 		User:                NewUserClient(cfg),
+		UserIdentity:        NewUserIdentityClient(cfg),
 		VisitLog:            NewVisitLogClient(cfg),
 		Wallet:              NewWalletClient(cfg),
 		WebHook:             NewWebHookClient(cfg),
+		WebHookDelivery:     NewWebHookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -349,12 +404,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                 ctx,
 		config:              cfg,
+		AIAskLog:            NewAIAskLogClient(cfg),
 		AIChatMessage:       NewAIChatMessageClient(cfg),
 		AIChatSession:       NewAIChatSessionClient(cfg),
 		AIModel:             NewAIModelClient(cfg),
+		AIPersona:           NewAIPersonaClient(cfg),
 		AIProvider:          NewAIProviderClient(cfg),
 		Album:               NewAlbumClient(cfg),
 		AlbumPhoto:          NewAlbumPhotoClient(cfg),
+		CartItem:            NewCartItemClient(cfg),
 		Category:            NewCategoryClient(cfg),
 		Comment:             NewCommentClient(cfg),
 		Coupon:              NewCouponClient(cfg),
@@ -366,19 +424,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		File:                NewFileClient(cfg),
 		FriendCircleRecord:  NewFriendCircleRecordClient(cfg),
 		License:             NewLicenseClient(cfg),
+		LoginLog:            NewLoginLogClient(cfg),
 		Member:              NewMemberClient(cfg),
 		MemberLevel:         NewMemberLevelClient(cfg),
 		Menu:                NewMenuClient(cfg),
 		Notification:        NewNotificationClient(cfg),
 		Oauth2AccessToken:   NewOauth2AccessTokenClient(cfg),
+		Oauth2Client:        NewOauth2ClientClient(cfg),
 		Oauth2Code:          NewOauth2CodeClient(cfg),
 		Oauth2RefreshToken:  NewOauth2RefreshTokenClient(cfg),
+		OrderDelivery:       NewOrderDeliveryClient(cfg),
 		PayOrder:            NewPayOrderClient(cfg),
+		PayOrderItem:        NewPayOrderItemClient(cfg),
 		PersonalAccessToken: NewPersonalAccessTokenClient(cfg),
 		Plugin:              NewPluginClient(cfg),
 		Post:                NewPostClient(cfg),
 		PostPurchase:        NewPostPurchaseClient(cfg),
 		Product:             NewProductClient(cfg),
+		ProductDeliverable:  NewProductDeliverableClient(cfg),
+		ProductKey:          NewProductKeyClient(cfg),
 		RefreshToken:        NewRefreshTokenClient(cfg),
 		Role:                NewRoleClient(cfg),
 		ScheduleJob:         NewScheduleJobClient(cfg),
@@ -387,16 +451,18 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Tag:                 NewTagClient(cfg),
 		Theme:               NewThemeClient(cfg),
 		User:                NewUserClient(cfg),
+		UserIdentity:        NewUserIdentityClient(cfg),
 		VisitLog:            NewVisitLogClient(cfg),
 		Wallet:              NewWalletClient(cfg),
 		WebHook:             NewWebHookClient(cfg),
+		WebHookDelivery:     NewWebHookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AIChatMessage.
+//		AIAskLog.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -419,14 +485,16 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AIChatMessage, c.AIChatSession, c.AIModel, c.AIProvider, c.Album,
-		c.AlbumPhoto, c.Category, c.Comment, c.Coupon, c.CouponUsage, c.Essay, c.FLink,
-		c.FLinkApplication, c.FLinkGroup, c.File, c.FriendCircleRecord, c.License,
-		c.Member, c.MemberLevel, c.Menu, c.Notification, c.Oauth2AccessToken,
-		c.Oauth2Code, c.Oauth2RefreshToken, c.PayOrder, c.PersonalAccessToken,
-		c.Plugin, c.Post, c.PostPurchase, c.Product, c.RefreshToken, c.Role,
-		c.ScheduleJob, c.Setting, c.StorageStrategy, c.Tag, c.Theme, c.User,
-		c.VisitLog, c.Wallet, c.WebHook,
+		c.AIAskLog, c.AIChatMessage, c.AIChatSession, c.AIModel, c.AIPersona,
+		c.AIProvider, c.Album, c.AlbumPhoto, c.CartItem, c.Category, c.Comment,
+		c.Coupon, c.CouponUsage, c.Essay, c.FLink, c.FLinkApplication, c.FLinkGroup,
+		c.File, c.FriendCircleRecord, c.License, c.LoginLog, c.Member, c.MemberLevel,
+		c.Menu, c.Notification, c.Oauth2AccessToken, c.Oauth2Client, c.Oauth2Code,
+		c.Oauth2RefreshToken, c.OrderDelivery, c.PayOrder, c.PayOrderItem,
+		c.PersonalAccessToken, c.Plugin, c.Post, c.PostPurchase, c.Product,
+		c.ProductDeliverable, c.ProductKey, c.RefreshToken, c.Role, c.ScheduleJob,
+		c.Setting, c.StorageStrategy, c.Tag, c.Theme, c.User, c.UserIdentity,
+		c.VisitLog, c.Wallet, c.WebHook, c.WebHookDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -436,14 +504,16 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AIChatMessage, c.AIChatSession, c.AIModel, c.AIProvider, c.Album,
-		c.AlbumPhoto, c.Category, c.Comment, c.Coupon, c.CouponUsage, c.Essay, c.FLink,
-		c.FLinkApplication, c.FLinkGroup, c.File, c.FriendCircleRecord, c.License,
-		c.Member, c.MemberLevel, c.Menu, c.Notification, c.Oauth2AccessToken,
-		c.Oauth2Code, c.Oauth2RefreshToken, c.PayOrder, c.PersonalAccessToken,
-		c.Plugin, c.Post, c.PostPurchase, c.Product, c.RefreshToken, c.Role,
-		c.ScheduleJob, c.Setting, c.StorageStrategy, c.Tag, c.Theme, c.User,
-		c.VisitLog, c.Wallet, c.WebHook,
+		c.AIAskLog, c.AIChatMessage, c.AIChatSession, c.AIModel, c.AIPersona,
+		c.AIProvider, c.Album, c.AlbumPhoto, c.CartItem, c.Category, c.Comment,
+		c.Coupon, c.CouponUsage, c.Essay, c.FLink, c.FLinkApplication, c.FLinkGroup,
+		c.File, c.FriendCircleRecord, c.License, c.LoginLog, c.Member, c.MemberLevel,
+		c.Menu, c.Notification, c.Oauth2AccessToken, c.Oauth2Client, c.Oauth2Code,
+		c.Oauth2RefreshToken, c.OrderDelivery, c.PayOrder, c.PayOrderItem,
+		c.PersonalAccessToken, c.Plugin, c.Post, c.PostPurchase, c.Product,
+		c.ProductDeliverable, c.ProductKey, c.RefreshToken, c.Role, c.ScheduleJob,
+		c.Setting, c.StorageStrategy, c.Tag, c.Theme, c.User, c.UserIdentity,
+		c.VisitLog, c.Wallet, c.WebHook, c.WebHookDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -452,18 +522,24 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AIAskLogMutation:
+		return c.AIAskLog.mutate(ctx, m)
 	case *AIChatMessageMutation:
 		return c.AIChatMessage.mutate(ctx, m)
 	case *AIChatSessionMutation:
 		return c.AIChatSession.mutate(ctx, m)
 	case *AIModelMutation:
 		return c.AIModel.mutate(ctx, m)
+	case *AIPersonaMutation:
+		return c.AIPersona.mutate(ctx, m)
 	case *AIProviderMutation:
 		return c.AIProvider.mutate(ctx, m)
 	case *AlbumMutation:
 		return c.Album.mutate(ctx, m)
 	case *AlbumPhotoMutation:
 		return c.AlbumPhoto.mutate(ctx, m)
+	case *CartItemMutation:
+		return c.CartItem.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
 	case *CommentMutation:
@@ -486,6 +562,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FriendCircleRecord.mutate(ctx, m)
 	case *LicenseMutation:
 		return c.License.mutate(ctx, m)
+	case *LoginLogMutation:
+		return c.LoginLog.mutate(ctx, m)
 	case *MemberMutation:
 		return c.Member.mutate(ctx, m)
 	case *MemberLevelMutation:
@@ -496,12 +574,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Notification.mutate(ctx, m)
 	case *Oauth2AccessTokenMutation:
 		return c.Oauth2AccessToken.mutate(ctx, m)
+	case *Oauth2ClientMutation:
+		return c.Oauth2Client.mutate(ctx, m)
 	case *Oauth2CodeMutation:
 		return c.Oauth2Code.mutate(ctx, m)
 	case *Oauth2RefreshTokenMutation:
 		return c.Oauth2RefreshToken.mutate(ctx, m)
+	case *OrderDeliveryMutation:
+		return c.OrderDelivery.mutate(ctx, m)
 	case *PayOrderMutation:
 		return c.PayOrder.mutate(ctx, m)
+	case *PayOrderItemMutation:
+		return c.PayOrderItem.mutate(ctx, m)
 	case *PersonalAccessTokenMutation:
 		return c.PersonalAccessToken.mutate(ctx, m)
 	case *PluginMutation:
@@ -512,6 +596,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PostPurchase.mutate(ctx, m)
 	case *ProductMutation:
 		return c.Product.mutate(ctx, m)
+	case *ProductDeliverableMutation:
+		return c.ProductDeliverable.mutate(ctx, m)
+	case *ProductKeyMutation:
+		return c.ProductKey.mutate(ctx, m)
 	case *RefreshTokenMutation:
 		return c.RefreshToken.mutate(ctx, m)
 	case *RoleMutation:
@@ -528,17 +616,154 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Theme.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
 	case *VisitLogMutation:
 		return c.VisitLog.mutate(ctx, m)
 	case *WalletMutation:
 		return c.Wallet.mutate(ctx, m)
 	case *WebHookMutation:
 		return c.WebHook.mutate(ctx, m)
+	case *WebHookDeliveryMutation:
+		return c.WebHookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AIAskLogClient is a client for the AIAskLog schema.
+type AIAskLogClient struct {
+	config
+}
+
+// NewAIAskLogClient returns a client for the AIAskLog from the given config.
+func NewAIAskLogClient(c config) *AIAskLogClient {
+	return &AIAskLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `aiasklog.Hooks(f(g(h())))`.
+func (c *AIAskLogClient) Use(hooks ...Hook) {
+	c.hooks.AIAskLog = append(c.hooks.AIAskLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `aiasklog.Intercept(f(g(h())))`.
+func (c *AIAskLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AIAskLog = append(c.inters.AIAskLog, interceptors...)
+}
+
+// Create returns a builder for creating a AIAskLog entity.
+func (c *AIAskLogClient) Create() *AIAskLogCreate {
+	mutation := newAIAskLogMutation(c.config, OpCreate)
+	return &AIAskLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AIAskLog entities.
+func (c *AIAskLogClient) CreateBulk(builders ...*AIAskLogCreate) *AIAskLogCreateBulk {
+	return &AIAskLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AIAskLogClient) MapCreateBulk(slice any, setFunc func(*AIAskLogCreate, int)) *AIAskLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AIAskLogCreateBulk{err: fmt.Errorf("calling to AIAskLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AIAskLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AIAskLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AIAskLog.
+func (c *AIAskLogClient) Update() *AIAskLogUpdate {
+	mutation := newAIAskLogMutation(c.config, OpUpdate)
+	return &AIAskLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AIAskLogClient) UpdateOne(_m *AIAskLog) *AIAskLogUpdateOne {
+	mutation := newAIAskLogMutation(c.config, OpUpdateOne, withAIAskLog(_m))
+	return &AIAskLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AIAskLogClient) UpdateOneID(id int) *AIAskLogUpdateOne {
+	mutation := newAIAskLogMutation(c.config, OpUpdateOne, withAIAskLogID(id))
+	return &AIAskLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AIAskLog.
+func (c *AIAskLogClient) Delete() *AIAskLogDelete {
+	mutation := newAIAskLogMutation(c.config, OpDelete)
+	return &AIAskLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AIAskLogClient) DeleteOne(_m *AIAskLog) *AIAskLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AIAskLogClient) DeleteOneID(id int) *AIAskLogDeleteOne {
+	builder := c.Delete().Where(aiasklog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AIAskLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AIAskLog.
+func (c *AIAskLogClient) Query() *AIAskLogQuery {
+	return &AIAskLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAIAskLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AIAskLog entity by its id.
+func (c *AIAskLogClient) Get(ctx context.Context, id int) (*AIAskLog, error) {
+	return c.Query().Where(aiasklog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AIAskLogClient) GetX(ctx context.Context, id int) *AIAskLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AIAskLogClient) Hooks() []Hook {
+	return c.hooks.AIAskLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AIAskLogClient) Interceptors() []Interceptor {
+	return c.inters.AIAskLog
+}
+
+func (c *AIAskLogClient) mutate(ctx context.Context, m *AIAskLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AIAskLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AIAskLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AIAskLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AIAskLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AIAskLog mutation op: %q", m.Op())
+	}
+}
+
 // AIChatMessageClient is a client for the AIChatMessage schema.
 type AIChatMessageClient struct {
 	config
@@ -1002,6 +1227,155 @@ func (c *AIModelClient) mutate(ctx context.Context, m *AIModelMutation) (Value,
 	}
 }
 
+// AIPersonaClient is a client for the AIPersona schema.
+type AIPersonaClient struct {
+	config
+}
+
+// NewAIPersonaClient returns a client for the AIPersona from the given config.
+func NewAIPersonaClient(c config) *AIPersonaClient {
+	return &AIPersonaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `aipersona.Hooks(f(g(h())))`.
+func (c *AIPersonaClient) Use(hooks ...Hook) {
+	c.hooks.AIPersona = append(c.hooks.AIPersona, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `aipersona.Intercept(f(g(h())))`.
+func (c *AIPersonaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AIPersona = append(c.inters.AIPersona, interceptors...)
+}
+
+// Create returns a builder for creating a AIPersona entity.
+func (c *AIPersonaClient) Create() *AIPersonaCreate {
+	mutation := newAIPersonaMutation(c.config, OpCreate)
+	return &AIPersonaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AIPersona entities.
+func (c *AIPersonaClient) CreateBulk(builders ...*AIPersonaCreate) *AIPersonaCreateBulk {
+	return &AIPersonaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AIPersonaClient) MapCreateBulk(slice any, setFunc func(*AIPersonaCreate, int)) *AIPersonaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AIPersonaCreateBulk{err: fmt.Errorf("calling to AIPersonaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AIPersonaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AIPersonaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AIPersona.
+func (c *AIPersonaClient) Update() *AIPersonaUpdate {
+	mutation := newAIPersonaMutation(c.config, OpUpdate)
+	return &AIPersonaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AIPersonaClient) UpdateOne(_m *AIPersona) *AIPersonaUpdateOne {
+	mutation := newAIPersonaMutation(c.config, OpUpdateOne, withAIPersona(_m))
+	return &AIPersonaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AIPersonaClient) UpdateOneID(id int) *AIPersonaUpdateOne {
+	mutation := newAIPersonaMutation(c.config, OpUpdateOne, withAIPersonaID(id))
+	return &AIPersonaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AIPersona.
+func (c *AIPersonaClient) Delete() *AIPersonaDelete {
+	mutation := newAIPersonaMutation(c.config, OpDelete)
+	return &AIPersonaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AIPersonaClient) DeleteOne(_m *AIPersona) *AIPersonaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AIPersonaClient) DeleteOneID(id int) *AIPersonaDeleteOne {
+	builder := c.Delete().Where(aipersona.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AIPersonaDeleteOne{builder}
+}
+
+// Query returns a query builder for AIPersona.
+func (c *AIPersonaClient) Query() *AIPersonaQuery {
+	return &AIPersonaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAIPersona},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AIPersona entity by its id.
+func (c *AIPersonaClient) Get(ctx context.Context, id int) (*AIPersona, error) {
+	return c.Query().Where(aipersona.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AIPersonaClient) GetX(ctx context.Context, id int) *AIPersona {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a AIPersona.
+func (c *AIPersonaClient) QueryUser(_m *AIPersona) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aipersona.Table, aipersona.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, aipersona.UserTable, aipersona.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AIPersonaClient) Hooks() []Hook {
+	return c.hooks.AIPersona
+}
+
+// Interceptors returns the client interceptors.
+func (c *AIPersonaClient) Interceptors() []Interceptor {
+	return c.inters.AIPersona
+}
+
+func (c *AIPersonaClient) mutate(ctx context.Context, m *AIPersonaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AIPersonaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AIPersonaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AIPersonaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AIPersonaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AIPersona mutation op: %q", m.Op())
+	}
+}
+
 // AIProviderClient is a client for the AIProvider schema.
 type AIProviderClient struct {
 	config
@@ -1417,107 +1791,107 @@ func (c *AlbumPhotoClient) mutate(ctx context.Context, m *AlbumPhotoMutation) (V
 	}
 }
 
-// CategoryClient is a client for the Category schema.
-type CategoryClient struct {
+// CartItemClient is a client for the CartItem schema.
+type CartItemClient struct {
 	config
 }
 
-// NewCategoryClient returns a client for the Category from the given config.
-func NewCategoryClient(c config) *CategoryClient {
-	return &CategoryClient{config: c}
+// NewCartItemClient returns a client for the CartItem from the given config.
+func NewCartItemClient(c config) *CartItemClient {
+	return &CartItemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `category.Hooks(f(g(h())))`.
-func (c *CategoryClient) Use(hooks ...Hook) {
-	c.hooks.Category = append(c.hooks.Category, hooks...)
+// A call to `Use(f, g, h)` equals to `cartitem.Hooks(f(g(h())))`.
+func (c *CartItemClient) Use(hooks ...Hook) {
+	c.hooks.CartItem = append(c.hooks.CartItem, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `category.Intercept(f(g(h())))`.
-func (c *CategoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Category = append(c.inters.Category, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cartitem.Intercept(f(g(h())))`.
+func (c *CartItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CartItem = append(c.inters.CartItem, interceptors...)
 }
 
-// Create returns a builder for creating a Category entity.
-func (c *CategoryClient) Create() *CategoryCreate {
-	mutation := newCategoryMutation(c.config, OpCreate)
-	return &CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CartItem entity.
+func (c *CartItemClient) Create() *CartItemCreate {
+	mutation := newCartItemMutation(c.config, OpCreate)
+	return &CartItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Category entities.
-func (c *CategoryClient) CreateBulk(builders ...*CategoryCreate) *CategoryCreateBulk {
-	return &CategoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CartItem entities.
+func (c *CartItemClient) CreateBulk(builders ...*CartItemCreate) *CartItemCreateBulk {
+	return &CartItemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CategoryClient) MapCreateBulk(slice any, setFunc func(*CategoryCreate, int)) *CategoryCreateBulk {
+func (c *CartItemClient) MapCreateBulk(slice any, setFunc func(*CartItemCreate, int)) *CartItemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CategoryCreateBulk{err: fmt.Errorf("calling to CategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CartItemCreateBulk{err: fmt.Errorf("calling to CartItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CategoryCreate, rv.Len())
+	builders := make([]*CartItemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CategoryCreateBulk{config: c.config, builders: builders}
+	return &CartItemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Category.
-func (c *CategoryClient) Update() *CategoryUpdate {
-	mutation := newCategoryMutation(c.config, OpUpdate)
-	return &CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CartItem.
+func (c *CartItemClient) Update() *CartItemUpdate {
+	mutation := newCartItemMutation(c.config, OpUpdate)
+	return &CartItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CategoryClient) UpdateOne(_m *Category) *CategoryUpdateOne {
-	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategory(_m))
-	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartItemClient) UpdateOne(_m *CartItem) *CartItemUpdateOne {
+	mutation := newCartItemMutation(c.config, OpUpdateOne, withCartItem(_m))
+	return &CartItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CategoryClient) UpdateOneID(id int) *CategoryUpdateOne {
-	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategoryID(id))
-	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartItemClient) UpdateOneID(id int) *CartItemUpdateOne {
+	mutation := newCartItemMutation(c.config, OpUpdateOne, withCartItemID(id))
+	return &CartItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Category.
-func (c *CategoryClient) Delete() *CategoryDelete {
-	mutation := newCategoryMutation(c.config, OpDelete)
-	return &CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CartItem.
+func (c *CartItemClient) Delete() *CartItemDelete {
+	mutation := newCartItemMutation(c.config, OpDelete)
+	return &CartItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CategoryClient) DeleteOne(_m *Category) *CategoryDeleteOne {
+func (c *CartItemClient) DeleteOne(_m *CartItem) *CartItemDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CategoryClient) DeleteOneID(id int) *CategoryDeleteOne {
-	builder := c.Delete().Where(category.ID(id))
+func (c *CartItemClient) DeleteOneID(id int) *CartItemDeleteOne {
+	builder := c.Delete().Where(cartitem.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CategoryDeleteOne{builder}
+	return &CartItemDeleteOne{builder}
 }
 
-// Query returns a query builder for Category.
-func (c *CategoryClient) Query() *CategoryQuery {
-	return &CategoryQuery{
+// Query returns a query builder for CartItem.
+func (c *CartItemClient) Query() *CartItemQuery {
+	return &CartItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCategory},
+		ctx:    &QueryContext{Type: TypeCartItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Category entity by its id.
-func (c *CategoryClient) Get(ctx context.Context, id int) (*Category, error) {
-	return c.Query().Where(category.ID(id)).Only(ctx)
+// Get returns a CartItem entity by its id.
+func (c *CartItemClient) Get(ctx context.Context, id int) (*CartItem, error) {
+	return c.Query().Where(cartitem.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CategoryClient) GetX(ctx context.Context, id int) *Category {
+func (c *CartItemClient) GetX(ctx context.Context, id int) *CartItem {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1525,15 +1899,31 @@ func (c *CategoryClient) GetX(ctx context.Context, id int) *Category {
 	return obj
 }
 
-// QueryPosts queries the posts edge of a Category.
-func (c *CategoryClient) QueryPosts(_m *Category) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
+// QueryUser queries the user edge of a CartItem.
+func (c *CartItemClient) QueryUser(_m *CartItem) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(category.Table, category.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, category.PostsTable, category.PostsPrimaryKey...),
+			sqlgraph.From(cartitem.Table, cartitem.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, cartitem.UserTable, cartitem.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProduct queries the product edge of a CartItem.
+func (c *CartItemClient) QueryProduct(_m *CartItem) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cartitem.Table, cartitem.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, cartitem.ProductTable, cartitem.ProductColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1542,44 +1932,193 @@ func (c *CategoryClient) QueryPosts(_m *Category) *PostQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *CategoryClient) Hooks() []Hook {
-	return c.hooks.Category
+func (c *CartItemClient) Hooks() []Hook {
+	return c.hooks.CartItem
 }
 
 // Interceptors returns the client interceptors.
-func (c *CategoryClient) Interceptors() []Interceptor {
-	return c.inters.Category
+func (c *CartItemClient) Interceptors() []Interceptor {
+	return c.inters.CartItem
 }
 
-func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value, error) {
+func (c *CartItemClient) mutate(ctx context.Context, m *CartItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CartItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Category mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CartItem mutation op: %q", m.Op())
 	}
 }
 
-// CommentClient is a client for the Comment schema.
-type CommentClient struct {
+// CategoryClient is a client for the Category schema.
+type CategoryClient struct {
 	config
 }
 
-// NewCommentClient returns a client for the Comment from the given config.
-func NewCommentClient(c config) *CommentClient {
-	return &CommentClient{config: c}
+// NewCategoryClient returns a client for the Category from the given config.
+func NewCategoryClient(c config) *CategoryClient {
+	return &CategoryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
-func (c *CommentClient) Use(hooks ...Hook) {
-	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+// A call to `Use(f, g, h)` equals to `category.Hooks(f(g(h())))`.
+func (c *CategoryClient) Use(hooks ...Hook) {
+	c.hooks.Category = append(c.hooks.Category, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `category.Intercept(f(g(h())))`.
+func (c *CategoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Category = append(c.inters.Category, interceptors...)
+}
+
+// Create returns a builder for creating a Category entity.
+func (c *CategoryClient) Create() *CategoryCreate {
+	mutation := newCategoryMutation(c.config, OpCreate)
+	return &CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Category entities.
+func (c *CategoryClient) CreateBulk(builders ...*CategoryCreate) *CategoryCreateBulk {
+	return &CategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryClient) MapCreateBulk(slice any, setFunc func(*CategoryCreate, int)) *CategoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryCreateBulk{err: fmt.Errorf("calling to CategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Category.
+func (c *CategoryClient) Update() *CategoryUpdate {
+	mutation := newCategoryMutation(c.config, OpUpdate)
+	return &CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryClient) UpdateOne(_m *Category) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategory(_m))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryClient) UpdateOneID(id int) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategoryID(id))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Category.
+func (c *CategoryClient) Delete() *CategoryDelete {
+	mutation := newCategoryMutation(c.config, OpDelete)
+	return &CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryClient) DeleteOne(_m *Category) *CategoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryClient) DeleteOneID(id int) *CategoryDeleteOne {
+	builder := c.Delete().Where(category.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryDeleteOne{builder}
+}
+
+// Query returns a query builder for Category.
+func (c *CategoryClient) Query() *CategoryQuery {
+	return &CategoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Category entity by its id.
+func (c *CategoryClient) Get(ctx context.Context, id int) (*Category, error) {
+	return c.Query().Where(category.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryClient) GetX(ctx context.Context, id int) *Category {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPosts queries the posts edge of a Category.
+func (c *CategoryClient) QueryPosts(_m *Category) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, category.PostsTable, category.PostsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryClient) Hooks() []Hook {
+	return c.hooks.Category
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryClient) Interceptors() []Interceptor {
+	return c.inters.Category
+}
+
+func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Category mutation op: %q", m.Op())
+	}
+}
+
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
+	config
+}
+
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
@@ -2960,6 +3499,139 @@ func (c *LicenseClient) mutate(ctx context.Context, m *LicenseMutation) (Value,
 	}
 }
 
+// LoginLogClient is a client for the LoginLog schema.
+type LoginLogClient struct {
+	config
+}
+
+// NewLoginLogClient returns a client for the LoginLog from the given config.
+func NewLoginLogClient(c config) *LoginLogClient {
+	return &LoginLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginlog.Hooks(f(g(h())))`.
+func (c *LoginLogClient) Use(hooks ...Hook) {
+	c.hooks.LoginLog = append(c.hooks.LoginLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loginlog.Intercept(f(g(h())))`.
+func (c *LoginLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginLog = append(c.inters.LoginLog, interceptors...)
+}
+
+// Create returns a builder for creating a LoginLog entity.
+func (c *LoginLogClient) Create() *LoginLogCreate {
+	mutation := newLoginLogMutation(c.config, OpCreate)
+	return &LoginLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginLog entities.
+func (c *LoginLogClient) CreateBulk(builders ...*LoginLogCreate) *LoginLogCreateBulk {
+	return &LoginLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginLogClient) MapCreateBulk(slice any, setFunc func(*LoginLogCreate, int)) *LoginLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginLogCreateBulk{err: fmt.Errorf("calling to LoginLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginLog.
+func (c *LoginLogClient) Update() *LoginLogUpdate {
+	mutation := newLoginLogMutation(c.config, OpUpdate)
+	return &LoginLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginLogClient) UpdateOne(_m *LoginLog) *LoginLogUpdateOne {
+	mutation := newLoginLogMutation(c.config, OpUpdateOne, withLoginLog(_m))
+	return &LoginLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginLogClient) UpdateOneID(id int) *LoginLogUpdateOne {
+	mutation := newLoginLogMutation(c.config, OpUpdateOne, withLoginLogID(id))
+	return &LoginLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginLog.
+func (c *LoginLogClient) Delete() *LoginLogDelete {
+	mutation := newLoginLogMutation(c.config, OpDelete)
+	return &LoginLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginLogClient) DeleteOne(_m *LoginLog) *LoginLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginLogClient) DeleteOneID(id int) *LoginLogDeleteOne {
+	builder := c.Delete().Where(loginlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginLogDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginLog.
+func (c *LoginLogClient) Query() *LoginLogQuery {
+	return &LoginLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginLog entity by its id.
+func (c *LoginLogClient) Get(ctx context.Context, id int) (*LoginLog, error) {
+	return c.Query().Where(loginlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginLogClient) GetX(ctx context.Context, id int) *LoginLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LoginLogClient) Hooks() []Hook {
+	return c.hooks.LoginLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoginLogClient) Interceptors() []Interceptor {
+	return c.inters.LoginLog
+}
+
+func (c *LoginLogClient) mutate(ctx context.Context, m *LoginLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoginLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoginLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginLog mutation op: %q", m.Op())
+	}
+}
+
 // MemberClient is a client for the Member schema.
 type MemberClient struct {
 	config
@@ -3657,70 +4329,203 @@ func (c *Oauth2AccessTokenClient) mutate(ctx context.Context, m *Oauth2AccessTok
 	}
 }
 
-// Oauth2CodeClient is a client for the Oauth2Code schema.
-type Oauth2CodeClient struct {
+// Oauth2ClientClient is a client for the Oauth2Client schema.
+type Oauth2ClientClient struct {
 	config
 }
 
-// NewOauth2CodeClient returns a client for the Oauth2Code from the given config.
-func NewOauth2CodeClient(c config) *Oauth2CodeClient {
-	return &Oauth2CodeClient{config: c}
+// NewOauth2ClientClient returns a client for the Oauth2Client from the given config.
+func NewOauth2ClientClient(c config) *Oauth2ClientClient {
+	return &Oauth2ClientClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `oauth2code.Hooks(f(g(h())))`.
-func (c *Oauth2CodeClient) Use(hooks ...Hook) {
-	c.hooks.Oauth2Code = append(c.hooks.Oauth2Code, hooks...)
+// A call to `Use(f, g, h)` equals to `oauth2client.Hooks(f(g(h())))`.
+func (c *Oauth2ClientClient) Use(hooks ...Hook) {
+	c.hooks.Oauth2Client = append(c.hooks.Oauth2Client, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `oauth2code.Intercept(f(g(h())))`.
-func (c *Oauth2CodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Oauth2Code = append(c.inters.Oauth2Code, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `oauth2client.Intercept(f(g(h())))`.
+func (c *Oauth2ClientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Oauth2Client = append(c.inters.Oauth2Client, interceptors...)
 }
 
-// Create returns a builder for creating a Oauth2Code entity.
-func (c *Oauth2CodeClient) Create() *Oauth2CodeCreate {
-	mutation := newOauth2CodeMutation(c.config, OpCreate)
-	return &Oauth2CodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Oauth2Client entity.
+func (c *Oauth2ClientClient) Create() *Oauth2ClientCreate {
+	mutation := newOauth2ClientMutation(c.config, OpCreate)
+	return &Oauth2ClientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Oauth2Code entities.
-func (c *Oauth2CodeClient) CreateBulk(builders ...*Oauth2CodeCreate) *Oauth2CodeCreateBulk {
-	return &Oauth2CodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Oauth2Client entities.
+func (c *Oauth2ClientClient) CreateBulk(builders ...*Oauth2ClientCreate) *Oauth2ClientCreateBulk {
+	return &Oauth2ClientCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *Oauth2CodeClient) MapCreateBulk(slice any, setFunc func(*Oauth2CodeCreate, int)) *Oauth2CodeCreateBulk {
+func (c *Oauth2ClientClient) MapCreateBulk(slice any, setFunc func(*Oauth2ClientCreate, int)) *Oauth2ClientCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &Oauth2CodeCreateBulk{err: fmt.Errorf("calling to Oauth2CodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &Oauth2ClientCreateBulk{err: fmt.Errorf("calling to Oauth2ClientClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*Oauth2CodeCreate, rv.Len())
+	builders := make([]*Oauth2ClientCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &Oauth2CodeCreateBulk{config: c.config, builders: builders}
+	return &Oauth2ClientCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Oauth2Code.
-func (c *Oauth2CodeClient) Update() *Oauth2CodeUpdate {
-	mutation := newOauth2CodeMutation(c.config, OpUpdate)
-	return &Oauth2CodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Oauth2Client.
+func (c *Oauth2ClientClient) Update() *Oauth2ClientUpdate {
+	mutation := newOauth2ClientMutation(c.config, OpUpdate)
+	return &Oauth2ClientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *Oauth2CodeClient) UpdateOne(_m *Oauth2Code) *Oauth2CodeUpdateOne {
-	mutation := newOauth2CodeMutation(c.config, OpUpdateOne, withOauth2Code(_m))
-	return &Oauth2CodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *Oauth2ClientClient) UpdateOne(_m *Oauth2Client) *Oauth2ClientUpdateOne {
+	mutation := newOauth2ClientMutation(c.config, OpUpdateOne, withOauth2Client(_m))
+	return &Oauth2ClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *Oauth2CodeClient) UpdateOneID(id int) *Oauth2CodeUpdateOne {
-	mutation := newOauth2CodeMutation(c.config, OpUpdateOne, withOauth2CodeID(id))
-	return &Oauth2CodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *Oauth2ClientClient) UpdateOneID(id int) *Oauth2ClientUpdateOne {
+	mutation := newOauth2ClientMutation(c.config, OpUpdateOne, withOauth2ClientID(id))
+	return &Oauth2ClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Oauth2Client.
+func (c *Oauth2ClientClient) Delete() *Oauth2ClientDelete {
+	mutation := newOauth2ClientMutation(c.config, OpDelete)
+	return &Oauth2ClientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *Oauth2ClientClient) DeleteOne(_m *Oauth2Client) *Oauth2ClientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *Oauth2ClientClient) DeleteOneID(id int) *Oauth2ClientDeleteOne {
+	builder := c.Delete().Where(oauth2client.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &Oauth2ClientDeleteOne{builder}
+}
+
+// Query returns a query builder for Oauth2Client.
+func (c *Oauth2ClientClient) Query() *Oauth2ClientQuery {
+	return &Oauth2ClientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauth2Client},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Oauth2Client entity by its id.
+func (c *Oauth2ClientClient) Get(ctx context.Context, id int) (*Oauth2Client, error) {
+	return c.Query().Where(oauth2client.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *Oauth2ClientClient) GetX(ctx context.Context, id int) *Oauth2Client {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *Oauth2ClientClient) Hooks() []Hook {
+	return c.hooks.Oauth2Client
+}
+
+// Interceptors returns the client interceptors.
+func (c *Oauth2ClientClient) Interceptors() []Interceptor {
+	return c.inters.Oauth2Client
+}
+
+func (c *Oauth2ClientClient) mutate(ctx context.Context, m *Oauth2ClientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&Oauth2ClientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&Oauth2ClientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&Oauth2ClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&Oauth2ClientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Oauth2Client mutation op: %q", m.Op())
+	}
+}
+
+// Oauth2CodeClient is a client for the Oauth2Code schema.
+type Oauth2CodeClient struct {
+	config
+}
+
+// NewOauth2CodeClient returns a client for the Oauth2Code from the given config.
+func NewOauth2CodeClient(c config) *Oauth2CodeClient {
+	return &Oauth2CodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauth2code.Hooks(f(g(h())))`.
+func (c *Oauth2CodeClient) Use(hooks ...Hook) {
+	c.hooks.Oauth2Code = append(c.hooks.Oauth2Code, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauth2code.Intercept(f(g(h())))`.
+func (c *Oauth2CodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Oauth2Code = append(c.inters.Oauth2Code, interceptors...)
+}
+
+// Create returns a builder for creating a Oauth2Code entity.
+func (c *Oauth2CodeClient) Create() *Oauth2CodeCreate {
+	mutation := newOauth2CodeMutation(c.config, OpCreate)
+	return &Oauth2CodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Oauth2Code entities.
+func (c *Oauth2CodeClient) CreateBulk(builders ...*Oauth2CodeCreate) *Oauth2CodeCreateBulk {
+	return &Oauth2CodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *Oauth2CodeClient) MapCreateBulk(slice any, setFunc func(*Oauth2CodeCreate, int)) *Oauth2CodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &Oauth2CodeCreateBulk{err: fmt.Errorf("calling to Oauth2CodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*Oauth2CodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &Oauth2CodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Oauth2Code.
+func (c *Oauth2CodeClient) Update() *Oauth2CodeUpdate {
+	mutation := newOauth2CodeMutation(c.config, OpUpdate)
+	return &Oauth2CodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *Oauth2CodeClient) UpdateOne(_m *Oauth2Code) *Oauth2CodeUpdateOne {
+	mutation := newOauth2CodeMutation(c.config, OpUpdateOne, withOauth2Code(_m))
+	return &Oauth2CodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *Oauth2CodeClient) UpdateOneID(id int) *Oauth2CodeUpdateOne {
+	mutation := newOauth2CodeMutation(c.config, OpUpdateOne, withOauth2CodeID(id))
+	return &Oauth2CodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // Delete returns a delete builder for Oauth2Code.
@@ -3923,6 +4728,155 @@ func (c *Oauth2RefreshTokenClient) mutate(ctx context.Context, m *Oauth2RefreshT
 	}
 }
 
+// OrderDeliveryClient is a client for the OrderDelivery schema.
+type OrderDeliveryClient struct {
+	config
+}
+
+// NewOrderDeliveryClient returns a client for the OrderDelivery from the given config.
+func NewOrderDeliveryClient(c config) *OrderDeliveryClient {
+	return &OrderDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderdelivery.Hooks(f(g(h())))`.
+func (c *OrderDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.OrderDelivery = append(c.hooks.OrderDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderdelivery.Intercept(f(g(h())))`.
+func (c *OrderDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderDelivery = append(c.inters.OrderDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a OrderDelivery entity.
+func (c *OrderDeliveryClient) Create() *OrderDeliveryCreate {
+	mutation := newOrderDeliveryMutation(c.config, OpCreate)
+	return &OrderDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderDelivery entities.
+func (c *OrderDeliveryClient) CreateBulk(builders ...*OrderDeliveryCreate) *OrderDeliveryCreateBulk {
+	return &OrderDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderDeliveryClient) MapCreateBulk(slice any, setFunc func(*OrderDeliveryCreate, int)) *OrderDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderDeliveryCreateBulk{err: fmt.Errorf("calling to OrderDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderDelivery.
+func (c *OrderDeliveryClient) Update() *OrderDeliveryUpdate {
+	mutation := newOrderDeliveryMutation(c.config, OpUpdate)
+	return &OrderDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderDeliveryClient) UpdateOne(_m *OrderDelivery) *OrderDeliveryUpdateOne {
+	mutation := newOrderDeliveryMutation(c.config, OpUpdateOne, withOrderDelivery(_m))
+	return &OrderDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderDeliveryClient) UpdateOneID(id int) *OrderDeliveryUpdateOne {
+	mutation := newOrderDeliveryMutation(c.config, OpUpdateOne, withOrderDeliveryID(id))
+	return &OrderDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderDelivery.
+func (c *OrderDeliveryClient) Delete() *OrderDeliveryDelete {
+	mutation := newOrderDeliveryMutation(c.config, OpDelete)
+	return &OrderDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderDeliveryClient) DeleteOne(_m *OrderDelivery) *OrderDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderDeliveryClient) DeleteOneID(id int) *OrderDeliveryDeleteOne {
+	builder := c.Delete().Where(orderdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderDelivery.
+func (c *OrderDeliveryClient) Query() *OrderDeliveryQuery {
+	return &OrderDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderDelivery entity by its id.
+func (c *OrderDeliveryClient) Get(ctx context.Context, id int) (*OrderDelivery, error) {
+	return c.Query().Where(orderdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderDeliveryClient) GetX(ctx context.Context, id int) *OrderDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a OrderDelivery.
+func (c *OrderDeliveryClient) QueryOrder(_m *OrderDelivery) *PayOrderQuery {
+	query := (&PayOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderdelivery.Table, orderdelivery.FieldID, id),
+			sqlgraph.To(payorder.Table, payorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orderdelivery.OrderTable, orderdelivery.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderDeliveryClient) Hooks() []Hook {
+	return c.hooks.OrderDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.OrderDelivery
+}
+
+func (c *OrderDeliveryClient) mutate(ctx context.Context, m *OrderDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderDelivery mutation op: %q", m.Op())
+	}
+}
+
 // PayOrderClient is a client for the PayOrder schema.
 type PayOrderClient struct {
 	config
@@ -4104,6 +5058,171 @@ func (c *PayOrderClient) mutate(ctx context.Context, m *PayOrderMutation) (Value
 	}
 }
 
+// PayOrderItemClient is a client for the PayOrderItem schema.
+type PayOrderItemClient struct {
+	config
+}
+
+// NewPayOrderItemClient returns a client for the PayOrderItem from the given config.
+func NewPayOrderItemClient(c config) *PayOrderItemClient {
+	return &PayOrderItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payorderitem.Hooks(f(g(h())))`.
+func (c *PayOrderItemClient) Use(hooks ...Hook) {
+	c.hooks.PayOrderItem = append(c.hooks.PayOrderItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payorderitem.Intercept(f(g(h())))`.
+func (c *PayOrderItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayOrderItem = append(c.inters.PayOrderItem, interceptors...)
+}
+
+// Create returns a builder for creating a PayOrderItem entity.
+func (c *PayOrderItemClient) Create() *PayOrderItemCreate {
+	mutation := newPayOrderItemMutation(c.config, OpCreate)
+	return &PayOrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayOrderItem entities.
+func (c *PayOrderItemClient) CreateBulk(builders ...*PayOrderItemCreate) *PayOrderItemCreateBulk {
+	return &PayOrderItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayOrderItemClient) MapCreateBulk(slice any, setFunc func(*PayOrderItemCreate, int)) *PayOrderItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayOrderItemCreateBulk{err: fmt.Errorf("calling to PayOrderItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayOrderItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayOrderItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayOrderItem.
+func (c *PayOrderItemClient) Update() *PayOrderItemUpdate {
+	mutation := newPayOrderItemMutation(c.config, OpUpdate)
+	return &PayOrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayOrderItemClient) UpdateOne(_m *PayOrderItem) *PayOrderItemUpdateOne {
+	mutation := newPayOrderItemMutation(c.config, OpUpdateOne, withPayOrderItem(_m))
+	return &PayOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayOrderItemClient) UpdateOneID(id int) *PayOrderItemUpdateOne {
+	mutation := newPayOrderItemMutation(c.config, OpUpdateOne, withPayOrderItemID(id))
+	return &PayOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayOrderItem.
+func (c *PayOrderItemClient) Delete() *PayOrderItemDelete {
+	mutation := newPayOrderItemMutation(c.config, OpDelete)
+	return &PayOrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayOrderItemClient) DeleteOne(_m *PayOrderItem) *PayOrderItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayOrderItemClient) DeleteOneID(id int) *PayOrderItemDeleteOne {
+	builder := c.Delete().Where(payorderitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayOrderItemDeleteOne{builder}
+}
+
+// Query returns a query builder for PayOrderItem.
+func (c *PayOrderItemClient) Query() *PayOrderItemQuery {
+	return &PayOrderItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayOrderItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayOrderItem entity by its id.
+func (c *PayOrderItemClient) Get(ctx context.Context, id int) (*PayOrderItem, error) {
+	return c.Query().Where(payorderitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayOrderItemClient) GetX(ctx context.Context, id int) *PayOrderItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a PayOrderItem.
+func (c *PayOrderItemClient) QueryOrder(_m *PayOrderItem) *PayOrderQuery {
+	query := (&PayOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payorderitem.Table, payorderitem.FieldID, id),
+			sqlgraph.To(payorder.Table, payorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, payorderitem.OrderTable, payorderitem.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProduct queries the product edge of a PayOrderItem.
+func (c *PayOrderItemClient) QueryProduct(_m *PayOrderItem) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payorderitem.Table, payorderitem.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, payorderitem.ProductTable, payorderitem.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PayOrderItemClient) Hooks() []Hook {
+	return c.hooks.PayOrderItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayOrderItemClient) Interceptors() []Interceptor {
+	return c.inters.PayOrderItem
+}
+
+func (c *PayOrderItemClient) mutate(ctx context.Context, m *PayOrderItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayOrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayOrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayOrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayOrderItem mutation op: %q", m.Op())
+	}
+}
+
 // PersonalAccessTokenClient is a client for the PersonalAccessToken schema.
 type PersonalAccessTokenClient struct {
 	config
@@ -4751,72 +5870,370 @@ func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBul
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductClient) MapCreateBulk(slice any, setFunc func(*ProductCreate, int)) *ProductCreateBulk {
+func (c *ProductClient) MapCreateBulk(slice any, setFunc func(*ProductCreate, int)) *ProductCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductCreateBulk{err: fmt.Errorf("calling to ProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Product.
+func (c *ProductClient) Update() *ProductUpdate {
+	mutation := newProductMutation(c.config, OpUpdate)
+	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductClient) UpdateOne(_m *Product) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(_m))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductClient) UpdateOneID(id int) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Product.
+func (c *ProductClient) Delete() *ProductDelete {
+	mutation := newProductMutation(c.config, OpDelete)
+	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductClient) DeleteOne(_m *Product) *ProductDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductClient) DeleteOneID(id int) *ProductDeleteOne {
+	builder := c.Delete().Where(product.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductDeleteOne{builder}
+}
+
+// Query returns a query builder for Product.
+func (c *ProductClient) Query() *ProductQuery {
+	return &ProductQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProduct},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Product entity by its id.
+func (c *ProductClient) Get(ctx context.Context, id int) (*Product, error) {
+	return c.Query().Where(product.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductClient) Hooks() []Hook {
+	return c.hooks.Product
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductClient) Interceptors() []Interceptor {
+	return c.inters.Product
+}
+
+func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Product mutation op: %q", m.Op())
+	}
+}
+
+// ProductDeliverableClient is a client for the ProductDeliverable schema.
+type ProductDeliverableClient struct {
+	config
+}
+
+// NewProductDeliverableClient returns a client for the ProductDeliverable from the given config.
+func NewProductDeliverableClient(c config) *ProductDeliverableClient {
+	return &ProductDeliverableClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productdeliverable.Hooks(f(g(h())))`.
+func (c *ProductDeliverableClient) Use(hooks ...Hook) {
+	c.hooks.ProductDeliverable = append(c.hooks.ProductDeliverable, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `productdeliverable.Intercept(f(g(h())))`.
+func (c *ProductDeliverableClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductDeliverable = append(c.inters.ProductDeliverable, interceptors...)
+}
+
+// Create returns a builder for creating a ProductDeliverable entity.
+func (c *ProductDeliverableClient) Create() *ProductDeliverableCreate {
+	mutation := newProductDeliverableMutation(c.config, OpCreate)
+	return &ProductDeliverableCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductDeliverable entities.
+func (c *ProductDeliverableClient) CreateBulk(builders ...*ProductDeliverableCreate) *ProductDeliverableCreateBulk {
+	return &ProductDeliverableCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductDeliverableClient) MapCreateBulk(slice any, setFunc func(*ProductDeliverableCreate, int)) *ProductDeliverableCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductDeliverableCreateBulk{err: fmt.Errorf("calling to ProductDeliverableClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductDeliverableCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductDeliverableCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductDeliverable.
+func (c *ProductDeliverableClient) Update() *ProductDeliverableUpdate {
+	mutation := newProductDeliverableMutation(c.config, OpUpdate)
+	return &ProductDeliverableUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductDeliverableClient) UpdateOne(_m *ProductDeliverable) *ProductDeliverableUpdateOne {
+	mutation := newProductDeliverableMutation(c.config, OpUpdateOne, withProductDeliverable(_m))
+	return &ProductDeliverableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductDeliverableClient) UpdateOneID(id int) *ProductDeliverableUpdateOne {
+	mutation := newProductDeliverableMutation(c.config, OpUpdateOne, withProductDeliverableID(id))
+	return &ProductDeliverableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductDeliverable.
+func (c *ProductDeliverableClient) Delete() *ProductDeliverableDelete {
+	mutation := newProductDeliverableMutation(c.config, OpDelete)
+	return &ProductDeliverableDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductDeliverableClient) DeleteOne(_m *ProductDeliverable) *ProductDeliverableDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductDeliverableClient) DeleteOneID(id int) *ProductDeliverableDeleteOne {
+	builder := c.Delete().Where(productdeliverable.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductDeliverableDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductDeliverable.
+func (c *ProductDeliverableClient) Query() *ProductDeliverableQuery {
+	return &ProductDeliverableQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProductDeliverable},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProductDeliverable entity by its id.
+func (c *ProductDeliverableClient) Get(ctx context.Context, id int) (*ProductDeliverable, error) {
+	return c.Query().Where(productdeliverable.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductDeliverableClient) GetX(ctx context.Context, id int) *ProductDeliverable {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a ProductDeliverable.
+func (c *ProductDeliverableClient) QueryProduct(_m *ProductDeliverable) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productdeliverable.Table, productdeliverable.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, productdeliverable.ProductTable, productdeliverable.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFile queries the file edge of a ProductDeliverable.
+func (c *ProductDeliverableClient) QueryFile(_m *ProductDeliverable) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productdeliverable.Table, productdeliverable.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, productdeliverable.FileTable, productdeliverable.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProductDeliverableClient) Hooks() []Hook {
+	return c.hooks.ProductDeliverable
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductDeliverableClient) Interceptors() []Interceptor {
+	return c.inters.ProductDeliverable
+}
+
+func (c *ProductDeliverableClient) mutate(ctx context.Context, m *ProductDeliverableMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductDeliverableCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductDeliverableUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductDeliverableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductDeliverableDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProductDeliverable mutation op: %q", m.Op())
+	}
+}
+
+// ProductKeyClient is a client for the ProductKey schema.
+type ProductKeyClient struct {
+	config
+}
+
+// NewProductKeyClient returns a client for the ProductKey from the given config.
+func NewProductKeyClient(c config) *ProductKeyClient {
+	return &ProductKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productkey.Hooks(f(g(h())))`.
+func (c *ProductKeyClient) Use(hooks ...Hook) {
+	c.hooks.ProductKey = append(c.hooks.ProductKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `productkey.Intercept(f(g(h())))`.
+func (c *ProductKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductKey = append(c.inters.ProductKey, interceptors...)
+}
+
+// Create returns a builder for creating a ProductKey entity.
+func (c *ProductKeyClient) Create() *ProductKeyCreate {
+	mutation := newProductKeyMutation(c.config, OpCreate)
+	return &ProductKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductKey entities.
+func (c *ProductKeyClient) CreateBulk(builders ...*ProductKeyCreate) *ProductKeyCreateBulk {
+	return &ProductKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductKeyClient) MapCreateBulk(slice any, setFunc func(*ProductKeyCreate, int)) *ProductKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductCreateBulk{err: fmt.Errorf("calling to ProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProductKeyCreateBulk{err: fmt.Errorf("calling to ProductKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductCreate, rv.Len())
+	builders := make([]*ProductKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductCreateBulk{config: c.config, builders: builders}
+	return &ProductKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Product.
-func (c *ProductClient) Update() *ProductUpdate {
-	mutation := newProductMutation(c.config, OpUpdate)
-	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProductKey.
+func (c *ProductKeyClient) Update() *ProductKeyUpdate {
+	mutation := newProductKeyMutation(c.config, OpUpdate)
+	return &ProductKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductClient) UpdateOne(_m *Product) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(_m))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductKeyClient) UpdateOne(_m *ProductKey) *ProductKeyUpdateOne {
+	mutation := newProductKeyMutation(c.config, OpUpdateOne, withProductKey(_m))
+	return &ProductKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductClient) UpdateOneID(id int) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProductKeyClient) UpdateOneID(id int) *ProductKeyUpdateOne {
+	mutation := newProductKeyMutation(c.config, OpUpdateOne, withProductKeyID(id))
+	return &ProductKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Product.
-func (c *ProductClient) Delete() *ProductDelete {
-	mutation := newProductMutation(c.config, OpDelete)
-	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProductKey.
+func (c *ProductKeyClient) Delete() *ProductKeyDelete {
+	mutation := newProductKeyMutation(c.config, OpDelete)
+	return &ProductKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductClient) DeleteOne(_m *Product) *ProductDeleteOne {
+func (c *ProductKeyClient) DeleteOne(_m *ProductKey) *ProductKeyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductClient) DeleteOneID(id int) *ProductDeleteOne {
-	builder := c.Delete().Where(product.ID(id))
+func (c *ProductKeyClient) DeleteOneID(id int) *ProductKeyDeleteOne {
+	builder := c.Delete().Where(productkey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductDeleteOne{builder}
+	return &ProductKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for Product.
-func (c *ProductClient) Query() *ProductQuery {
-	return &ProductQuery{
+// Query returns a query builder for ProductKey.
+func (c *ProductKeyClient) Query() *ProductKeyQuery {
+	return &ProductKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProduct},
+		ctx:    &QueryContext{Type: TypeProductKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Product entity by its id.
-func (c *ProductClient) Get(ctx context.Context, id int) (*Product, error) {
-	return c.Query().Where(product.ID(id)).Only(ctx)
+// Get returns a ProductKey entity by its id.
+func (c *ProductKeyClient) Get(ctx context.Context, id int) (*ProductKey, error) {
+	return c.Query().Where(productkey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
+func (c *ProductKeyClient) GetX(ctx context.Context, id int) *ProductKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4824,28 +6241,44 @@ func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
 	return obj
 }
 
+// QueryProduct queries the product edge of a ProductKey.
+func (c *ProductKeyClient) QueryProduct(_m *ProductKey) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productkey.Table, productkey.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, productkey.ProductTable, productkey.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *ProductClient) Hooks() []Hook {
-	return c.hooks.Product
+func (c *ProductKeyClient) Hooks() []Hook {
+	return c.hooks.ProductKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductClient) Interceptors() []Interceptor {
-	return c.inters.Product
+func (c *ProductKeyClient) Interceptors() []Interceptor {
+	return c.inters.ProductKey
 }
 
-func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value, error) {
+func (c *ProductKeyClient) mutate(ctx context.Context, m *ProductKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProductKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProductKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Product mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProductKey mutation op: %q", m.Op())
 	}
 }
 
@@ -6000,6 +7433,38 @@ func (c *UserClient) QueryAiChatSessions(_m *User) *AIChatSessionQuery {
 	return query
 }
 
+// QueryAiPersonas queries the ai_personas edge of a User.
+func (c *UserClient) QueryAiPersonas(_m *User) *AIPersonaQuery {
+	query := (&AIPersonaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(aipersona.Table, aipersona.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AiPersonasTable, user.AiPersonasColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(_m *User) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -6025,6 +7490,155 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
+	config
+}
+
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserIdentityClient) UpdateOne(_m *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(_m))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserIdentityClient) UpdateOneID(id int) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserIdentityClient) DeleteOne(_m *UserIdentity) *UserIdentityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserIdentityClient) DeleteOneID(id int) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id int) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserIdentityClient) GetX(ctx context.Context, id int) *UserIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserIdentity.
+func (c *UserIdentityClient) QueryUser(_m *UserIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useridentity.UserTable, useridentity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
+}
+
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserIdentity mutation op: %q", m.Op())
+	}
+}
+
 // VisitLogClient is a client for the VisitLog schema.
 type VisitLogClient struct {
 	config
@@ -6415,6 +8029,22 @@ func (c *WebHookClient) GetX(ctx context.Context, id int) *WebHook {
 	return obj
 }
 
+// QueryDeliveries queries the deliveries edge of a WebHook.
+func (c *WebHookClient) QueryDeliveries(_m *WebHook) *WebHookDeliveryQuery {
+	query := (&WebHookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WebHookClient) Hooks() []Hook {
 	return c.hooks.WebHook
@@ -6440,24 +8070,177 @@ func (c *WebHookClient) mutate(ctx context.Context, m *WebHookMutation) (Value,
 	}
 }
 
+// WebHookDeliveryClient is a client for the WebHookDelivery schema.
+type WebHookDeliveryClient struct {
+	config
+}
+
+// NewWebHookDeliveryClient returns a client for the WebHookDelivery from the given config.
+func NewWebHookDeliveryClient(c config) *WebHookDeliveryClient {
+	return &WebHookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebHookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebHookDelivery = append(c.hooks.WebHookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebHookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebHookDelivery = append(c.inters.WebHookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebHookDelivery entity.
+func (c *WebHookDeliveryClient) Create() *WebHookDeliveryCreate {
+	mutation := newWebHookDeliveryMutation(c.config, OpCreate)
+	return &WebHookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebHookDelivery entities.
+func (c *WebHookDeliveryClient) CreateBulk(builders ...*WebHookDeliveryCreate) *WebHookDeliveryCreateBulk {
+	return &WebHookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebHookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebHookDeliveryCreate, int)) *WebHookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebHookDeliveryCreateBulk{err: fmt.Errorf("calling to WebHookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebHookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebHookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebHookDelivery.
+func (c *WebHookDeliveryClient) Update() *WebHookDeliveryUpdate {
+	mutation := newWebHookDeliveryMutation(c.config, OpUpdate)
+	return &WebHookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebHookDeliveryClient) UpdateOne(_m *WebHookDelivery) *WebHookDeliveryUpdateOne {
+	mutation := newWebHookDeliveryMutation(c.config, OpUpdateOne, withWebHookDelivery(_m))
+	return &WebHookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebHookDeliveryClient) UpdateOneID(id int) *WebHookDeliveryUpdateOne {
+	mutation := newWebHookDeliveryMutation(c.config, OpUpdateOne, withWebHookDeliveryID(id))
+	return &WebHookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebHookDelivery.
+func (c *WebHookDeliveryClient) Delete() *WebHookDeliveryDelete {
+	mutation := newWebHookDeliveryMutation(c.config, OpDelete)
+	return &WebHookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebHookDeliveryClient) DeleteOne(_m *WebHookDelivery) *WebHookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebHookDeliveryClient) DeleteOneID(id int) *WebHookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebHookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebHookDelivery.
+func (c *WebHookDeliveryClient) Query() *WebHookDeliveryQuery {
+	return &WebHookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebHookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebHookDelivery entity by its id.
+func (c *WebHookDeliveryClient) Get(ctx context.Context, id int) (*WebHookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebHookDeliveryClient) GetX(ctx context.Context, id int) *WebHookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebHookDelivery.
+func (c *WebHookDeliveryClient) QueryWebhook(_m *WebHookDelivery) *WebHookQuery {
+	query := (&WebHookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebHookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebHookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebHookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebHookDelivery
+}
+
+func (c *WebHookDeliveryClient) mutate(ctx context.Context, m *WebHookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebHookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebHookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebHookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebHookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebHookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AIChatMessage, AIChatSession, AIModel, AIProvider, Album, AlbumPhoto, Category,
-		Comment, Coupon, CouponUsage, Essay, FLink, FLinkApplication, FLinkGroup, File,
-		FriendCircleRecord, License, Member, MemberLevel, Menu, Notification,
-		Oauth2AccessToken, Oauth2Code, Oauth2RefreshToken, PayOrder,
-		PersonalAccessToken, Plugin, Post, PostPurchase, Product, RefreshToken, Role,
-		ScheduleJob, Setting, StorageStrategy, Tag, Theme, User, VisitLog, Wallet,
-		WebHook []ent.Hook
+		AIAskLog, AIChatMessage, AIChatSession, AIModel, AIPersona, AIProvider, Album,
+		AlbumPhoto, CartItem, Category, Comment, Coupon, CouponUsage, Essay, FLink,
+		FLinkApplication, FLinkGroup, File, FriendCircleRecord, License, LoginLog,
+		Member, MemberLevel, Menu, Notification, Oauth2AccessToken, Oauth2Client,
+		Oauth2Code, Oauth2RefreshToken, OrderDelivery, PayOrder, PayOrderItem,
+		PersonalAccessToken, Plugin, Post, PostPurchase, Product, ProductDeliverable,
+		ProductKey, RefreshToken, Role, ScheduleJob, Setting, StorageStrategy, Tag,
+		Theme, User, UserIdentity, VisitLog, Wallet, WebHook,
+		WebHookDelivery []ent.Hook
 	}
 	inters struct {
-		AIChatMessage, AIChatSession, AIModel, AIProvider, Album, AlbumPhoto, Category,
-		Comment, Coupon, CouponUsage, Essay, FLink, FLinkApplication, FLinkGroup, File,
-		FriendCircleRecord, License, Member, MemberLevel, Menu, Notification,
-		Oauth2AccessToken, Oauth2Code, Oauth2RefreshToken, PayOrder,
-		PersonalAccessToken, Plugin, Post, PostPurchase, Product, RefreshToken, Role,
-		ScheduleJob, Setting, StorageStrategy, Tag, Theme, User, VisitLog, Wallet,
-		WebHook []ent.Interceptor
+		AIAskLog, AIChatMessage, AIChatSession, AIModel, AIPersona, AIProvider, Album,
+		AlbumPhoto, CartItem, Category, Comment, Coupon, CouponUsage, Essay, FLink,
+		FLinkApplication, FLinkGroup, File, FriendCircleRecord, License, LoginLog,
+		Member, MemberLevel, Menu, Notification, Oauth2AccessToken, Oauth2Client,
+		Oauth2Code, Oauth2RefreshToken, OrderDelivery, PayOrder, PayOrderItem,
+		PersonalAccessToken, Plugin, Post, PostPurchase, Product, ProductDeliverable,
+		ProductKey, RefreshToken, Role, ScheduleJob, Setting, StorageStrategy, Tag,
+		Theme, User, UserIdentity, VisitLog, Wallet, WebHook,
+		WebHookDelivery []ent.Interceptor
 	}
 )
@@ -33,8 +33,16 @@ type Comment struct {
 	Content string `json:"content,omitempty"`
 	// 评论者用户ID
 	UserID *int `json:"user_id,omitempty"`
-	// 状态(1未审核,2已审核)
+	// 评论者昵称
+	Nick string `json:"nick,omitempty"`
+	// 评论者邮箱
+	Email string `json:"email,omitempty"`
+	// 状态(1待审核,2已通过,3已拒绝)
 	Status int `json:"status,omitempty"`
+	// 审核原因
+	ModerationReason string `json:"moderation_reason,omitempty"`
+	// AI垃圾评论评分(0-1)
+	SpamScore *float64 `json:"spam_score,omitempty"`
 	// 评论者用户代理
 	UserAgent *string `json:"user_agent,omitempty"`
 	// 评论者IP地址
@@ -53,9 +61,11 @@ func (*Comment) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case comment.FieldPinned:
 			values[i] = new(sql.NullBool)
+		case comment.FieldSpamScore:
+			values[i] = new(sql.NullFloat64)
 		case comment.FieldID, comment.FieldPostID, comment.FieldPageID, comment.FieldParentID, comment.FieldUserID, comment.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case comment.FieldURL, comment.FieldContent, comment.FieldUserAgent, comment.FieldIPAddress, comment.FieldIPLocation:
+		case comment.FieldURL, comment.FieldContent, comment.FieldNick, comment.FieldEmail, comment.FieldModerationReason, comment.FieldUserAgent, comment.FieldIPAddress, comment.FieldIPLocation:
 			values[i] = new(sql.NullString)
 		case comment.FieldCreatedAt, comment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -132,12 +142,37 @@ func (_m *Comment) assignValues(columns []string, values []any) error {
 				_m.UserID = new(int)
 				*_m.UserID = int(value.Int64)
 			}
+		case comment.FieldNick:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nick", values[i])
+			} else if value.Valid {
+				_m.Nick = value.String
+			}
+		case comment.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
 		case comment.FieldStatus:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = int(value.Int64)
 			}
+		case comment.FieldModerationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_reason", values[i])
+			} else if value.Valid {
+				_m.ModerationReason = value.String
+			}
+		case comment.FieldSpamScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_score", values[i])
+			} else if value.Valid {
+				_m.SpamScore = new(float64)
+				*_m.SpamScore = value.Float64
+			}
 		case comment.FieldUserAgent:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
@@ -232,9 +267,23 @@ func (_m *Comment) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("nick=")
+	builder.WriteString(_m.Nick)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("moderation_reason=")
+	builder.WriteString(_m.ModerationReason)
+	builder.WriteString(", ")
+	if v := _m.SpamScore; v != nil {
+		builder.WriteString("spam_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.UserAgent; v != nil {
 		builder.WriteString("user_agent=")
 		builder.WriteString(*v)
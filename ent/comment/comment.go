@@ -29,8 +29,16 @@ const (
 	FieldContent = "content"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldNick holds the string denoting the nick field in the database.
+	FieldNick = "nick"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldModerationReason holds the string denoting the moderation_reason field in the database.
+	FieldModerationReason = "moderation_reason"
+	// FieldSpamScore holds the string denoting the spam_score field in the database.
+	FieldSpamScore = "spam_score"
 	// FieldUserAgent holds the string denoting the user_agent field in the database.
 	FieldUserAgent = "user_agent"
 	// FieldIPAddress holds the string denoting the ip_address field in the database.
@@ -54,7 +62,11 @@ var Columns = []string{
 	FieldParentID,
 	FieldContent,
 	FieldUserID,
+	FieldNick,
+	FieldEmail,
 	FieldStatus,
+	FieldModerationReason,
+	FieldSpamScore,
 	FieldUserAgent,
 	FieldIPAddress,
 	FieldIPLocation,
@@ -80,8 +92,14 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	ContentValidator func(string) error
+	// NickValidator is a validator for the "nick" field. It is called by the builders before save.
+	NickValidator func(string) error
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus int
+	// ModerationReasonValidator is a validator for the "moderation_reason" field. It is called by the builders before save.
+	ModerationReasonValidator func(string) error
 	// IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
 	IPAddressValidator func(string) error
 	// IPLocationValidator is a validator for the "ip_location" field. It is called by the builders before save.
@@ -138,11 +156,31 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByNick orders the results by the nick field.
+func ByNick(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNick, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByModerationReason orders the results by the moderation_reason field.
+func ByModerationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationReason, opts...).ToFunc()
+}
+
+// BySpamScore orders the results by the spam_score field.
+func BySpamScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpamScore, opts...).ToFunc()
+}
+
 // ByUserAgent orders the results by the user_agent field.
 func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
@@ -94,11 +94,31 @@ func UserID(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldUserID, v))
 }
 
+// Nick applies equality check predicate on the "nick" field. It's identical to NickEQ.
+func Nick(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldNick, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEmail, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldStatus, v))
 }
 
+// ModerationReason applies equality check predicate on the "moderation_reason" field. It's identical to ModerationReasonEQ.
+func ModerationReason(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldModerationReason, v))
+}
+
+// SpamScore applies equality check predicate on the "spam_score" field. It's identical to SpamScoreEQ.
+func SpamScore(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldSpamScore, v))
+}
+
 // UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
 func UserAgent(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldUserAgent, v))
@@ -539,6 +559,156 @@ func UserIDNotNil() predicate.Comment {
 	return predicate.Comment(sql.FieldNotNull(FieldUserID))
 }
 
+// NickEQ applies the EQ predicate on the "nick" field.
+func NickEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldNick, v))
+}
+
+// NickNEQ applies the NEQ predicate on the "nick" field.
+func NickNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldNick, v))
+}
+
+// NickIn applies the In predicate on the "nick" field.
+func NickIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldNick, vs...))
+}
+
+// NickNotIn applies the NotIn predicate on the "nick" field.
+func NickNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldNick, vs...))
+}
+
+// NickGT applies the GT predicate on the "nick" field.
+func NickGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldNick, v))
+}
+
+// NickGTE applies the GTE predicate on the "nick" field.
+func NickGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldNick, v))
+}
+
+// NickLT applies the LT predicate on the "nick" field.
+func NickLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldNick, v))
+}
+
+// NickLTE applies the LTE predicate on the "nick" field.
+func NickLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldNick, v))
+}
+
+// NickContains applies the Contains predicate on the "nick" field.
+func NickContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldNick, v))
+}
+
+// NickHasPrefix applies the HasPrefix predicate on the "nick" field.
+func NickHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldNick, v))
+}
+
+// NickHasSuffix applies the HasSuffix predicate on the "nick" field.
+func NickHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldNick, v))
+}
+
+// NickIsNil applies the IsNil predicate on the "nick" field.
+func NickIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldNick))
+}
+
+// NickNotNil applies the NotNil predicate on the "nick" field.
+func NickNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldNick))
+}
+
+// NickEqualFold applies the EqualFold predicate on the "nick" field.
+func NickEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldNick, v))
+}
+
+// NickContainsFold applies the ContainsFold predicate on the "nick" field.
+func NickContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldNick, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldEmail, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldStatus, v))
@@ -579,6 +749,131 @@ func StatusLTE(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldLTE(FieldStatus, v))
 }
 
+// ModerationReasonEQ applies the EQ predicate on the "moderation_reason" field.
+func ModerationReasonEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldModerationReason, v))
+}
+
+// ModerationReasonNEQ applies the NEQ predicate on the "moderation_reason" field.
+func ModerationReasonNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldModerationReason, v))
+}
+
+// ModerationReasonIn applies the In predicate on the "moderation_reason" field.
+func ModerationReasonIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldModerationReason, vs...))
+}
+
+// ModerationReasonNotIn applies the NotIn predicate on the "moderation_reason" field.
+func ModerationReasonNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldModerationReason, vs...))
+}
+
+// ModerationReasonGT applies the GT predicate on the "moderation_reason" field.
+func ModerationReasonGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldModerationReason, v))
+}
+
+// ModerationReasonGTE applies the GTE predicate on the "moderation_reason" field.
+func ModerationReasonGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldModerationReason, v))
+}
+
+// ModerationReasonLT applies the LT predicate on the "moderation_reason" field.
+func ModerationReasonLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldModerationReason, v))
+}
+
+// ModerationReasonLTE applies the LTE predicate on the "moderation_reason" field.
+func ModerationReasonLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldModerationReason, v))
+}
+
+// ModerationReasonContains applies the Contains predicate on the "moderation_reason" field.
+func ModerationReasonContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldModerationReason, v))
+}
+
+// ModerationReasonHasPrefix applies the HasPrefix predicate on the "moderation_reason" field.
+func ModerationReasonHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldModerationReason, v))
+}
+
+// ModerationReasonHasSuffix applies the HasSuffix predicate on the "moderation_reason" field.
+func ModerationReasonHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldModerationReason, v))
+}
+
+// ModerationReasonIsNil applies the IsNil predicate on the "moderation_reason" field.
+func ModerationReasonIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldModerationReason))
+}
+
+// ModerationReasonNotNil applies the NotNil predicate on the "moderation_reason" field.
+func ModerationReasonNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldModerationReason))
+}
+
+// ModerationReasonEqualFold applies the EqualFold predicate on the "moderation_reason" field.
+func ModerationReasonEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldModerationReason, v))
+}
+
+// ModerationReasonContainsFold applies the ContainsFold predicate on the "moderation_reason" field.
+func ModerationReasonContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldModerationReason, v))
+}
+
+// SpamScoreEQ applies the EQ predicate on the "spam_score" field.
+func SpamScoreEQ(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldSpamScore, v))
+}
+
+// SpamScoreNEQ applies the NEQ predicate on the "spam_score" field.
+func SpamScoreNEQ(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldSpamScore, v))
+}
+
+// SpamScoreIn applies the In predicate on the "spam_score" field.
+func SpamScoreIn(vs ...float64) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldSpamScore, vs...))
+}
+
+// SpamScoreNotIn applies the NotIn predicate on the "spam_score" field.
+func SpamScoreNotIn(vs ...float64) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldSpamScore, vs...))
+}
+
+// SpamScoreGT applies the GT predicate on the "spam_score" field.
+func SpamScoreGT(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldSpamScore, v))
+}
+
+// SpamScoreGTE applies the GTE predicate on the "spam_score" field.
+func SpamScoreGTE(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldSpamScore, v))
+}
+
+// SpamScoreLT applies the LT predicate on the "spam_score" field.
+func SpamScoreLT(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldSpamScore, v))
+}
+
+// SpamScoreLTE applies the LTE predicate on the "spam_score" field.
+func SpamScoreLTE(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldSpamScore, v))
+}
+
+// SpamScoreIsNil applies the IsNil predicate on the "spam_score" field.
+func SpamScoreIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldSpamScore))
+}
+
+// SpamScoreNotNil applies the NotNil predicate on the "spam_score" field.
+func SpamScoreNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldSpamScore))
+}
+
 // UserAgentEQ applies the EQ predicate on the "user_agent" field.
 func UserAgentEQ(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldUserAgent, v))
@@ -124,6 +124,34 @@ func (_c *CommentCreate) SetNillableUserID(v *int) *CommentCreate {
 	return _c
 }
 
+// SetNick sets the "nick" field.
+func (_c *CommentCreate) SetNick(v string) *CommentCreate {
+	_c.mutation.SetNick(v)
+	return _c
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableNick(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetNick(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *CommentCreate) SetEmail(v string) *CommentCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableEmail(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *CommentCreate) SetStatus(v int) *CommentCreate {
 	_c.mutation.SetStatus(v)
@@ -138,6 +166,34 @@ func (_c *CommentCreate) SetNillableStatus(v *int) *CommentCreate {
 	return _c
 }
 
+// SetModerationReason sets the "moderation_reason" field.
+func (_c *CommentCreate) SetModerationReason(v string) *CommentCreate {
+	_c.mutation.SetModerationReason(v)
+	return _c
+}
+
+// SetNillableModerationReason sets the "moderation_reason" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableModerationReason(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetModerationReason(*v)
+	}
+	return _c
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (_c *CommentCreate) SetSpamScore(v float64) *CommentCreate {
+	_c.mutation.SetSpamScore(v)
+	return _c
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableSpamScore(v *float64) *CommentCreate {
+	if v != nil {
+		_c.SetSpamScore(*v)
+	}
+	return _c
+}
+
 // SetUserAgent sets the "user_agent" field.
 func (_c *CommentCreate) SetUserAgent(v string) *CommentCreate {
 	_c.mutation.SetUserAgent(v)
@@ -261,9 +317,24 @@ func (_c *CommentCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Comment.content": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Nick(); ok {
+		if err := comment.NickValidator(v); err != nil {
+			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "Comment.nick": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := comment.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Comment.email": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Comment.status"`)}
 	}
+	if v, ok := _c.mutation.ModerationReason(); ok {
+		if err := comment.ModerationReasonValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_reason", err: fmt.Errorf(`ent: validator failed for field "Comment.moderation_reason": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.IPAddress(); !ok {
 		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "Comment.ip_address"`)}
 	}
@@ -344,10 +415,26 @@ func (_c *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldUserID, field.TypeInt, value)
 		_node.UserID = &value
 	}
+	if value, ok := _c.mutation.Nick(); ok {
+		_spec.SetField(comment.FieldNick, field.TypeString, value)
+		_node.Nick = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(comment.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(comment.FieldStatus, field.TypeInt, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.ModerationReason(); ok {
+		_spec.SetField(comment.FieldModerationReason, field.TypeString, value)
+		_node.ModerationReason = value
+	}
+	if value, ok := _c.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+		_node.SpamScore = &value
+	}
 	if value, ok := _c.mutation.UserAgent(); ok {
 		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
 		_node.UserAgent = &value
@@ -176,6 +176,46 @@ func (_u *CommentUpdate) ClearUserID() *CommentUpdate {
 	return _u
 }
 
+// SetNick sets the "nick" field.
+func (_u *CommentUpdate) SetNick(v string) *CommentUpdate {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableNick(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// ClearNick clears the value of the "nick" field.
+func (_u *CommentUpdate) ClearNick() *CommentUpdate {
+	_u.mutation.ClearNick()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *CommentUpdate) SetEmail(v string) *CommentUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableEmail(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *CommentUpdate) ClearEmail() *CommentUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *CommentUpdate) SetStatus(v int) *CommentUpdate {
 	_u.mutation.ResetStatus()
@@ -197,6 +237,53 @@ func (_u *CommentUpdate) AddStatus(v int) *CommentUpdate {
 	return _u
 }
 
+// SetModerationReason sets the "moderation_reason" field.
+func (_u *CommentUpdate) SetModerationReason(v string) *CommentUpdate {
+	_u.mutation.SetModerationReason(v)
+	return _u
+}
+
+// SetNillableModerationReason sets the "moderation_reason" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableModerationReason(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetModerationReason(*v)
+	}
+	return _u
+}
+
+// ClearModerationReason clears the value of the "moderation_reason" field.
+func (_u *CommentUpdate) ClearModerationReason() *CommentUpdate {
+	_u.mutation.ClearModerationReason()
+	return _u
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (_u *CommentUpdate) SetSpamScore(v float64) *CommentUpdate {
+	_u.mutation.ResetSpamScore()
+	_u.mutation.SetSpamScore(v)
+	return _u
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableSpamScore(v *float64) *CommentUpdate {
+	if v != nil {
+		_u.SetSpamScore(*v)
+	}
+	return _u
+}
+
+// AddSpamScore adds value to the "spam_score" field.
+func (_u *CommentUpdate) AddSpamScore(v float64) *CommentUpdate {
+	_u.mutation.AddSpamScore(v)
+	return _u
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (_u *CommentUpdate) ClearSpamScore() *CommentUpdate {
+	_u.mutation.ClearSpamScore()
+	return _u
+}
+
 // SetUserAgent sets the "user_agent" field.
 func (_u *CommentUpdate) SetUserAgent(v string) *CommentUpdate {
 	_u.mutation.SetUserAgent(v)
@@ -313,6 +400,21 @@ func (_u *CommentUpdate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Comment.content": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Nick(); ok {
+		if err := comment.NickValidator(v); err != nil {
+			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "Comment.nick": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := comment.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Comment.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationReason(); ok {
+		if err := comment.ModerationReasonValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_reason", err: fmt.Errorf(`ent: validator failed for field "Comment.moderation_reason": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.IPAddress(); ok {
 		if err := comment.IPAddressValidator(v); err != nil {
 			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "Comment.ip_address": %w`, err)}
@@ -386,12 +488,39 @@ func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UserIDCleared() {
 		_spec.ClearField(comment.FieldUserID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(comment.FieldNick, field.TypeString, value)
+	}
+	if _u.mutation.NickCleared() {
+		_spec.ClearField(comment.FieldNick, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(comment.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(comment.FieldEmail, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(comment.FieldStatus, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedStatus(); ok {
 		_spec.AddField(comment.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ModerationReason(); ok {
+		_spec.SetField(comment.FieldModerationReason, field.TypeString, value)
+	}
+	if _u.mutation.ModerationReasonCleared() {
+		_spec.ClearField(comment.FieldModerationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpamScore(); ok {
+		_spec.AddField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpamScoreCleared() {
+		_spec.ClearField(comment.FieldSpamScore, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.UserAgent(); ok {
 		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
 	}
@@ -578,6 +707,46 @@ func (_u *CommentUpdateOne) ClearUserID() *CommentUpdateOne {
 	return _u
 }
 
+// SetNick sets the "nick" field.
+func (_u *CommentUpdateOne) SetNick(v string) *CommentUpdateOne {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableNick(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// ClearNick clears the value of the "nick" field.
+func (_u *CommentUpdateOne) ClearNick() *CommentUpdateOne {
+	_u.mutation.ClearNick()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *CommentUpdateOne) SetEmail(v string) *CommentUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableEmail(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *CommentUpdateOne) ClearEmail() *CommentUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *CommentUpdateOne) SetStatus(v int) *CommentUpdateOne {
 	_u.mutation.ResetStatus()
@@ -599,6 +768,53 @@ func (_u *CommentUpdateOne) AddStatus(v int) *CommentUpdateOne {
 	return _u
 }
 
+// SetModerationReason sets the "moderation_reason" field.
+func (_u *CommentUpdateOne) SetModerationReason(v string) *CommentUpdateOne {
+	_u.mutation.SetModerationReason(v)
+	return _u
+}
+
+// SetNillableModerationReason sets the "moderation_reason" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableModerationReason(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetModerationReason(*v)
+	}
+	return _u
+}
+
+// ClearModerationReason clears the value of the "moderation_reason" field.
+func (_u *CommentUpdateOne) ClearModerationReason() *CommentUpdateOne {
+	_u.mutation.ClearModerationReason()
+	return _u
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (_u *CommentUpdateOne) SetSpamScore(v float64) *CommentUpdateOne {
+	_u.mutation.ResetSpamScore()
+	_u.mutation.SetSpamScore(v)
+	return _u
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableSpamScore(v *float64) *CommentUpdateOne {
+	if v != nil {
+		_u.SetSpamScore(*v)
+	}
+	return _u
+}
+
+// AddSpamScore adds value to the "spam_score" field.
+func (_u *CommentUpdateOne) AddSpamScore(v float64) *CommentUpdateOne {
+	_u.mutation.AddSpamScore(v)
+	return _u
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (_u *CommentUpdateOne) ClearSpamScore() *CommentUpdateOne {
+	_u.mutation.ClearSpamScore()
+	return _u
+}
+
 // SetUserAgent sets the "user_agent" field.
 func (_u *CommentUpdateOne) SetUserAgent(v string) *CommentUpdateOne {
 	_u.mutation.SetUserAgent(v)
@@ -728,6 +944,21 @@ func (_u *CommentUpdateOne) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Comment.content": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Nick(); ok {
+		if err := comment.NickValidator(v); err != nil {
+			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "Comment.nick": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := comment.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Comment.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationReason(); ok {
+		if err := comment.ModerationReasonValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_reason", err: fmt.Errorf(`ent: validator failed for field "Comment.moderation_reason": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.IPAddress(); ok {
 		if err := comment.IPAddressValidator(v); err != nil {
 			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "Comment.ip_address": %w`, err)}
@@ -818,12 +1049,39 @@ func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err er
 	if _u.mutation.UserIDCleared() {
 		_spec.ClearField(comment.FieldUserID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(comment.FieldNick, field.TypeString, value)
+	}
+	if _u.mutation.NickCleared() {
+		_spec.ClearField(comment.FieldNick, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(comment.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(comment.FieldEmail, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(comment.FieldStatus, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedStatus(); ok {
 		_spec.AddField(comment.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ModerationReason(); ok {
+		_spec.SetField(comment.FieldModerationReason, field.TypeString, value)
+	}
+	if _u.mutation.ModerationReasonCleared() {
+		_spec.ClearField(comment.FieldModerationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpamScore(); ok {
+		_spec.AddField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpamScoreCleared() {
+		_spec.ClearField(comment.FieldSpamScore, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.UserAgent(); ok {
 		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
 	}
@@ -12,12 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
 	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
 	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
 	"github.com/shuTwT/hoshikuzu/ent/aimodel"
+	"github.com/shuTwT/hoshikuzu/ent/aipersona"
 	"github.com/shuTwT/hoshikuzu/ent/aiprovider"
 	"github.com/shuTwT/hoshikuzu/ent/album"
 	"github.com/shuTwT/hoshikuzu/ent/albumphoto"
+	"github.com/shuTwT/hoshikuzu/ent/cartitem"
 	"github.com/shuTwT/hoshikuzu/ent/category"
 	"github.com/shuTwT/hoshikuzu/ent/comment"
 	"github.com/shuTwT/hoshikuzu/ent/coupon"
@@ -29,19 +32,25 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/flinkgroup"
 	"github.com/shuTwT/hoshikuzu/ent/friendcirclerecord"
 	"github.com/shuTwT/hoshikuzu/ent/license"
+	"github.com/shuTwT/hoshikuzu/ent/loginlog"
 	"github.com/shuTwT/hoshikuzu/ent/member"
 	"github.com/shuTwT/hoshikuzu/ent/memberlevel"
 	"github.com/shuTwT/hoshikuzu/ent/menu"
 	"github.com/shuTwT/hoshikuzu/ent/notification"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2accesstoken"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2client"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2code"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2refreshtoken"
+	"github.com/shuTwT/hoshikuzu/ent/orderdelivery"
 	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
 	"github.com/shuTwT/hoshikuzu/ent/personalaccesstoken"
 	"github.com/shuTwT/hoshikuzu/ent/plugin"
 	"github.com/shuTwT/hoshikuzu/ent/post"
 	"github.com/shuTwT/hoshikuzu/ent/postpurchase"
 	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productdeliverable"
+	"github.com/shuTwT/hoshikuzu/ent/productkey"
 	"github.com/shuTwT/hoshikuzu/ent/refreshtoken"
 	"github.com/shuTwT/hoshikuzu/ent/role"
 	"github.com/shuTwT/hoshikuzu/ent/schedulejob"
@@ -50,9 +59,11 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/tag"
 	"github.com/shuTwT/hoshikuzu/ent/theme"
 	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/ent/useridentity"
 	"github.com/shuTwT/hoshikuzu/ent/visitlog"
 	"github.com/shuTwT/hoshikuzu/ent/wallet"
 	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -113,12 +124,15 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			aiasklog.Table:            aiasklog.ValidColumn,
 			aichatmessage.Table:       aichatmessage.ValidColumn,
 			aichatsession.Table:       aichatsession.ValidColumn,
 			aimodel.Table:             aimodel.ValidColumn,
+			aipersona.Table:           aipersona.ValidColumn,
 			aiprovider.Table:          aiprovider.ValidColumn,
 			album.Table:               album.ValidColumn,
 			albumphoto.Table:          albumphoto.ValidColumn,
+			cartitem.Table:            cartitem.ValidColumn,
 			category.Table:            category.ValidColumn,
 			comment.Table:             comment.ValidColumn,
 			coupon.Table:              coupon.ValidColumn,
@@ -130,19 +144,25 @@ func checkColumn(t, c string) error {
 			file.Table:                file.ValidColumn,
 			friendcirclerecord.Table:  friendcirclerecord.ValidColumn,
 			license.Table:             license.ValidColumn,
+			loginlog.Table:            loginlog.ValidColumn,
 			member.Table:              member.ValidColumn,
 			memberlevel.Table:         memberlevel.ValidColumn,
 			menu.Table:                menu.ValidColumn,
 			notification.Table:        notification.ValidColumn,
 			oauth2accesstoken.Table:   oauth2accesstoken.ValidColumn,
+			oauth2client.Table:        oauth2client.ValidColumn,
 			oauth2code.Table:          oauth2code.ValidColumn,
 			oauth2refreshtoken.Table:  oauth2refreshtoken.ValidColumn,
+			orderdelivery.Table:       orderdelivery.ValidColumn,
 			payorder.Table:            payorder.ValidColumn,
+			payorderitem.Table:        payorderitem.ValidColumn,
 			personalaccesstoken.Table: personalaccesstoken.ValidColumn,
 			plugin.Table:              plugin.ValidColumn,
 			post.Table:                post.ValidColumn,
 			postpurchase.Table:        postpurchase.ValidColumn,
 			product.Table:             product.ValidColumn,
+			productdeliverable.Table:  productdeliverable.ValidColumn,
+			productkey.Table:          productkey.ValidColumn,
 			refreshtoken.Table:        refreshtoken.ValidColumn,
 			role.Table:                role.ValidColumn,
 			schedulejob.Table:         schedulejob.ValidColumn,
@@ -151,9 +171,11 @@ func checkColumn(t, c string) error {
 			tag.Table:                 tag.ValidColumn,
 			theme.Table:               theme.ValidColumn,
 			user.Table:                user.ValidColumn,
+			useridentity.Table:        useridentity.ValidColumn,
 			visitlog.Table:            visitlog.ValidColumn,
 			wallet.Table:              wallet.ValidColumn,
 			webhook.Table:             webhook.ValidColumn,
+			webhookdelivery.Table:     webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
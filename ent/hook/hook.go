@@ -9,6 +9,18 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent"
 )
 
+// The AIAskLogFunc type is an adapter to allow the use of ordinary
+// function as AIAskLog mutator.
+type AIAskLogFunc func(context.Context, *ent.AIAskLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AIAskLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AIAskLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AIAskLogMutation", m)
+}
+
 // The AIChatMessageFunc type is an adapter to allow the use of ordinary
 // function as AIChatMessage mutator.
 type AIChatMessageFunc func(context.Context, *ent.AIChatMessageMutation) (ent.Value, error)
@@ -45,6 +57,18 @@ func (f AIModelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AIModelMutation", m)
 }
 
+// The AIPersonaFunc type is an adapter to allow the use of ordinary
+// function as AIPersona mutator.
+type AIPersonaFunc func(context.Context, *ent.AIPersonaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AIPersonaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AIPersonaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AIPersonaMutation", m)
+}
+
 // The AIProviderFunc type is an adapter to allow the use of ordinary
 // function as AIProvider mutator.
 type AIProviderFunc func(context.Context, *ent.AIProviderMutation) (ent.Value, error)
@@ -81,6 +105,18 @@ func (f AlbumPhotoFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlbumPhotoMutation", m)
 }
 
+// The CartItemFunc type is an adapter to allow the use of ordinary
+// function as CartItem mutator.
+type CartItemFunc func(context.Context, *ent.CartItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CartItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CartItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CartItemMutation", m)
+}
+
 // The CategoryFunc type is an adapter to allow the use of ordinary
 // function as Category mutator.
 type CategoryFunc func(context.Context, *ent.CategoryMutation) (ent.Value, error)
@@ -213,6 +249,18 @@ func (f LicenseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LicenseMutation", m)
 }
 
+// The LoginLogFunc type is an adapter to allow the use of ordinary
+// function as LoginLog mutator.
+type LoginLogFunc func(context.Context, *ent.LoginLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoginLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginLogMutation", m)
+}
+
 // The MemberFunc type is an adapter to allow the use of ordinary
 // function as Member mutator.
 type MemberFunc func(context.Context, *ent.MemberMutation) (ent.Value, error)
@@ -273,6 +321,18 @@ func (f Oauth2AccessTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.Oauth2AccessTokenMutation", m)
 }
 
+// The Oauth2ClientFunc type is an adapter to allow the use of ordinary
+// function as Oauth2Client mutator.
+type Oauth2ClientFunc func(context.Context, *ent.Oauth2ClientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f Oauth2ClientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.Oauth2ClientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.Oauth2ClientMutation", m)
+}
+
 // The Oauth2CodeFunc type is an adapter to allow the use of ordinary
 // function as Oauth2Code mutator.
 type Oauth2CodeFunc func(context.Context, *ent.Oauth2CodeMutation) (ent.Value, error)
@@ -297,6 +357,18 @@ func (f Oauth2RefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.Oauth2RefreshTokenMutation", m)
 }
 
+// The OrderDeliveryFunc type is an adapter to allow the use of ordinary
+// function as OrderDelivery mutator.
+type OrderDeliveryFunc func(context.Context, *ent.OrderDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderDeliveryMutation", m)
+}
+
 // The PayOrderFunc type is an adapter to allow the use of ordinary
 // function as PayOrder mutator.
 type PayOrderFunc func(context.Context, *ent.PayOrderMutation) (ent.Value, error)
@@ -309,6 +381,18 @@ func (f PayOrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayOrderMutation", m)
 }
 
+// The PayOrderItemFunc type is an adapter to allow the use of ordinary
+// function as PayOrderItem mutator.
+type PayOrderItemFunc func(context.Context, *ent.PayOrderItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayOrderItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayOrderItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayOrderItemMutation", m)
+}
+
 // The PersonalAccessTokenFunc type is an adapter to allow the use of ordinary
 // function as PersonalAccessToken mutator.
 type PersonalAccessTokenFunc func(context.Context, *ent.PersonalAccessTokenMutation) (ent.Value, error)
@@ -369,6 +453,30 @@ func (f ProductFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductMutation", m)
 }
 
+// The ProductDeliverableFunc type is an adapter to allow the use of ordinary
+// function as ProductDeliverable mutator.
+type ProductDeliverableFunc func(context.Context, *ent.ProductDeliverableMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductDeliverableFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProductDeliverableMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductDeliverableMutation", m)
+}
+
+// The ProductKeyFunc type is an adapter to allow the use of ordinary
+// function as ProductKey mutator.
+type ProductKeyFunc func(context.Context, *ent.ProductKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProductKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductKeyMutation", m)
+}
+
 // The RefreshTokenFunc type is an adapter to allow the use of ordinary
 // function as RefreshToken mutator.
 type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
@@ -465,6 +573,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The UserIdentityFunc type is an adapter to allow the use of ordinary
+// function as UserIdentity mutator.
+type UserIdentityFunc func(context.Context, *ent.UserIdentityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserIdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserIdentityMutation", m)
+}
+
 // The VisitLogFunc type is an adapter to allow the use of ordinary
 // function as VisitLog mutator.
 type VisitLogFunc func(context.Context, *ent.VisitLogMutation) (ent.Value, error)
@@ -501,6 +621,18 @@ func (f WebHookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebHookMutation", m)
 }
 
+// The WebHookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebHookDelivery mutator.
+type WebHookDeliveryFunc func(context.Context, *ent.WebHookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebHookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebHookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebHookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,700 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/loginlog"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+)
+
+// LoginLogUpdate is the builder for updating LoginLog entities.
+type LoginLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginLogMutation
+}
+
+// Where appends a list predicates to the LoginLogUpdate builder.
+func (_u *LoginLogUpdate) Where(ps ...predicate.LoginLog) *LoginLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginLogUpdate) SetUpdatedAt(v time.Time) *LoginLogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LoginLogUpdate) SetUserID(v int) *LoginLogUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableUserID(v *int) *LoginLogUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LoginLogUpdate) AddUserID(v int) *LoginLogUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LoginLogUpdate) ClearUserID() *LoginLogUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *LoginLogUpdate) SetEmail(v string) *LoginLogUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableEmail(v *string) *LoginLogUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *LoginLogUpdate) ClearEmail() *LoginLogUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *LoginLogUpdate) SetMethod(v loginlog.Method) *LoginLogUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableMethod(v *loginlog.Method) *LoginLogUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *LoginLogUpdate) SetOutcome(v loginlog.Outcome) *LoginLogUpdate {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableOutcome(v *loginlog.Outcome) *LoginLogUpdate {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LoginLogUpdate) SetReason(v string) *LoginLogUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableReason(v *string) *LoginLogUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LoginLogUpdate) ClearReason() *LoginLogUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LoginLogUpdate) SetIP(v string) *LoginLogUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableIP(v *string) *LoginLogUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *LoginLogUpdate) ClearIP() *LoginLogUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *LoginLogUpdate) SetUserAgent(v string) *LoginLogUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableUserAgent(v *string) *LoginLogUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *LoginLogUpdate) ClearUserAgent() *LoginLogUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetBrowser sets the "browser" field.
+func (_u *LoginLogUpdate) SetBrowser(v string) *LoginLogUpdate {
+	_u.mutation.SetBrowser(v)
+	return _u
+}
+
+// SetNillableBrowser sets the "browser" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableBrowser(v *string) *LoginLogUpdate {
+	if v != nil {
+		_u.SetBrowser(*v)
+	}
+	return _u
+}
+
+// ClearBrowser clears the value of the "browser" field.
+func (_u *LoginLogUpdate) ClearBrowser() *LoginLogUpdate {
+	_u.mutation.ClearBrowser()
+	return _u
+}
+
+// SetOs sets the "os" field.
+func (_u *LoginLogUpdate) SetOs(v string) *LoginLogUpdate {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *LoginLogUpdate) SetNillableOs(v *string) *LoginLogUpdate {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *LoginLogUpdate) ClearOs() *LoginLogUpdate {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// Mutation returns the LoginLogMutation object of the builder.
+func (_u *LoginLogUpdate) Mutation() *LoginLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoginLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoginLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loginlog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginLogUpdate) check() error {
+	if v, ok := _u.mutation.Method(); ok {
+		if err := loginlog.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LoginLog.method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := loginlog.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "LoginLog.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginlog.Table, loginlog.Columns, sqlgraph.NewFieldSpec(loginlog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(loginlog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(loginlog.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(loginlog.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(loginlog.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(loginlog.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(loginlog.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(loginlog.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(loginlog.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(loginlog.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(loginlog.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(loginlog.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(loginlog.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(loginlog.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Browser(); ok {
+		_spec.SetField(loginlog.FieldBrowser, field.TypeString, value)
+	}
+	if _u.mutation.BrowserCleared() {
+		_spec.ClearField(loginlog.FieldBrowser, field.TypeString)
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(loginlog.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(loginlog.FieldOs, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoginLogUpdateOne is the builder for updating a single LoginLog entity.
+type LoginLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginLogMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginLogUpdateOne) SetUpdatedAt(v time.Time) *LoginLogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LoginLogUpdateOne) SetUserID(v int) *LoginLogUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableUserID(v *int) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LoginLogUpdateOne) AddUserID(v int) *LoginLogUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LoginLogUpdateOne) ClearUserID() *LoginLogUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *LoginLogUpdateOne) SetEmail(v string) *LoginLogUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableEmail(v *string) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *LoginLogUpdateOne) ClearEmail() *LoginLogUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *LoginLogUpdateOne) SetMethod(v loginlog.Method) *LoginLogUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableMethod(v *loginlog.Method) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *LoginLogUpdateOne) SetOutcome(v loginlog.Outcome) *LoginLogUpdateOne {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableOutcome(v *loginlog.Outcome) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LoginLogUpdateOne) SetReason(v string) *LoginLogUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableReason(v *string) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LoginLogUpdateOne) ClearReason() *LoginLogUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LoginLogUpdateOne) SetIP(v string) *LoginLogUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableIP(v *string) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *LoginLogUpdateOne) ClearIP() *LoginLogUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *LoginLogUpdateOne) SetUserAgent(v string) *LoginLogUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableUserAgent(v *string) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *LoginLogUpdateOne) ClearUserAgent() *LoginLogUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetBrowser sets the "browser" field.
+func (_u *LoginLogUpdateOne) SetBrowser(v string) *LoginLogUpdateOne {
+	_u.mutation.SetBrowser(v)
+	return _u
+}
+
+// SetNillableBrowser sets the "browser" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableBrowser(v *string) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetBrowser(*v)
+	}
+	return _u
+}
+
+// ClearBrowser clears the value of the "browser" field.
+func (_u *LoginLogUpdateOne) ClearBrowser() *LoginLogUpdateOne {
+	_u.mutation.ClearBrowser()
+	return _u
+}
+
+// SetOs sets the "os" field.
+func (_u *LoginLogUpdateOne) SetOs(v string) *LoginLogUpdateOne {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *LoginLogUpdateOne) SetNillableOs(v *string) *LoginLogUpdateOne {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *LoginLogUpdateOne) ClearOs() *LoginLogUpdateOne {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// Mutation returns the LoginLogMutation object of the builder.
+func (_u *LoginLogUpdateOne) Mutation() *LoginLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LoginLogUpdate builder.
+func (_u *LoginLogUpdateOne) Where(ps ...predicate.LoginLog) *LoginLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoginLogUpdateOne) Select(field string, fields ...string) *LoginLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoginLog entity.
+func (_u *LoginLogUpdateOne) Save(ctx context.Context) (*LoginLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginLogUpdateOne) SaveX(ctx context.Context) *LoginLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoginLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loginlog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Method(); ok {
+		if err := loginlog.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LoginLog.method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := loginlog.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "LoginLog.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginLogUpdateOne) sqlSave(ctx context.Context) (_node *LoginLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginlog.Table, loginlog.Columns, sqlgraph.NewFieldSpec(loginlog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginlog.FieldID)
+		for _, f := range fields {
+			if !loginlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(loginlog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(loginlog.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(loginlog.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(loginlog.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(loginlog.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(loginlog.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(loginlog.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(loginlog.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(loginlog.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(loginlog.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(loginlog.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(loginlog.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(loginlog.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Browser(); ok {
+		_spec.SetField(loginlog.FieldBrowser, field.TypeString, value)
+	}
+	if _u.mutation.BrowserCleared() {
+		_spec.ClearField(loginlog.FieldBrowser, field.TypeString)
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(loginlog.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(loginlog.FieldOs, field.TypeString)
+	}
+	_node = &LoginLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
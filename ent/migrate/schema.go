@@ -8,14 +8,53 @@ import (
 )
 
 var (
+	// AiAskLogsColumns holds the columns for the "ai_ask_logs" table.
+	AiAskLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "question", Type: field.TypeString, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "citations", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"success", "failed"}},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "model", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+	}
+	// AiAskLogsTable holds the schema information for the "ai_ask_logs" table.
+	AiAskLogsTable = &schema.Table{
+		Name:       "ai_ask_logs",
+		Columns:    AiAskLogsColumns,
+		PrimaryKey: []*schema.Column{AiAskLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "aiasklog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AiAskLogsColumns[1]},
+			},
+			{
+				Name:    "aiasklog_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AiAskLogsColumns[12], AiAskLogsColumns[1]},
+			},
+		},
+	}
 	// AiChatMessagesColumns holds the columns for the "ai_chat_messages" table.
 	AiChatMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "assistant"}},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "assistant", "summary"}},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "model", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "citations", Type: field.TypeJSON, Nullable: true},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "summary_until", Type: field.TypeInt, Nullable: true},
 		{Name: "session_id", Type: field.TypeInt},
 	}
 	// AiChatMessagesTable holds the schema information for the "ai_chat_messages" table.
@@ -26,7 +65,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ai_chat_messages_ai_chat_sessions_messages",
-				Columns:    []*schema.Column{AiChatMessagesColumns[6]},
+				Columns:    []*schema.Column{AiChatMessagesColumns[10]},
 				RefColumns: []*schema.Column{AiChatSessionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -38,6 +77,11 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "title", Type: field.TypeString, Size: 255, Default: "新对话"},
+		{Name: "model_id", Type: field.TypeInt, Nullable: true},
+		{Name: "persona_id", Type: field.TypeInt, Nullable: true},
+		{Name: "system_prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "temperature", Type: field.TypeFloat64, Nullable: true},
+		{Name: "max_tokens", Type: field.TypeInt, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// AiChatSessionsTable holds the schema information for the "ai_chat_sessions" table.
@@ -48,7 +92,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ai_chat_sessions_users_ai_chat_sessions",
-				Columns:    []*schema.Column{AiChatSessionsColumns[4]},
+				Columns:    []*schema.Column{AiChatSessionsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -63,6 +107,7 @@ var (
 		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "is_enabled", Type: field.TypeBool, Default: true},
 		{Name: "sort", Type: field.TypeInt, Default: 0},
+		{Name: "context_window", Type: field.TypeInt, Default: 0},
 		{Name: "provider_id", Type: field.TypeInt},
 	}
 	// AiModelsTable holds the schema information for the "ai_models" table.
@@ -73,12 +118,38 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ai_models_ai_providers_models",
-				Columns:    []*schema.Column{AiModelsColumns[7]},
+				Columns:    []*schema.Column{AiModelsColumns[8]},
 				RefColumns: []*schema.Column{AiProvidersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// AiPersonasColumns holds the columns for the "ai_personas" table.
+	AiPersonasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "system_prompt", Type: field.TypeString, Size: 2147483647},
+		{Name: "temperature", Type: field.TypeFloat64, Nullable: true},
+		{Name: "max_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AiPersonasTable holds the schema information for the "ai_personas" table.
+	AiPersonasTable = &schema.Table{
+		Name:       "ai_personas",
+		Columns:    AiPersonasColumns,
+		PrimaryKey: []*schema.Column{AiPersonasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ai_personas_users_ai_personas",
+				Columns:    []*schema.Column{AiPersonasColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// AiProvidersColumns holds the columns for the "ai_providers" table.
 	AiProvidersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -88,6 +159,7 @@ var (
 		{Name: "provider_type", Type: field.TypeString, Size: 50},
 		{Name: "base_url", Type: field.TypeString, Size: 2048},
 		{Name: "api_key_ciphertext", Type: field.TypeString, Nullable: true},
+		{Name: "embedding_model", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "temperature", Type: field.TypeFloat64, Default: 0.7},
 		{Name: "max_tokens", Type: field.TypeInt, Default: 2048},
 		{Name: "top_p", Type: field.TypeFloat64, Default: 1},
@@ -136,6 +208,42 @@ var (
 		Columns:    AlbumPhotosColumns,
 		PrimaryKey: []*schema.Column{AlbumPhotosColumns[0]},
 	}
+	// CartItemsColumns holds the columns for the "cart_items" table.
+	CartItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "quantity", Type: field.TypeInt, Default: 1},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// CartItemsTable holds the schema information for the "cart_items" table.
+	CartItemsTable = &schema.Table{
+		Name:       "cart_items",
+		Columns:    CartItemsColumns,
+		PrimaryKey: []*schema.Column{CartItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cart_items_users_user",
+				Columns:    []*schema.Column{CartItemsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "cart_items_products_product",
+				Columns:    []*schema.Column{CartItemsColumns[5]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cartitem_user_id_product_id",
+				Unique:  true,
+				Columns: []*schema.Column{CartItemsColumns[4], CartItemsColumns[5]},
+			},
+		},
+	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -164,7 +272,11 @@ var (
 		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 		{Name: "content", Type: field.TypeString, Size: 1024},
 		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "nick", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "status", Type: field.TypeInt, Default: 2},
+		{Name: "moderation_reason", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "spam_score", Type: field.TypeFloat64, Nullable: true},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true},
 		{Name: "ip_address", Type: field.TypeString, Size: 45},
 		{Name: "ip_location", Type: field.TypeString, Nullable: true, Size: 255},
@@ -175,6 +287,18 @@ var (
 		Name:       "comments",
 		Columns:    CommentsColumns,
 		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_status",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[11]},
+			},
+			{
+				Name:    "comment_ip_address_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[15], CommentsColumns[1]},
+			},
+		},
 	}
 	// CouponsColumns holds the columns for the "coupons" table.
 	CouponsColumns = []*schema.Column{
@@ -383,6 +507,44 @@ var (
 		Columns:    LicensesColumns,
 		PrimaryKey: []*schema.Column{LicensesColumns[0]},
 	}
+	// LoginLogsColumns holds the columns for the "login_logs" table.
+	LoginLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "method", Type: field.TypeEnum, Enums: []string{"password", "social", "two_factor"}},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"success", "failure", "locked", "challenge"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "browser", Type: field.TypeString, Nullable: true},
+		{Name: "os", Type: field.TypeString, Nullable: true},
+	}
+	// LoginLogsTable holds the schema information for the "login_logs" table.
+	LoginLogsTable = &schema.Table{
+		Name:       "login_logs",
+		Columns:    LoginLogsColumns,
+		PrimaryKey: []*schema.Column{LoginLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginlog_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{LoginLogsColumns[3]},
+			},
+			{
+				Name:    "loginlog_ip",
+				Unique:  false,
+				Columns: []*schema.Column{LoginLogsColumns[8]},
+			},
+			{
+				Name:    "loginlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginLogsColumns[1]},
+			},
+		},
+	}
 	// MembersColumns holds the columns for the "members" table.
 	MembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -483,10 +645,10 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt},
-		{Name: "access_token", Type: field.TypeString, Size: 255},
-		{Name: "refresh_token", Type: field.TypeString, Size: 32},
+		{Name: "access_token", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "refresh_token", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "client_id", Type: field.TypeString, Size: 255},
-		{Name: "scope", Type: field.TypeString, Size: 255},
+		{Name: "scope", Type: field.TypeString, Size: 1024},
 		{Name: "expire_at", Type: field.TypeTime},
 	}
 	// Oauth2accessTokensTable holds the schema information for the "oauth2access_tokens" table.
@@ -495,17 +657,42 @@ var (
 		Columns:    Oauth2accessTokensColumns,
 		PrimaryKey: []*schema.Column{Oauth2accessTokensColumns[0]},
 	}
+	// Oauth2clientsColumns holds the columns for the "oauth2clients" table.
+	Oauth2clientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "client_id", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "client_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "logo", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "redirect_uris", Type: field.TypeJSON},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "public", Type: field.TypeBool, Default: false},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// Oauth2clientsTable holds the schema information for the "oauth2clients" table.
+	Oauth2clientsTable = &schema.Table{
+		Name:       "oauth2clients",
+		Columns:    Oauth2clientsColumns,
+		PrimaryKey: []*schema.Column{Oauth2clientsColumns[0]},
+	}
 	// Oauth2codesColumns holds the columns for the "oauth2codes" table.
 	Oauth2codesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt},
-		{Name: "code", Type: field.TypeString, Size: 32},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 64},
 		{Name: "expire_at", Type: field.TypeTime},
 		{Name: "client_id", Type: field.TypeString, Size: 255},
 		{Name: "redirect_uri", Type: field.TypeString, Size: 255},
-		{Name: "scope", Type: field.TypeString, Size: 255},
+		{Name: "scope", Type: field.TypeString, Size: 1024},
+		{Name: "code_challenge", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "code_challenge_method", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "nonce", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "auth_time", Type: field.TypeTime, Nullable: true},
 	}
 	// Oauth2codesTable holds the schema information for the "oauth2codes" table.
 	Oauth2codesTable = &schema.Table{
@@ -519,9 +706,10 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt},
-		{Name: "refresh_token", Type: field.TypeString, Size: 32},
+		{Name: "refresh_token", Type: field.TypeString, Unique: true, Size: 64},
 		{Name: "client_id", Type: field.TypeString, Size: 255},
-		{Name: "scope", Type: field.TypeString, Size: 255},
+		{Name: "scope", Type: field.TypeString, Size: 1024},
+		{Name: "auth_time", Type: field.TypeTime, Nullable: true},
 		{Name: "expire_at", Type: field.TypeTime},
 	}
 	// Oauth2refreshTokensTable holds the schema information for the "oauth2refresh_tokens" table.
@@ -530,6 +718,50 @@ var (
 		Columns:    Oauth2refreshTokensColumns,
 		PrimaryKey: []*schema.Column{Oauth2refreshTokensColumns[0]},
 	}
+	// OrderDeliveriesColumns holds the columns for the "order_deliveries" table.
+	OrderDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "deliverable_id", Type: field.TypeInt, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"file", "key", "text"}},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "file_id", Type: field.TypeInt, Nullable: true},
+		{Name: "key_id", Type: field.TypeInt, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeInt, Default: 1},
+		{Name: "download_count", Type: field.TypeInt, Default: 0},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "order_id", Type: field.TypeInt},
+	}
+	// OrderDeliveriesTable holds the schema information for the "order_deliveries" table.
+	OrderDeliveriesTable = &schema.Table{
+		Name:       "order_deliveries",
+		Columns:    OrderDeliveriesColumns,
+		PrimaryKey: []*schema.Column{OrderDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_deliveries_pay_orders_order",
+				Columns:    []*schema.Column{OrderDeliveriesColumns[14]},
+				RefColumns: []*schema.Column{PayOrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderdelivery_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderDeliveriesColumns[14]},
+			},
+			{
+				Name:    "orderdelivery_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderDeliveriesColumns[3]},
+			},
+		},
+	}
 	// PayOrdersColumns holds the columns for the "pay_orders" table.
 	PayOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -556,6 +788,7 @@ var (
 		{Name: "refund_amount", Type: field.TypeInt, Nullable: true},
 		{Name: "refund_at", Type: field.TypeTime, Nullable: true},
 		{Name: "points_granted", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "price_detail", Type: field.TypeJSON, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
 		{Name: "post_id", Type: field.TypeInt, Nullable: true},
 		{Name: "product_id", Type: field.TypeInt, Nullable: true},
@@ -568,24 +801,64 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pay_orders_users_user",
-				Columns:    []*schema.Column{PayOrdersColumns[24]},
+				Columns:    []*schema.Column{PayOrdersColumns[25]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "pay_orders_posts_post",
-				Columns:    []*schema.Column{PayOrdersColumns[25]},
+				Columns:    []*schema.Column{PayOrdersColumns[26]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "pay_orders_products_product",
-				Columns:    []*schema.Column{PayOrdersColumns[26]},
+				Columns:    []*schema.Column{PayOrdersColumns[27]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// PayOrderItemsColumns holds the columns for the "pay_order_items" table.
+	PayOrderItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_name", Type: field.TypeString, Size: 255},
+		{Name: "sku", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "unit_price", Type: field.TypeInt},
+		{Name: "total_price", Type: field.TypeInt},
+		{Name: "order_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// PayOrderItemsTable holds the schema information for the "pay_order_items" table.
+	PayOrderItemsTable = &schema.Table{
+		Name:       "pay_order_items",
+		Columns:    PayOrderItemsColumns,
+		PrimaryKey: []*schema.Column{PayOrderItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pay_order_items_pay_orders_order",
+				Columns:    []*schema.Column{PayOrderItemsColumns[8]},
+				RefColumns: []*schema.Column{PayOrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pay_order_items_products_product",
+				Columns:    []*schema.Column{PayOrderItemsColumns[9]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payorderitem_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{PayOrderItemsColumns[8]},
+			},
+		},
+	}
 	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
 	PersonalAccessTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -594,8 +867,13 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "expires", Type: field.TypeTime, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "token", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString, Nullable: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "token_prefix", Type: field.TypeString, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
 	}
 	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
 	PersonalAccessTokensTable = &schema.Table{
@@ -744,6 +1022,76 @@ var (
 		Columns:    ProductsColumns,
 		PrimaryKey: []*schema.Column{ProductsColumns[0]},
 	}
+	// ProductDeliverablesColumns holds the columns for the "product_deliverables" table.
+	ProductDeliverablesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"file", "key", "text"}},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "file_id", Type: field.TypeInt, Nullable: true},
+	}
+	// ProductDeliverablesTable holds the schema information for the "product_deliverables" table.
+	ProductDeliverablesTable = &schema.Table{
+		Name:       "product_deliverables",
+		Columns:    ProductDeliverablesColumns,
+		PrimaryKey: []*schema.Column{ProductDeliverablesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_deliverables_products_product",
+				Columns:    []*schema.Column{ProductDeliverablesColumns[7]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "product_deliverables_files_file",
+				Columns:    []*schema.Column{ProductDeliverablesColumns[8]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ProductKeysColumns holds the columns for the "product_keys" table.
+	ProductKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "code", Type: field.TypeString, Size: 512},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "order_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "assigned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// ProductKeysTable holds the schema information for the "product_keys" table.
+	ProductKeysTable = &schema.Table{
+		Name:       "product_keys",
+		Columns:    ProductKeysColumns,
+		PrimaryKey: []*schema.Column{ProductKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_keys_products_product",
+				Columns:    []*schema.Column{ProductKeysColumns[8]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productkey_product_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{ProductKeysColumns[8], ProductKeysColumns[3]},
+			},
+			{
+				Name:    "productkey_product_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProductKeysColumns[8], ProductKeysColumns[4]},
+			},
+		},
+	}
 	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
 	RefreshTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -755,6 +1103,8 @@ var (
 		{Name: "revoked", Type: field.TypeBool, Default: false},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true},
 		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "family_id", Type: field.TypeString, Nullable: true},
+		{Name: "login_at", Type: field.TypeTime, Nullable: true},
 	}
 	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
 	RefreshTokensTable = &schema.Table{
@@ -767,6 +1117,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{RefreshTokensColumns[4]},
 			},
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[9]},
+			},
 		},
 	}
 	// RolesColumns holds the columns for the "roles" table.
@@ -778,6 +1133,8 @@ var (
 		{Name: "code", Type: field.TypeString, Unique: true, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 512},
 		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+		{Name: "require_two_factor", Type: field.TypeBool, Default: false},
 	}
 	// RolesTable holds the schema information for the "roles" table.
 	RolesTable = &schema.Table{
@@ -899,12 +1256,17 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 255},
 		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "email_verify_required", Type: field.TypeBool, Default: false},
 		{Name: "name", Type: field.TypeString, Default: "unknown"},
 		{Name: "phone_number", Type: field.TypeString, Unique: true, Nullable: true, Size: 20},
 		{Name: "phone_number_verified", Type: field.TypeBool, Default: false},
 		{Name: "password", Type: field.TypeString, Size: 255},
 		{Name: "nickname", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_recovery_codes", Type: field.TypeJSON, Nullable: true},
 		{Name: "role_id", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -915,12 +1277,52 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_roles_users",
-				Columns:    []*schema.Column{UsersColumns[11]},
+				Columns:    []*schema.Column{UsersColumns[16]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "provider", Type: field.TypeString, Size: 64},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "avatar", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "provisioned", Type: field.TypeBool, Default: false},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[3], UserIdentitiesColumns[4]},
+			},
+			{
+				Name:    "useridentity_user_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[10], UserIdentitiesColumns[3]},
+			},
+		},
+	}
 	// VisitLogsColumns holds the columns for the "visit_logs" table.
 	VisitLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -985,8 +1387,10 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
-		{Name: "url", Type: field.TypeString, Size: 255},
-		{Name: "event", Type: field.TypeString, Size: 255},
+		{Name: "url", Type: field.TypeString, Size: 1024},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
 	}
 	// WebHooksTable holds the schema information for the "web_hooks" table.
 	WebHooksTable = &schema.Table{
@@ -994,6 +1398,50 @@ var (
 		Columns:    WebHooksColumns,
 		PrimaryKey: []*schema.Column{WebHooksColumns[0]},
 	}
+	// WebHookDeliveriesColumns holds the columns for the "web_hook_deliveries" table.
+	WebHookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "success", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_retry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_code", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "duration", Type: field.TypeInt, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// WebHookDeliveriesTable holds the schema information for the "web_hook_deliveries" table.
+	WebHookDeliveriesTable = &schema.Table{
+		Name:       "web_hook_deliveries",
+		Columns:    WebHookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebHookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "web_hook_deliveries_web_hooks_deliveries",
+				Columns:    []*schema.Column{WebHookDeliveriesColumns[14]},
+				RefColumns: []*schema.Column{WebHooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebHookDeliveriesColumns[14]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebHookDeliveriesColumns[6], WebHookDeliveriesColumns[8]},
+			},
+		},
+	}
 	// CategoryPostsColumns holds the columns for the "category_posts" table.
 	CategoryPostsColumns = []*schema.Column{
 		{Name: "category_id", Type: field.TypeInt},
@@ -1046,12 +1494,15 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AiAskLogsTable,
 		AiChatMessagesTable,
 		AiChatSessionsTable,
 		AiModelsTable,
+		AiPersonasTable,
 		AiProvidersTable,
 		AlbumsTable,
 		AlbumPhotosTable,
+		CartItemsTable,
 		CategoriesTable,
 		CommentsTable,
 		CouponsTable,
@@ -1063,19 +1514,25 @@ var (
 		FilesTable,
 		FriendCircleRecordsTable,
 		LicensesTable,
+		LoginLogsTable,
 		MembersTable,
 		MemberLevelsTable,
 		MenusTable,
 		NotificationsTable,
 		Oauth2accessTokensTable,
+		Oauth2clientsTable,
 		Oauth2codesTable,
 		Oauth2refreshTokensTable,
+		OrderDeliveriesTable,
 		PayOrdersTable,
+		PayOrderItemsTable,
 		PersonalAccessTokensTable,
 		PluginsTable,
 		PostsTable,
 		PostPurchasesTable,
 		ProductsTable,
+		ProductDeliverablesTable,
+		ProductKeysTable,
 		RefreshTokensTable,
 		RolesTable,
 		ScheduleJobsTable,
@@ -1084,9 +1541,11 @@ var (
 		TagsTable,
 		ThemesTable,
 		UsersTable,
+		UserIdentitiesTable,
 		VisitLogsTable,
 		WalletsTable,
 		WebHooksTable,
+		WebHookDeliveriesTable,
 		CategoryPostsTable,
 		TagPostsTable,
 	}
@@ -1096,19 +1555,30 @@ func init() {
 	AiChatMessagesTable.ForeignKeys[0].RefTable = AiChatSessionsTable
 	AiChatSessionsTable.ForeignKeys[0].RefTable = UsersTable
 	AiModelsTable.ForeignKeys[0].RefTable = AiProvidersTable
+	AiPersonasTable.ForeignKeys[0].RefTable = UsersTable
+	CartItemsTable.ForeignKeys[0].RefTable = UsersTable
+	CartItemsTable.ForeignKeys[1].RefTable = ProductsTable
 	EssaysTable.ForeignKeys[0].RefTable = UsersTable
 	FlinksTable.ForeignKeys[0].RefTable = FlinkGroupsTable
 	FilesTable.ForeignKeys[0].RefTable = StorageStrategiesTable
 	MembersTable.ForeignKeys[0].RefTable = MemberLevelsTable
 	MembersTable.ForeignKeys[1].RefTable = UsersTable
+	OrderDeliveriesTable.ForeignKeys[0].RefTable = PayOrdersTable
 	PayOrdersTable.ForeignKeys[0].RefTable = UsersTable
 	PayOrdersTable.ForeignKeys[1].RefTable = PostsTable
 	PayOrdersTable.ForeignKeys[2].RefTable = ProductsTable
+	PayOrderItemsTable.ForeignKeys[0].RefTable = PayOrdersTable
+	PayOrderItemsTable.ForeignKeys[1].RefTable = ProductsTable
 	PostPurchasesTable.ForeignKeys[0].RefTable = UsersTable
 	PostPurchasesTable.ForeignKeys[1].RefTable = PostsTable
 	PostPurchasesTable.ForeignKeys[2].RefTable = PayOrdersTable
+	ProductDeliverablesTable.ForeignKeys[0].RefTable = ProductsTable
+	ProductDeliverablesTable.ForeignKeys[1].RefTable = FilesTable
+	ProductKeysTable.ForeignKeys[0].RefTable = ProductsTable
 	UsersTable.ForeignKeys[0].RefTable = RolesTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	WalletsTable.ForeignKeys[0].RefTable = UsersTable
+	WebHookDeliveriesTable.ForeignKeys[0].RefTable = WebHooksTable
 	CategoryPostsTable.ForeignKeys[0].RefTable = CategoriesTable
 	CategoryPostsTable.ForeignKeys[1].RefTable = PostsTable
 	TagPostsTable.ForeignKeys[0].RefTable = TagsTable
@@ -23,13 +23,13 @@ type Oauth2AccessToken struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// 用户ID
 	UserID int `json:"user_id,omitempty"`
-	// 访问令牌
-	AccessToken string `json:"access_token,omitempty"`
-	// 刷新令牌
-	RefreshToken string `json:"refresh_token,omitempty"`
+	// 访问令牌 SHA-256 哈希
+	AccessToken string `json:"-"`
+	// 同时签发的刷新令牌哈希,吊销刷新令牌时一并吊销
+	RefreshToken string `json:"-"`
 	// 客户端ID
 	ClientID string `json:"client_id,omitempty"`
-	// 授权范围
+	// 授权范围,空格分隔
 	Scope string `json:"scope,omitempty"`
 	// 过期时间
 	ExpireAt     time.Time `json:"expire_at,omitempty"`
@@ -161,11 +161,9 @@ func (_m *Oauth2AccessToken) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
-	builder.WriteString("access_token=")
-	builder.WriteString(_m.AccessToken)
+	builder.WriteString("access_token=<sensitive>")
 	builder.WriteString(", ")
-	builder.WriteString("refresh_token=")
-	builder.WriteString(_m.RefreshToken)
+	builder.WriteString("refresh_token=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("client_id=")
 	builder.WriteString(_m.ClientID)
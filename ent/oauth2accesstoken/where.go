@@ -334,6 +334,16 @@ func RefreshTokenHasSuffix(v string) predicate.Oauth2AccessToken {
 	return predicate.Oauth2AccessToken(sql.FieldHasSuffix(FieldRefreshToken, v))
 }
 
+// RefreshTokenIsNil applies the IsNil predicate on the "refresh_token" field.
+func RefreshTokenIsNil() predicate.Oauth2AccessToken {
+	return predicate.Oauth2AccessToken(sql.FieldIsNull(FieldRefreshToken))
+}
+
+// RefreshTokenNotNil applies the NotNil predicate on the "refresh_token" field.
+func RefreshTokenNotNil() predicate.Oauth2AccessToken {
+	return predicate.Oauth2AccessToken(sql.FieldNotNull(FieldRefreshToken))
+}
+
 // RefreshTokenEqualFold applies the EqualFold predicate on the "refresh_token" field.
 func RefreshTokenEqualFold(v string) predicate.Oauth2AccessToken {
 	return predicate.Oauth2AccessToken(sql.FieldEqualFold(FieldRefreshToken, v))
@@ -66,6 +66,14 @@ func (_c *Oauth2AccessTokenCreate) SetRefreshToken(v string) *Oauth2AccessTokenC
 	return _c
 }
 
+// SetNillableRefreshToken sets the "refresh_token" field if the given value is not nil.
+func (_c *Oauth2AccessTokenCreate) SetNillableRefreshToken(v *string) *Oauth2AccessTokenCreate {
+	if v != nil {
+		_c.SetRefreshToken(*v)
+	}
+	return _c
+}
+
 // SetClientID sets the "client_id" field.
 func (_c *Oauth2AccessTokenCreate) SetClientID(v string) *Oauth2AccessTokenCreate {
 	_c.mutation.SetClientID(v)
@@ -154,9 +162,6 @@ func (_c *Oauth2AccessTokenCreate) check() error {
 			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "Oauth2AccessToken.access_token": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.RefreshToken(); !ok {
-		return &ValidationError{Name: "refresh_token", err: errors.New(`ent: missing required field "Oauth2AccessToken.refresh_token"`)}
-	}
 	if v, ok := _c.mutation.RefreshToken(); ok {
 		if err := oauth2accesstoken.RefreshTokenValidator(v); err != nil {
 			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "Oauth2AccessToken.refresh_token": %w`, err)}
@@ -83,6 +83,12 @@ func (_u *Oauth2AccessTokenUpdate) SetNillableRefreshToken(v *string) *Oauth2Acc
 	return _u
 }
 
+// ClearRefreshToken clears the value of the "refresh_token" field.
+func (_u *Oauth2AccessTokenUpdate) ClearRefreshToken() *Oauth2AccessTokenUpdate {
+	_u.mutation.ClearRefreshToken()
+	return _u
+}
+
 // SetClientID sets the "client_id" field.
 func (_u *Oauth2AccessTokenUpdate) SetClientID(v string) *Oauth2AccessTokenUpdate {
 	_u.mutation.SetClientID(v)
@@ -218,6 +224,9 @@ func (_u *Oauth2AccessTokenUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.RefreshToken(); ok {
 		_spec.SetField(oauth2accesstoken.FieldRefreshToken, field.TypeString, value)
 	}
+	if _u.mutation.RefreshTokenCleared() {
+		_spec.ClearField(oauth2accesstoken.FieldRefreshToken, field.TypeString)
+	}
 	if value, ok := _u.mutation.ClientID(); ok {
 		_spec.SetField(oauth2accesstoken.FieldClientID, field.TypeString, value)
 	}
@@ -302,6 +311,12 @@ func (_u *Oauth2AccessTokenUpdateOne) SetNillableRefreshToken(v *string) *Oauth2
 	return _u
 }
 
+// ClearRefreshToken clears the value of the "refresh_token" field.
+func (_u *Oauth2AccessTokenUpdateOne) ClearRefreshToken() *Oauth2AccessTokenUpdateOne {
+	_u.mutation.ClearRefreshToken()
+	return _u
+}
+
 // SetClientID sets the "client_id" field.
 func (_u *Oauth2AccessTokenUpdateOne) SetClientID(v string) *Oauth2AccessTokenUpdateOne {
 	_u.mutation.SetClientID(v)
@@ -467,6 +482,9 @@ func (_u *Oauth2AccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *Oauth
 	if value, ok := _u.mutation.RefreshToken(); ok {
 		_spec.SetField(oauth2accesstoken.FieldRefreshToken, field.TypeString, value)
 	}
+	if _u.mutation.RefreshTokenCleared() {
+		_spec.ClearField(oauth2accesstoken.FieldRefreshToken, field.TypeString)
+	}
 	if value, ok := _u.mutation.ClientID(); ok {
 		_spec.SetField(oauth2accesstoken.FieldClientID, field.TypeString, value)
 	}
@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2client"
+)
+
+// Oauth2ClientCreate is the builder for creating a Oauth2Client entity.
+type Oauth2ClientCreate struct {
+	config
+	mutation *Oauth2ClientMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *Oauth2ClientCreate) SetCreatedAt(v time.Time) *Oauth2ClientCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *Oauth2ClientCreate) SetNillableCreatedAt(v *time.Time) *Oauth2ClientCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *Oauth2ClientCreate) SetUpdatedAt(v time.Time) *Oauth2ClientCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *Oauth2ClientCreate) SetNillableUpdatedAt(v *time.Time) *Oauth2ClientCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *Oauth2ClientCreate) SetClientID(v string) *Oauth2ClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_c *Oauth2ClientCreate) SetClientSecretHash(v string) *Oauth2ClientCreate {
+	_c.mutation.SetClientSecretHash(v)
+	return _c
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_c *Oauth2ClientCreate) SetNillableClientSecretHash(v *string) *Oauth2ClientCreate {
+	if v != nil {
+		_c.SetClientSecretHash(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *Oauth2ClientCreate) SetName(v string) *Oauth2ClientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *Oauth2ClientCreate) SetDescription(v string) *Oauth2ClientCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *Oauth2ClientCreate) SetNillableDescription(v *string) *Oauth2ClientCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetLogo sets the "logo" field.
+func (_c *Oauth2ClientCreate) SetLogo(v string) *Oauth2ClientCreate {
+	_c.mutation.SetLogo(v)
+	return _c
+}
+
+// SetNillableLogo sets the "logo" field if the given value is not nil.
+func (_c *Oauth2ClientCreate) SetNillableLogo(v *string) *Oauth2ClientCreate {
+	if v != nil {
+		_c.SetLogo(*v)
+	}
+	return _c
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_c *Oauth2ClientCreate) SetRedirectUris(v []string) *Oauth2ClientCreate {
+	_c.mutation.SetRedirectUris(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *Oauth2ClientCreate) SetScopes(v []string) *Oauth2ClientCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetPublic sets the "public" field.
+func (_c *Oauth2ClientCreate) SetPublic(v bool) *Oauth2ClientCreate {
+	_c.mutation.SetPublic(v)
+	return _c
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_c *Oauth2ClientCreate) SetNillablePublic(v *bool) *Oauth2ClientCreate {
+	if v != nil {
+		_c.SetPublic(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *Oauth2ClientCreate) SetEnabled(v bool) *Oauth2ClientCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *Oauth2ClientCreate) SetNillableEnabled(v *bool) *Oauth2ClientCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *Oauth2ClientCreate) SetID(v int) *Oauth2ClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the Oauth2ClientMutation object of the builder.
+func (_c *Oauth2ClientCreate) Mutation() *Oauth2ClientMutation {
+	return _c.mutation
+}
+
+// Save creates the Oauth2Client in the database.
+func (_c *Oauth2ClientCreate) Save(ctx context.Context) (*Oauth2Client, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *Oauth2ClientCreate) SaveX(ctx context.Context) *Oauth2Client {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *Oauth2ClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *Oauth2ClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *Oauth2ClientCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oauth2client.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oauth2client.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Public(); !ok {
+		v := oauth2client.DefaultPublic
+		_c.mutation.SetPublic(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := oauth2client.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *Oauth2ClientCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Oauth2Client.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Oauth2Client.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "Oauth2Client.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oauth2client.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Oauth2Client.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := oauth2client.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := oauth2client.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.description": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Logo(); ok {
+		if err := oauth2client.LogoValidator(v); err != nil {
+			return &ValidationError{Name: "logo", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.logo": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RedirectUris(); !ok {
+		return &ValidationError{Name: "redirect_uris", err: errors.New(`ent: missing required field "Oauth2Client.redirect_uris"`)}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "Oauth2Client.scopes"`)}
+	}
+	if _, ok := _c.mutation.Public(); !ok {
+		return &ValidationError{Name: "public", err: errors.New(`ent: missing required field "Oauth2Client.public"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Oauth2Client.enabled"`)}
+	}
+	return nil
+}
+
+func (_c *Oauth2ClientCreate) sqlSave(ctx context.Context) (*Oauth2Client, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *Oauth2ClientCreate) createSpec() (*Oauth2Client, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Oauth2Client{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauth2client.Table, sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauth2client.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauth2client.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oauth2client.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauth2client.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(oauth2client.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(oauth2client.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Logo(); ok {
+		_spec.SetField(oauth2client.FieldLogo, field.TypeString, value)
+		_node.Logo = value
+	}
+	if value, ok := _c.mutation.RedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauth2client.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Public(); ok {
+		_spec.SetField(oauth2client.FieldPublic, field.TypeBool, value)
+		_node.Public = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(oauth2client.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// Oauth2ClientCreateBulk is the builder for creating many Oauth2Client entities in bulk.
+type Oauth2ClientCreateBulk struct {
+	config
+	err      error
+	builders []*Oauth2ClientCreate
+}
+
+// Save creates the Oauth2Client entities in the database.
+func (_c *Oauth2ClientCreateBulk) Save(ctx context.Context) ([]*Oauth2Client, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Oauth2Client, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*Oauth2ClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *Oauth2ClientCreateBulk) SaveX(ctx context.Context) []*Oauth2Client {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *Oauth2ClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *Oauth2ClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2client"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+)
+
+// Oauth2ClientUpdate is the builder for updating Oauth2Client entities.
+type Oauth2ClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *Oauth2ClientMutation
+}
+
+// Where appends a list predicates to the Oauth2ClientUpdate builder.
+func (_u *Oauth2ClientUpdate) Where(ps ...predicate.Oauth2Client) *Oauth2ClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *Oauth2ClientUpdate) SetUpdatedAt(v time.Time) *Oauth2ClientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *Oauth2ClientUpdate) SetClientSecretHash(v string) *Oauth2ClientUpdate {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *Oauth2ClientUpdate) SetNillableClientSecretHash(v *string) *Oauth2ClientUpdate {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *Oauth2ClientUpdate) ClearClientSecretHash() *Oauth2ClientUpdate {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *Oauth2ClientUpdate) SetName(v string) *Oauth2ClientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *Oauth2ClientUpdate) SetNillableName(v *string) *Oauth2ClientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *Oauth2ClientUpdate) SetDescription(v string) *Oauth2ClientUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *Oauth2ClientUpdate) SetNillableDescription(v *string) *Oauth2ClientUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *Oauth2ClientUpdate) ClearDescription() *Oauth2ClientUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetLogo sets the "logo" field.
+func (_u *Oauth2ClientUpdate) SetLogo(v string) *Oauth2ClientUpdate {
+	_u.mutation.SetLogo(v)
+	return _u
+}
+
+// SetNillableLogo sets the "logo" field if the given value is not nil.
+func (_u *Oauth2ClientUpdate) SetNillableLogo(v *string) *Oauth2ClientUpdate {
+	if v != nil {
+		_u.SetLogo(*v)
+	}
+	return _u
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (_u *Oauth2ClientUpdate) ClearLogo() *Oauth2ClientUpdate {
+	_u.mutation.ClearLogo()
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *Oauth2ClientUpdate) SetRedirectUris(v []string) *Oauth2ClientUpdate {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *Oauth2ClientUpdate) AppendRedirectUris(v []string) *Oauth2ClientUpdate {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *Oauth2ClientUpdate) SetScopes(v []string) *Oauth2ClientUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *Oauth2ClientUpdate) AppendScopes(v []string) *Oauth2ClientUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *Oauth2ClientUpdate) SetEnabled(v bool) *Oauth2ClientUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *Oauth2ClientUpdate) SetNillableEnabled(v *bool) *Oauth2ClientUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the Oauth2ClientMutation object of the builder.
+func (_u *Oauth2ClientUpdate) Mutation() *Oauth2ClientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *Oauth2ClientUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *Oauth2ClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *Oauth2ClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *Oauth2ClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *Oauth2ClientUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauth2client.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *Oauth2ClientUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauth2client.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := oauth2client.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Logo(); ok {
+		if err := oauth2client.LogoValidator(v); err != nil {
+			return &ValidationError{Name: "logo", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.logo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *Oauth2ClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauth2client.Table, oauth2client.Columns, sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauth2client.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauth2client.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauth2client.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauth2client.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauth2client.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauth2client.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Logo(); ok {
+		_spec.SetField(oauth2client.FieldLogo, field.TypeString, value)
+	}
+	if _u.mutation.LogoCleared() {
+		_spec.ClearField(oauth2client.FieldLogo, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauth2client.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(oauth2client.FieldEnabled, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauth2client.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// Oauth2ClientUpdateOne is the builder for updating a single Oauth2Client entity.
+type Oauth2ClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *Oauth2ClientMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *Oauth2ClientUpdateOne) SetUpdatedAt(v time.Time) *Oauth2ClientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *Oauth2ClientUpdateOne) SetClientSecretHash(v string) *Oauth2ClientUpdateOne {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *Oauth2ClientUpdateOne) SetNillableClientSecretHash(v *string) *Oauth2ClientUpdateOne {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *Oauth2ClientUpdateOne) ClearClientSecretHash() *Oauth2ClientUpdateOne {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *Oauth2ClientUpdateOne) SetName(v string) *Oauth2ClientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *Oauth2ClientUpdateOne) SetNillableName(v *string) *Oauth2ClientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *Oauth2ClientUpdateOne) SetDescription(v string) *Oauth2ClientUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *Oauth2ClientUpdateOne) SetNillableDescription(v *string) *Oauth2ClientUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *Oauth2ClientUpdateOne) ClearDescription() *Oauth2ClientUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetLogo sets the "logo" field.
+func (_u *Oauth2ClientUpdateOne) SetLogo(v string) *Oauth2ClientUpdateOne {
+	_u.mutation.SetLogo(v)
+	return _u
+}
+
+// SetNillableLogo sets the "logo" field if the given value is not nil.
+func (_u *Oauth2ClientUpdateOne) SetNillableLogo(v *string) *Oauth2ClientUpdateOne {
+	if v != nil {
+		_u.SetLogo(*v)
+	}
+	return _u
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (_u *Oauth2ClientUpdateOne) ClearLogo() *Oauth2ClientUpdateOne {
+	_u.mutation.ClearLogo()
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *Oauth2ClientUpdateOne) SetRedirectUris(v []string) *Oauth2ClientUpdateOne {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *Oauth2ClientUpdateOne) AppendRedirectUris(v []string) *Oauth2ClientUpdateOne {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *Oauth2ClientUpdateOne) SetScopes(v []string) *Oauth2ClientUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *Oauth2ClientUpdateOne) AppendScopes(v []string) *Oauth2ClientUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *Oauth2ClientUpdateOne) SetEnabled(v bool) *Oauth2ClientUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *Oauth2ClientUpdateOne) SetNillableEnabled(v *bool) *Oauth2ClientUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the Oauth2ClientMutation object of the builder.
+func (_u *Oauth2ClientUpdateOne) Mutation() *Oauth2ClientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the Oauth2ClientUpdate builder.
+func (_u *Oauth2ClientUpdateOne) Where(ps ...predicate.Oauth2Client) *Oauth2ClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *Oauth2ClientUpdateOne) Select(field string, fields ...string) *Oauth2ClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Oauth2Client entity.
+func (_u *Oauth2ClientUpdateOne) Save(ctx context.Context) (*Oauth2Client, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *Oauth2ClientUpdateOne) SaveX(ctx context.Context) *Oauth2Client {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *Oauth2ClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *Oauth2ClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *Oauth2ClientUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauth2client.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *Oauth2ClientUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauth2client.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := oauth2client.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Logo(); ok {
+		if err := oauth2client.LogoValidator(v); err != nil {
+			return &ValidationError{Name: "logo", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.logo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *Oauth2ClientUpdateOne) sqlSave(ctx context.Context) (_node *Oauth2Client, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauth2client.Table, oauth2client.Columns, sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Oauth2Client.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauth2client.FieldID)
+		for _, f := range fields {
+			if !oauth2client.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauth2client.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauth2client.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauth2client.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauth2client.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauth2client.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauth2client.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauth2client.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Logo(); ok {
+		_spec.SetField(oauth2client.FieldLogo, field.TypeString, value)
+	}
+	if _u.mutation.LogoCleared() {
+		_spec.ClearField(oauth2client.FieldLogo, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauth2client.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(oauth2client.FieldEnabled, field.TypeBool, value)
+	}
+	_node = &Oauth2Client{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauth2client.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
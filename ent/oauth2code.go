@@ -23,16 +23,24 @@ type Oauth2Code struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// 用户ID
 	UserID int `json:"user_id,omitempty"`
-	// 授权码
-	Code string `json:"code,omitempty"`
+	// 授权码 SHA-256 哈希
+	Code string `json:"-"`
 	// 过期时间
 	ExpireAt time.Time `json:"expire_at,omitempty"`
 	// 客户端ID
 	ClientID string `json:"client_id,omitempty"`
 	// 重定向URI
 	RedirectURI string `json:"redirect_uri,omitempty"`
-	// 授权范围
-	Scope        string `json:"scope,omitempty"`
+	// 授权范围,空格分隔
+	Scope string `json:"scope,omitempty"`
+	// PKCE 挑战码
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	// PKCE 挑战方式
+	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
+	// OIDC nonce
+	Nonce string `json:"nonce,omitempty"`
+	// 用户授权时间
+	AuthTime     time.Time `json:"auth_time,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -43,9 +51,9 @@ func (*Oauth2Code) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case oauth2code.FieldID, oauth2code.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case oauth2code.FieldCode, oauth2code.FieldClientID, oauth2code.FieldRedirectURI, oauth2code.FieldScope:
+		case oauth2code.FieldCode, oauth2code.FieldClientID, oauth2code.FieldRedirectURI, oauth2code.FieldScope, oauth2code.FieldCodeChallenge, oauth2code.FieldCodeChallengeMethod, oauth2code.FieldNonce:
 			values[i] = new(sql.NullString)
-		case oauth2code.FieldCreatedAt, oauth2code.FieldUpdatedAt, oauth2code.FieldExpireAt:
+		case oauth2code.FieldCreatedAt, oauth2code.FieldUpdatedAt, oauth2code.FieldExpireAt, oauth2code.FieldAuthTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -116,6 +124,30 @@ func (_m *Oauth2Code) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Scope = value.String
 			}
+		case oauth2code.FieldCodeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge", values[i])
+			} else if value.Valid {
+				_m.CodeChallenge = value.String
+			}
+		case oauth2code.FieldCodeChallengeMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge_method", values[i])
+			} else if value.Valid {
+				_m.CodeChallengeMethod = value.String
+			}
+		case oauth2code.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				_m.Nonce = value.String
+			}
+		case oauth2code.FieldAuthTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_time", values[i])
+			} else if value.Valid {
+				_m.AuthTime = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -161,8 +193,7 @@ func (_m *Oauth2Code) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
-	builder.WriteString("code=")
-	builder.WriteString(_m.Code)
+	builder.WriteString("code=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("expire_at=")
 	builder.WriteString(_m.ExpireAt.Format(time.ANSIC))
@@ -175,6 +206,18 @@ func (_m *Oauth2Code) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("scope=")
 	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge=")
+	builder.WriteString(_m.CodeChallenge)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge_method=")
+	builder.WriteString(_m.CodeChallengeMethod)
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(_m.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("auth_time=")
+	builder.WriteString(_m.AuthTime.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -94,6 +94,26 @@ func Scope(v string) predicate.Oauth2Code {
 	return predicate.Oauth2Code(sql.FieldEQ(FieldScope, v))
 }
 
+// CodeChallenge applies equality check predicate on the "code_challenge" field. It's identical to CodeChallengeEQ.
+func CodeChallenge(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeMethod applies equality check predicate on the "code_challenge_method" field. It's identical to CodeChallengeMethodEQ.
+func CodeChallengeMethod(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldCodeChallengeMethod, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldNonce, v))
+}
+
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldAuthTime, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Oauth2Code {
 	return predicate.Oauth2Code(sql.FieldEQ(FieldCreatedAt, v))
@@ -514,6 +534,281 @@ func ScopeContainsFold(v string) predicate.Oauth2Code {
 	return predicate.Oauth2Code(sql.FieldContainsFold(FieldScope, v))
 }
 
+// CodeChallengeEQ applies the EQ predicate on the "code_challenge" field.
+func CodeChallengeEQ(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeNEQ applies the NEQ predicate on the "code_challenge" field.
+func CodeChallengeNEQ(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeIn applies the In predicate on the "code_challenge" field.
+func CodeChallengeIn(vs ...string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIn(FieldCodeChallenge, vs...))
+}
+
+// CodeChallengeNotIn applies the NotIn predicate on the "code_challenge" field.
+func CodeChallengeNotIn(vs ...string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotIn(FieldCodeChallenge, vs...))
+}
+
+// CodeChallengeGT applies the GT predicate on the "code_challenge" field.
+func CodeChallengeGT(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGT(FieldCodeChallenge, v))
+}
+
+// CodeChallengeGTE applies the GTE predicate on the "code_challenge" field.
+func CodeChallengeGTE(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGTE(FieldCodeChallenge, v))
+}
+
+// CodeChallengeLT applies the LT predicate on the "code_challenge" field.
+func CodeChallengeLT(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLT(FieldCodeChallenge, v))
+}
+
+// CodeChallengeLTE applies the LTE predicate on the "code_challenge" field.
+func CodeChallengeLTE(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLTE(FieldCodeChallenge, v))
+}
+
+// CodeChallengeContains applies the Contains predicate on the "code_challenge" field.
+func CodeChallengeContains(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldContains(FieldCodeChallenge, v))
+}
+
+// CodeChallengeHasPrefix applies the HasPrefix predicate on the "code_challenge" field.
+func CodeChallengeHasPrefix(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldHasPrefix(FieldCodeChallenge, v))
+}
+
+// CodeChallengeHasSuffix applies the HasSuffix predicate on the "code_challenge" field.
+func CodeChallengeHasSuffix(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldHasSuffix(FieldCodeChallenge, v))
+}
+
+// CodeChallengeIsNil applies the IsNil predicate on the "code_challenge" field.
+func CodeChallengeIsNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIsNull(FieldCodeChallenge))
+}
+
+// CodeChallengeNotNil applies the NotNil predicate on the "code_challenge" field.
+func CodeChallengeNotNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotNull(FieldCodeChallenge))
+}
+
+// CodeChallengeEqualFold applies the EqualFold predicate on the "code_challenge" field.
+func CodeChallengeEqualFold(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEqualFold(FieldCodeChallenge, v))
+}
+
+// CodeChallengeContainsFold applies the ContainsFold predicate on the "code_challenge" field.
+func CodeChallengeContainsFold(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldContainsFold(FieldCodeChallenge, v))
+}
+
+// CodeChallengeMethodEQ applies the EQ predicate on the "code_challenge_method" field.
+func CodeChallengeMethodEQ(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodNEQ applies the NEQ predicate on the "code_challenge_method" field.
+func CodeChallengeMethodNEQ(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNEQ(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodIn applies the In predicate on the "code_challenge_method" field.
+func CodeChallengeMethodIn(vs ...string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIn(FieldCodeChallengeMethod, vs...))
+}
+
+// CodeChallengeMethodNotIn applies the NotIn predicate on the "code_challenge_method" field.
+func CodeChallengeMethodNotIn(vs ...string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotIn(FieldCodeChallengeMethod, vs...))
+}
+
+// CodeChallengeMethodGT applies the GT predicate on the "code_challenge_method" field.
+func CodeChallengeMethodGT(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGT(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodGTE applies the GTE predicate on the "code_challenge_method" field.
+func CodeChallengeMethodGTE(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGTE(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodLT applies the LT predicate on the "code_challenge_method" field.
+func CodeChallengeMethodLT(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLT(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodLTE applies the LTE predicate on the "code_challenge_method" field.
+func CodeChallengeMethodLTE(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLTE(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodContains applies the Contains predicate on the "code_challenge_method" field.
+func CodeChallengeMethodContains(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldContains(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodHasPrefix applies the HasPrefix predicate on the "code_challenge_method" field.
+func CodeChallengeMethodHasPrefix(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldHasPrefix(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodHasSuffix applies the HasSuffix predicate on the "code_challenge_method" field.
+func CodeChallengeMethodHasSuffix(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldHasSuffix(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodIsNil applies the IsNil predicate on the "code_challenge_method" field.
+func CodeChallengeMethodIsNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIsNull(FieldCodeChallengeMethod))
+}
+
+// CodeChallengeMethodNotNil applies the NotNil predicate on the "code_challenge_method" field.
+func CodeChallengeMethodNotNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotNull(FieldCodeChallengeMethod))
+}
+
+// CodeChallengeMethodEqualFold applies the EqualFold predicate on the "code_challenge_method" field.
+func CodeChallengeMethodEqualFold(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEqualFold(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodContainsFold applies the ContainsFold predicate on the "code_challenge_method" field.
+func CodeChallengeMethodContainsFold(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldContainsFold(FieldCodeChallengeMethod, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceIsNil applies the IsNil predicate on the "nonce" field.
+func NonceIsNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIsNull(FieldNonce))
+}
+
+// NonceNotNil applies the NotNil predicate on the "nonce" field.
+func NonceNotNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotNull(FieldNonce))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// AuthTimeIsNil applies the IsNil predicate on the "auth_time" field.
+func AuthTimeIsNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldIsNull(FieldAuthTime))
+}
+
+// AuthTimeNotNil applies the NotNil predicate on the "auth_time" field.
+func AuthTimeNotNil() predicate.Oauth2Code {
+	return predicate.Oauth2Code(sql.FieldNotNull(FieldAuthTime))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Oauth2Code) predicate.Oauth2Code {
 	return predicate.Oauth2Code(sql.AndPredicates(predicates...))
@@ -84,6 +84,62 @@ func (_c *Oauth2CodeCreate) SetScope(v string) *Oauth2CodeCreate {
 	return _c
 }
 
+// SetCodeChallenge sets the "code_challenge" field.
+func (_c *Oauth2CodeCreate) SetCodeChallenge(v string) *Oauth2CodeCreate {
+	_c.mutation.SetCodeChallenge(v)
+	return _c
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_c *Oauth2CodeCreate) SetNillableCodeChallenge(v *string) *Oauth2CodeCreate {
+	if v != nil {
+		_c.SetCodeChallenge(*v)
+	}
+	return _c
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_c *Oauth2CodeCreate) SetCodeChallengeMethod(v string) *Oauth2CodeCreate {
+	_c.mutation.SetCodeChallengeMethod(v)
+	return _c
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_c *Oauth2CodeCreate) SetNillableCodeChallengeMethod(v *string) *Oauth2CodeCreate {
+	if v != nil {
+		_c.SetCodeChallengeMethod(*v)
+	}
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *Oauth2CodeCreate) SetNonce(v string) *Oauth2CodeCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_c *Oauth2CodeCreate) SetNillableNonce(v *string) *Oauth2CodeCreate {
+	if v != nil {
+		_c.SetNonce(*v)
+	}
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *Oauth2CodeCreate) SetAuthTime(v time.Time) *Oauth2CodeCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *Oauth2CodeCreate) SetNillableAuthTime(v *time.Time) *Oauth2CodeCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *Oauth2CodeCreate) SetID(v int) *Oauth2CodeCreate {
 	_c.mutation.SetID(v)
@@ -181,6 +237,21 @@ func (_c *Oauth2CodeCreate) check() error {
 			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.scope": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.CodeChallenge(); ok {
+		if err := oauth2code.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.code_challenge": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CodeChallengeMethod(); ok {
+		if err := oauth2code.CodeChallengeMethodValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge_method", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.code_challenge_method": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Nonce(); ok {
+		if err := oauth2code.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.nonce": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -245,6 +316,22 @@ func (_c *Oauth2CodeCreate) createSpec() (*Oauth2Code, *sqlgraph.CreateSpec) {
 		_spec.SetField(oauth2code.FieldScope, field.TypeString, value)
 		_node.Scope = value
 	}
+	if value, ok := _c.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauth2code.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := _c.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauth2code.FieldCodeChallengeMethod, field.TypeString, value)
+		_node.CodeChallengeMethod = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(oauth2code.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(oauth2code.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
 	return _node, _spec
 }
 
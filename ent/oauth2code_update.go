@@ -125,6 +125,86 @@ func (_u *Oauth2CodeUpdate) SetNillableScope(v *string) *Oauth2CodeUpdate {
 	return _u
 }
 
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *Oauth2CodeUpdate) SetCodeChallenge(v string) *Oauth2CodeUpdate {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *Oauth2CodeUpdate) SetNillableCodeChallenge(v *string) *Oauth2CodeUpdate {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallenge clears the value of the "code_challenge" field.
+func (_u *Oauth2CodeUpdate) ClearCodeChallenge() *Oauth2CodeUpdate {
+	_u.mutation.ClearCodeChallenge()
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *Oauth2CodeUpdate) SetCodeChallengeMethod(v string) *Oauth2CodeUpdate {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *Oauth2CodeUpdate) SetNillableCodeChallengeMethod(v *string) *Oauth2CodeUpdate {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallengeMethod clears the value of the "code_challenge_method" field.
+func (_u *Oauth2CodeUpdate) ClearCodeChallengeMethod() *Oauth2CodeUpdate {
+	_u.mutation.ClearCodeChallengeMethod()
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *Oauth2CodeUpdate) SetNonce(v string) *Oauth2CodeUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *Oauth2CodeUpdate) SetNillableNonce(v *string) *Oauth2CodeUpdate {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (_u *Oauth2CodeUpdate) ClearNonce() *Oauth2CodeUpdate {
+	_u.mutation.ClearNonce()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *Oauth2CodeUpdate) SetAuthTime(v time.Time) *Oauth2CodeUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *Oauth2CodeUpdate) SetNillableAuthTime(v *time.Time) *Oauth2CodeUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *Oauth2CodeUpdate) ClearAuthTime() *Oauth2CodeUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
 // Mutation returns the Oauth2CodeMutation object of the builder.
 func (_u *Oauth2CodeUpdate) Mutation() *Oauth2CodeMutation {
 	return _u.mutation
@@ -188,6 +268,21 @@ func (_u *Oauth2CodeUpdate) check() error {
 			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.scope": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CodeChallenge(); ok {
+		if err := oauth2code.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.code_challenge": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeChallengeMethod(); ok {
+		if err := oauth2code.CodeChallengeMethodValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge_method", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.code_challenge_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Nonce(); ok {
+		if err := oauth2code.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.nonce": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -227,6 +322,30 @@ func (_u *Oauth2CodeUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Scope(); ok {
 		_spec.SetField(oauth2code.FieldScope, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauth2code.FieldCodeChallenge, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeCleared() {
+		_spec.ClearField(oauth2code.FieldCodeChallenge, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauth2code.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeMethodCleared() {
+		_spec.ClearField(oauth2code.FieldCodeChallengeMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(oauth2code.FieldNonce, field.TypeString, value)
+	}
+	if _u.mutation.NonceCleared() {
+		_spec.ClearField(oauth2code.FieldNonce, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(oauth2code.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(oauth2code.FieldAuthTime, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{oauth2code.Label}
@@ -344,6 +463,86 @@ func (_u *Oauth2CodeUpdateOne) SetNillableScope(v *string) *Oauth2CodeUpdateOne
 	return _u
 }
 
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *Oauth2CodeUpdateOne) SetCodeChallenge(v string) *Oauth2CodeUpdateOne {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *Oauth2CodeUpdateOne) SetNillableCodeChallenge(v *string) *Oauth2CodeUpdateOne {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallenge clears the value of the "code_challenge" field.
+func (_u *Oauth2CodeUpdateOne) ClearCodeChallenge() *Oauth2CodeUpdateOne {
+	_u.mutation.ClearCodeChallenge()
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *Oauth2CodeUpdateOne) SetCodeChallengeMethod(v string) *Oauth2CodeUpdateOne {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *Oauth2CodeUpdateOne) SetNillableCodeChallengeMethod(v *string) *Oauth2CodeUpdateOne {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallengeMethod clears the value of the "code_challenge_method" field.
+func (_u *Oauth2CodeUpdateOne) ClearCodeChallengeMethod() *Oauth2CodeUpdateOne {
+	_u.mutation.ClearCodeChallengeMethod()
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *Oauth2CodeUpdateOne) SetNonce(v string) *Oauth2CodeUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *Oauth2CodeUpdateOne) SetNillableNonce(v *string) *Oauth2CodeUpdateOne {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (_u *Oauth2CodeUpdateOne) ClearNonce() *Oauth2CodeUpdateOne {
+	_u.mutation.ClearNonce()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *Oauth2CodeUpdateOne) SetAuthTime(v time.Time) *Oauth2CodeUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *Oauth2CodeUpdateOne) SetNillableAuthTime(v *time.Time) *Oauth2CodeUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *Oauth2CodeUpdateOne) ClearAuthTime() *Oauth2CodeUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
 // Mutation returns the Oauth2CodeMutation object of the builder.
 func (_u *Oauth2CodeUpdateOne) Mutation() *Oauth2CodeMutation {
 	return _u.mutation
@@ -420,6 +619,21 @@ func (_u *Oauth2CodeUpdateOne) check() error {
 			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.scope": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CodeChallenge(); ok {
+		if err := oauth2code.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.code_challenge": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeChallengeMethod(); ok {
+		if err := oauth2code.CodeChallengeMethodValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge_method", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.code_challenge_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Nonce(); ok {
+		if err := oauth2code.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "Oauth2Code.nonce": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -476,6 +690,30 @@ func (_u *Oauth2CodeUpdateOne) sqlSave(ctx context.Context) (_node *Oauth2Code,
 	if value, ok := _u.mutation.Scope(); ok {
 		_spec.SetField(oauth2code.FieldScope, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauth2code.FieldCodeChallenge, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeCleared() {
+		_spec.ClearField(oauth2code.FieldCodeChallenge, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauth2code.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeMethodCleared() {
+		_spec.ClearField(oauth2code.FieldCodeChallengeMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(oauth2code.FieldNonce, field.TypeString, value)
+	}
+	if _u.mutation.NonceCleared() {
+		_spec.ClearField(oauth2code.FieldNonce, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(oauth2code.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(oauth2code.FieldAuthTime, field.TypeTime)
+	}
 	_node = &Oauth2Code{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
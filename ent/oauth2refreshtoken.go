@@ -23,12 +23,14 @@ type Oauth2RefreshToken struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// 用户ID
 	UserID int `json:"user_id,omitempty"`
-	// 刷新令牌
-	RefreshToken string `json:"refresh_token,omitempty"`
+	// 刷新令牌 SHA-256 哈希
+	RefreshToken string `json:"-"`
 	// 客户端ID
 	ClientID string `json:"client_id,omitempty"`
-	// 授权范围
+	// 授权范围,空格分隔
 	Scope string `json:"scope,omitempty"`
+	// 用户授权时间
+	AuthTime time.Time `json:"auth_time,omitempty"`
 	// 过期时间
 	ExpireAt     time.Time `json:"expire_at,omitempty"`
 	selectValues sql.SelectValues
@@ -43,7 +45,7 @@ func (*Oauth2RefreshToken) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case oauth2refreshtoken.FieldRefreshToken, oauth2refreshtoken.FieldClientID, oauth2refreshtoken.FieldScope:
 			values[i] = new(sql.NullString)
-		case oauth2refreshtoken.FieldCreatedAt, oauth2refreshtoken.FieldUpdatedAt, oauth2refreshtoken.FieldExpireAt:
+		case oauth2refreshtoken.FieldCreatedAt, oauth2refreshtoken.FieldUpdatedAt, oauth2refreshtoken.FieldAuthTime, oauth2refreshtoken.FieldExpireAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -102,6 +104,12 @@ func (_m *Oauth2RefreshToken) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Scope = value.String
 			}
+		case oauth2refreshtoken.FieldAuthTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_time", values[i])
+			} else if value.Valid {
+				_m.AuthTime = value.Time
+			}
 		case oauth2refreshtoken.FieldExpireAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field expire_at", values[i])
@@ -153,8 +161,7 @@ func (_m *Oauth2RefreshToken) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
-	builder.WriteString("refresh_token=")
-	builder.WriteString(_m.RefreshToken)
+	builder.WriteString("refresh_token=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("client_id=")
 	builder.WriteString(_m.ClientID)
@@ -162,6 +169,9 @@ func (_m *Oauth2RefreshToken) String() string {
 	builder.WriteString("scope=")
 	builder.WriteString(_m.Scope)
 	builder.WriteString(", ")
+	builder.WriteString("auth_time=")
+	builder.WriteString(_m.AuthTime.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("expire_at=")
 	builder.WriteString(_m.ExpireAt.Format(time.ANSIC))
 	builder.WriteByte(')')
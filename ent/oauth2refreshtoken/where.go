@@ -84,6 +84,11 @@ func Scope(v string) predicate.Oauth2RefreshToken {
 	return predicate.Oauth2RefreshToken(sql.FieldEQ(FieldScope, v))
 }
 
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldEQ(FieldAuthTime, v))
+}
+
 // ExpireAt applies equality check predicate on the "expire_at" field. It's identical to ExpireAtEQ.
 func ExpireAt(v time.Time) predicate.Oauth2RefreshToken {
 	return predicate.Oauth2RefreshToken(sql.FieldEQ(FieldExpireAt, v))
@@ -404,6 +409,56 @@ func ScopeContainsFold(v string) predicate.Oauth2RefreshToken {
 	return predicate.Oauth2RefreshToken(sql.FieldContainsFold(FieldScope, v))
 }
 
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// AuthTimeIsNil applies the IsNil predicate on the "auth_time" field.
+func AuthTimeIsNil() predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldIsNull(FieldAuthTime))
+}
+
+// AuthTimeNotNil applies the NotNil predicate on the "auth_time" field.
+func AuthTimeNotNil() predicate.Oauth2RefreshToken {
+	return predicate.Oauth2RefreshToken(sql.FieldNotNull(FieldAuthTime))
+}
+
 // ExpireAtEQ applies the EQ predicate on the "expire_at" field.
 func ExpireAtEQ(v time.Time) predicate.Oauth2RefreshToken {
 	return predicate.Oauth2RefreshToken(sql.FieldEQ(FieldExpireAt, v))
@@ -72,6 +72,20 @@ func (_c *Oauth2RefreshTokenCreate) SetScope(v string) *Oauth2RefreshTokenCreate
 	return _c
 }
 
+// SetAuthTime sets the "auth_time" field.
+func (_c *Oauth2RefreshTokenCreate) SetAuthTime(v time.Time) *Oauth2RefreshTokenCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *Oauth2RefreshTokenCreate) SetNillableAuthTime(v *time.Time) *Oauth2RefreshTokenCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
 // SetExpireAt sets the "expire_at" field.
 func (_c *Oauth2RefreshTokenCreate) SetExpireAt(v time.Time) *Oauth2RefreshTokenCreate {
 	_c.mutation.SetExpireAt(v)
@@ -223,6 +237,10 @@ func (_c *Oauth2RefreshTokenCreate) createSpec() (*Oauth2RefreshToken, *sqlgraph
 		_spec.SetField(oauth2refreshtoken.FieldScope, field.TypeString, value)
 		_node.Scope = value
 	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(oauth2refreshtoken.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
 	if value, ok := _c.mutation.ExpireAt(); ok {
 		_spec.SetField(oauth2refreshtoken.FieldExpireAt, field.TypeTime, value)
 		_node.ExpireAt = value
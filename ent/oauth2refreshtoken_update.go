@@ -97,6 +97,26 @@ func (_u *Oauth2RefreshTokenUpdate) SetNillableScope(v *string) *Oauth2RefreshTo
 	return _u
 }
 
+// SetAuthTime sets the "auth_time" field.
+func (_u *Oauth2RefreshTokenUpdate) SetAuthTime(v time.Time) *Oauth2RefreshTokenUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *Oauth2RefreshTokenUpdate) SetNillableAuthTime(v *time.Time) *Oauth2RefreshTokenUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *Oauth2RefreshTokenUpdate) ClearAuthTime() *Oauth2RefreshTokenUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
 // SetExpireAt sets the "expire_at" field.
 func (_u *Oauth2RefreshTokenUpdate) SetExpireAt(v time.Time) *Oauth2RefreshTokenUpdate {
 	_u.mutation.SetExpireAt(v)
@@ -202,6 +222,12 @@ func (_u *Oauth2RefreshTokenUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Scope(); ok {
 		_spec.SetField(oauth2refreshtoken.FieldScope, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(oauth2refreshtoken.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(oauth2refreshtoken.FieldAuthTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ExpireAt(); ok {
 		_spec.SetField(oauth2refreshtoken.FieldExpireAt, field.TypeTime, value)
 	}
@@ -294,6 +320,26 @@ func (_u *Oauth2RefreshTokenUpdateOne) SetNillableScope(v *string) *Oauth2Refres
 	return _u
 }
 
+// SetAuthTime sets the "auth_time" field.
+func (_u *Oauth2RefreshTokenUpdateOne) SetAuthTime(v time.Time) *Oauth2RefreshTokenUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *Oauth2RefreshTokenUpdateOne) SetNillableAuthTime(v *time.Time) *Oauth2RefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *Oauth2RefreshTokenUpdateOne) ClearAuthTime() *Oauth2RefreshTokenUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
 // SetExpireAt sets the "expire_at" field.
 func (_u *Oauth2RefreshTokenUpdateOne) SetExpireAt(v time.Time) *Oauth2RefreshTokenUpdateOne {
 	_u.mutation.SetExpireAt(v)
@@ -429,6 +475,12 @@ func (_u *Oauth2RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *Oaut
 	if value, ok := _u.mutation.Scope(); ok {
 		_spec.SetField(oauth2refreshtoken.FieldScope, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(oauth2refreshtoken.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(oauth2refreshtoken.FieldAuthTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ExpireAt(); ok {
 		_spec.SetField(oauth2refreshtoken.FieldExpireAt, field.TypeTime, value)
 	}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shuTwT/hoshikuzu/ent/orderdelivery"
+	"github.com/shuTwT/hoshikuzu/ent/payorder"
+)
+
+// OrderDelivery is the model entity for the OrderDelivery schema.
+type OrderDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 支付订单ID
+	OrderID int `json:"order_id,omitempty"`
+	// 购买用户
+	UserID int `json:"user_id,omitempty"`
+	// 商品ID
+	ProductID int `json:"product_id,omitempty"`
+	// 交付内容ID
+	DeliverableID int `json:"deliverable_id,omitempty"`
+	// 交付类型: file-文件 key-卡密 text-文本
+	Type orderdelivery.Type `json:"type,omitempty"`
+	// 交付名称
+	Name string `json:"name,omitempty"`
+	// 文件ID
+	FileID int `json:"file_id,omitempty"`
+	// 分配的卡密ID
+	KeyID int `json:"key_id,omitempty"`
+	// 交付时的卡密或文本内容
+	Content string `json:"content,omitempty"`
+	// 状态: 1-已交付 2-待补货 3-已撤销
+	Status int `json:"status,omitempty"`
+	// 下载次数
+	DownloadCount int `json:"download_count,omitempty"`
+	// 撤销时间
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderDeliveryQuery when eager-loading is set.
+	Edges        OrderDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderDeliveryEdges holds the relations/edges for other nodes in the graph.
+type OrderDeliveryEdges struct {
+	// 支付订单
+	Order *PayOrder `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderDeliveryEdges) OrderOrErr() (*PayOrder, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: payorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderdelivery.FieldID, orderdelivery.FieldOrderID, orderdelivery.FieldUserID, orderdelivery.FieldProductID, orderdelivery.FieldDeliverableID, orderdelivery.FieldFileID, orderdelivery.FieldKeyID, orderdelivery.FieldStatus, orderdelivery.FieldDownloadCount:
+			values[i] = new(sql.NullInt64)
+		case orderdelivery.FieldType, orderdelivery.FieldName, orderdelivery.FieldContent:
+			values[i] = new(sql.NullString)
+		case orderdelivery.FieldCreatedAt, orderdelivery.FieldUpdatedAt, orderdelivery.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderDelivery fields.
+func (_m *OrderDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderdelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case orderdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case orderdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case orderdelivery.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = int(value.Int64)
+			}
+		case orderdelivery.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case orderdelivery.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				_m.ProductID = int(value.Int64)
+			}
+		case orderdelivery.FieldDeliverableID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deliverable_id", values[i])
+			} else if value.Valid {
+				_m.DeliverableID = int(value.Int64)
+			}
+		case orderdelivery.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = orderdelivery.Type(value.String)
+			}
+		case orderdelivery.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case orderdelivery.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				_m.FileID = int(value.Int64)
+			}
+		case orderdelivery.FieldKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				_m.KeyID = int(value.Int64)
+			}
+		case orderdelivery.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case orderdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = int(value.Int64)
+			}
+		case orderdelivery.FieldDownloadCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_count", values[i])
+			} else if value.Valid {
+				_m.DownloadCount = int(value.Int64)
+			}
+		case orderdelivery.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *OrderDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the OrderDelivery entity.
+func (_m *OrderDelivery) QueryOrder() *PayOrderQuery {
+	return NewOrderDeliveryClient(_m.config).QueryOrder(_m)
+}
+
+// Update returns a builder for updating this OrderDelivery.
+// Note that you need to call OrderDelivery.Unwrap() before calling this method if this OrderDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OrderDelivery) Update() *OrderDeliveryUpdateOne {
+	return NewOrderDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OrderDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OrderDelivery) Unwrap() *OrderDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OrderDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("deliverable_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliverableID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FileID))
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("download_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadCount))
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderDeliveries is a parsable slice of OrderDelivery.
+type OrderDeliveries []*OrderDelivery
@@ -0,0 +1,779 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderdelivery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldOrderID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldUserID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldProductID, v))
+}
+
+// DeliverableID applies equality check predicate on the "deliverable_id" field. It's identical to DeliverableIDEQ.
+func DeliverableID(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldDeliverableID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldName, v))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldFileID, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldKeyID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldContent, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// DownloadCount applies equality check predicate on the "download_count" field. It's identical to DownloadCountEQ.
+func DownloadCount(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldUserID, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldProductID, v))
+}
+
+// DeliverableIDEQ applies the EQ predicate on the "deliverable_id" field.
+func DeliverableIDEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldDeliverableID, v))
+}
+
+// DeliverableIDNEQ applies the NEQ predicate on the "deliverable_id" field.
+func DeliverableIDNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldDeliverableID, v))
+}
+
+// DeliverableIDIn applies the In predicate on the "deliverable_id" field.
+func DeliverableIDIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldDeliverableID, vs...))
+}
+
+// DeliverableIDNotIn applies the NotIn predicate on the "deliverable_id" field.
+func DeliverableIDNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldDeliverableID, vs...))
+}
+
+// DeliverableIDGT applies the GT predicate on the "deliverable_id" field.
+func DeliverableIDGT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldDeliverableID, v))
+}
+
+// DeliverableIDGTE applies the GTE predicate on the "deliverable_id" field.
+func DeliverableIDGTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldDeliverableID, v))
+}
+
+// DeliverableIDLT applies the LT predicate on the "deliverable_id" field.
+func DeliverableIDLT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldDeliverableID, v))
+}
+
+// DeliverableIDLTE applies the LTE predicate on the "deliverable_id" field.
+func DeliverableIDLTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldDeliverableID, v))
+}
+
+// DeliverableIDIsNil applies the IsNil predicate on the "deliverable_id" field.
+func DeliverableIDIsNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIsNull(FieldDeliverableID))
+}
+
+// DeliverableIDNotNil applies the NotNil predicate on the "deliverable_id" field.
+func DeliverableIDNotNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotNull(FieldDeliverableID))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldType, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldContainsFold(FieldName, v))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// FileIDGT applies the GT predicate on the "file_id" field.
+func FileIDGT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldFileID, v))
+}
+
+// FileIDGTE applies the GTE predicate on the "file_id" field.
+func FileIDGTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldFileID, v))
+}
+
+// FileIDLT applies the LT predicate on the "file_id" field.
+func FileIDLT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldFileID, v))
+}
+
+// FileIDLTE applies the LTE predicate on the "file_id" field.
+func FileIDLTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldFileID, v))
+}
+
+// FileIDIsNil applies the IsNil predicate on the "file_id" field.
+func FileIDIsNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIsNull(FieldFileID))
+}
+
+// FileIDNotNil applies the NotNil predicate on the "file_id" field.
+func FileIDNotNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotNull(FieldFileID))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDIsNil applies the IsNil predicate on the "key_id" field.
+func KeyIDIsNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIsNull(FieldKeyID))
+}
+
+// KeyIDNotNil applies the NotNil predicate on the "key_id" field.
+func KeyIDNotNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotNull(FieldKeyID))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldContainsFold(FieldContent, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldStatus, v))
+}
+
+// DownloadCountEQ applies the EQ predicate on the "download_count" field.
+func DownloadCountEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountNEQ applies the NEQ predicate on the "download_count" field.
+func DownloadCountNEQ(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountIn applies the In predicate on the "download_count" field.
+func DownloadCountIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountNotIn applies the NotIn predicate on the "download_count" field.
+func DownloadCountNotIn(vs ...int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountGT applies the GT predicate on the "download_count" field.
+func DownloadCountGT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldDownloadCount, v))
+}
+
+// DownloadCountGTE applies the GTE predicate on the "download_count" field.
+func DownloadCountGTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldDownloadCount, v))
+}
+
+// DownloadCountLT applies the LT predicate on the "download_count" field.
+func DownloadCountLT(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldDownloadCount, v))
+}
+
+// DownloadCountLTE applies the LTE predicate on the "download_count" field.
+func DownloadCountLTE(v int) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldDownloadCount, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.OrderDelivery {
+	return predicate.OrderDelivery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.PayOrder) predicate.OrderDelivery {
+	return predicate.OrderDelivery(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrderDelivery) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrderDelivery) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrderDelivery) predicate.OrderDelivery {
+	return predicate.OrderDelivery(sql.NotPredicates(p))
+}
@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/orderdelivery"
+	"github.com/shuTwT/hoshikuzu/ent/payorder"
+)
+
+// OrderDeliveryCreate is the builder for creating a OrderDelivery entity.
+type OrderDeliveryCreate struct {
+	config
+	mutation *OrderDeliveryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OrderDeliveryCreate) SetCreatedAt(v time.Time) *OrderDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableCreatedAt(v *time.Time) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OrderDeliveryCreate) SetUpdatedAt(v time.Time) *OrderDeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableUpdatedAt(v *time.Time) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *OrderDeliveryCreate) SetOrderID(v int) *OrderDeliveryCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OrderDeliveryCreate) SetUserID(v int) *OrderDeliveryCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetProductID sets the "product_id" field.
+func (_c *OrderDeliveryCreate) SetProductID(v int) *OrderDeliveryCreate {
+	_c.mutation.SetProductID(v)
+	return _c
+}
+
+// SetDeliverableID sets the "deliverable_id" field.
+func (_c *OrderDeliveryCreate) SetDeliverableID(v int) *OrderDeliveryCreate {
+	_c.mutation.SetDeliverableID(v)
+	return _c
+}
+
+// SetNillableDeliverableID sets the "deliverable_id" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableDeliverableID(v *int) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetDeliverableID(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *OrderDeliveryCreate) SetType(v orderdelivery.Type) *OrderDeliveryCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *OrderDeliveryCreate) SetName(v string) *OrderDeliveryCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetFileID sets the "file_id" field.
+func (_c *OrderDeliveryCreate) SetFileID(v int) *OrderDeliveryCreate {
+	_c.mutation.SetFileID(v)
+	return _c
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableFileID(v *int) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetFileID(*v)
+	}
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *OrderDeliveryCreate) SetKeyID(v int) *OrderDeliveryCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableKeyID(v *int) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetKeyID(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *OrderDeliveryCreate) SetContent(v string) *OrderDeliveryCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableContent(v *string) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OrderDeliveryCreate) SetStatus(v int) *OrderDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableStatus(v *int) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_c *OrderDeliveryCreate) SetDownloadCount(v int) *OrderDeliveryCreate {
+	_c.mutation.SetDownloadCount(v)
+	return _c
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableDownloadCount(v *int) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetDownloadCount(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *OrderDeliveryCreate) SetRevokedAt(v time.Time) *OrderDeliveryCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *OrderDeliveryCreate) SetNillableRevokedAt(v *time.Time) *OrderDeliveryCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrderDeliveryCreate) SetID(v int) *OrderDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetOrder sets the "order" edge to the PayOrder entity.
+func (_c *OrderDeliveryCreate) SetOrder(v *PayOrder) *OrderDeliveryCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// Mutation returns the OrderDeliveryMutation object of the builder.
+func (_c *OrderDeliveryCreate) Mutation() *OrderDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the OrderDelivery in the database.
+func (_c *OrderDeliveryCreate) Save(ctx context.Context) (*OrderDelivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrderDeliveryCreate) SaveX(ctx context.Context) *OrderDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrderDeliveryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := orderdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := orderdelivery.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := orderdelivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		v := orderdelivery.DefaultDownloadCount
+		_c.mutation.SetDownloadCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrderDeliveryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrderDelivery.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OrderDelivery.updated_at"`)}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "OrderDelivery.order_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OrderDelivery.user_id"`)}
+	}
+	if _, ok := _c.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "OrderDelivery.product_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "OrderDelivery.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := orderdelivery.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OrderDelivery.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OrderDelivery.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := orderdelivery.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OrderDelivery.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OrderDelivery.status"`)}
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "OrderDelivery.download_count"`)}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "OrderDelivery.order"`)}
+	}
+	return nil
+}
+
+func (_c *OrderDeliveryCreate) sqlSave(ctx context.Context) (*OrderDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrderDeliveryCreate) createSpec() (*OrderDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(orderdelivery.Table, sqlgraph.NewFieldSpec(orderdelivery.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(orderdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(orderdelivery.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ProductID(); ok {
+		_spec.SetField(orderdelivery.FieldProductID, field.TypeInt, value)
+		_node.ProductID = value
+	}
+	if value, ok := _c.mutation.DeliverableID(); ok {
+		_spec.SetField(orderdelivery.FieldDeliverableID, field.TypeInt, value)
+		_node.DeliverableID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(orderdelivery.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(orderdelivery.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.FileID(); ok {
+		_spec.SetField(orderdelivery.FieldFileID, field.TypeInt, value)
+		_node.FileID = value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(orderdelivery.FieldKeyID, field.TypeInt, value)
+		_node.KeyID = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(orderdelivery.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(orderdelivery.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DownloadCount(); ok {
+		_spec.SetField(orderdelivery.FieldDownloadCount, field.TypeInt, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(orderdelivery.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orderdelivery.OrderTable,
+			Columns: []string{orderdelivery.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderDeliveryCreateBulk is the builder for creating many OrderDelivery entities in bulk.
+type OrderDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*OrderDeliveryCreate
+}
+
+// Save creates the OrderDelivery entities in the database.
+func (_c *OrderDeliveryCreateBulk) Save(ctx context.Context) ([]*OrderDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrderDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrderDeliveryCreateBulk) SaveX(ctx context.Context) []*OrderDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,967 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/orderdelivery"
+	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+)
+
+// OrderDeliveryUpdate is the builder for updating OrderDelivery entities.
+type OrderDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderDeliveryMutation
+}
+
+// Where appends a list predicates to the OrderDeliveryUpdate builder.
+func (_u *OrderDeliveryUpdate) Where(ps ...predicate.OrderDelivery) *OrderDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrderDeliveryUpdate) SetUpdatedAt(v time.Time) *OrderDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *OrderDeliveryUpdate) SetOrderID(v int) *OrderDeliveryUpdate {
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableOrderID(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OrderDeliveryUpdate) SetUserID(v int) *OrderDeliveryUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableUserID(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *OrderDeliveryUpdate) AddUserID(v int) *OrderDeliveryUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *OrderDeliveryUpdate) SetProductID(v int) *OrderDeliveryUpdate {
+	_u.mutation.ResetProductID()
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableProductID(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// AddProductID adds value to the "product_id" field.
+func (_u *OrderDeliveryUpdate) AddProductID(v int) *OrderDeliveryUpdate {
+	_u.mutation.AddProductID(v)
+	return _u
+}
+
+// SetDeliverableID sets the "deliverable_id" field.
+func (_u *OrderDeliveryUpdate) SetDeliverableID(v int) *OrderDeliveryUpdate {
+	_u.mutation.ResetDeliverableID()
+	_u.mutation.SetDeliverableID(v)
+	return _u
+}
+
+// SetNillableDeliverableID sets the "deliverable_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableDeliverableID(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetDeliverableID(*v)
+	}
+	return _u
+}
+
+// AddDeliverableID adds value to the "deliverable_id" field.
+func (_u *OrderDeliveryUpdate) AddDeliverableID(v int) *OrderDeliveryUpdate {
+	_u.mutation.AddDeliverableID(v)
+	return _u
+}
+
+// ClearDeliverableID clears the value of the "deliverable_id" field.
+func (_u *OrderDeliveryUpdate) ClearDeliverableID() *OrderDeliveryUpdate {
+	_u.mutation.ClearDeliverableID()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *OrderDeliveryUpdate) SetType(v orderdelivery.Type) *OrderDeliveryUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableType(v *orderdelivery.Type) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OrderDeliveryUpdate) SetName(v string) *OrderDeliveryUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableName(v *string) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *OrderDeliveryUpdate) SetFileID(v int) *OrderDeliveryUpdate {
+	_u.mutation.ResetFileID()
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableFileID(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// AddFileID adds value to the "file_id" field.
+func (_u *OrderDeliveryUpdate) AddFileID(v int) *OrderDeliveryUpdate {
+	_u.mutation.AddFileID(v)
+	return _u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (_u *OrderDeliveryUpdate) ClearFileID() *OrderDeliveryUpdate {
+	_u.mutation.ClearFileID()
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *OrderDeliveryUpdate) SetKeyID(v int) *OrderDeliveryUpdate {
+	_u.mutation.ResetKeyID()
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableKeyID(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// AddKeyID adds value to the "key_id" field.
+func (_u *OrderDeliveryUpdate) AddKeyID(v int) *OrderDeliveryUpdate {
+	_u.mutation.AddKeyID(v)
+	return _u
+}
+
+// ClearKeyID clears the value of the "key_id" field.
+func (_u *OrderDeliveryUpdate) ClearKeyID() *OrderDeliveryUpdate {
+	_u.mutation.ClearKeyID()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *OrderDeliveryUpdate) SetContent(v string) *OrderDeliveryUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableContent(v *string) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *OrderDeliveryUpdate) ClearContent() *OrderDeliveryUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OrderDeliveryUpdate) SetStatus(v int) *OrderDeliveryUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableStatus(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OrderDeliveryUpdate) AddStatus(v int) *OrderDeliveryUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *OrderDeliveryUpdate) SetDownloadCount(v int) *OrderDeliveryUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableDownloadCount(v *int) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *OrderDeliveryUpdate) AddDownloadCount(v int) *OrderDeliveryUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *OrderDeliveryUpdate) SetRevokedAt(v time.Time) *OrderDeliveryUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *OrderDeliveryUpdate) SetNillableRevokedAt(v *time.Time) *OrderDeliveryUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *OrderDeliveryUpdate) ClearRevokedAt() *OrderDeliveryUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetOrder sets the "order" edge to the PayOrder entity.
+func (_u *OrderDeliveryUpdate) SetOrder(v *PayOrder) *OrderDeliveryUpdate {
+	return _u.SetOrderID(v.ID)
+}
+
+// Mutation returns the OrderDeliveryMutation object of the builder.
+func (_u *OrderDeliveryUpdate) Mutation() *OrderDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearOrder clears the "order" edge to the PayOrder entity.
+func (_u *OrderDeliveryUpdate) ClearOrder() *OrderDeliveryUpdate {
+	_u.mutation.ClearOrder()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrderDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrderDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrderDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := orderdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := orderdelivery.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OrderDelivery.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := orderdelivery.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OrderDelivery.name": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderDelivery.order"`)
+	}
+	return nil
+}
+
+func (_u *OrderDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderdelivery.Table, orderdelivery.Columns, sqlgraph.NewFieldSpec(orderdelivery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(orderdelivery.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(orderdelivery.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProductID(); ok {
+		_spec.SetField(orderdelivery.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProductID(); ok {
+		_spec.AddField(orderdelivery.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeliverableID(); ok {
+		_spec.SetField(orderdelivery.FieldDeliverableID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliverableID(); ok {
+		_spec.AddField(orderdelivery.FieldDeliverableID, field.TypeInt, value)
+	}
+	if _u.mutation.DeliverableIDCleared() {
+		_spec.ClearField(orderdelivery.FieldDeliverableID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(orderdelivery.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(orderdelivery.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(orderdelivery.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFileID(); ok {
+		_spec.AddField(orderdelivery.FieldFileID, field.TypeInt, value)
+	}
+	if _u.mutation.FileIDCleared() {
+		_spec.ClearField(orderdelivery.FieldFileID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(orderdelivery.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeyID(); ok {
+		_spec.AddField(orderdelivery.FieldKeyID, field.TypeInt, value)
+	}
+	if _u.mutation.KeyIDCleared() {
+		_spec.ClearField(orderdelivery.FieldKeyID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(orderdelivery.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(orderdelivery.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(orderdelivery.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(orderdelivery.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(orderdelivery.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(orderdelivery.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(orderdelivery.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(orderdelivery.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orderdelivery.OrderTable,
+			Columns: []string{orderdelivery.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orderdelivery.OrderTable,
+			Columns: []string{orderdelivery.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrderDeliveryUpdateOne is the builder for updating a single OrderDelivery entity.
+type OrderDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrderDeliveryUpdateOne) SetUpdatedAt(v time.Time) *OrderDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *OrderDeliveryUpdateOne) SetOrderID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableOrderID(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OrderDeliveryUpdateOne) SetUserID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableUserID(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *OrderDeliveryUpdateOne) AddUserID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *OrderDeliveryUpdateOne) SetProductID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.ResetProductID()
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableProductID(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// AddProductID adds value to the "product_id" field.
+func (_u *OrderDeliveryUpdateOne) AddProductID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.AddProductID(v)
+	return _u
+}
+
+// SetDeliverableID sets the "deliverable_id" field.
+func (_u *OrderDeliveryUpdateOne) SetDeliverableID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.ResetDeliverableID()
+	_u.mutation.SetDeliverableID(v)
+	return _u
+}
+
+// SetNillableDeliverableID sets the "deliverable_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableDeliverableID(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDeliverableID(*v)
+	}
+	return _u
+}
+
+// AddDeliverableID adds value to the "deliverable_id" field.
+func (_u *OrderDeliveryUpdateOne) AddDeliverableID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.AddDeliverableID(v)
+	return _u
+}
+
+// ClearDeliverableID clears the value of the "deliverable_id" field.
+func (_u *OrderDeliveryUpdateOne) ClearDeliverableID() *OrderDeliveryUpdateOne {
+	_u.mutation.ClearDeliverableID()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *OrderDeliveryUpdateOne) SetType(v orderdelivery.Type) *OrderDeliveryUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableType(v *orderdelivery.Type) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OrderDeliveryUpdateOne) SetName(v string) *OrderDeliveryUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableName(v *string) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *OrderDeliveryUpdateOne) SetFileID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.ResetFileID()
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableFileID(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// AddFileID adds value to the "file_id" field.
+func (_u *OrderDeliveryUpdateOne) AddFileID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.AddFileID(v)
+	return _u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (_u *OrderDeliveryUpdateOne) ClearFileID() *OrderDeliveryUpdateOne {
+	_u.mutation.ClearFileID()
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *OrderDeliveryUpdateOne) SetKeyID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.ResetKeyID()
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableKeyID(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// AddKeyID adds value to the "key_id" field.
+func (_u *OrderDeliveryUpdateOne) AddKeyID(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.AddKeyID(v)
+	return _u
+}
+
+// ClearKeyID clears the value of the "key_id" field.
+func (_u *OrderDeliveryUpdateOne) ClearKeyID() *OrderDeliveryUpdateOne {
+	_u.mutation.ClearKeyID()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *OrderDeliveryUpdateOne) SetContent(v string) *OrderDeliveryUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableContent(v *string) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *OrderDeliveryUpdateOne) ClearContent() *OrderDeliveryUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OrderDeliveryUpdateOne) SetStatus(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableStatus(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OrderDeliveryUpdateOne) AddStatus(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *OrderDeliveryUpdateOne) SetDownloadCount(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableDownloadCount(v *int) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *OrderDeliveryUpdateOne) AddDownloadCount(v int) *OrderDeliveryUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *OrderDeliveryUpdateOne) SetRevokedAt(v time.Time) *OrderDeliveryUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *OrderDeliveryUpdateOne) SetNillableRevokedAt(v *time.Time) *OrderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *OrderDeliveryUpdateOne) ClearRevokedAt() *OrderDeliveryUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetOrder sets the "order" edge to the PayOrder entity.
+func (_u *OrderDeliveryUpdateOne) SetOrder(v *PayOrder) *OrderDeliveryUpdateOne {
+	return _u.SetOrderID(v.ID)
+}
+
+// Mutation returns the OrderDeliveryMutation object of the builder.
+func (_u *OrderDeliveryUpdateOne) Mutation() *OrderDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearOrder clears the "order" edge to the PayOrder entity.
+func (_u *OrderDeliveryUpdateOne) ClearOrder() *OrderDeliveryUpdateOne {
+	_u.mutation.ClearOrder()
+	return _u
+}
+
+// Where appends a list predicates to the OrderDeliveryUpdate builder.
+func (_u *OrderDeliveryUpdateOne) Where(ps ...predicate.OrderDelivery) *OrderDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrderDeliveryUpdateOne) Select(field string, fields ...string) *OrderDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrderDelivery entity.
+func (_u *OrderDeliveryUpdateOne) Save(ctx context.Context) (*OrderDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderDeliveryUpdateOne) SaveX(ctx context.Context) *OrderDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrderDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrderDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := orderdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := orderdelivery.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OrderDelivery.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := orderdelivery.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OrderDelivery.name": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderDelivery.order"`)
+	}
+	return nil
+}
+
+func (_u *OrderDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *OrderDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderdelivery.Table, orderdelivery.Columns, sqlgraph.NewFieldSpec(orderdelivery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderdelivery.FieldID)
+		for _, f := range fields {
+			if !orderdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orderdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(orderdelivery.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(orderdelivery.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProductID(); ok {
+		_spec.SetField(orderdelivery.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProductID(); ok {
+		_spec.AddField(orderdelivery.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeliverableID(); ok {
+		_spec.SetField(orderdelivery.FieldDeliverableID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliverableID(); ok {
+		_spec.AddField(orderdelivery.FieldDeliverableID, field.TypeInt, value)
+	}
+	if _u.mutation.DeliverableIDCleared() {
+		_spec.ClearField(orderdelivery.FieldDeliverableID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(orderdelivery.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(orderdelivery.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(orderdelivery.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFileID(); ok {
+		_spec.AddField(orderdelivery.FieldFileID, field.TypeInt, value)
+	}
+	if _u.mutation.FileIDCleared() {
+		_spec.ClearField(orderdelivery.FieldFileID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(orderdelivery.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeyID(); ok {
+		_spec.AddField(orderdelivery.FieldKeyID, field.TypeInt, value)
+	}
+	if _u.mutation.KeyIDCleared() {
+		_spec.ClearField(orderdelivery.FieldKeyID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(orderdelivery.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(orderdelivery.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(orderdelivery.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(orderdelivery.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(orderdelivery.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(orderdelivery.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(orderdelivery.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(orderdelivery.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orderdelivery.OrderTable,
+			Columns: []string{orderdelivery.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orderdelivery.OrderTable,
+			Columns: []string{orderdelivery.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrderDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/payorder"
 	"github.com/shuTwT/hoshikuzu/ent/post"
 	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 	"github.com/shuTwT/hoshikuzu/ent/user"
 )
 
@@ -72,6 +74,8 @@ type PayOrder struct {
 	RefundAt *time.Time `json:"refund_at,omitempty"`
 	// 充值发放的积分数量
 	PointsGranted int `json:"points_granted,omitempty"`
+	// 金额明细
+	PriceDetail *schema.PayOrderPriceDetail `json:"price_detail,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PayOrderQuery when eager-loading is set.
 	Edges        PayOrderEdges `json:"edges"`
@@ -129,6 +133,8 @@ func (*PayOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case payorder.FieldPriceDetail:
+			values[i] = new([]byte)
 		case payorder.FieldID, payorder.FieldUserID, payorder.FieldPostID, payorder.FieldProductID, payorder.FieldOrderPrice, payorder.FieldPrice, payorder.FieldChannelFeePrice, payorder.FieldRefundAmount, payorder.FieldPointsGranted:
 			values[i] = new(sql.NullInt64)
 		case payorder.FieldOrderType, payorder.FieldChannelType, payorder.FieldOrderID, payorder.FieldMerchantOrderID, payorder.FieldOutTradeNo, payorder.FieldSubject, payorder.FieldBody, payorder.FieldNotifyURL, payorder.FieldReturnURL, payorder.FieldExtra, payorder.FieldPayURL, payorder.FieldState, payorder.FieldErrorMsg, payorder.FieldRaw, payorder.FieldRefundNo:
@@ -321,6 +327,14 @@ func (_m *PayOrder) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PointsGranted = int(value.Int64)
 			}
+		case payorder.FieldPriceDetail:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field price_detail", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PriceDetail); err != nil {
+					return fmt.Errorf("unmarshal field price_detail: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -467,6 +481,9 @@ func (_m *PayOrder) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("points_granted=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PointsGranted))
+	builder.WriteString(", ")
+	builder.WriteString("price_detail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceDetail))
 	builder.WriteByte(')')
 	return builder.String()
 }
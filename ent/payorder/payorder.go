@@ -66,6 +66,8 @@ const (
 	FieldRefundAt = "refund_at"
 	// FieldPointsGranted holds the string denoting the points_granted field in the database.
 	FieldPointsGranted = "points_granted"
+	// FieldPriceDetail holds the string denoting the price_detail field in the database.
+	FieldPriceDetail = "price_detail"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgePost holds the string denoting the post edge name in mutations.
@@ -126,6 +128,7 @@ var Columns = []string{
 	FieldRefundAmount,
 	FieldRefundAt,
 	FieldPointsGranted,
+	FieldPriceDetail,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
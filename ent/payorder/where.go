@@ -1770,6 +1770,16 @@ func PointsGrantedNotNil() predicate.PayOrder {
 	return predicate.PayOrder(sql.FieldNotNull(FieldPointsGranted))
 }
 
+// PriceDetailIsNil applies the IsNil predicate on the "price_detail" field.
+func PriceDetailIsNil() predicate.PayOrder {
+	return predicate.PayOrder(sql.FieldIsNull(FieldPriceDetail))
+}
+
+// PriceDetailNotNil applies the NotNil predicate on the "price_detail" field.
+func PriceDetailNotNil() predicate.PayOrder {
+	return predicate.PayOrder(sql.FieldNotNull(FieldPriceDetail))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.PayOrder {
 	return predicate.PayOrder(func(s *sql.Selector) {
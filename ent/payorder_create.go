@@ -13,6 +13,7 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/payorder"
 	"github.com/shuTwT/hoshikuzu/ent/post"
 	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 	"github.com/shuTwT/hoshikuzu/ent/user"
 )
 
@@ -379,6 +380,12 @@ func (_c *PayOrderCreate) SetNillablePointsGranted(v *int) *PayOrderCreate {
 	return _c
 }
 
+// SetPriceDetail sets the "price_detail" field.
+func (_c *PayOrderCreate) SetPriceDetail(v *schema.PayOrderPriceDetail) *PayOrderCreate {
+	_c.mutation.SetPriceDetail(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PayOrderCreate) SetID(v int) *PayOrderCreate {
 	_c.mutation.SetID(v)
@@ -591,6 +598,10 @@ func (_c *PayOrderCreate) createSpec() (*PayOrder, *sqlgraph.CreateSpec) {
 		_spec.SetField(payorder.FieldPointsGranted, field.TypeInt, value)
 		_node.PointsGranted = value
 	}
+	if value, ok := _c.mutation.PriceDetail(); ok {
+		_spec.SetField(payorder.FieldPriceDetail, field.TypeJSON, value)
+		_node.PriceDetail = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
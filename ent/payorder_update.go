@@ -15,6 +15,7 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/post"
 	"github.com/shuTwT/hoshikuzu/ent/predicate"
 	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 	"github.com/shuTwT/hoshikuzu/ent/user"
 )
 
@@ -546,6 +547,18 @@ func (_u *PayOrderUpdate) ClearPointsGranted() *PayOrderUpdate {
 	return _u
 }
 
+// SetPriceDetail sets the "price_detail" field.
+func (_u *PayOrderUpdate) SetPriceDetail(v *schema.PayOrderPriceDetail) *PayOrderUpdate {
+	_u.mutation.SetPriceDetail(v)
+	return _u
+}
+
+// ClearPriceDetail clears the value of the "price_detail" field.
+func (_u *PayOrderUpdate) ClearPriceDetail() *PayOrderUpdate {
+	_u.mutation.ClearPriceDetail()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *PayOrderUpdate) SetUser(v *User) *PayOrderUpdate {
 	return _u.SetUserID(v.ID)
@@ -784,6 +797,12 @@ func (_u *PayOrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PointsGrantedCleared() {
 		_spec.ClearField(payorder.FieldPointsGranted, field.TypeInt)
 	}
+	if value, ok := _u.mutation.PriceDetail(); ok {
+		_spec.SetField(payorder.FieldPriceDetail, field.TypeJSON, value)
+	}
+	if _u.mutation.PriceDetailCleared() {
+		_spec.ClearField(payorder.FieldPriceDetail, field.TypeJSON)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1406,6 +1425,18 @@ func (_u *PayOrderUpdateOne) ClearPointsGranted() *PayOrderUpdateOne {
 	return _u
 }
 
+// SetPriceDetail sets the "price_detail" field.
+func (_u *PayOrderUpdateOne) SetPriceDetail(v *schema.PayOrderPriceDetail) *PayOrderUpdateOne {
+	_u.mutation.SetPriceDetail(v)
+	return _u
+}
+
+// ClearPriceDetail clears the value of the "price_detail" field.
+func (_u *PayOrderUpdateOne) ClearPriceDetail() *PayOrderUpdateOne {
+	_u.mutation.ClearPriceDetail()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *PayOrderUpdateOne) SetUser(v *User) *PayOrderUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -1674,6 +1705,12 @@ func (_u *PayOrderUpdateOne) sqlSave(ctx context.Context) (_node *PayOrder, err
 	if _u.mutation.PointsGrantedCleared() {
 		_spec.ClearField(payorder.FieldPointsGranted, field.TypeInt)
 	}
+	if value, ok := _u.mutation.PriceDetail(); ok {
+		_spec.SetField(payorder.FieldPriceDetail, field.TypeJSON, value)
+	}
+	if _u.mutation.PriceDetailCleared() {
+		_spec.ClearField(payorder.FieldPriceDetail, field.TypeJSON)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
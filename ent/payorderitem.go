@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+)
+
+// PayOrderItem is the model entity for the PayOrderItem schema.
+type PayOrderItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 支付订单ID
+	OrderID int `json:"order_id,omitempty"`
+	// 商品ID
+	ProductID int `json:"product_id,omitempty"`
+	// 下单时的商品名称
+	ProductName string `json:"product_name,omitempty"`
+	// 下单时的商品SKU
+	Sku string `json:"sku,omitempty"`
+	// 数量
+	Quantity int `json:"quantity,omitempty"`
+	// 下单时的单价(分)
+	UnitPrice int `json:"unit_price,omitempty"`
+	// 小计(分)
+	TotalPrice int `json:"total_price,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PayOrderItemQuery when eager-loading is set.
+	Edges        PayOrderItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PayOrderItemEdges holds the relations/edges for other nodes in the graph.
+type PayOrderItemEdges struct {
+	// 支付订单
+	Order *PayOrder `json:"order,omitempty"`
+	// 商品
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PayOrderItemEdges) OrderOrErr() (*PayOrder, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: payorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PayOrderItemEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayOrderItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payorderitem.FieldID, payorderitem.FieldOrderID, payorderitem.FieldProductID, payorderitem.FieldQuantity, payorderitem.FieldUnitPrice, payorderitem.FieldTotalPrice:
+			values[i] = new(sql.NullInt64)
+		case payorderitem.FieldProductName, payorderitem.FieldSku:
+			values[i] = new(sql.NullString)
+		case payorderitem.FieldCreatedAt, payorderitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayOrderItem fields.
+func (_m *PayOrderItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payorderitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case payorderitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case payorderitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case payorderitem.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = int(value.Int64)
+			}
+		case payorderitem.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				_m.ProductID = int(value.Int64)
+			}
+		case payorderitem.FieldProductName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_name", values[i])
+			} else if value.Valid {
+				_m.ProductName = value.String
+			}
+		case payorderitem.FieldSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sku", values[i])
+			} else if value.Valid {
+				_m.Sku = value.String
+			}
+		case payorderitem.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case payorderitem.FieldUnitPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value.Valid {
+				_m.UnitPrice = int(value.Int64)
+			}
+		case payorderitem.FieldTotalPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_price", values[i])
+			} else if value.Valid {
+				_m.TotalPrice = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayOrderItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *PayOrderItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the PayOrderItem entity.
+func (_m *PayOrderItem) QueryOrder() *PayOrderQuery {
+	return NewPayOrderItemClient(_m.config).QueryOrder(_m)
+}
+
+// QueryProduct queries the "product" edge of the PayOrderItem entity.
+func (_m *PayOrderItem) QueryProduct() *ProductQuery {
+	return NewPayOrderItemClient(_m.config).QueryProduct(_m)
+}
+
+// Update returns a builder for updating this PayOrderItem.
+// Note that you need to call PayOrderItem.Unwrap() before calling this method if this PayOrderItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PayOrderItem) Update() *PayOrderItemUpdateOne {
+	return NewPayOrderItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PayOrderItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PayOrderItem) Unwrap() *PayOrderItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayOrderItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PayOrderItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayOrderItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("product_name=")
+	builder.WriteString(_m.ProductName)
+	builder.WriteString(", ")
+	builder.WriteString("sku=")
+	builder.WriteString(_m.Sku)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("total_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalPrice))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayOrderItems is a parsable slice of PayOrderItem.
+type PayOrderItems []*PayOrderItem
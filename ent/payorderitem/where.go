@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payorderitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldOrderID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductName applies equality check predicate on the "product_name" field. It's identical to ProductNameEQ.
+func ProductName(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldProductName, v))
+}
+
+// Sku applies equality check predicate on the "sku" field. It's identical to SkuEQ.
+func Sku(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldSku, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// TotalPrice applies equality check predicate on the "total_price" field. It's identical to TotalPriceEQ.
+func TotalPrice(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldTotalPrice, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductNameEQ applies the EQ predicate on the "product_name" field.
+func ProductNameEQ(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldProductName, v))
+}
+
+// ProductNameNEQ applies the NEQ predicate on the "product_name" field.
+func ProductNameNEQ(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldProductName, v))
+}
+
+// ProductNameIn applies the In predicate on the "product_name" field.
+func ProductNameIn(vs ...string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldProductName, vs...))
+}
+
+// ProductNameNotIn applies the NotIn predicate on the "product_name" field.
+func ProductNameNotIn(vs ...string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldProductName, vs...))
+}
+
+// ProductNameGT applies the GT predicate on the "product_name" field.
+func ProductNameGT(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldProductName, v))
+}
+
+// ProductNameGTE applies the GTE predicate on the "product_name" field.
+func ProductNameGTE(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldProductName, v))
+}
+
+// ProductNameLT applies the LT predicate on the "product_name" field.
+func ProductNameLT(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldProductName, v))
+}
+
+// ProductNameLTE applies the LTE predicate on the "product_name" field.
+func ProductNameLTE(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldProductName, v))
+}
+
+// ProductNameContains applies the Contains predicate on the "product_name" field.
+func ProductNameContains(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldContains(FieldProductName, v))
+}
+
+// ProductNameHasPrefix applies the HasPrefix predicate on the "product_name" field.
+func ProductNameHasPrefix(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldHasPrefix(FieldProductName, v))
+}
+
+// ProductNameHasSuffix applies the HasSuffix predicate on the "product_name" field.
+func ProductNameHasSuffix(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldHasSuffix(FieldProductName, v))
+}
+
+// ProductNameEqualFold applies the EqualFold predicate on the "product_name" field.
+func ProductNameEqualFold(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEqualFold(FieldProductName, v))
+}
+
+// ProductNameContainsFold applies the ContainsFold predicate on the "product_name" field.
+func ProductNameContainsFold(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldContainsFold(FieldProductName, v))
+}
+
+// SkuEQ applies the EQ predicate on the "sku" field.
+func SkuEQ(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldSku, v))
+}
+
+// SkuNEQ applies the NEQ predicate on the "sku" field.
+func SkuNEQ(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldSku, v))
+}
+
+// SkuIn applies the In predicate on the "sku" field.
+func SkuIn(vs ...string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldSku, vs...))
+}
+
+// SkuNotIn applies the NotIn predicate on the "sku" field.
+func SkuNotIn(vs ...string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldSku, vs...))
+}
+
+// SkuGT applies the GT predicate on the "sku" field.
+func SkuGT(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldSku, v))
+}
+
+// SkuGTE applies the GTE predicate on the "sku" field.
+func SkuGTE(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldSku, v))
+}
+
+// SkuLT applies the LT predicate on the "sku" field.
+func SkuLT(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldSku, v))
+}
+
+// SkuLTE applies the LTE predicate on the "sku" field.
+func SkuLTE(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldSku, v))
+}
+
+// SkuContains applies the Contains predicate on the "sku" field.
+func SkuContains(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldContains(FieldSku, v))
+}
+
+// SkuHasPrefix applies the HasPrefix predicate on the "sku" field.
+func SkuHasPrefix(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldHasPrefix(FieldSku, v))
+}
+
+// SkuHasSuffix applies the HasSuffix predicate on the "sku" field.
+func SkuHasSuffix(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldHasSuffix(FieldSku, v))
+}
+
+// SkuIsNil applies the IsNil predicate on the "sku" field.
+func SkuIsNil() predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIsNull(FieldSku))
+}
+
+// SkuNotNil applies the NotNil predicate on the "sku" field.
+func SkuNotNil() predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotNull(FieldSku))
+}
+
+// SkuEqualFold applies the EqualFold predicate on the "sku" field.
+func SkuEqualFold(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEqualFold(FieldSku, v))
+}
+
+// SkuContainsFold applies the ContainsFold predicate on the "sku" field.
+func SkuContainsFold(v string) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldContainsFold(FieldSku, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// TotalPriceEQ applies the EQ predicate on the "total_price" field.
+func TotalPriceEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldEQ(FieldTotalPrice, v))
+}
+
+// TotalPriceNEQ applies the NEQ predicate on the "total_price" field.
+func TotalPriceNEQ(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNEQ(FieldTotalPrice, v))
+}
+
+// TotalPriceIn applies the In predicate on the "total_price" field.
+func TotalPriceIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldIn(FieldTotalPrice, vs...))
+}
+
+// TotalPriceNotIn applies the NotIn predicate on the "total_price" field.
+func TotalPriceNotIn(vs ...int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldNotIn(FieldTotalPrice, vs...))
+}
+
+// TotalPriceGT applies the GT predicate on the "total_price" field.
+func TotalPriceGT(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGT(FieldTotalPrice, v))
+}
+
+// TotalPriceGTE applies the GTE predicate on the "total_price" field.
+func TotalPriceGTE(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldGTE(FieldTotalPrice, v))
+}
+
+// TotalPriceLT applies the LT predicate on the "total_price" field.
+func TotalPriceLT(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLT(FieldTotalPrice, v))
+}
+
+// TotalPriceLTE applies the LTE predicate on the "total_price" field.
+func TotalPriceLTE(v int) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.FieldLTE(FieldTotalPrice, v))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.PayOrderItem {
+	return predicate.PayOrderItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.PayOrder) predicate.PayOrderItem {
+	return predicate.PayOrderItem(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.PayOrderItem {
+	return predicate.PayOrderItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.PayOrderItem {
+	return predicate.PayOrderItem(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayOrderItem) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayOrderItem) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayOrderItem) predicate.PayOrderItem {
+	return predicate.PayOrderItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+)
+
+// PayOrderItemCreate is the builder for creating a PayOrderItem entity.
+type PayOrderItemCreate struct {
+	config
+	mutation *PayOrderItemMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayOrderItemCreate) SetCreatedAt(v time.Time) *PayOrderItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayOrderItemCreate) SetNillableCreatedAt(v *time.Time) *PayOrderItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PayOrderItemCreate) SetUpdatedAt(v time.Time) *PayOrderItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PayOrderItemCreate) SetNillableUpdatedAt(v *time.Time) *PayOrderItemCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *PayOrderItemCreate) SetOrderID(v int) *PayOrderItemCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetProductID sets the "product_id" field.
+func (_c *PayOrderItemCreate) SetProductID(v int) *PayOrderItemCreate {
+	_c.mutation.SetProductID(v)
+	return _c
+}
+
+// SetProductName sets the "product_name" field.
+func (_c *PayOrderItemCreate) SetProductName(v string) *PayOrderItemCreate {
+	_c.mutation.SetProductName(v)
+	return _c
+}
+
+// SetSku sets the "sku" field.
+func (_c *PayOrderItemCreate) SetSku(v string) *PayOrderItemCreate {
+	_c.mutation.SetSku(v)
+	return _c
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (_c *PayOrderItemCreate) SetNillableSku(v *string) *PayOrderItemCreate {
+	if v != nil {
+		_c.SetSku(*v)
+	}
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *PayOrderItemCreate) SetQuantity(v int) *PayOrderItemCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *PayOrderItemCreate) SetUnitPrice(v int) *PayOrderItemCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetTotalPrice sets the "total_price" field.
+func (_c *PayOrderItemCreate) SetTotalPrice(v int) *PayOrderItemCreate {
+	_c.mutation.SetTotalPrice(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PayOrderItemCreate) SetID(v int) *PayOrderItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetOrder sets the "order" edge to the PayOrder entity.
+func (_c *PayOrderItemCreate) SetOrder(v *PayOrder) *PayOrderItemCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_c *PayOrderItemCreate) SetProduct(v *Product) *PayOrderItemCreate {
+	return _c.SetProductID(v.ID)
+}
+
+// Mutation returns the PayOrderItemMutation object of the builder.
+func (_c *PayOrderItemCreate) Mutation() *PayOrderItemMutation {
+	return _c.mutation
+}
+
+// Save creates the PayOrderItem in the database.
+func (_c *PayOrderItemCreate) Save(ctx context.Context) (*PayOrderItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayOrderItemCreate) SaveX(ctx context.Context) *PayOrderItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayOrderItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayOrderItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayOrderItemCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := payorderitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := payorderitem.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayOrderItemCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayOrderItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PayOrderItem.updated_at"`)}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "PayOrderItem.order_id"`)}
+	}
+	if _, ok := _c.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "PayOrderItem.product_id"`)}
+	}
+	if _, ok := _c.mutation.ProductName(); !ok {
+		return &ValidationError{Name: "product_name", err: errors.New(`ent: missing required field "PayOrderItem.product_name"`)}
+	}
+	if v, ok := _c.mutation.ProductName(); ok {
+		if err := payorderitem.ProductNameValidator(v); err != nil {
+			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.product_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Sku(); ok {
+		if err := payorderitem.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.sku": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "PayOrderItem.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := payorderitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "PayOrderItem.unit_price"`)}
+	}
+	if _, ok := _c.mutation.TotalPrice(); !ok {
+		return &ValidationError{Name: "total_price", err: errors.New(`ent: missing required field "PayOrderItem.total_price"`)}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "PayOrderItem.order"`)}
+	}
+	if len(_c.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "PayOrderItem.product"`)}
+	}
+	return nil
+}
+
+func (_c *PayOrderItemCreate) sqlSave(ctx context.Context) (*PayOrderItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayOrderItemCreate) createSpec() (*PayOrderItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayOrderItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payorderitem.Table, sqlgraph.NewFieldSpec(payorderitem.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payorderitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(payorderitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ProductName(); ok {
+		_spec.SetField(payorderitem.FieldProductName, field.TypeString, value)
+		_node.ProductName = value
+	}
+	if value, ok := _c.mutation.Sku(); ok {
+		_spec.SetField(payorderitem.FieldSku, field.TypeString, value)
+		_node.Sku = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(payorderitem.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(payorderitem.FieldUnitPrice, field.TypeInt, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.TotalPrice(); ok {
+		_spec.SetField(payorderitem.FieldTotalPrice, field.TypeInt, value)
+		_node.TotalPrice = value
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.OrderTable,
+			Columns: []string{payorderitem.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.ProductTable,
+			Columns: []string{payorderitem.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PayOrderItemCreateBulk is the builder for creating many PayOrderItem entities in bulk.
+type PayOrderItemCreateBulk struct {
+	config
+	err      error
+	builders []*PayOrderItemCreate
+}
+
+// Save creates the PayOrderItem entities in the database.
+func (_c *PayOrderItemCreateBulk) Save(ctx context.Context) ([]*PayOrderItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PayOrderItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayOrderItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayOrderItemCreateBulk) SaveX(ctx context.Context) []*PayOrderItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayOrderItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayOrderItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
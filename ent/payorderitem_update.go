@@ -0,0 +1,736 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+)
+
+// PayOrderItemUpdate is the builder for updating PayOrderItem entities.
+type PayOrderItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayOrderItemMutation
+}
+
+// Where appends a list predicates to the PayOrderItemUpdate builder.
+func (_u *PayOrderItemUpdate) Where(ps ...predicate.PayOrderItem) *PayOrderItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayOrderItemUpdate) SetUpdatedAt(v time.Time) *PayOrderItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *PayOrderItemUpdate) SetOrderID(v int) *PayOrderItemUpdate {
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *PayOrderItemUpdate) SetNillableOrderID(v *int) *PayOrderItemUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *PayOrderItemUpdate) SetProductID(v int) *PayOrderItemUpdate {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *PayOrderItemUpdate) SetNillableProductID(v *int) *PayOrderItemUpdate {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// SetProductName sets the "product_name" field.
+func (_u *PayOrderItemUpdate) SetProductName(v string) *PayOrderItemUpdate {
+	_u.mutation.SetProductName(v)
+	return _u
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (_u *PayOrderItemUpdate) SetNillableProductName(v *string) *PayOrderItemUpdate {
+	if v != nil {
+		_u.SetProductName(*v)
+	}
+	return _u
+}
+
+// SetSku sets the "sku" field.
+func (_u *PayOrderItemUpdate) SetSku(v string) *PayOrderItemUpdate {
+	_u.mutation.SetSku(v)
+	return _u
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (_u *PayOrderItemUpdate) SetNillableSku(v *string) *PayOrderItemUpdate {
+	if v != nil {
+		_u.SetSku(*v)
+	}
+	return _u
+}
+
+// ClearSku clears the value of the "sku" field.
+func (_u *PayOrderItemUpdate) ClearSku() *PayOrderItemUpdate {
+	_u.mutation.ClearSku()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PayOrderItemUpdate) SetQuantity(v int) *PayOrderItemUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PayOrderItemUpdate) SetNillableQuantity(v *int) *PayOrderItemUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *PayOrderItemUpdate) AddQuantity(v int) *PayOrderItemUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *PayOrderItemUpdate) SetUnitPrice(v int) *PayOrderItemUpdate {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *PayOrderItemUpdate) SetNillableUnitPrice(v *int) *PayOrderItemUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *PayOrderItemUpdate) AddUnitPrice(v int) *PayOrderItemUpdate {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetTotalPrice sets the "total_price" field.
+func (_u *PayOrderItemUpdate) SetTotalPrice(v int) *PayOrderItemUpdate {
+	_u.mutation.ResetTotalPrice()
+	_u.mutation.SetTotalPrice(v)
+	return _u
+}
+
+// SetNillableTotalPrice sets the "total_price" field if the given value is not nil.
+func (_u *PayOrderItemUpdate) SetNillableTotalPrice(v *int) *PayOrderItemUpdate {
+	if v != nil {
+		_u.SetTotalPrice(*v)
+	}
+	return _u
+}
+
+// AddTotalPrice adds value to the "total_price" field.
+func (_u *PayOrderItemUpdate) AddTotalPrice(v int) *PayOrderItemUpdate {
+	_u.mutation.AddTotalPrice(v)
+	return _u
+}
+
+// SetOrder sets the "order" edge to the PayOrder entity.
+func (_u *PayOrderItemUpdate) SetOrder(v *PayOrder) *PayOrderItemUpdate {
+	return _u.SetOrderID(v.ID)
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *PayOrderItemUpdate) SetProduct(v *Product) *PayOrderItemUpdate {
+	return _u.SetProductID(v.ID)
+}
+
+// Mutation returns the PayOrderItemMutation object of the builder.
+func (_u *PayOrderItemUpdate) Mutation() *PayOrderItemMutation {
+	return _u.mutation
+}
+
+// ClearOrder clears the "order" edge to the PayOrder entity.
+func (_u *PayOrderItemUpdate) ClearOrder() *PayOrderItemUpdate {
+	_u.mutation.ClearOrder()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *PayOrderItemUpdate) ClearProduct() *PayOrderItemUpdate {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayOrderItemUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayOrderItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayOrderItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayOrderItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayOrderItemUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payorderitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayOrderItemUpdate) check() error {
+	if v, ok := _u.mutation.ProductName(); ok {
+		if err := payorderitem.ProductNameValidator(v); err != nil {
+			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.product_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sku(); ok {
+		if err := payorderitem.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.sku": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := payorderitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PayOrderItem.order"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PayOrderItem.product"`)
+	}
+	return nil
+}
+
+func (_u *PayOrderItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payorderitem.Table, payorderitem.Columns, sqlgraph.NewFieldSpec(payorderitem.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payorderitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProductName(); ok {
+		_spec.SetField(payorderitem.FieldProductName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sku(); ok {
+		_spec.SetField(payorderitem.FieldSku, field.TypeString, value)
+	}
+	if _u.mutation.SkuCleared() {
+		_spec.ClearField(payorderitem.FieldSku, field.TypeString)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(payorderitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(payorderitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(payorderitem.FieldUnitPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(payorderitem.FieldUnitPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalPrice(); ok {
+		_spec.SetField(payorderitem.FieldTotalPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalPrice(); ok {
+		_spec.AddField(payorderitem.FieldTotalPrice, field.TypeInt, value)
+	}
+	if _u.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.OrderTable,
+			Columns: []string{payorderitem.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.OrderTable,
+			Columns: []string{payorderitem.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.ProductTable,
+			Columns: []string{payorderitem.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.ProductTable,
+			Columns: []string{payorderitem.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payorderitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayOrderItemUpdateOne is the builder for updating a single PayOrderItem entity.
+type PayOrderItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayOrderItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayOrderItemUpdateOne) SetUpdatedAt(v time.Time) *PayOrderItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *PayOrderItemUpdateOne) SetOrderID(v int) *PayOrderItemUpdateOne {
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *PayOrderItemUpdateOne) SetNillableOrderID(v *int) *PayOrderItemUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *PayOrderItemUpdateOne) SetProductID(v int) *PayOrderItemUpdateOne {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *PayOrderItemUpdateOne) SetNillableProductID(v *int) *PayOrderItemUpdateOne {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// SetProductName sets the "product_name" field.
+func (_u *PayOrderItemUpdateOne) SetProductName(v string) *PayOrderItemUpdateOne {
+	_u.mutation.SetProductName(v)
+	return _u
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (_u *PayOrderItemUpdateOne) SetNillableProductName(v *string) *PayOrderItemUpdateOne {
+	if v != nil {
+		_u.SetProductName(*v)
+	}
+	return _u
+}
+
+// SetSku sets the "sku" field.
+func (_u *PayOrderItemUpdateOne) SetSku(v string) *PayOrderItemUpdateOne {
+	_u.mutation.SetSku(v)
+	return _u
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (_u *PayOrderItemUpdateOne) SetNillableSku(v *string) *PayOrderItemUpdateOne {
+	if v != nil {
+		_u.SetSku(*v)
+	}
+	return _u
+}
+
+// ClearSku clears the value of the "sku" field.
+func (_u *PayOrderItemUpdateOne) ClearSku() *PayOrderItemUpdateOne {
+	_u.mutation.ClearSku()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PayOrderItemUpdateOne) SetQuantity(v int) *PayOrderItemUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PayOrderItemUpdateOne) SetNillableQuantity(v *int) *PayOrderItemUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *PayOrderItemUpdateOne) AddQuantity(v int) *PayOrderItemUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *PayOrderItemUpdateOne) SetUnitPrice(v int) *PayOrderItemUpdateOne {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *PayOrderItemUpdateOne) SetNillableUnitPrice(v *int) *PayOrderItemUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *PayOrderItemUpdateOne) AddUnitPrice(v int) *PayOrderItemUpdateOne {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetTotalPrice sets the "total_price" field.
+func (_u *PayOrderItemUpdateOne) SetTotalPrice(v int) *PayOrderItemUpdateOne {
+	_u.mutation.ResetTotalPrice()
+	_u.mutation.SetTotalPrice(v)
+	return _u
+}
+
+// SetNillableTotalPrice sets the "total_price" field if the given value is not nil.
+func (_u *PayOrderItemUpdateOne) SetNillableTotalPrice(v *int) *PayOrderItemUpdateOne {
+	if v != nil {
+		_u.SetTotalPrice(*v)
+	}
+	return _u
+}
+
+// AddTotalPrice adds value to the "total_price" field.
+func (_u *PayOrderItemUpdateOne) AddTotalPrice(v int) *PayOrderItemUpdateOne {
+	_u.mutation.AddTotalPrice(v)
+	return _u
+}
+
+// SetOrder sets the "order" edge to the PayOrder entity.
+func (_u *PayOrderItemUpdateOne) SetOrder(v *PayOrder) *PayOrderItemUpdateOne {
+	return _u.SetOrderID(v.ID)
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *PayOrderItemUpdateOne) SetProduct(v *Product) *PayOrderItemUpdateOne {
+	return _u.SetProductID(v.ID)
+}
+
+// Mutation returns the PayOrderItemMutation object of the builder.
+func (_u *PayOrderItemUpdateOne) Mutation() *PayOrderItemMutation {
+	return _u.mutation
+}
+
+// ClearOrder clears the "order" edge to the PayOrder entity.
+func (_u *PayOrderItemUpdateOne) ClearOrder() *PayOrderItemUpdateOne {
+	_u.mutation.ClearOrder()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *PayOrderItemUpdateOne) ClearProduct() *PayOrderItemUpdateOne {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// Where appends a list predicates to the PayOrderItemUpdate builder.
+func (_u *PayOrderItemUpdateOne) Where(ps ...predicate.PayOrderItem) *PayOrderItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayOrderItemUpdateOne) Select(field string, fields ...string) *PayOrderItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PayOrderItem entity.
+func (_u *PayOrderItemUpdateOne) Save(ctx context.Context) (*PayOrderItem, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayOrderItemUpdateOne) SaveX(ctx context.Context) *PayOrderItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayOrderItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayOrderItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayOrderItemUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payorderitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayOrderItemUpdateOne) check() error {
+	if v, ok := _u.mutation.ProductName(); ok {
+		if err := payorderitem.ProductNameValidator(v); err != nil {
+			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.product_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sku(); ok {
+		if err := payorderitem.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.sku": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := payorderitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PayOrderItem.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PayOrderItem.order"`)
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PayOrderItem.product"`)
+	}
+	return nil
+}
+
+func (_u *PayOrderItemUpdateOne) sqlSave(ctx context.Context) (_node *PayOrderItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payorderitem.Table, payorderitem.Columns, sqlgraph.NewFieldSpec(payorderitem.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayOrderItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payorderitem.FieldID)
+		for _, f := range fields {
+			if !payorderitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payorderitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payorderitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProductName(); ok {
+		_spec.SetField(payorderitem.FieldProductName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sku(); ok {
+		_spec.SetField(payorderitem.FieldSku, field.TypeString, value)
+	}
+	if _u.mutation.SkuCleared() {
+		_spec.ClearField(payorderitem.FieldSku, field.TypeString)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(payorderitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(payorderitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(payorderitem.FieldUnitPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(payorderitem.FieldUnitPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalPrice(); ok {
+		_spec.SetField(payorderitem.FieldTotalPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalPrice(); ok {
+		_spec.AddField(payorderitem.FieldTotalPrice, field.TypeInt, value)
+	}
+	if _u.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.OrderTable,
+			Columns: []string{payorderitem.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.OrderTable,
+			Columns: []string{payorderitem.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.ProductTable,
+			Columns: []string{payorderitem.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payorderitem.ProductTable,
+			Columns: []string{payorderitem.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PayOrderItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payorderitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -27,10 +28,20 @@ type PersonalAccessToken struct {
 	Expires time.Time `json:"expires,omitempty"`
 	// 描述
 	Description string `json:"description,omitempty"`
-	// Token holds the value of the "token" field.
-	Token string `json:"token,omitempty"`
+	// 旧版明文令牌,启动时迁移为哈希后清空
+	Token string `json:"-"`
+	// 令牌 SHA-256 哈希
+	TokenHash string `json:"-"`
+	// 令牌前缀,用于辨识令牌
+	TokenPrefix string `json:"token_prefix,omitempty"`
 	// UserID holds the value of the "user_id" field.
-	UserID       int `json:"user_id,omitempty"`
+	UserID int `json:"user_id,omitempty"`
+	// 权限范围,旧版令牌为空时继承用户角色的全部权限
+	Scopes []string `json:"scopes,omitempty"`
+	// 最后使用时间
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// 最后使用 IP
+	LastUsedIP   string `json:"last_used_ip,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -39,11 +50,13 @@ func (*PersonalAccessToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case personalaccesstoken.FieldScopes:
+			values[i] = new([]byte)
 		case personalaccesstoken.FieldID, personalaccesstoken.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case personalaccesstoken.FieldName, personalaccesstoken.FieldDescription, personalaccesstoken.FieldToken:
+		case personalaccesstoken.FieldName, personalaccesstoken.FieldDescription, personalaccesstoken.FieldToken, personalaccesstoken.FieldTokenHash, personalaccesstoken.FieldTokenPrefix, personalaccesstoken.FieldLastUsedIP:
 			values[i] = new(sql.NullString)
-		case personalaccesstoken.FieldCreatedAt, personalaccesstoken.FieldUpdatedAt, personalaccesstoken.FieldExpires:
+		case personalaccesstoken.FieldCreatedAt, personalaccesstoken.FieldUpdatedAt, personalaccesstoken.FieldExpires, personalaccesstoken.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -102,12 +115,45 @@ func (_m *PersonalAccessToken) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				_m.Token = value.String
 			}
+		case personalaccesstoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case personalaccesstoken.FieldTokenPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_prefix", values[i])
+			} else if value.Valid {
+				_m.TokenPrefix = value.String
+			}
 		case personalaccesstoken.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
 			} else if value.Valid {
 				_m.UserID = int(value.Int64)
 			}
+		case personalaccesstoken.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case personalaccesstoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		case personalaccesstoken.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				_m.LastUsedIP = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -159,11 +205,26 @@ func (_m *PersonalAccessToken) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
-	builder.WriteString("token=")
-	builder.WriteString(_m.Token)
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("token_prefix=")
+	builder.WriteString(_m.TokenPrefix)
 	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_ip=")
+	builder.WriteString(_m.LastUsedIP)
 	builder.WriteByte(')')
 	return builder.String()
 }
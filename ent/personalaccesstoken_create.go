@@ -88,12 +88,82 @@ func (_c *PersonalAccessTokenCreate) SetToken(v string) *PersonalAccessTokenCrea
 	return _c
 }
 
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableToken(v *string) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetToken(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *PersonalAccessTokenCreate) SetTokenHash(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableTokenHash(v *string) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetTokenHash(*v)
+	}
+	return _c
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_c *PersonalAccessTokenCreate) SetTokenPrefix(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetTokenPrefix(v)
+	return _c
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableTokenPrefix(v *string) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetTokenPrefix(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user_id" field.
 func (_c *PersonalAccessTokenCreate) SetUserID(v int) *PersonalAccessTokenCreate {
 	_c.mutation.SetUserID(v)
 	return _c
 }
 
+// SetScopes sets the "scopes" field.
+func (_c *PersonalAccessTokenCreate) SetScopes(v []string) *PersonalAccessTokenCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *PersonalAccessTokenCreate) SetLastUsedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableLastUsedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_c *PersonalAccessTokenCreate) SetLastUsedIP(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetLastUsedIP(v)
+	return _c
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableLastUsedIP(v *string) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetLastUsedIP(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PersonalAccessTokenCreate) SetID(v int) *PersonalAccessTokenCreate {
 	_c.mutation.SetID(v)
@@ -161,9 +231,6 @@ func (_c *PersonalAccessTokenCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.Token(); !ok {
-		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "PersonalAccessToken.token"`)}
-	}
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PersonalAccessToken.user_id"`)}
 	}
@@ -223,10 +290,30 @@ func (_c *PersonalAccessTokenCreate) createSpec() (*PersonalAccessToken, *sqlgra
 		_spec.SetField(personalaccesstoken.FieldToken, field.TypeString, value)
 		_node.Token = value
 	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
 	if value, ok := _c.mutation.UserID(); ok {
 		_spec.SetField(personalaccesstoken.FieldUserID, field.TypeInt, value)
 		_node.UserID = value
 	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedIP(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = value
+	}
 	return _node, _spec
 }
 
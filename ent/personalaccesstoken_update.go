@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/shuTwT/hoshikuzu/ent/personalaccesstoken"
 	"github.com/shuTwT/hoshikuzu/ent/predicate"
@@ -102,6 +103,52 @@ func (_u *PersonalAccessTokenUpdate) SetNillableToken(v *string) *PersonalAccess
 	return _u
 }
 
+// ClearToken clears the value of the "token" field.
+func (_u *PersonalAccessTokenUpdate) ClearToken() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *PersonalAccessTokenUpdate) SetTokenHash(v string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableTokenHash(v *string) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (_u *PersonalAccessTokenUpdate) ClearTokenHash() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearTokenHash()
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *PersonalAccessTokenUpdate) SetTokenPrefix(v string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableTokenPrefix(v *string) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (_u *PersonalAccessTokenUpdate) ClearTokenPrefix() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearTokenPrefix()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *PersonalAccessTokenUpdate) SetUserID(v int) *PersonalAccessTokenUpdate {
 	_u.mutation.ResetUserID()
@@ -123,6 +170,64 @@ func (_u *PersonalAccessTokenUpdate) AddUserID(v int) *PersonalAccessTokenUpdate
 	return _u
 }
 
+// SetScopes sets the "scopes" field.
+func (_u *PersonalAccessTokenUpdate) SetScopes(v []string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *PersonalAccessTokenUpdate) AppendScopes(v []string) *PersonalAccessTokenUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *PersonalAccessTokenUpdate) ClearScopes() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdate) SetLastUsedAt(v time.Time) *PersonalAccessTokenUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableLastUsedAt(v *time.Time) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdate) ClearLastUsedAt() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *PersonalAccessTokenUpdate) SetLastUsedIP(v string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableLastUsedIP(v *string) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (_u *PersonalAccessTokenUpdate) ClearLastUsedIP() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearLastUsedIP()
+	return _u
+}
+
 // Mutation returns the PersonalAccessTokenMutation object of the builder.
 func (_u *PersonalAccessTokenUpdate) Mutation() *PersonalAccessTokenMutation {
 	return _u.mutation
@@ -207,12 +312,50 @@ func (_u *PersonalAccessTokenUpdate) sqlSave(ctx context.Context) (_node int, er
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(personalaccesstoken.FieldToken, field.TypeString, value)
 	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(personalaccesstoken.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.TokenHashCleared() {
+		_spec.ClearField(personalaccesstoken.FieldTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+	}
+	if _u.mutation.TokenPrefixCleared() {
+		_spec.ClearField(personalaccesstoken.FieldTokenPrefix, field.TypeString)
+	}
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(personalaccesstoken.FieldUserID, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedUserID(); ok {
 		_spec.AddField(personalaccesstoken.FieldUserID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(personalaccesstoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedIP, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedIPCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedIP, field.TypeString)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{personalaccesstoken.Label}
@@ -307,6 +450,52 @@ func (_u *PersonalAccessTokenUpdateOne) SetNillableToken(v *string) *PersonalAcc
 	return _u
 }
 
+// ClearToken clears the value of the "token" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearToken() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *PersonalAccessTokenUpdateOne) SetTokenHash(v string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableTokenHash(v *string) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearTokenHash() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearTokenHash()
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *PersonalAccessTokenUpdateOne) SetTokenPrefix(v string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableTokenPrefix(v *string) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// ClearTokenPrefix clears the value of the "token_prefix" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearTokenPrefix() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearTokenPrefix()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *PersonalAccessTokenUpdateOne) SetUserID(v int) *PersonalAccessTokenUpdateOne {
 	_u.mutation.ResetUserID()
@@ -328,6 +517,64 @@ func (_u *PersonalAccessTokenUpdateOne) AddUserID(v int) *PersonalAccessTokenUpd
 	return _u
 }
 
+// SetScopes sets the "scopes" field.
+func (_u *PersonalAccessTokenUpdateOne) SetScopes(v []string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *PersonalAccessTokenUpdateOne) AppendScopes(v []string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearScopes() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdateOne) SetLastUsedAt(v time.Time) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableLastUsedAt(v *time.Time) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearLastUsedAt() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *PersonalAccessTokenUpdateOne) SetLastUsedIP(v string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableLastUsedIP(v *string) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearLastUsedIP() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearLastUsedIP()
+	return _u
+}
+
 // Mutation returns the PersonalAccessTokenMutation object of the builder.
 func (_u *PersonalAccessTokenUpdateOne) Mutation() *PersonalAccessTokenMutation {
 	return _u.mutation
@@ -442,12 +689,50 @@ func (_u *PersonalAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *Per
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(personalaccesstoken.FieldToken, field.TypeString, value)
 	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(personalaccesstoken.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.TokenHashCleared() {
+		_spec.ClearField(personalaccesstoken.FieldTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+	}
+	if _u.mutation.TokenPrefixCleared() {
+		_spec.ClearField(personalaccesstoken.FieldTokenPrefix, field.TypeString)
+	}
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(personalaccesstoken.FieldUserID, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedUserID(); ok {
 		_spec.AddField(personalaccesstoken.FieldUserID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(personalaccesstoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedIP, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedIPCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedIP, field.TypeString)
+	}
 	_node = &PersonalAccessToken{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AIAskLog is the predicate function for aiasklog builders.
+type AIAskLog func(*sql.Selector)
+
 // AIChatMessage is the predicate function for aichatmessage builders.
 type AIChatMessage func(*sql.Selector)
 
@@ -15,6 +18,9 @@ type AIChatSession func(*sql.Selector)
 // AIModel is the predicate function for aimodel builders.
 type AIModel func(*sql.Selector)
 
+// AIPersona is the predicate function for aipersona builders.
+type AIPersona func(*sql.Selector)
+
 // AIProvider is the predicate function for aiprovider builders.
 type AIProvider func(*sql.Selector)
 
@@ -24,6 +30,9 @@ type Album func(*sql.Selector)
 // AlbumPhoto is the predicate function for albumphoto builders.
 type AlbumPhoto func(*sql.Selector)
 
+// CartItem is the predicate function for cartitem builders.
+type CartItem func(*sql.Selector)
+
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
@@ -57,6 +66,9 @@ type FriendCircleRecord func(*sql.Selector)
 // License is the predicate function for license builders.
 type License func(*sql.Selector)
 
+// LoginLog is the predicate function for loginlog builders.
+type LoginLog func(*sql.Selector)
+
 // Member is the predicate function for member builders.
 type Member func(*sql.Selector)
 
@@ -72,15 +84,24 @@ type Notification func(*sql.Selector)
 // Oauth2AccessToken is the predicate function for oauth2accesstoken builders.
 type Oauth2AccessToken func(*sql.Selector)
 
+// Oauth2Client is the predicate function for oauth2client builders.
+type Oauth2Client func(*sql.Selector)
+
 // Oauth2Code is the predicate function for oauth2code builders.
 type Oauth2Code func(*sql.Selector)
 
 // Oauth2RefreshToken is the predicate function for oauth2refreshtoken builders.
 type Oauth2RefreshToken func(*sql.Selector)
 
+// OrderDelivery is the predicate function for orderdelivery builders.
+type OrderDelivery func(*sql.Selector)
+
 // PayOrder is the predicate function for payorder builders.
 type PayOrder func(*sql.Selector)
 
+// PayOrderItem is the predicate function for payorderitem builders.
+type PayOrderItem func(*sql.Selector)
+
 // PersonalAccessToken is the predicate function for personalaccesstoken builders.
 type PersonalAccessToken func(*sql.Selector)
 
@@ -96,6 +117,12 @@ type PostPurchase func(*sql.Selector)
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
+// ProductDeliverable is the predicate function for productdeliverable builders.
+type ProductDeliverable func(*sql.Selector)
+
+// ProductKey is the predicate function for productkey builders.
+type ProductKey func(*sql.Selector)
+
 // RefreshToken is the predicate function for refreshtoken builders.
 type RefreshToken func(*sql.Selector)
 
@@ -120,6 +147,9 @@ type Theme func(*sql.Selector)
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
 // VisitLog is the predicate function for visitlog builders.
 type VisitLog func(*sql.Selector)
 
@@ -128,3 +158,6 @@ type Wallet func(*sql.Selector)
 
 // WebHook is the predicate function for webhook builders.
 type WebHook func(*sql.Selector)
+
+// WebHookDelivery is the predicate function for webhookdelivery builders.
+type WebHookDelivery func(*sql.Selector)
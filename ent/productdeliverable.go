@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shuTwT/hoshikuzu/ent/file"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productdeliverable"
+)
+
+// ProductDeliverable is the model entity for the ProductDeliverable schema.
+type ProductDeliverable struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 商品ID
+	ProductID int `json:"product_id,omitempty"`
+	// 交付类型: file-文件 key-卡密 text-文本
+	Type productdeliverable.Type `json:"type,omitempty"`
+	// 交付名称
+	Name string `json:"name,omitempty"`
+	// 文件ID，文件交付时使用
+	FileID int `json:"file_id,omitempty"`
+	// 文本内容，文本交付时使用
+	Content string `json:"content,omitempty"`
+	// 排序
+	SortOrder int `json:"sort_order,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductDeliverableQuery when eager-loading is set.
+	Edges        ProductDeliverableEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProductDeliverableEdges holds the relations/edges for other nodes in the graph.
+type ProductDeliverableEdges struct {
+	// 商品
+	Product *Product `json:"product,omitempty"`
+	// 交付文件
+	File *File `json:"file,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductDeliverableEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductDeliverableEdges) FileOrErr() (*File, error) {
+	if e.File != nil {
+		return e.File, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductDeliverable) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productdeliverable.FieldID, productdeliverable.FieldProductID, productdeliverable.FieldFileID, productdeliverable.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case productdeliverable.FieldType, productdeliverable.FieldName, productdeliverable.FieldContent:
+			values[i] = new(sql.NullString)
+		case productdeliverable.FieldCreatedAt, productdeliverable.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductDeliverable fields.
+func (_m *ProductDeliverable) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productdeliverable.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case productdeliverable.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case productdeliverable.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case productdeliverable.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				_m.ProductID = int(value.Int64)
+			}
+		case productdeliverable.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = productdeliverable.Type(value.String)
+			}
+		case productdeliverable.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case productdeliverable.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				_m.FileID = int(value.Int64)
+			}
+		case productdeliverable.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case productdeliverable.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProductDeliverable.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProductDeliverable) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProduct queries the "product" edge of the ProductDeliverable entity.
+func (_m *ProductDeliverable) QueryProduct() *ProductQuery {
+	return NewProductDeliverableClient(_m.config).QueryProduct(_m)
+}
+
+// QueryFile queries the "file" edge of the ProductDeliverable entity.
+func (_m *ProductDeliverable) QueryFile() *FileQuery {
+	return NewProductDeliverableClient(_m.config).QueryFile(_m)
+}
+
+// Update returns a builder for updating this ProductDeliverable.
+// Note that you need to call ProductDeliverable.Unwrap() before calling this method if this ProductDeliverable
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProductDeliverable) Update() *ProductDeliverableUpdateOne {
+	return NewProductDeliverableClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProductDeliverable entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProductDeliverable) Unwrap() *ProductDeliverable {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductDeliverable is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProductDeliverable) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductDeliverable(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FileID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductDeliverables is a parsable slice of ProductDeliverable.
+type ProductDeliverables []*ProductDeliverable
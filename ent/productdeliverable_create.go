@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/file"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productdeliverable"
+)
+
+// ProductDeliverableCreate is the builder for creating a ProductDeliverable entity.
+type ProductDeliverableCreate struct {
+	config
+	mutation *ProductDeliverableMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProductDeliverableCreate) SetCreatedAt(v time.Time) *ProductDeliverableCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProductDeliverableCreate) SetNillableCreatedAt(v *time.Time) *ProductDeliverableCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProductDeliverableCreate) SetUpdatedAt(v time.Time) *ProductDeliverableCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProductDeliverableCreate) SetNillableUpdatedAt(v *time.Time) *ProductDeliverableCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetProductID sets the "product_id" field.
+func (_c *ProductDeliverableCreate) SetProductID(v int) *ProductDeliverableCreate {
+	_c.mutation.SetProductID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ProductDeliverableCreate) SetType(v productdeliverable.Type) *ProductDeliverableCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ProductDeliverableCreate) SetName(v string) *ProductDeliverableCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetFileID sets the "file_id" field.
+func (_c *ProductDeliverableCreate) SetFileID(v int) *ProductDeliverableCreate {
+	_c.mutation.SetFileID(v)
+	return _c
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_c *ProductDeliverableCreate) SetNillableFileID(v *int) *ProductDeliverableCreate {
+	if v != nil {
+		_c.SetFileID(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *ProductDeliverableCreate) SetContent(v string) *ProductDeliverableCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *ProductDeliverableCreate) SetNillableContent(v *string) *ProductDeliverableCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *ProductDeliverableCreate) SetSortOrder(v int) *ProductDeliverableCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *ProductDeliverableCreate) SetNillableSortOrder(v *int) *ProductDeliverableCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProductDeliverableCreate) SetID(v int) *ProductDeliverableCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_c *ProductDeliverableCreate) SetProduct(v *Product) *ProductDeliverableCreate {
+	return _c.SetProductID(v.ID)
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_c *ProductDeliverableCreate) SetFile(v *File) *ProductDeliverableCreate {
+	return _c.SetFileID(v.ID)
+}
+
+// Mutation returns the ProductDeliverableMutation object of the builder.
+func (_c *ProductDeliverableCreate) Mutation() *ProductDeliverableMutation {
+	return _c.mutation
+}
+
+// Save creates the ProductDeliverable in the database.
+func (_c *ProductDeliverableCreate) Save(ctx context.Context) (*ProductDeliverable, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProductDeliverableCreate) SaveX(ctx context.Context) *ProductDeliverable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProductDeliverableCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProductDeliverableCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProductDeliverableCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := productdeliverable.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := productdeliverable.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := productdeliverable.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProductDeliverableCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductDeliverable.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductDeliverable.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductDeliverable.product_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ProductDeliverable.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := productdeliverable.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProductDeliverable.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProductDeliverable.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := productdeliverable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductDeliverable.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "ProductDeliverable.sort_order"`)}
+	}
+	if len(_c.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "ProductDeliverable.product"`)}
+	}
+	return nil
+}
+
+func (_c *ProductDeliverableCreate) sqlSave(ctx context.Context) (*ProductDeliverable, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProductDeliverableCreate) createSpec() (*ProductDeliverable, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductDeliverable{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(productdeliverable.Table, sqlgraph.NewFieldSpec(productdeliverable.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(productdeliverable.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(productdeliverable.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(productdeliverable.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(productdeliverable.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(productdeliverable.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(productdeliverable.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if nodes := _c.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.ProductTable,
+			Columns: []string{productdeliverable.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.FileTable,
+			Columns: []string{productdeliverable.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProductDeliverableCreateBulk is the builder for creating many ProductDeliverable entities in bulk.
+type ProductDeliverableCreateBulk struct {
+	config
+	err      error
+	builders []*ProductDeliverableCreate
+}
+
+// Save creates the ProductDeliverable entities in the database.
+func (_c *ProductDeliverableCreateBulk) Save(ctx context.Context) ([]*ProductDeliverable, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProductDeliverable, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductDeliverableMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProductDeliverableCreateBulk) SaveX(ctx context.Context) []*ProductDeliverable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProductDeliverableCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProductDeliverableCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/file"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productdeliverable"
+)
+
+// ProductDeliverableUpdate is the builder for updating ProductDeliverable entities.
+type ProductDeliverableUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductDeliverableMutation
+}
+
+// Where appends a list predicates to the ProductDeliverableUpdate builder.
+func (_u *ProductDeliverableUpdate) Where(ps ...predicate.ProductDeliverable) *ProductDeliverableUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProductDeliverableUpdate) SetUpdatedAt(v time.Time) *ProductDeliverableUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *ProductDeliverableUpdate) SetProductID(v int) *ProductDeliverableUpdate {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *ProductDeliverableUpdate) SetNillableProductID(v *int) *ProductDeliverableUpdate {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ProductDeliverableUpdate) SetType(v productdeliverable.Type) *ProductDeliverableUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ProductDeliverableUpdate) SetNillableType(v *productdeliverable.Type) *ProductDeliverableUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProductDeliverableUpdate) SetName(v string) *ProductDeliverableUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProductDeliverableUpdate) SetNillableName(v *string) *ProductDeliverableUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *ProductDeliverableUpdate) SetFileID(v int) *ProductDeliverableUpdate {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *ProductDeliverableUpdate) SetNillableFileID(v *int) *ProductDeliverableUpdate {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (_u *ProductDeliverableUpdate) ClearFileID() *ProductDeliverableUpdate {
+	_u.mutation.ClearFileID()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ProductDeliverableUpdate) SetContent(v string) *ProductDeliverableUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ProductDeliverableUpdate) SetNillableContent(v *string) *ProductDeliverableUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *ProductDeliverableUpdate) ClearContent() *ProductDeliverableUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ProductDeliverableUpdate) SetSortOrder(v int) *ProductDeliverableUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ProductDeliverableUpdate) SetNillableSortOrder(v *int) *ProductDeliverableUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ProductDeliverableUpdate) AddSortOrder(v int) *ProductDeliverableUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *ProductDeliverableUpdate) SetProduct(v *Product) *ProductDeliverableUpdate {
+	return _u.SetProductID(v.ID)
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_u *ProductDeliverableUpdate) SetFile(v *File) *ProductDeliverableUpdate {
+	return _u.SetFileID(v.ID)
+}
+
+// Mutation returns the ProductDeliverableMutation object of the builder.
+func (_u *ProductDeliverableUpdate) Mutation() *ProductDeliverableMutation {
+	return _u.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *ProductDeliverableUpdate) ClearProduct() *ProductDeliverableUpdate {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (_u *ProductDeliverableUpdate) ClearFile() *ProductDeliverableUpdate {
+	_u.mutation.ClearFile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProductDeliverableUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProductDeliverableUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProductDeliverableUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProductDeliverableUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProductDeliverableUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := productdeliverable.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProductDeliverableUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := productdeliverable.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProductDeliverable.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := productdeliverable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductDeliverable.name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProductDeliverable.product"`)
+	}
+	return nil
+}
+
+func (_u *ProductDeliverableUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productdeliverable.Table, productdeliverable.Columns, sqlgraph.NewFieldSpec(productdeliverable.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(productdeliverable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(productdeliverable.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(productdeliverable.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(productdeliverable.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(productdeliverable.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(productdeliverable.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(productdeliverable.FieldSortOrder, field.TypeInt, value)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.ProductTable,
+			Columns: []string{productdeliverable.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.ProductTable,
+			Columns: []string{productdeliverable.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.FileTable,
+			Columns: []string{productdeliverable.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.FileTable,
+			Columns: []string{productdeliverable.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productdeliverable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProductDeliverableUpdateOne is the builder for updating a single ProductDeliverable entity.
+type ProductDeliverableUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductDeliverableMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProductDeliverableUpdateOne) SetUpdatedAt(v time.Time) *ProductDeliverableUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *ProductDeliverableUpdateOne) SetProductID(v int) *ProductDeliverableUpdateOne {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *ProductDeliverableUpdateOne) SetNillableProductID(v *int) *ProductDeliverableUpdateOne {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ProductDeliverableUpdateOne) SetType(v productdeliverable.Type) *ProductDeliverableUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ProductDeliverableUpdateOne) SetNillableType(v *productdeliverable.Type) *ProductDeliverableUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProductDeliverableUpdateOne) SetName(v string) *ProductDeliverableUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProductDeliverableUpdateOne) SetNillableName(v *string) *ProductDeliverableUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *ProductDeliverableUpdateOne) SetFileID(v int) *ProductDeliverableUpdateOne {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *ProductDeliverableUpdateOne) SetNillableFileID(v *int) *ProductDeliverableUpdateOne {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (_u *ProductDeliverableUpdateOne) ClearFileID() *ProductDeliverableUpdateOne {
+	_u.mutation.ClearFileID()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ProductDeliverableUpdateOne) SetContent(v string) *ProductDeliverableUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ProductDeliverableUpdateOne) SetNillableContent(v *string) *ProductDeliverableUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *ProductDeliverableUpdateOne) ClearContent() *ProductDeliverableUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ProductDeliverableUpdateOne) SetSortOrder(v int) *ProductDeliverableUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ProductDeliverableUpdateOne) SetNillableSortOrder(v *int) *ProductDeliverableUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ProductDeliverableUpdateOne) AddSortOrder(v int) *ProductDeliverableUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *ProductDeliverableUpdateOne) SetProduct(v *Product) *ProductDeliverableUpdateOne {
+	return _u.SetProductID(v.ID)
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_u *ProductDeliverableUpdateOne) SetFile(v *File) *ProductDeliverableUpdateOne {
+	return _u.SetFileID(v.ID)
+}
+
+// Mutation returns the ProductDeliverableMutation object of the builder.
+func (_u *ProductDeliverableUpdateOne) Mutation() *ProductDeliverableMutation {
+	return _u.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *ProductDeliverableUpdateOne) ClearProduct() *ProductDeliverableUpdateOne {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (_u *ProductDeliverableUpdateOne) ClearFile() *ProductDeliverableUpdateOne {
+	_u.mutation.ClearFile()
+	return _u
+}
+
+// Where appends a list predicates to the ProductDeliverableUpdate builder.
+func (_u *ProductDeliverableUpdateOne) Where(ps ...predicate.ProductDeliverable) *ProductDeliverableUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProductDeliverableUpdateOne) Select(field string, fields ...string) *ProductDeliverableUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProductDeliverable entity.
+func (_u *ProductDeliverableUpdateOne) Save(ctx context.Context) (*ProductDeliverable, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProductDeliverableUpdateOne) SaveX(ctx context.Context) *ProductDeliverable {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProductDeliverableUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProductDeliverableUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProductDeliverableUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := productdeliverable.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProductDeliverableUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := productdeliverable.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProductDeliverable.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := productdeliverable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductDeliverable.name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProductDeliverable.product"`)
+	}
+	return nil
+}
+
+func (_u *ProductDeliverableUpdateOne) sqlSave(ctx context.Context) (_node *ProductDeliverable, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productdeliverable.Table, productdeliverable.Columns, sqlgraph.NewFieldSpec(productdeliverable.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductDeliverable.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productdeliverable.FieldID)
+		for _, f := range fields {
+			if !productdeliverable.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productdeliverable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(productdeliverable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(productdeliverable.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(productdeliverable.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(productdeliverable.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(productdeliverable.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(productdeliverable.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(productdeliverable.FieldSortOrder, field.TypeInt, value)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.ProductTable,
+			Columns: []string{productdeliverable.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.ProductTable,
+			Columns: []string{productdeliverable.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.FileTable,
+			Columns: []string{productdeliverable.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productdeliverable.FileTable,
+			Columns: []string{productdeliverable.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProductDeliverable{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productdeliverable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productkey"
+)
+
+// ProductKeyUpdate is the builder for updating ProductKey entities.
+type ProductKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductKeyMutation
+}
+
+// Where appends a list predicates to the ProductKeyUpdate builder.
+func (_u *ProductKeyUpdate) Where(ps ...predicate.ProductKey) *ProductKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProductKeyUpdate) SetUpdatedAt(v time.Time) *ProductKeyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *ProductKeyUpdate) SetProductID(v int) *ProductKeyUpdate {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *ProductKeyUpdate) SetNillableProductID(v *int) *ProductKeyUpdate {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ProductKeyUpdate) SetCode(v string) *ProductKeyUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ProductKeyUpdate) SetNillableCode(v *string) *ProductKeyUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProductKeyUpdate) SetStatus(v int) *ProductKeyUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProductKeyUpdate) SetNillableStatus(v *int) *ProductKeyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ProductKeyUpdate) AddStatus(v int) *ProductKeyUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *ProductKeyUpdate) SetOrderID(v int) *ProductKeyUpdate {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *ProductKeyUpdate) SetNillableOrderID(v *int) *ProductKeyUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *ProductKeyUpdate) AddOrderID(v int) *ProductKeyUpdate {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *ProductKeyUpdate) ClearOrderID() *ProductKeyUpdate {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ProductKeyUpdate) SetUserID(v int) *ProductKeyUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ProductKeyUpdate) SetNillableUserID(v *int) *ProductKeyUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ProductKeyUpdate) AddUserID(v int) *ProductKeyUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ProductKeyUpdate) ClearUserID() *ProductKeyUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_u *ProductKeyUpdate) SetAssignedAt(v time.Time) *ProductKeyUpdate {
+	_u.mutation.SetAssignedAt(v)
+	return _u
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_u *ProductKeyUpdate) SetNillableAssignedAt(v *time.Time) *ProductKeyUpdate {
+	if v != nil {
+		_u.SetAssignedAt(*v)
+	}
+	return _u
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (_u *ProductKeyUpdate) ClearAssignedAt() *ProductKeyUpdate {
+	_u.mutation.ClearAssignedAt()
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *ProductKeyUpdate) SetProduct(v *Product) *ProductKeyUpdate {
+	return _u.SetProductID(v.ID)
+}
+
+// Mutation returns the ProductKeyMutation object of the builder.
+func (_u *ProductKeyUpdate) Mutation() *ProductKeyMutation {
+	return _u.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *ProductKeyUpdate) ClearProduct() *ProductKeyUpdate {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProductKeyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProductKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProductKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProductKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProductKeyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := productkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProductKeyUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := productkey.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ProductKey.code": %w`, err)}
+		}
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProductKey.product"`)
+	}
+	return nil
+}
+
+func (_u *ProductKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productkey.Table, productkey.Columns, sqlgraph.NewFieldSpec(productkey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(productkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(productkey.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(productkey.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(productkey.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(productkey.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(productkey.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(productkey.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(productkey.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(productkey.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(productkey.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AssignedAt(); ok {
+		_spec.SetField(productkey.FieldAssignedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignedAtCleared() {
+		_spec.ClearField(productkey.FieldAssignedAt, field.TypeTime)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productkey.ProductTable,
+			Columns: []string{productkey.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productkey.ProductTable,
+			Columns: []string{productkey.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProductKeyUpdateOne is the builder for updating a single ProductKey entity.
+type ProductKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductKeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProductKeyUpdateOne) SetUpdatedAt(v time.Time) *ProductKeyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *ProductKeyUpdateOne) SetProductID(v int) *ProductKeyUpdateOne {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *ProductKeyUpdateOne) SetNillableProductID(v *int) *ProductKeyUpdateOne {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ProductKeyUpdateOne) SetCode(v string) *ProductKeyUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ProductKeyUpdateOne) SetNillableCode(v *string) *ProductKeyUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProductKeyUpdateOne) SetStatus(v int) *ProductKeyUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProductKeyUpdateOne) SetNillableStatus(v *int) *ProductKeyUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ProductKeyUpdateOne) AddStatus(v int) *ProductKeyUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *ProductKeyUpdateOne) SetOrderID(v int) *ProductKeyUpdateOne {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *ProductKeyUpdateOne) SetNillableOrderID(v *int) *ProductKeyUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *ProductKeyUpdateOne) AddOrderID(v int) *ProductKeyUpdateOne {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *ProductKeyUpdateOne) ClearOrderID() *ProductKeyUpdateOne {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ProductKeyUpdateOne) SetUserID(v int) *ProductKeyUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ProductKeyUpdateOne) SetNillableUserID(v *int) *ProductKeyUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ProductKeyUpdateOne) AddUserID(v int) *ProductKeyUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ProductKeyUpdateOne) ClearUserID() *ProductKeyUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_u *ProductKeyUpdateOne) SetAssignedAt(v time.Time) *ProductKeyUpdateOne {
+	_u.mutation.SetAssignedAt(v)
+	return _u
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_u *ProductKeyUpdateOne) SetNillableAssignedAt(v *time.Time) *ProductKeyUpdateOne {
+	if v != nil {
+		_u.SetAssignedAt(*v)
+	}
+	return _u
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (_u *ProductKeyUpdateOne) ClearAssignedAt() *ProductKeyUpdateOne {
+	_u.mutation.ClearAssignedAt()
+	return _u
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *ProductKeyUpdateOne) SetProduct(v *Product) *ProductKeyUpdateOne {
+	return _u.SetProductID(v.ID)
+}
+
+// Mutation returns the ProductKeyMutation object of the builder.
+func (_u *ProductKeyUpdateOne) Mutation() *ProductKeyMutation {
+	return _u.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *ProductKeyUpdateOne) ClearProduct() *ProductKeyUpdateOne {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// Where appends a list predicates to the ProductKeyUpdate builder.
+func (_u *ProductKeyUpdateOne) Where(ps ...predicate.ProductKey) *ProductKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProductKeyUpdateOne) Select(field string, fields ...string) *ProductKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProductKey entity.
+func (_u *ProductKeyUpdateOne) Save(ctx context.Context) (*ProductKey, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProductKeyUpdateOne) SaveX(ctx context.Context) *ProductKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProductKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProductKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProductKeyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := productkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProductKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := productkey.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ProductKey.code": %w`, err)}
+		}
+	}
+	if _u.mutation.ProductCleared() && len(_u.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProductKey.product"`)
+	}
+	return nil
+}
+
+func (_u *ProductKeyUpdateOne) sqlSave(ctx context.Context) (_node *ProductKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productkey.Table, productkey.Columns, sqlgraph.NewFieldSpec(productkey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productkey.FieldID)
+		for _, f := range fields {
+			if !productkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(productkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(productkey.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(productkey.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(productkey.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(productkey.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(productkey.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(productkey.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(productkey.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(productkey.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(productkey.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AssignedAt(); ok {
+		_spec.SetField(productkey.FieldAssignedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignedAtCleared() {
+		_spec.ClearField(productkey.FieldAssignedAt, field.TypeTime)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productkey.ProductTable,
+			Columns: []string{productkey.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   productkey.ProductTable,
+			Columns: []string{productkey.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProductKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -32,7 +32,11 @@ type RefreshToken struct {
 	// 签发时的用户代理
 	UserAgent string `json:"user_agent,omitempty"`
 	// 签发时的IP
-	IP           string `json:"ip,omitempty"`
+	IP string `json:"ip,omitempty"`
+	// 会话ID
+	FamilyID string `json:"family_id,omitempty"`
+	// 会话登录时间
+	LoginAt      time.Time `json:"login_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -45,9 +49,9 @@ func (*RefreshToken) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case refreshtoken.FieldID, refreshtoken.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case refreshtoken.FieldTokenHash, refreshtoken.FieldUserAgent, refreshtoken.FieldIP:
+		case refreshtoken.FieldTokenHash, refreshtoken.FieldUserAgent, refreshtoken.FieldIP, refreshtoken.FieldFamilyID:
 			values[i] = new(sql.NullString)
-		case refreshtoken.FieldCreatedAt, refreshtoken.FieldUpdatedAt, refreshtoken.FieldExpiresAt:
+		case refreshtoken.FieldCreatedAt, refreshtoken.FieldUpdatedAt, refreshtoken.FieldExpiresAt, refreshtoken.FieldLoginAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -118,6 +122,18 @@ func (_m *RefreshToken) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IP = value.String
 			}
+		case refreshtoken.FieldFamilyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value.Valid {
+				_m.FamilyID = value.String
+			}
+		case refreshtoken.FieldLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field login_at", values[i])
+			} else if value.Valid {
+				_m.LoginAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -177,6 +193,12 @@ func (_m *RefreshToken) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("ip=")
 	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("family_id=")
+	builder.WriteString(_m.FamilyID)
+	builder.WriteString(", ")
+	builder.WriteString("login_at=")
+	builder.WriteString(_m.LoginAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
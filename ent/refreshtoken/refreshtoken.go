@@ -29,6 +29,10 @@ const (
 	FieldUserAgent = "user_agent"
 	// FieldIP holds the string denoting the ip field in the database.
 	FieldIP = "ip"
+	// FieldFamilyID holds the string denoting the family_id field in the database.
+	FieldFamilyID = "family_id"
+	// FieldLoginAt holds the string denoting the login_at field in the database.
+	FieldLoginAt = "login_at"
 	// Table holds the table name of the refreshtoken in the database.
 	Table = "refresh_tokens"
 )
@@ -44,6 +48,8 @@ var Columns = []string{
 	FieldRevoked,
 	FieldUserAgent,
 	FieldIP,
+	FieldFamilyID,
+	FieldLoginAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -116,3 +122,13 @@ func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
 func ByIP(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIP, opts...).ToFunc()
 }
+
+// ByFamilyID orders the results by the family_id field.
+func ByFamilyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFamilyID, opts...).ToFunc()
+}
+
+// ByLoginAt orders the results by the login_at field.
+func ByLoginAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginAt, opts...).ToFunc()
+}
@@ -108,6 +108,34 @@ func (_c *RefreshTokenCreate) SetNillableIP(v *string) *RefreshTokenCreate {
 	return _c
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_c *RefreshTokenCreate) SetFamilyID(v string) *RefreshTokenCreate {
+	_c.mutation.SetFamilyID(v)
+	return _c
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_c *RefreshTokenCreate) SetNillableFamilyID(v *string) *RefreshTokenCreate {
+	if v != nil {
+		_c.SetFamilyID(*v)
+	}
+	return _c
+}
+
+// SetLoginAt sets the "login_at" field.
+func (_c *RefreshTokenCreate) SetLoginAt(v time.Time) *RefreshTokenCreate {
+	_c.mutation.SetLoginAt(v)
+	return _c
+}
+
+// SetNillableLoginAt sets the "login_at" field if the given value is not nil.
+func (_c *RefreshTokenCreate) SetNillableLoginAt(v *time.Time) *RefreshTokenCreate {
+	if v != nil {
+		_c.SetLoginAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RefreshTokenCreate) SetID(v int) *RefreshTokenCreate {
 	_c.mutation.SetID(v)
@@ -252,6 +280,14 @@ func (_c *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec)
 		_spec.SetField(refreshtoken.FieldIP, field.TypeString, value)
 		_node.IP = value
 	}
+	if value, ok := _c.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
+	if value, ok := _c.mutation.LoginAt(); ok {
+		_spec.SetField(refreshtoken.FieldLoginAt, field.TypeTime, value)
+		_node.LoginAt = value
+	}
 	return _node, _spec
 }
 
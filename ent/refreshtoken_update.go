@@ -137,6 +137,46 @@ func (_u *RefreshTokenUpdate) ClearIP() *RefreshTokenUpdate {
 	return _u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_u *RefreshTokenUpdate) SetFamilyID(v string) *RefreshTokenUpdate {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *RefreshTokenUpdate) SetNillableFamilyID(v *string) *RefreshTokenUpdate {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *RefreshTokenUpdate) ClearFamilyID() *RefreshTokenUpdate {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
+// SetLoginAt sets the "login_at" field.
+func (_u *RefreshTokenUpdate) SetLoginAt(v time.Time) *RefreshTokenUpdate {
+	_u.mutation.SetLoginAt(v)
+	return _u
+}
+
+// SetNillableLoginAt sets the "login_at" field if the given value is not nil.
+func (_u *RefreshTokenUpdate) SetNillableLoginAt(v *time.Time) *RefreshTokenUpdate {
+	if v != nil {
+		_u.SetLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLoginAt clears the value of the "login_at" field.
+func (_u *RefreshTokenUpdate) ClearLoginAt() *RefreshTokenUpdate {
+	_u.mutation.ClearLoginAt()
+	return _u
+}
+
 // Mutation returns the RefreshTokenMutation object of the builder.
 func (_u *RefreshTokenUpdate) Mutation() *RefreshTokenMutation {
 	return _u.mutation
@@ -230,6 +270,18 @@ func (_u *RefreshTokenUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.IPCleared() {
 		_spec.ClearField(refreshtoken.FieldIP, field.TypeString)
 	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeString, value)
+	}
+	if _u.mutation.FamilyIDCleared() {
+		_spec.ClearField(refreshtoken.FieldFamilyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginAt(); ok {
+		_spec.SetField(refreshtoken.FieldLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LoginAtCleared() {
+		_spec.ClearField(refreshtoken.FieldLoginAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{refreshtoken.Label}
@@ -359,6 +411,46 @@ func (_u *RefreshTokenUpdateOne) ClearIP() *RefreshTokenUpdateOne {
 	return _u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_u *RefreshTokenUpdateOne) SetFamilyID(v string) *RefreshTokenUpdateOne {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *RefreshTokenUpdateOne) SetNillableFamilyID(v *string) *RefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *RefreshTokenUpdateOne) ClearFamilyID() *RefreshTokenUpdateOne {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
+// SetLoginAt sets the "login_at" field.
+func (_u *RefreshTokenUpdateOne) SetLoginAt(v time.Time) *RefreshTokenUpdateOne {
+	_u.mutation.SetLoginAt(v)
+	return _u
+}
+
+// SetNillableLoginAt sets the "login_at" field if the given value is not nil.
+func (_u *RefreshTokenUpdateOne) SetNillableLoginAt(v *time.Time) *RefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLoginAt clears the value of the "login_at" field.
+func (_u *RefreshTokenUpdateOne) ClearLoginAt() *RefreshTokenUpdateOne {
+	_u.mutation.ClearLoginAt()
+	return _u
+}
+
 // Mutation returns the RefreshTokenMutation object of the builder.
 func (_u *RefreshTokenUpdateOne) Mutation() *RefreshTokenMutation {
 	return _u.mutation
@@ -482,6 +574,18 @@ func (_u *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshTok
 	if _u.mutation.IPCleared() {
 		_spec.ClearField(refreshtoken.FieldIP, field.TypeString)
 	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeString, value)
+	}
+	if _u.mutation.FamilyIDCleared() {
+		_spec.ClearField(refreshtoken.FieldFamilyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginAt(); ok {
+		_spec.SetField(refreshtoken.FieldLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LoginAtCleared() {
+		_spec.ClearField(refreshtoken.FieldLoginAt, field.TypeTime)
+	}
 	_node = &RefreshToken{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -85,6 +85,11 @@ func IsDefault(v bool) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldIsDefault, v))
 }
 
+// RequireTwoFactor applies equality check predicate on the "require_two_factor" field. It's identical to RequireTwoFactorEQ.
+func RequireTwoFactor(v bool) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldRequireTwoFactor, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldCreatedAt, v))
@@ -380,6 +385,26 @@ func IsDefaultNEQ(v bool) predicate.Role {
 	return predicate.Role(sql.FieldNEQ(FieldIsDefault, v))
 }
 
+// PermissionsIsNil applies the IsNil predicate on the "permissions" field.
+func PermissionsIsNil() predicate.Role {
+	return predicate.Role(sql.FieldIsNull(FieldPermissions))
+}
+
+// PermissionsNotNil applies the NotNil predicate on the "permissions" field.
+func PermissionsNotNil() predicate.Role {
+	return predicate.Role(sql.FieldNotNull(FieldPermissions))
+}
+
+// RequireTwoFactorEQ applies the EQ predicate on the "require_two_factor" field.
+func RequireTwoFactorEQ(v bool) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldRequireTwoFactor, v))
+}
+
+// RequireTwoFactorNEQ applies the NEQ predicate on the "require_two_factor" field.
+func RequireTwoFactorNEQ(v bool) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldRequireTwoFactor, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
@@ -89,6 +89,26 @@ func (_c *RoleCreate) SetNillableIsDefault(v *bool) *RoleCreate {
 	return _c
 }
 
+// SetPermissions sets the "permissions" field.
+func (_c *RoleCreate) SetPermissions(v []string) *RoleCreate {
+	_c.mutation.SetPermissions(v)
+	return _c
+}
+
+// SetRequireTwoFactor sets the "require_two_factor" field.
+func (_c *RoleCreate) SetRequireTwoFactor(v bool) *RoleCreate {
+	_c.mutation.SetRequireTwoFactor(v)
+	return _c
+}
+
+// SetNillableRequireTwoFactor sets the "require_two_factor" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableRequireTwoFactor(v *bool) *RoleCreate {
+	if v != nil {
+		_c.SetRequireTwoFactor(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RoleCreate) SetID(v int) *RoleCreate {
 	_c.mutation.SetID(v)
@@ -157,6 +177,10 @@ func (_c *RoleCreate) defaults() {
 		v := role.DefaultIsDefault
 		_c.mutation.SetIsDefault(v)
 	}
+	if _, ok := _c.mutation.RequireTwoFactor(); !ok {
+		v := role.DefaultRequireTwoFactor
+		_c.mutation.SetRequireTwoFactor(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -191,6 +215,9 @@ func (_c *RoleCreate) check() error {
 	if _, ok := _c.mutation.IsDefault(); !ok {
 		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "Role.is_default"`)}
 	}
+	if _, ok := _c.mutation.RequireTwoFactor(); !ok {
+		return &ValidationError{Name: "require_two_factor", err: errors.New(`ent: missing required field "Role.require_two_factor"`)}
+	}
 	return nil
 }
 
@@ -247,6 +274,14 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldIsDefault, field.TypeBool, value)
 		_node.IsDefault = value
 	}
+	if value, ok := _c.mutation.Permissions(); ok {
+		_spec.SetField(role.FieldPermissions, field.TypeJSON, value)
+		_node.Permissions = value
+	}
+	if value, ok := _c.mutation.RequireTwoFactor(); ok {
+		_spec.SetField(role.FieldRequireTwoFactor, field.TypeBool, value)
+		_node.RequireTwoFactor = value
+	}
 	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -5,12 +5,15 @@ package ent
 import (
 	"time"
 
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
 	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
 	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
 	"github.com/shuTwT/hoshikuzu/ent/aimodel"
+	"github.com/shuTwT/hoshikuzu/ent/aipersona"
 	"github.com/shuTwT/hoshikuzu/ent/aiprovider"
 	"github.com/shuTwT/hoshikuzu/ent/album"
 	"github.com/shuTwT/hoshikuzu/ent/albumphoto"
+	"github.com/shuTwT/hoshikuzu/ent/cartitem"
 	"github.com/shuTwT/hoshikuzu/ent/category"
 	"github.com/shuTwT/hoshikuzu/ent/comment"
 	"github.com/shuTwT/hoshikuzu/ent/coupon"
@@ -22,19 +25,25 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/flinkgroup"
 	"github.com/shuTwT/hoshikuzu/ent/friendcirclerecord"
 	"github.com/shuTwT/hoshikuzu/ent/license"
+	"github.com/shuTwT/hoshikuzu/ent/loginlog"
 	"github.com/shuTwT/hoshikuzu/ent/member"
 	"github.com/shuTwT/hoshikuzu/ent/memberlevel"
 	"github.com/shuTwT/hoshikuzu/ent/menu"
 	"github.com/shuTwT/hoshikuzu/ent/notification"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2accesstoken"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2client"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2code"
 	"github.com/shuTwT/hoshikuzu/ent/oauth2refreshtoken"
+	"github.com/shuTwT/hoshikuzu/ent/orderdelivery"
 	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
 	"github.com/shuTwT/hoshikuzu/ent/personalaccesstoken"
 	"github.com/shuTwT/hoshikuzu/ent/plugin"
 	"github.com/shuTwT/hoshikuzu/ent/post"
 	"github.com/shuTwT/hoshikuzu/ent/postpurchase"
 	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productdeliverable"
+	"github.com/shuTwT/hoshikuzu/ent/productkey"
 	"github.com/shuTwT/hoshikuzu/ent/refreshtoken"
 	"github.com/shuTwT/hoshikuzu/ent/role"
 	"github.com/shuTwT/hoshikuzu/ent/schedulejob"
@@ -44,15 +53,62 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/tag"
 	"github.com/shuTwT/hoshikuzu/ent/theme"
 	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/ent/useridentity"
 	"github.com/shuTwT/hoshikuzu/ent/visitlog"
 	"github.com/shuTwT/hoshikuzu/ent/wallet"
 	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	aiasklogMixin := schema.AIAskLog{}.Mixin()
+	aiasklogMixinFields0 := aiasklogMixin[0].Fields()
+	_ = aiasklogMixinFields0
+	aiasklogFields := schema.AIAskLog{}.Fields()
+	_ = aiasklogFields
+	// aiasklogDescCreatedAt is the schema descriptor for created_at field.
+	aiasklogDescCreatedAt := aiasklogMixinFields0[1].Descriptor()
+	// aiasklog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	aiasklog.DefaultCreatedAt = aiasklogDescCreatedAt.Default.(func() time.Time)
+	// aiasklogDescUpdatedAt is the schema descriptor for updated_at field.
+	aiasklogDescUpdatedAt := aiasklogMixinFields0[2].Descriptor()
+	// aiasklog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	aiasklog.DefaultUpdatedAt = aiasklogDescUpdatedAt.Default.(func() time.Time)
+	// aiasklog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	aiasklog.UpdateDefaultUpdatedAt = aiasklogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// aiasklogDescQuestion is the schema descriptor for question field.
+	aiasklogDescQuestion := aiasklogFields[0].Descriptor()
+	// aiasklog.QuestionValidator is a validator for the "question" field. It is called by the builders before save.
+	aiasklog.QuestionValidator = aiasklogDescQuestion.Validators[0].(func(string) error)
+	// aiasklogDescError is the schema descriptor for error field.
+	aiasklogDescError := aiasklogFields[4].Descriptor()
+	// aiasklog.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	aiasklog.ErrorValidator = aiasklogDescError.Validators[0].(func(string) error)
+	// aiasklogDescModel is the schema descriptor for model field.
+	aiasklogDescModel := aiasklogFields[5].Descriptor()
+	// aiasklog.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	aiasklog.ModelValidator = aiasklogDescModel.Validators[0].(func(string) error)
+	// aiasklogDescPromptTokens is the schema descriptor for prompt_tokens field.
+	aiasklogDescPromptTokens := aiasklogFields[6].Descriptor()
+	// aiasklog.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	aiasklog.DefaultPromptTokens = aiasklogDescPromptTokens.Default.(int)
+	// aiasklog.PromptTokensValidator is a validator for the "prompt_tokens" field. It is called by the builders before save.
+	aiasklog.PromptTokensValidator = aiasklogDescPromptTokens.Validators[0].(func(int) error)
+	// aiasklogDescCompletionTokens is the schema descriptor for completion_tokens field.
+	aiasklogDescCompletionTokens := aiasklogFields[7].Descriptor()
+	// aiasklog.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	aiasklog.DefaultCompletionTokens = aiasklogDescCompletionTokens.Default.(int)
+	// aiasklog.CompletionTokensValidator is a validator for the "completion_tokens" field. It is called by the builders before save.
+	aiasklog.CompletionTokensValidator = aiasklogDescCompletionTokens.Validators[0].(func(int) error)
+	// aiasklogDescTotalTokens is the schema descriptor for total_tokens field.
+	aiasklogDescTotalTokens := aiasklogFields[8].Descriptor()
+	// aiasklog.DefaultTotalTokens holds the default value on creation for the total_tokens field.
+	aiasklog.DefaultTotalTokens = aiasklogDescTotalTokens.Default.(int)
+	// aiasklog.TotalTokensValidator is a validator for the "total_tokens" field. It is called by the builders before save.
+	aiasklog.TotalTokensValidator = aiasklogDescTotalTokens.Validators[0].(func(int) error)
 	aichatmessageMixin := schema.AIChatMessage{}.Mixin()
 	aichatmessageMixinFields0 := aichatmessageMixin[0].Fields()
 	_ = aichatmessageMixinFields0
@@ -80,6 +136,18 @@ func init() {
 	aichatmessageDescModel := aichatmessageFields[3].Descriptor()
 	// aichatmessage.ModelValidator is a validator for the "model" field. It is called by the builders before save.
 	aichatmessage.ModelValidator = aichatmessageDescModel.Validators[0].(func(string) error)
+	// aichatmessageDescPromptTokens is the schema descriptor for prompt_tokens field.
+	aichatmessageDescPromptTokens := aichatmessageFields[5].Descriptor()
+	// aichatmessage.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	aichatmessage.DefaultPromptTokens = aichatmessageDescPromptTokens.Default.(int)
+	// aichatmessage.PromptTokensValidator is a validator for the "prompt_tokens" field. It is called by the builders before save.
+	aichatmessage.PromptTokensValidator = aichatmessageDescPromptTokens.Validators[0].(func(int) error)
+	// aichatmessageDescCompletionTokens is the schema descriptor for completion_tokens field.
+	aichatmessageDescCompletionTokens := aichatmessageFields[6].Descriptor()
+	// aichatmessage.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	aichatmessage.DefaultCompletionTokens = aichatmessageDescCompletionTokens.Default.(int)
+	// aichatmessage.CompletionTokensValidator is a validator for the "completion_tokens" field. It is called by the builders before save.
+	aichatmessage.CompletionTokensValidator = aichatmessageDescCompletionTokens.Validators[0].(func(int) error)
 	aichatsessionMixin := schema.AIChatSession{}.Mixin()
 	aichatsessionMixinFields0 := aichatsessionMixin[0].Fields()
 	_ = aichatsessionMixinFields0
@@ -168,6 +236,57 @@ func init() {
 	aimodelDescSort := aimodelFields[4].Descriptor()
 	// aimodel.DefaultSort holds the default value on creation for the sort field.
 	aimodel.DefaultSort = aimodelDescSort.Default.(int)
+	// aimodelDescContextWindow is the schema descriptor for context_window field.
+	aimodelDescContextWindow := aimodelFields[5].Descriptor()
+	// aimodel.DefaultContextWindow holds the default value on creation for the context_window field.
+	aimodel.DefaultContextWindow = aimodelDescContextWindow.Default.(int)
+	// aimodel.ContextWindowValidator is a validator for the "context_window" field. It is called by the builders before save.
+	aimodel.ContextWindowValidator = aimodelDescContextWindow.Validators[0].(func(int) error)
+	aipersonaMixin := schema.AIPersona{}.Mixin()
+	aipersonaMixinFields0 := aipersonaMixin[0].Fields()
+	_ = aipersonaMixinFields0
+	aipersonaFields := schema.AIPersona{}.Fields()
+	_ = aipersonaFields
+	// aipersonaDescCreatedAt is the schema descriptor for created_at field.
+	aipersonaDescCreatedAt := aipersonaMixinFields0[1].Descriptor()
+	// aipersona.DefaultCreatedAt holds the default value on creation for the created_at field.
+	aipersona.DefaultCreatedAt = aipersonaDescCreatedAt.Default.(func() time.Time)
+	// aipersonaDescUpdatedAt is the schema descriptor for updated_at field.
+	aipersonaDescUpdatedAt := aipersonaMixinFields0[2].Descriptor()
+	// aipersona.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	aipersona.DefaultUpdatedAt = aipersonaDescUpdatedAt.Default.(func() time.Time)
+	// aipersona.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	aipersona.UpdateDefaultUpdatedAt = aipersonaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// aipersonaDescUserID is the schema descriptor for user_id field.
+	aipersonaDescUserID := aipersonaFields[0].Descriptor()
+	// aipersona.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	aipersona.UserIDValidator = aipersonaDescUserID.Validators[0].(func(int) error)
+	// aipersonaDescName is the schema descriptor for name field.
+	aipersonaDescName := aipersonaFields[1].Descriptor()
+	// aipersona.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	aipersona.NameValidator = func() func(string) error {
+		validators := aipersonaDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// aipersonaDescDescription is the schema descriptor for description field.
+	aipersonaDescDescription := aipersonaFields[2].Descriptor()
+	// aipersona.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	aipersona.DescriptionValidator = aipersonaDescDescription.Validators[0].(func(string) error)
+	// aipersonaDescSystemPrompt is the schema descriptor for system_prompt field.
+	aipersonaDescSystemPrompt := aipersonaFields[3].Descriptor()
+	// aipersona.SystemPromptValidator is a validator for the "system_prompt" field. It is called by the builders before save.
+	aipersona.SystemPromptValidator = aipersonaDescSystemPrompt.Validators[0].(func(string) error)
 	aiproviderMixin := schema.AIProvider{}.Mixin()
 	aiproviderMixinFields0 := aiproviderMixin[0].Fields()
 	_ = aiproviderMixinFields0
@@ -237,42 +356,46 @@ func init() {
 			return nil
 		}
 	}()
+	// aiproviderDescEmbeddingModel is the schema descriptor for embedding_model field.
+	aiproviderDescEmbeddingModel := aiproviderFields[4].Descriptor()
+	// aiprovider.EmbeddingModelValidator is a validator for the "embedding_model" field. It is called by the builders before save.
+	aiprovider.EmbeddingModelValidator = aiproviderDescEmbeddingModel.Validators[0].(func(string) error)
 	// aiproviderDescTemperature is the schema descriptor for temperature field.
-	aiproviderDescTemperature := aiproviderFields[4].Descriptor()
+	aiproviderDescTemperature := aiproviderFields[5].Descriptor()
 	// aiprovider.DefaultTemperature holds the default value on creation for the temperature field.
 	aiprovider.DefaultTemperature = aiproviderDescTemperature.Default.(float64)
 	// aiproviderDescMaxTokens is the schema descriptor for max_tokens field.
-	aiproviderDescMaxTokens := aiproviderFields[5].Descriptor()
+	aiproviderDescMaxTokens := aiproviderFields[6].Descriptor()
 	// aiprovider.DefaultMaxTokens holds the default value on creation for the max_tokens field.
 	aiprovider.DefaultMaxTokens = aiproviderDescMaxTokens.Default.(int)
 	// aiprovider.MaxTokensValidator is a validator for the "max_tokens" field. It is called by the builders before save.
 	aiprovider.MaxTokensValidator = aiproviderDescMaxTokens.Validators[0].(func(int) error)
 	// aiproviderDescTopP is the schema descriptor for top_p field.
-	aiproviderDescTopP := aiproviderFields[6].Descriptor()
+	aiproviderDescTopP := aiproviderFields[7].Descriptor()
 	// aiprovider.DefaultTopP holds the default value on creation for the top_p field.
 	aiprovider.DefaultTopP = aiproviderDescTopP.Default.(float64)
 	// aiproviderDescFrequencyPenalty is the schema descriptor for frequency_penalty field.
-	aiproviderDescFrequencyPenalty := aiproviderFields[7].Descriptor()
+	aiproviderDescFrequencyPenalty := aiproviderFields[8].Descriptor()
 	// aiprovider.DefaultFrequencyPenalty holds the default value on creation for the frequency_penalty field.
 	aiprovider.DefaultFrequencyPenalty = aiproviderDescFrequencyPenalty.Default.(float64)
 	// aiproviderDescPresencePenalty is the schema descriptor for presence_penalty field.
-	aiproviderDescPresencePenalty := aiproviderFields[8].Descriptor()
+	aiproviderDescPresencePenalty := aiproviderFields[9].Descriptor()
 	// aiprovider.DefaultPresencePenalty holds the default value on creation for the presence_penalty field.
 	aiprovider.DefaultPresencePenalty = aiproviderDescPresencePenalty.Default.(float64)
 	// aiproviderDescIsDefault is the schema descriptor for is_default field.
-	aiproviderDescIsDefault := aiproviderFields[9].Descriptor()
+	aiproviderDescIsDefault := aiproviderFields[10].Descriptor()
 	// aiprovider.DefaultIsDefault holds the default value on creation for the is_default field.
 	aiprovider.DefaultIsDefault = aiproviderDescIsDefault.Default.(bool)
 	// aiproviderDescIsEnabled is the schema descriptor for is_enabled field.
-	aiproviderDescIsEnabled := aiproviderFields[10].Descriptor()
+	aiproviderDescIsEnabled := aiproviderFields[11].Descriptor()
 	// aiprovider.DefaultIsEnabled holds the default value on creation for the is_enabled field.
 	aiprovider.DefaultIsEnabled = aiproviderDescIsEnabled.Default.(bool)
 	// aiproviderDescSort is the schema descriptor for sort field.
-	aiproviderDescSort := aiproviderFields[11].Descriptor()
+	aiproviderDescSort := aiproviderFields[12].Descriptor()
 	// aiprovider.DefaultSort holds the default value on creation for the sort field.
 	aiprovider.DefaultSort = aiproviderDescSort.Default.(int)
 	// aiproviderDescRemark is the schema descriptor for remark field.
-	aiproviderDescRemark := aiproviderFields[12].Descriptor()
+	aiproviderDescRemark := aiproviderFields[13].Descriptor()
 	// aiprovider.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
 	aiprovider.RemarkValidator = aiproviderDescRemark.Validators[0].(func(string) error)
 	albumMixin := schema.Album{}.Mixin()
@@ -321,6 +444,27 @@ func init() {
 	albumphotoDescViewCount := albumphotoFields[3].Descriptor()
 	// albumphoto.DefaultViewCount holds the default value on creation for the view_count field.
 	albumphoto.DefaultViewCount = albumphotoDescViewCount.Default.(int)
+	cartitemMixin := schema.CartItem{}.Mixin()
+	cartitemMixinFields0 := cartitemMixin[0].Fields()
+	_ = cartitemMixinFields0
+	cartitemFields := schema.CartItem{}.Fields()
+	_ = cartitemFields
+	// cartitemDescCreatedAt is the schema descriptor for created_at field.
+	cartitemDescCreatedAt := cartitemMixinFields0[1].Descriptor()
+	// cartitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cartitem.DefaultCreatedAt = cartitemDescCreatedAt.Default.(func() time.Time)
+	// cartitemDescUpdatedAt is the schema descriptor for updated_at field.
+	cartitemDescUpdatedAt := cartitemMixinFields0[2].Descriptor()
+	// cartitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cartitem.DefaultUpdatedAt = cartitemDescUpdatedAt.Default.(func() time.Time)
+	// cartitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cartitem.UpdateDefaultUpdatedAt = cartitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// cartitemDescQuantity is the schema descriptor for quantity field.
+	cartitemDescQuantity := cartitemFields[2].Descriptor()
+	// cartitem.DefaultQuantity holds the default value on creation for the quantity field.
+	cartitem.DefaultQuantity = cartitemDescQuantity.Default.(int)
+	// cartitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	cartitem.QuantityValidator = cartitemDescQuantity.Validators[0].(func(int) error)
 	categoryMixin := schema.Category{}.Mixin()
 	categoryMixinFields0 := categoryMixin[0].Fields()
 	_ = categoryMixinFields0
@@ -403,20 +547,32 @@ func init() {
 			return nil
 		}
 	}()
+	// commentDescNick is the schema descriptor for nick field.
+	commentDescNick := commentFields[6].Descriptor()
+	// comment.NickValidator is a validator for the "nick" field. It is called by the builders before save.
+	comment.NickValidator = commentDescNick.Validators[0].(func(string) error)
+	// commentDescEmail is the schema descriptor for email field.
+	commentDescEmail := commentFields[7].Descriptor()
+	// comment.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	comment.EmailValidator = commentDescEmail.Validators[0].(func(string) error)
 	// commentDescStatus is the schema descriptor for status field.
-	commentDescStatus := commentFields[6].Descriptor()
+	commentDescStatus := commentFields[8].Descriptor()
 	// comment.DefaultStatus holds the default value on creation for the status field.
 	comment.DefaultStatus = commentDescStatus.Default.(int)
+	// commentDescModerationReason is the schema descriptor for moderation_reason field.
+	commentDescModerationReason := commentFields[9].Descriptor()
+	// comment.ModerationReasonValidator is a validator for the "moderation_reason" field. It is called by the builders before save.
+	comment.ModerationReasonValidator = commentDescModerationReason.Validators[0].(func(string) error)
 	// commentDescIPAddress is the schema descriptor for ip_address field.
-	commentDescIPAddress := commentFields[8].Descriptor()
+	commentDescIPAddress := commentFields[12].Descriptor()
 	// comment.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
 	comment.IPAddressValidator = commentDescIPAddress.Validators[0].(func(string) error)
 	// commentDescIPLocation is the schema descriptor for ip_location field.
-	commentDescIPLocation := commentFields[9].Descriptor()
+	commentDescIPLocation := commentFields[13].Descriptor()
 	// comment.IPLocationValidator is a validator for the "ip_location" field. It is called by the builders before save.
 	comment.IPLocationValidator = commentDescIPLocation.Validators[0].(func(string) error)
 	// commentDescPinned is the schema descriptor for pinned field.
-	commentDescPinned := commentFields[10].Descriptor()
+	commentDescPinned := commentFields[14].Descriptor()
 	// comment.DefaultPinned holds the default value on creation for the pinned field.
 	comment.DefaultPinned = commentDescPinned.Default.(bool)
 	couponMixin := schema.Coupon{}.Mixin()
@@ -830,6 +986,21 @@ func init() {
 	licenseDescStatus := licenseFields[4].Descriptor()
 	// license.DefaultStatus holds the default value on creation for the status field.
 	license.DefaultStatus = licenseDescStatus.Default.(int)
+	loginlogMixin := schema.LoginLog{}.Mixin()
+	loginlogMixinFields0 := loginlogMixin[0].Fields()
+	_ = loginlogMixinFields0
+	loginlogFields := schema.LoginLog{}.Fields()
+	_ = loginlogFields
+	// loginlogDescCreatedAt is the schema descriptor for created_at field.
+	loginlogDescCreatedAt := loginlogMixinFields0[1].Descriptor()
+	// loginlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginlog.DefaultCreatedAt = loginlogDescCreatedAt.Default.(func() time.Time)
+	// loginlogDescUpdatedAt is the schema descriptor for updated_at field.
+	loginlogDescUpdatedAt := loginlogMixinFields0[2].Descriptor()
+	// loginlog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginlog.DefaultUpdatedAt = loginlogDescUpdatedAt.Default.(func() time.Time)
+	// loginlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginlog.UpdateDefaultUpdatedAt = loginlogDescUpdatedAt.UpdateDefault.(func() time.Time)
 	memberMixin := schema.Member{}.Mixin()
 	memberMixinFields0 := memberMixin[0].Fields()
 	_ = memberMixinFields0
@@ -1070,21 +1241,7 @@ func init() {
 	// oauth2accesstokenDescRefreshToken is the schema descriptor for refresh_token field.
 	oauth2accesstokenDescRefreshToken := oauth2accesstokenFields[2].Descriptor()
 	// oauth2accesstoken.RefreshTokenValidator is a validator for the "refresh_token" field. It is called by the builders before save.
-	oauth2accesstoken.RefreshTokenValidator = func() func(string) error {
-		validators := oauth2accesstokenDescRefreshToken.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(refresh_token string) error {
-			for _, fn := range fns {
-				if err := fn(refresh_token); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
+	oauth2accesstoken.RefreshTokenValidator = oauth2accesstokenDescRefreshToken.Validators[0].(func(string) error)
 	// oauth2accesstokenDescClientID is the schema descriptor for client_id field.
 	oauth2accesstokenDescClientID := oauth2accesstokenFields[3].Descriptor()
 	// oauth2accesstoken.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
@@ -1121,6 +1278,73 @@ func init() {
 			return nil
 		}
 	}()
+	oauth2clientMixin := schema.Oauth2Client{}.Mixin()
+	oauth2clientMixinFields0 := oauth2clientMixin[0].Fields()
+	_ = oauth2clientMixinFields0
+	oauth2clientFields := schema.Oauth2Client{}.Fields()
+	_ = oauth2clientFields
+	// oauth2clientDescCreatedAt is the schema descriptor for created_at field.
+	oauth2clientDescCreatedAt := oauth2clientMixinFields0[1].Descriptor()
+	// oauth2client.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauth2client.DefaultCreatedAt = oauth2clientDescCreatedAt.Default.(func() time.Time)
+	// oauth2clientDescUpdatedAt is the schema descriptor for updated_at field.
+	oauth2clientDescUpdatedAt := oauth2clientMixinFields0[2].Descriptor()
+	// oauth2client.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauth2client.DefaultUpdatedAt = oauth2clientDescUpdatedAt.Default.(func() time.Time)
+	// oauth2client.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauth2client.UpdateDefaultUpdatedAt = oauth2clientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauth2clientDescClientID is the schema descriptor for client_id field.
+	oauth2clientDescClientID := oauth2clientFields[0].Descriptor()
+	// oauth2client.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauth2client.ClientIDValidator = func() func(string) error {
+		validators := oauth2clientDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauth2clientDescName is the schema descriptor for name field.
+	oauth2clientDescName := oauth2clientFields[2].Descriptor()
+	// oauth2client.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauth2client.NameValidator = func() func(string) error {
+		validators := oauth2clientDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauth2clientDescDescription is the schema descriptor for description field.
+	oauth2clientDescDescription := oauth2clientFields[3].Descriptor()
+	// oauth2client.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	oauth2client.DescriptionValidator = oauth2clientDescDescription.Validators[0].(func(string) error)
+	// oauth2clientDescLogo is the schema descriptor for logo field.
+	oauth2clientDescLogo := oauth2clientFields[4].Descriptor()
+	// oauth2client.LogoValidator is a validator for the "logo" field. It is called by the builders before save.
+	oauth2client.LogoValidator = oauth2clientDescLogo.Validators[0].(func(string) error)
+	// oauth2clientDescPublic is the schema descriptor for public field.
+	oauth2clientDescPublic := oauth2clientFields[7].Descriptor()
+	// oauth2client.DefaultPublic holds the default value on creation for the public field.
+	oauth2client.DefaultPublic = oauth2clientDescPublic.Default.(bool)
+	// oauth2clientDescEnabled is the schema descriptor for enabled field.
+	oauth2clientDescEnabled := oauth2clientFields[8].Descriptor()
+	// oauth2client.DefaultEnabled holds the default value on creation for the enabled field.
+	oauth2client.DefaultEnabled = oauth2clientDescEnabled.Default.(bool)
 	oauth2codeMixin := schema.Oauth2Code{}.Mixin()
 	oauth2codeMixinFields0 := oauth2codeMixin[0].Fields()
 	_ = oauth2codeMixinFields0
@@ -1208,6 +1432,18 @@ func init() {
 			return nil
 		}
 	}()
+	// oauth2codeDescCodeChallenge is the schema descriptor for code_challenge field.
+	oauth2codeDescCodeChallenge := oauth2codeFields[6].Descriptor()
+	// oauth2code.CodeChallengeValidator is a validator for the "code_challenge" field. It is called by the builders before save.
+	oauth2code.CodeChallengeValidator = oauth2codeDescCodeChallenge.Validators[0].(func(string) error)
+	// oauth2codeDescCodeChallengeMethod is the schema descriptor for code_challenge_method field.
+	oauth2codeDescCodeChallengeMethod := oauth2codeFields[7].Descriptor()
+	// oauth2code.CodeChallengeMethodValidator is a validator for the "code_challenge_method" field. It is called by the builders before save.
+	oauth2code.CodeChallengeMethodValidator = oauth2codeDescCodeChallengeMethod.Validators[0].(func(string) error)
+	// oauth2codeDescNonce is the schema descriptor for nonce field.
+	oauth2codeDescNonce := oauth2codeFields[8].Descriptor()
+	// oauth2code.NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
+	oauth2code.NonceValidator = oauth2codeDescNonce.Validators[0].(func(string) error)
 	oauth2refreshtokenMixin := schema.Oauth2RefreshToken{}.Mixin()
 	oauth2refreshtokenMixinFields0 := oauth2refreshtokenMixin[0].Fields()
 	_ = oauth2refreshtokenMixinFields0
@@ -1277,6 +1513,33 @@ func init() {
 			return nil
 		}
 	}()
+	orderdeliveryMixin := schema.OrderDelivery{}.Mixin()
+	orderdeliveryMixinFields0 := orderdeliveryMixin[0].Fields()
+	_ = orderdeliveryMixinFields0
+	orderdeliveryFields := schema.OrderDelivery{}.Fields()
+	_ = orderdeliveryFields
+	// orderdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	orderdeliveryDescCreatedAt := orderdeliveryMixinFields0[1].Descriptor()
+	// orderdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderdelivery.DefaultCreatedAt = orderdeliveryDescCreatedAt.Default.(func() time.Time)
+	// orderdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	orderdeliveryDescUpdatedAt := orderdeliveryMixinFields0[2].Descriptor()
+	// orderdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	orderdelivery.DefaultUpdatedAt = orderdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// orderdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	orderdelivery.UpdateDefaultUpdatedAt = orderdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orderdeliveryDescName is the schema descriptor for name field.
+	orderdeliveryDescName := orderdeliveryFields[5].Descriptor()
+	// orderdelivery.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	orderdelivery.NameValidator = orderdeliveryDescName.Validators[0].(func(string) error)
+	// orderdeliveryDescStatus is the schema descriptor for status field.
+	orderdeliveryDescStatus := orderdeliveryFields[9].Descriptor()
+	// orderdelivery.DefaultStatus holds the default value on creation for the status field.
+	orderdelivery.DefaultStatus = orderdeliveryDescStatus.Default.(int)
+	// orderdeliveryDescDownloadCount is the schema descriptor for download_count field.
+	orderdeliveryDescDownloadCount := orderdeliveryFields[10].Descriptor()
+	// orderdelivery.DefaultDownloadCount holds the default value on creation for the download_count field.
+	orderdelivery.DefaultDownloadCount = orderdeliveryDescDownloadCount.Default.(int)
 	payorderMixin := schema.PayOrder{}.Mixin()
 	payorderMixinFields0 := payorderMixin[0].Fields()
 	_ = payorderMixinFields0
@@ -1300,6 +1563,33 @@ func init() {
 	payorderDescPointsGranted := payorderFields[23].Descriptor()
 	// payorder.DefaultPointsGranted holds the default value on creation for the points_granted field.
 	payorder.DefaultPointsGranted = payorderDescPointsGranted.Default.(int)
+	payorderitemMixin := schema.PayOrderItem{}.Mixin()
+	payorderitemMixinFields0 := payorderitemMixin[0].Fields()
+	_ = payorderitemMixinFields0
+	payorderitemFields := schema.PayOrderItem{}.Fields()
+	_ = payorderitemFields
+	// payorderitemDescCreatedAt is the schema descriptor for created_at field.
+	payorderitemDescCreatedAt := payorderitemMixinFields0[1].Descriptor()
+	// payorderitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payorderitem.DefaultCreatedAt = payorderitemDescCreatedAt.Default.(func() time.Time)
+	// payorderitemDescUpdatedAt is the schema descriptor for updated_at field.
+	payorderitemDescUpdatedAt := payorderitemMixinFields0[2].Descriptor()
+	// payorderitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payorderitem.DefaultUpdatedAt = payorderitemDescUpdatedAt.Default.(func() time.Time)
+	// payorderitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payorderitem.UpdateDefaultUpdatedAt = payorderitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// payorderitemDescProductName is the schema descriptor for product_name field.
+	payorderitemDescProductName := payorderitemFields[2].Descriptor()
+	// payorderitem.ProductNameValidator is a validator for the "product_name" field. It is called by the builders before save.
+	payorderitem.ProductNameValidator = payorderitemDescProductName.Validators[0].(func(string) error)
+	// payorderitemDescSku is the schema descriptor for sku field.
+	payorderitemDescSku := payorderitemFields[3].Descriptor()
+	// payorderitem.SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	payorderitem.SkuValidator = payorderitemDescSku.Validators[0].(func(string) error)
+	// payorderitemDescQuantity is the schema descriptor for quantity field.
+	payorderitemDescQuantity := payorderitemFields[4].Descriptor()
+	// payorderitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	payorderitem.QuantityValidator = payorderitemDescQuantity.Validators[0].(func(int) error)
 	personalaccesstokenMixin := schema.PersonalAccessToken{}.Mixin()
 	personalaccesstokenMixinFields0 := personalaccesstokenMixin[0].Fields()
 	_ = personalaccesstokenMixinFields0
@@ -1679,6 +1969,80 @@ func init() {
 	productDescSortOrder := productFields[24].Descriptor()
 	// product.DefaultSortOrder holds the default value on creation for the sort_order field.
 	product.DefaultSortOrder = productDescSortOrder.Default.(int)
+	productdeliverableMixin := schema.ProductDeliverable{}.Mixin()
+	productdeliverableMixinFields0 := productdeliverableMixin[0].Fields()
+	_ = productdeliverableMixinFields0
+	productdeliverableFields := schema.ProductDeliverable{}.Fields()
+	_ = productdeliverableFields
+	// productdeliverableDescCreatedAt is the schema descriptor for created_at field.
+	productdeliverableDescCreatedAt := productdeliverableMixinFields0[1].Descriptor()
+	// productdeliverable.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productdeliverable.DefaultCreatedAt = productdeliverableDescCreatedAt.Default.(func() time.Time)
+	// productdeliverableDescUpdatedAt is the schema descriptor for updated_at field.
+	productdeliverableDescUpdatedAt := productdeliverableMixinFields0[2].Descriptor()
+	// productdeliverable.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productdeliverable.DefaultUpdatedAt = productdeliverableDescUpdatedAt.Default.(func() time.Time)
+	// productdeliverable.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productdeliverable.UpdateDefaultUpdatedAt = productdeliverableDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productdeliverableDescName is the schema descriptor for name field.
+	productdeliverableDescName := productdeliverableFields[2].Descriptor()
+	// productdeliverable.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	productdeliverable.NameValidator = func() func(string) error {
+		validators := productdeliverableDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productdeliverableDescSortOrder is the schema descriptor for sort_order field.
+	productdeliverableDescSortOrder := productdeliverableFields[5].Descriptor()
+	// productdeliverable.DefaultSortOrder holds the default value on creation for the sort_order field.
+	productdeliverable.DefaultSortOrder = productdeliverableDescSortOrder.Default.(int)
+	productkeyMixin := schema.ProductKey{}.Mixin()
+	productkeyMixinFields0 := productkeyMixin[0].Fields()
+	_ = productkeyMixinFields0
+	productkeyFields := schema.ProductKey{}.Fields()
+	_ = productkeyFields
+	// productkeyDescCreatedAt is the schema descriptor for created_at field.
+	productkeyDescCreatedAt := productkeyMixinFields0[1].Descriptor()
+	// productkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productkey.DefaultCreatedAt = productkeyDescCreatedAt.Default.(func() time.Time)
+	// productkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	productkeyDescUpdatedAt := productkeyMixinFields0[2].Descriptor()
+	// productkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productkey.DefaultUpdatedAt = productkeyDescUpdatedAt.Default.(func() time.Time)
+	// productkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productkey.UpdateDefaultUpdatedAt = productkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productkeyDescCode is the schema descriptor for code field.
+	productkeyDescCode := productkeyFields[1].Descriptor()
+	// productkey.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	productkey.CodeValidator = func() func(string) error {
+		validators := productkeyDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productkeyDescStatus is the schema descriptor for status field.
+	productkeyDescStatus := productkeyFields[2].Descriptor()
+	// productkey.DefaultStatus holds the default value on creation for the status field.
+	productkey.DefaultStatus = productkeyDescStatus.Default.(int)
 	refreshtokenMixin := schema.RefreshToken{}.Mixin()
 	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
 	_ = refreshtokenMixinFields0
@@ -1761,6 +2125,10 @@ func init() {
 	roleDescIsDefault := roleFields[3].Descriptor()
 	// role.DefaultIsDefault holds the default value on creation for the is_default field.
 	role.DefaultIsDefault = roleDescIsDefault.Default.(bool)
+	// roleDescRequireTwoFactor is the schema descriptor for require_two_factor field.
+	roleDescRequireTwoFactor := roleFields[5].Descriptor()
+	// role.DefaultRequireTwoFactor holds the default value on creation for the require_two_factor field.
+	role.DefaultRequireTwoFactor = roleDescRequireTwoFactor.Default.(bool)
 	schedulejobMixin := schema.ScheduleJob{}.Mixin()
 	schedulejobMixinFields0 := schedulejobMixin[0].Fields()
 	_ = schedulejobMixinFields0
@@ -2101,20 +2469,24 @@ func init() {
 	userDescEmailVerified := userFields[1].Descriptor()
 	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
 	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescEmailVerifyRequired is the schema descriptor for email_verify_required field.
+	userDescEmailVerifyRequired := userFields[2].Descriptor()
+	// user.DefaultEmailVerifyRequired holds the default value on creation for the email_verify_required field.
+	user.DefaultEmailVerifyRequired = userDescEmailVerifyRequired.Default.(bool)
 	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[2].Descriptor()
+	userDescName := userFields[3].Descriptor()
 	// user.DefaultName holds the default value on creation for the name field.
 	user.DefaultName = userDescName.Default.(string)
 	// userDescPhoneNumber is the schema descriptor for phone_number field.
-	userDescPhoneNumber := userFields[3].Descriptor()
+	userDescPhoneNumber := userFields[4].Descriptor()
 	// user.PhoneNumberValidator is a validator for the "phone_number" field. It is called by the builders before save.
 	user.PhoneNumberValidator = userDescPhoneNumber.Validators[0].(func(string) error)
 	// userDescPhoneNumberVerified is the schema descriptor for phone_number_verified field.
-	userDescPhoneNumberVerified := userFields[4].Descriptor()
+	userDescPhoneNumberVerified := userFields[5].Descriptor()
 	// user.DefaultPhoneNumberVerified holds the default value on creation for the phone_number_verified field.
 	user.DefaultPhoneNumberVerified = userDescPhoneNumberVerified.Default.(bool)
 	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[5].Descriptor()
+	userDescPassword := userFields[6].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = func() func(string) error {
 		validators := userDescPassword.Validators
@@ -2132,13 +2504,96 @@ func init() {
 		}
 	}()
 	// userDescNickname is the schema descriptor for nickname field.
-	userDescNickname := userFields[7].Descriptor()
+	userDescNickname := userFields[8].Descriptor()
 	// user.NicknameValidator is a validator for the "nickname" field. It is called by the builders before save.
 	user.NicknameValidator = userDescNickname.Validators[0].(func(string) error)
 	// userDescBio is the schema descriptor for bio field.
-	userDescBio := userFields[8].Descriptor()
+	userDescBio := userFields[9].Descriptor()
 	// user.BioValidator is a validator for the "bio" field. It is called by the builders before save.
 	user.BioValidator = userDescBio.Validators[0].(func(string) error)
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[10].Descriptor()
+	// user.TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	user.TotpSecretValidator = userDescTotpSecret.Validators[0].(func(string) error)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[11].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[12].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	useridentityMixin := schema.UserIdentity{}.Mixin()
+	useridentityMixinFields0 := useridentityMixin[0].Fields()
+	_ = useridentityMixinFields0
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityMixinFields0[1].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
+	useridentityDescUpdatedAt := useridentityMixinFields0[2].Descriptor()
+	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() time.Time)
+	// useridentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useridentity.UpdateDefaultUpdatedAt = useridentityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// useridentityDescUserID is the schema descriptor for user_id field.
+	useridentityDescUserID := useridentityFields[0].Descriptor()
+	// useridentity.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	useridentity.UserIDValidator = useridentityDescUserID.Validators[0].(func(int) error)
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[1].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = func() func(string) error {
+		validators := useridentityDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[2].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = func() func(string) error {
+		validators := useridentityDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[3].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
+	// useridentityDescName is the schema descriptor for name field.
+	useridentityDescName := useridentityFields[4].Descriptor()
+	// useridentity.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	useridentity.NameValidator = useridentityDescName.Validators[0].(func(string) error)
+	// useridentityDescAvatar is the schema descriptor for avatar field.
+	useridentityDescAvatar := useridentityFields[5].Descriptor()
+	// useridentity.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
+	useridentity.AvatarValidator = useridentityDescAvatar.Validators[0].(func(string) error)
+	// useridentityDescProvisioned is the schema descriptor for provisioned field.
+	useridentityDescProvisioned := useridentityFields[6].Descriptor()
+	// useridentity.DefaultProvisioned holds the default value on creation for the provisioned field.
+	useridentity.DefaultProvisioned = useridentityDescProvisioned.Default.(bool)
 	visitlogMixin := schema.VisitLog{}.Mixin()
 	visitlogMixinFields0 := visitlogMixin[0].Fields()
 	_ = visitlogMixinFields0
@@ -2248,22 +2703,43 @@ func init() {
 			return nil
 		}
 	}()
-	// webhookDescEvent is the schema descriptor for event field.
-	webhookDescEvent := webhookFields[2].Descriptor()
-	// webhook.EventValidator is a validator for the "event" field. It is called by the builders before save.
-	webhook.EventValidator = func() func(string) error {
-		validators := webhookDescEvent.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(event string) error {
-			for _, fn := range fns {
-				if err := fn(event); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	webhookdeliveryMixin := schema.WebHookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebHookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[2].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescWebhookID is the schema descriptor for webhook_id field.
+	webhookdeliveryDescWebhookID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.WebhookIDValidator is a validator for the "webhook_id" field. It is called by the builders before save.
+	webhookdelivery.WebhookIDValidator = webhookdeliveryDescWebhookID.Validators[0].(func(int) error)
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
 }
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AIAskLog is one anonymous "ask this blog" question and its answer, kept for
+// review and for enforcing the daily token budgets.
+type AIAskLog struct {
+	ent.Schema
+}
+
+func (AIAskLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (AIAskLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("question").NotEmpty().Comment("访客问题"),
+		field.Text("answer").Optional().Comment("AI 回答"),
+		field.JSON("citations", []AIChatCitation{}).Optional().Comment("回答引用的文章"),
+		field.Enum("status").Values("success", "failed").Comment("回答结果"),
+		field.String("error").Optional().MaxLen(1024).Comment("失败原因"),
+		field.String("model").Optional().MaxLen(255).Comment("生成回答的模型"),
+		field.Int("prompt_tokens").NonNegative().Default(0).Comment("提示词令牌数"),
+		field.Int("completion_tokens").NonNegative().Default(0).Comment("回答令牌数"),
+		field.Int("total_tokens").NonNegative().Default(0).Comment("总令牌数，用于每日预算统计"),
+		field.String("ip").Optional().Comment("访客IP"),
+		field.String("user_agent").Optional().Comment("用户代理"),
+	}
+}
+
+func (AIAskLog) Edges() []ent.Edge {
+	return nil
+}
+
+func (AIAskLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("ip", "created_at"),
+	}
+}
@@ -6,7 +6,18 @@ import (
 	"entgo.io/ent/schema/field"
 )
 
-// AIChatMessage is one persisted user or completed assistant message.
+// AIChatCitation is a site content source referenced by a knowledge-mode answer.
+type AIChatCitation struct {
+	Kind     string `json:"kind"`
+	SourceID int    `json:"source_id"`
+	Title    string `json:"title"`
+	Slug     string `json:"slug,omitempty"`
+}
+
+// AIChatMessage is one persisted user or completed assistant message, or the
+// summary of the earlier turns that no longer fit the context budget. A
+// summary covers every turn up to and including SummaryUntil and only the
+// latest one is kept per session.
 type AIChatMessage struct {
 	ent.Schema
 }
@@ -20,9 +31,13 @@ func (AIChatMessage) Mixin() []ent.Mixin {
 func (AIChatMessage) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("session_id").Positive().Comment("会话 ID"),
-		field.Enum("role").Values("user", "assistant").Comment("消息角色"),
+		field.Enum("role").Values("user", "assistant", "summary").Comment("消息角色"),
 		field.Text("content").NotEmpty().Comment("消息内容"),
 		field.String("model").Optional().MaxLen(255).Comment("生成该回复的模型"),
+		field.JSON("citations", []AIChatCitation{}).Optional().Comment("站点知识模式下回答引用的内容来源"),
+		field.Int("prompt_tokens").Default(0).NonNegative().Comment("生成该回复时提交的令牌数，提供商未报告时为估算值"),
+		field.Int("completion_tokens").Default(0).NonNegative().Comment("该回复的令牌数，提供商未报告时为估算值"),
+		field.Int("summary_until").Optional().Nillable().Comment("摘要覆盖到的最后一条消息 ID"),
 	}
 }
 
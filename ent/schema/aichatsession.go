@@ -6,7 +6,9 @@ import (
 	"entgo.io/ent/schema/field"
 )
 
-// AIChatSession belongs to exactly one authenticated user.
+// AIChatSession belongs to exactly one authenticated user. The model and
+// persona are plain IDs rather than edges, so deleting or re-syncing a model
+// only makes the session fall back to the default.
 type AIChatSession struct {
 	ent.Schema
 }
@@ -21,6 +23,11 @@ func (AIChatSession) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("user_id").Positive().Comment("所属用户 ID"),
 		field.String("title").NotEmpty().MaxLen(255).Default("新对话").Comment("会话标题"),
+		field.Int("model_id").Optional().Nillable().Comment("选用的模型 ID，为空或模型不可用时使用默认模型"),
+		field.Int("persona_id").Optional().Nillable().Comment("绑定的人设 ID"),
+		field.Text("system_prompt").Optional().Comment("会话系统提示词，优先于人设"),
+		field.Float("temperature").Optional().Nillable().Comment("温度覆盖值"),
+		field.Int("max_tokens").Optional().Nillable().Comment("最大令牌数覆盖值"),
 	}
 }
 
@@ -36,6 +36,10 @@ func (AIModel) Fields() []ent.Field {
 		field.Int("sort").
 			Default(0).
 			Comment("排序值，越小越靠前"),
+		field.Int("context_window").
+			Default(0).
+			NonNegative().
+			Comment("上下文窗口令牌数，0 表示只使用全局上下文预算"),
 	}
 }
 
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AIPersona is a reusable assistant persona of one user: a system prompt with
+// optional sampling overrides that chat sessions can be bound to.
+type AIPersona struct {
+	ent.Schema
+}
+
+func (AIPersona) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (AIPersona) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Positive().Comment("所属用户 ID"),
+		field.String("name").NotEmpty().MaxLen(64).Comment("人设名称"),
+		field.String("description").Optional().MaxLen(255).Comment("人设说明"),
+		field.Text("system_prompt").NotEmpty().Comment("系统提示词"),
+		field.Float("temperature").Optional().Nillable().Comment("温度，为空时使用提供商设置"),
+		field.Int("max_tokens").Optional().Nillable().Comment("最大令牌数，为空时使用提供商设置"),
+	}
+}
+
+func (AIPersona) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("ai_personas").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
@@ -37,6 +37,10 @@ func (AIProvider) Fields() []ent.Field {
 			Optional().
 			Sensitive().
 			Comment("AES-GCM 加密后的供应商 API Key，本地服务可留空"),
+		field.String("embedding_model").
+			Optional().
+			MaxLen(255).
+			Comment("向量嵌入模型名称（如 text-embedding-3-small），为空时该提供商不用于站点知识库"),
 		field.Float("temperature").
 			Default(0.7).
 			Comment("温度"),
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// 购物车商品
+type CartItem struct {
+	ent.Schema
+}
+
+func (CartItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the CartItem.
+func (CartItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Comment("用户ID"),
+		field.Int("product_id").
+			Comment("商品ID"),
+		field.Int("quantity").
+			Positive().
+			Default(1).
+			Comment("数量"),
+	}
+}
+
+// Edges of the CartItem.
+func (CartItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id").
+			Comment("用户"),
+		edge.To("product", Product.Type).
+			Unique().
+			Required().
+			Field("product_id").
+			Comment("商品"),
+	}
+}
+
+// Indexes of the CartItem.
+func (CartItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "product_id").Unique(),
+	}
+}
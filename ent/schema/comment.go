@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // 评论
@@ -25,7 +26,11 @@ func (Comment) Fields() []ent.Field {
 		field.Int("parent_id").Optional().Nillable().Comment("父评论ID"),
 		field.String("content").NotEmpty().MaxLen(1024).Comment("评论内容"),
 		field.Int("user_id").Optional().Nillable().Comment("评论者用户ID"),
-		field.Int("status").Default(2).Comment("状态(1未审核,2已审核)"),
+		field.String("nick").Optional().MaxLen(64).Comment("评论者昵称"),
+		field.String("email").Optional().MaxLen(255).Comment("评论者邮箱"),
+		field.Int("status").Default(2).Comment("状态(1待审核,2已通过,3已拒绝)"),
+		field.String("moderation_reason").Optional().MaxLen(1024).Comment("审核原因"),
+		field.Float("spam_score").Optional().Nillable().Comment("AI垃圾评论评分(0-1)"),
 		field.String("user_agent").Optional().Nillable().Comment("评论者用户代理"),
 		field.String("ip_address").MaxLen(45).Comment("评论者IP地址"),
 		field.String("ip_location").Optional().Nillable().MaxLen(255).Comment("评论者IP位置"),
@@ -37,3 +42,10 @@ func (Comment) Fields() []ent.Field {
 func (Comment) Edges() []ent.Edge {
 	return nil
 }
+
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("ip_address", "created_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginLog 登录审计日志
+type LoginLog struct {
+	ent.Schema
+}
+
+func (LoginLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the LoginLog.
+func (LoginLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Optional().Nillable().Comment("用户ID，账号不存在时为空"),
+		field.String("email").Optional().Comment("登录时填写的邮箱"),
+		field.Enum("method").Values("password", "social", "two_factor").Comment("登录方式"),
+		field.Enum("outcome").Values("success", "failure", "locked", "challenge").Comment("登录结果"),
+		field.String("reason").Optional().Comment("失败原因"),
+		field.String("ip").Optional().Comment("登录IP"),
+		field.String("user_agent").Optional().Comment("用户代理"),
+		field.String("browser").Optional().Comment("浏览器"),
+		field.String("os").Optional().Comment("操作系统"),
+	}
+}
+
+// Edges of the LoginLog.
+func (LoginLog) Edges() []ent.Edge {
+	return nil
+}
+
+func (LoginLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("ip"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuth2 客户端
+type Oauth2Client struct {
+	ent.Schema
+}
+
+func (Oauth2Client) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Oauth2Client.
+func (Oauth2Client) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("client_id").NotEmpty().Unique().Immutable().MaxLen(64).Comment("客户端ID"),
+		field.String("client_secret_hash").Optional().Sensitive().Comment("客户端密钥 SHA-256 哈希,公开客户端为空"),
+		field.String("name").NotEmpty().MaxLen(100).Comment("名称"),
+		field.String("description").Optional().MaxLen(255).Comment("描述"),
+		field.String("logo").Optional().MaxLen(255).Comment("图标地址"),
+		field.JSON("redirect_uris", []string{}).Comment("允许的回调地址"),
+		field.JSON("scopes", []string{}).Comment("允许申请的授权范围"),
+		field.Bool("public").Default(false).Immutable().Comment("是否公开客户端,公开客户端没有密钥且必须使用 PKCE"),
+		field.Bool("enabled").Default(true).Comment("是否启用"),
+	}
+}
+
+// Edges of the Oauth2Client.
+func (Oauth2Client) Edges() []ent.Edge {
+	return nil
+}
@@ -20,11 +20,15 @@ func (Oauth2Code) Mixin() []ent.Mixin {
 func (Oauth2Code) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("user_id").Comment("用户ID"),
-		field.String("code").NotEmpty().MaxLen(32).Comment("授权码"),
+		field.String("code").NotEmpty().Unique().MaxLen(64).Sensitive().Comment("授权码 SHA-256 哈希"),
 		field.Time("expire_at").Comment("过期时间"),
 		field.String("client_id").NotEmpty().MaxLen(255).Comment("客户端ID"),
 		field.String("redirect_uri").NotEmpty().MaxLen(255).Comment("重定向URI"),
-		field.String("scope").NotEmpty().MaxLen(255).Comment("授权范围"),
+		field.String("scope").NotEmpty().MaxLen(1024).Comment("授权范围,空格分隔"),
+		field.String("code_challenge").Optional().MaxLen(128).Comment("PKCE 挑战码"),
+		field.String("code_challenge_method").Optional().MaxLen(10).Comment("PKCE 挑战方式"),
+		field.String("nonce").Optional().MaxLen(255).Comment("OIDC nonce"),
+		field.Time("auth_time").Optional().Comment("用户授权时间"),
 	}
 }
 
@@ -20,9 +20,10 @@ func (Oauth2RefreshToken) Mixin() []ent.Mixin {
 func (Oauth2RefreshToken) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("user_id").Comment("用户ID"),
-		field.String("refresh_token").NotEmpty().MaxLen(32).Comment("刷新令牌"),
+		field.String("refresh_token").NotEmpty().Unique().MaxLen(64).Sensitive().Comment("刷新令牌 SHA-256 哈希"),
 		field.String("client_id").NotEmpty().MaxLen(255).Comment("客户端ID"),
-		field.String("scope").NotEmpty().MaxLen(255).Comment("授权范围"),
+		field.String("scope").NotEmpty().MaxLen(1024).Comment("授权范围,空格分隔"),
+		field.Time("auth_time").Optional().Comment("用户授权时间"),
 		field.Time("expire_at").Comment("过期时间"),
 	}
 }
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// 数字商品交付记录
+type OrderDelivery struct {
+	ent.Schema
+}
+
+func (OrderDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the OrderDelivery.
+func (OrderDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("order_id").
+			Comment("支付订单ID"),
+		field.Int("user_id").
+			Comment("购买用户"),
+		field.Int("product_id").
+			Comment("商品ID"),
+		field.Int("deliverable_id").
+			Optional().
+			Comment("交付内容ID"),
+		field.Enum("type").
+			Values("file", "key", "text").
+			Comment("交付类型: file-文件 key-卡密 text-文本"),
+		field.String("name").
+			MaxLen(255).
+			Comment("交付名称"),
+		field.Int("file_id").
+			Optional().
+			Comment("文件ID"),
+		field.Int("key_id").
+			Optional().
+			Comment("分配的卡密ID"),
+		field.Text("content").
+			Optional().
+			Comment("交付时的卡密或文本内容"),
+		field.Int("status").
+			Default(1).
+			Comment("状态: 1-已交付 2-待补货 3-已撤销"),
+		field.Int("download_count").
+			Default(0).
+			Comment("下载次数"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("撤销时间"),
+	}
+}
+
+// Edges of the OrderDelivery.
+func (OrderDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("order", PayOrder.Type).
+			Unique().
+			Required().
+			Field("order_id").
+			Comment("支付订单"),
+	}
+}
+
+// Indexes of the OrderDelivery.
+func (OrderDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id"),
+		index.Fields("user_id"),
+	}
+}
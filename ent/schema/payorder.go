@@ -11,6 +11,18 @@ type PayOrder struct {
 	ent.Schema
 }
 
+// PayOrderPriceDetail 订单金额明细，由服务端计算，金额单位为分
+type PayOrderPriceDetail struct {
+	OriginalAmount     int    `json:"original_amount"`             // 原价
+	MemberLevelID      int    `json:"member_level_id,omitempty"`   // 会员等级ID
+	MemberLevelName    string `json:"member_level_name,omitempty"` // 会员等级名称
+	MemberDiscountRate int    `json:"member_discount_rate"`        // 会员折扣率(百分比)
+	MemberDiscount     int    `json:"member_discount"`             // 会员优惠金额
+	CouponCode         string `json:"coupon_code,omitempty"`       // 优惠券代码
+	CouponDiscount     int    `json:"coupon_discount"`             // 优惠券优惠金额
+	PayAmount          int    `json:"pay_amount"`                  // 应付金额
+}
+
 func (PayOrder) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		BaseMixin{},
@@ -102,6 +114,9 @@ func (PayOrder) Fields() []ent.Field {
 			Optional().
 			Default(0).
 			Comment("充值发放的积分数量"),
+		field.JSON("price_detail", &PayOrderPriceDetail{}).
+			Optional().
+			Comment("金额明细"),
 	}
 }
 
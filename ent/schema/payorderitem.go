@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// 支付订单商品明细
+type PayOrderItem struct {
+	ent.Schema
+}
+
+func (PayOrderItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the PayOrderItem.
+func (PayOrderItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("order_id").
+			Comment("支付订单ID"),
+		field.Int("product_id").
+			Comment("商品ID"),
+		field.String("product_name").
+			MaxLen(255).
+			Comment("下单时的商品名称"),
+		field.String("sku").
+			Optional().
+			MaxLen(100).
+			Comment("下单时的商品SKU"),
+		field.Int("quantity").
+			Positive().
+			Comment("数量"),
+		field.Int("unit_price").
+			Comment("下单时的单价(分)"),
+		field.Int("total_price").
+			Comment("小计(分)"),
+	}
+}
+
+// Edges of the PayOrderItem.
+func (PayOrderItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("order", PayOrder.Type).
+			Unique().
+			Required().
+			Field("order_id").
+			Comment("支付订单"),
+		edge.To("product", Product.Type).
+			Unique().
+			Required().
+			Field("product_id").
+			Comment("商品"),
+	}
+}
+
+// Indexes of the PayOrderItem.
+func (PayOrderItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id"),
+	}
+}
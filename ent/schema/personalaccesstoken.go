@@ -22,8 +22,13 @@ func (PersonalAccessToken) Fields() []ent.Field {
 		field.String("name").NotEmpty().Unique().Comment("名称"),
 		field.Time("expires").Optional().Comment("过期时间"),
 		field.String("description").Optional().Comment("描述"),
-		field.String("token"),
+		field.String("token").Optional().Sensitive().Comment("旧版明文令牌,启动时迁移为哈希后清空"),
+		field.String("token_hash").Optional().Unique().Sensitive().Comment("令牌 SHA-256 哈希"),
+		field.String("token_prefix").Optional().Comment("令牌前缀,用于辨识令牌"),
 		field.Int("user_id"),
+		field.JSON("scopes", []string{}).Optional().Comment("权限范围,旧版令牌为空时继承用户角色的全部权限"),
+		field.Time("last_used_at").Optional().Nillable().Comment("最后使用时间"),
+		field.String("last_used_ip").Optional().Comment("最后使用 IP"),
 	}
 }
 
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// 数字商品交付内容
+type ProductDeliverable struct {
+	ent.Schema
+}
+
+func (ProductDeliverable) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the ProductDeliverable.
+func (ProductDeliverable) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id").
+			Comment("商品ID"),
+		field.Enum("type").
+			Values("file", "key", "text").
+			Comment("交付类型: file-文件 key-卡密 text-文本"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("交付名称"),
+		field.Int("file_id").
+			Optional().
+			Comment("文件ID，文件交付时使用"),
+		field.Text("content").
+			Optional().
+			Comment("文本内容，文本交付时使用"),
+		field.Int("sort_order").
+			Default(0).
+			Comment("排序"),
+	}
+}
+
+// Edges of the ProductDeliverable.
+func (ProductDeliverable) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("product", Product.Type).
+			Unique().
+			Required().
+			Field("product_id").
+			Comment("商品"),
+		edge.To("file", File.Type).
+			Unique().
+			Field("file_id").
+			Comment("交付文件"),
+	}
+}
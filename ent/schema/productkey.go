@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// 数字商品卡密库
+type ProductKey struct {
+	ent.Schema
+}
+
+func (ProductKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the ProductKey.
+func (ProductKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id").
+			Comment("商品ID"),
+		field.String("code").
+			NotEmpty().
+			MaxLen(512).
+			Comment("卡密"),
+		field.Int("status").
+			Default(0).
+			Comment("状态: 0-未分配 1-已分配 2-已作废"),
+		field.Int("order_id").
+			Optional().
+			Comment("分配的订单ID"),
+		field.Int("user_id").
+			Optional().
+			Comment("分配的用户ID"),
+		field.Time("assigned_at").
+			Optional().
+			Nillable().
+			Comment("分配时间"),
+	}
+}
+
+// Edges of the ProductKey.
+func (ProductKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("product", Product.Type).
+			Unique().
+			Required().
+			Field("product_id").
+			Comment("商品"),
+	}
+}
+
+// Indexes of the ProductKey.
+func (ProductKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "code").Unique(),
+		index.Fields("product_id", "status"),
+	}
+}
@@ -29,6 +29,9 @@ func (RefreshToken) Fields() []ent.Field {
 		field.Bool("revoked").Default(false).Comment("是否已吊销"),
 		field.String("user_agent").Optional().Comment("签发时的用户代理"),
 		field.String("ip").Optional().Comment("签发时的IP"),
+		// 同一次登录轮换出的 refresh token 属于同一会话，共用会话ID
+		field.String("family_id").Optional().Comment("会话ID"),
+		field.Time("login_at").Optional().Comment("会话登录时间"),
 	}
 }
 
@@ -40,6 +43,7 @@ func (RefreshToken) Edges() []ent.Edge {
 func (RefreshToken) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
+		index.Fields("family_id"),
 	}
 }
 
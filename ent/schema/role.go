@@ -24,6 +24,8 @@ func (Role) Fields() []ent.Field {
 		field.String("code").NotEmpty().MaxLen(255).Unique().Comment("角色标识"),
 		field.String("description").Optional().MaxLen(512).Comment("角色描述"),
 		field.Bool("is_default").Default(false).Comment("是否默认角色,默认角色不能删除"),
+		field.JSON("permissions", []string{}).Optional().Comment("权限范围,如 hoshikuzu:post:create,* 表示全部权限"),
+		field.Bool("require_two_factor").Default(false).Comment("是否要求该角色的用户启用两步验证"),
 	}
 }
 
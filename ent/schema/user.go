@@ -5,6 +5,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -29,6 +30,9 @@ func (User) Fields() []ent.Field {
 			Immutable(),
 		field.Bool("email_verified").
 			Default(false),
+		field.Bool("email_verify_required").
+			Default(false).
+			Comment("邮箱验证后才允许登录，自助注册的用户需要验证"),
 		field.String("name").
 			Default("unknown"),
 		field.String("phone_number").
@@ -43,6 +47,21 @@ func (User) Fields() []ent.Field {
 		field.Int("role_id").Optional().Comment("角色ID"),
 		field.String("nickname").Optional().MaxLen(50).Comment("昵称"),
 		field.String("bio").Optional().MaxLen(255).Comment("个人简介"),
+		field.String("totp_secret").
+			Optional().
+			MaxLen(64).
+			Sensitive().
+			Comment("TOTP 密钥，启用前为待验证的密钥"),
+		field.Bool("totp_enabled").
+			Default(false).
+			Comment("是否已启用两步验证"),
+		field.Int64("totp_last_step").
+			Default(0).
+			Comment("最近一次使用的验证码步数，防止验证码重放"),
+		field.JSON("totp_recovery_codes", []string{}).
+			Optional().
+			Sensitive().
+			Comment("未使用的恢复码哈希"),
 	}
 }
 
@@ -57,5 +76,8 @@ func (User) Edges() []ent.Edge {
 		edge.To("wallet", Wallet.Type).
 			Unique(),
 		edge.To("ai_chat_sessions", AIChatSession.Type),
+		edge.To("ai_personas", AIPersona.Type),
+		edge.To("identities", UserIdentity.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
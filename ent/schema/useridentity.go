@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity 用户关联的第三方登录身份
+type UserIdentity struct {
+	ent.Schema
+}
+
+func (UserIdentity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Positive().Comment("所属用户ID"),
+		field.String("provider").NotEmpty().MaxLen(64).Immutable().Comment("登录方式标识,对应社交登录设置中的 name"),
+		field.String("subject").NotEmpty().MaxLen(255).Immutable().Comment("第三方账号的唯一标识"),
+		field.String("email").Optional().MaxLen(255).Comment("第三方账号邮箱"),
+		field.String("name").Optional().MaxLen(255).Comment("第三方账号名称"),
+		field.String("avatar").Optional().MaxLen(1024).Comment("第三方账号头像"),
+		field.Bool("provisioned").Default(false).Immutable().Comment("是否在首次登录时创建了本地用户"),
+		field.Time("last_login_at").Optional().Nillable().Comment("最后登录时间"),
+	}
+}
+
+// Edges of the UserIdentity.
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("identities").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+		index.Fields("user_id", "provider").Unique(),
+	}
+}
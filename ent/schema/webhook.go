@@ -2,10 +2,12 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
-// WebHook
+// WebHook 出站 Webhook，订阅领域事件并向回调地址推送签名后的 JSON
 type WebHook struct {
 	ent.Schema
 }
@@ -20,12 +22,18 @@ func (WebHook) Mixin() []ent.Mixin {
 func (WebHook) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty().MaxLen(255),
-		field.String("url").NotEmpty().MaxLen(255),
-		field.String("event").NotEmpty().MaxLen(255),
+		field.String("url").NotEmpty().MaxLen(1024).Comment("回调地址"),
+		field.JSON("events", []string{}).Optional().Comment("订阅的事件"),
+		// 需要明文参与 HMAC 签名，不能只存哈希
+		field.String("secret").Sensitive().NotEmpty().Comment("签名密钥"),
+		field.Bool("enabled").Default(true).Comment("是否启用"),
 	}
 }
 
 // Edges of the WebHook.
 func (WebHook) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("deliveries", WebHookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebHookDelivery Webhook 投递记录，失败的投递按退避时间重试
+type WebHookDelivery struct {
+	ent.Schema
+}
+
+func (WebHookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the WebHookDelivery.
+func (WebHookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id").Positive().Comment("所属Webhook ID"),
+		field.String("event").NotEmpty().Comment("事件名称"),
+		field.String("event_id").NotEmpty().Comment("事件ID，重新投递时保持不变"),
+		field.Text("payload").Comment("请求体"),
+		field.Enum("status").Values("pending", "success", "failed").Default("pending").Comment("投递状态"),
+		field.Int("attempts").Default(0).Comment("已尝试次数"),
+		field.Time("next_retry_at").Optional().Nillable().Comment("下次重试时间"),
+		field.Int("response_code").Optional().Comment("最近一次响应状态码"),
+		field.Text("response_body").Optional().Comment("最近一次响应内容（截断）"),
+		field.String("error").Optional().Comment("最近一次失败原因"),
+		field.Int("duration").Optional().Comment("最近一次请求耗时（毫秒）"),
+		field.Time("delivered_at").Optional().Nillable().Comment("最近一次投递时间"),
+	}
+}
+
+// Edges of the WebHookDelivery.
+func (WebHookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", WebHook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (WebHookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id"),
+		index.Fields("status", "next_retry_at"),
+	}
+}
@@ -12,18 +12,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AIAskLog is the client for interacting with the AIAskLog builders.
+	AIAskLog *AIAskLogClient
 	// AIChatMessage is the client for interacting with the AIChatMessage builders.
 	AIChatMessage *AIChatMessageClient
 	// AIChatSession is the client for interacting with the AIChatSession builders.
 	AIChatSession *AIChatSessionClient
 	// AIModel is the client for interacting with the AIModel builders.
 	AIModel *AIModelClient
+	// AIPersona is the client for interacting with the AIPersona builders.
+	AIPersona *AIPersonaClient
 	// AIProvider is the client for interacting with the AIProvider builders.
 	AIProvider *AIProviderClient
 	// Album is the client for interacting with the Album builders.
 	Album *AlbumClient
 	// AlbumPhoto is the client for interacting with the AlbumPhoto builders.
 	AlbumPhoto *AlbumPhotoClient
+	// CartItem is the client for interacting with the CartItem builders.
+	CartItem *CartItemClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// Comment is the client for interacting with the Comment builders.
@@ -46,6 +52,8 @@ type Tx struct {
 	FriendCircleRecord *FriendCircleRecordClient
 	// License is the client for interacting with the License builders.
 	License *LicenseClient
+	// LoginLog is the client for interacting with the LoginLog builders.
+	LoginLog *LoginLogClient
 	// Member is the client for interacting with the Member builders.
 	Member *MemberClient
 	// MemberLevel is the client for interacting with the MemberLevel builders.
@@ -56,12 +64,18 @@ type Tx struct {
 	Notification *NotificationClient
 	// Oauth2AccessToken is the client for interacting with the Oauth2AccessToken builders.
 	Oauth2AccessToken *Oauth2AccessTokenClient
+	// Oauth2Client is the client for interacting with the Oauth2Client builders.
+	Oauth2Client *Oauth2ClientClient
 	// Oauth2Code is the client for interacting with the Oauth2Code builders.
 	Oauth2Code *Oauth2CodeClient
 	// Oauth2RefreshToken is the client for interacting with the Oauth2RefreshToken builders.
 	Oauth2RefreshToken *Oauth2RefreshTokenClient
+	// OrderDelivery is the client for interacting with the OrderDelivery builders.
+	OrderDelivery *OrderDeliveryClient
 	// PayOrder is the client for interacting with the PayOrder builders.
 	PayOrder *PayOrderClient
+	// PayOrderItem is the client for interacting with the PayOrderItem builders.
+	PayOrderItem *PayOrderItemClient
 	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
 	PersonalAccessToken *PersonalAccessTokenClient
 	// Plugin is the client for interacting with the Plugin builders.
@@ -72,6 +86,10 @@ type Tx struct {
 	PostPurchase *PostPurchaseClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductDeliverable is the client for interacting with the ProductDeliverable builders.
+	ProductDeliverable *ProductDeliverableClient
+	// ProductKey is the client for interacting with the ProductKey builders.
+	ProductKey *ProductKeyClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
 	// Role is the client for interacting with the Role builders.
@@ -88,12 +106,16 @@ type Tx struct {
 	Theme *ThemeClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// VisitLog is the client for interacting with the VisitLog builders.
 	VisitLog *VisitLogClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
 	// WebHook is the client for interacting with the WebHook builders.
 	WebHook *WebHookClient
+	// WebHookDelivery is the client for interacting with the WebHookDelivery builders.
+	WebHookDelivery *WebHookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -225,12 +247,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AIAskLog = NewAIAskLogClient(tx.config)
 	tx.AIChatMessage = NewAIChatMessageClient(tx.config)
 	tx.AIChatSession = NewAIChatSessionClient(tx.config)
 	tx.AIModel = NewAIModelClient(tx.config)
+	tx.AIPersona = NewAIPersonaClient(tx.config)
 	tx.AIProvider = NewAIProviderClient(tx.config)
 	tx.Album = NewAlbumClient(tx.config)
 	tx.AlbumPhoto = NewAlbumPhotoClient(tx.config)
+	tx.CartItem = NewCartItemClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
 	tx.Coupon = NewCouponClient(tx.config)
@@ -242,19 +267,25 @@ func (tx *Tx) init() {
 	tx.File = NewFileClient(tx.config)
 	tx.FriendCircleRecord = NewFriendCircleRecordClient(tx.config)
 	tx.License = NewLicenseClient(tx.config)
+	tx.LoginLog = NewLoginLogClient(tx.config)
 	tx.Member = NewMemberClient(tx.config)
 	tx.MemberLevel = NewMemberLevelClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
 	tx.Oauth2AccessToken = NewOauth2AccessTokenClient(tx.config)
+	tx.Oauth2Client = NewOauth2ClientClient(tx.config)
 	tx.Oauth2Code = NewOauth2CodeClient(tx.config)
 	tx.Oauth2RefreshToken = NewOauth2RefreshTokenClient(tx.config)
+	tx.OrderDelivery = NewOrderDeliveryClient(tx.config)
 	tx.PayOrder = NewPayOrderClient(tx.config)
+	tx.PayOrderItem = NewPayOrderItemClient(tx.config)
 	tx.PersonalAccessToken = NewPersonalAccessTokenClient(tx.config)
 	tx.Plugin = NewPluginClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
 	tx.PostPurchase = NewPostPurchaseClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
+	tx.ProductDeliverable = NewProductDeliverableClient(tx.config)
+	tx.ProductKey = NewProductKeyClient(tx.config)
 	tx.RefreshToken = NewRefreshTokenClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.ScheduleJob = NewScheduleJobClient(tx.config)
@@ -263,9 +294,11 @@ func (tx *Tx) init() {
 	tx.Tag = NewTagClient(tx.config)
 	tx.Theme = NewThemeClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 	tx.VisitLog = NewVisitLogClient(tx.config)
 	tx.Wallet = NewWalletClient(tx.config)
 	tx.WebHook = NewWebHookClient(tx.config)
+	tx.WebHookDelivery = NewWebHookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -275,7 +308,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AIChatMessage.QueryXXX(), the query will be executed
+// applies a query, for example: AIAskLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,6 +29,8 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// EmailVerified holds the value of the "email_verified" field.
 	EmailVerified bool `json:"email_verified,omitempty"`
+	// 邮箱验证后才允许登录，自助注册的用户需要验证
+	EmailVerifyRequired bool `json:"email_verify_required,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// PhoneNumber holds the value of the "phone_number" field.
@@ -42,6 +45,14 @@ type User struct {
 	Nickname string `json:"nickname,omitempty"`
 	// 个人简介
 	Bio string `json:"bio,omitempty"`
+	// TOTP 密钥，启用前为待验证的密钥
+	TotpSecret string `json:"-"`
+	// 是否已启用两步验证
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// 最近一次使用的验证码步数，防止验证码重放
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// 未使用的恢复码哈希
+	TotpRecoveryCodes []string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -58,9 +69,13 @@ type UserEdges struct {
 	Wallet *Wallet `json:"wallet,omitempty"`
 	// AiChatSessions holds the value of the ai_chat_sessions edge.
 	AiChatSessions []*AIChatSession `json:"ai_chat_sessions,omitempty"`
+	// AiPersonas holds the value of the ai_personas edge.
+	AiPersonas []*AIPersona `json:"ai_personas,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // RoleOrErr returns the Role value or an error if the edge
@@ -105,16 +120,36 @@ func (e UserEdges) AiChatSessionsOrErr() ([]*AIChatSession, error) {
 	return nil, &NotLoadedError{edge: "ai_chat_sessions"}
 }
 
+// AiPersonasOrErr returns the AiPersonas value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AiPersonasOrErr() ([]*AIPersona, error) {
+	if e.loadedTypes[4] {
+		return e.AiPersonas, nil
+	}
+	return nil, &NotLoadedError{edge: "ai_personas"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[5] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmailVerified, user.FieldPhoneNumberVerified:
+		case user.FieldTotpRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldEmailVerified, user.FieldEmailVerifyRequired, user.FieldPhoneNumberVerified, user.FieldTotpEnabled:
 			values[i] = new(sql.NullBool)
-		case user.FieldID, user.FieldRoleID:
+		case user.FieldID, user.FieldRoleID, user.FieldTotpLastStep:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldName, user.FieldPhoneNumber, user.FieldPassword, user.FieldNickname, user.FieldBio:
+		case user.FieldEmail, user.FieldName, user.FieldPhoneNumber, user.FieldPassword, user.FieldNickname, user.FieldBio, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -163,6 +198,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EmailVerified = value.Bool
 			}
+		case user.FieldEmailVerifyRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verify_required", values[i])
+			} else if value.Valid {
+				_m.EmailVerifyRequired = value.Bool
+			}
 		case user.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -205,6 +246,32 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Bio = value.String
 			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				_m.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				_m.TotpLastStep = value.Int64
+			}
+		case user.FieldTotpRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TotpRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field totp_recovery_codes: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -238,6 +305,16 @@ func (_m *User) QueryAiChatSessions() *AIChatSessionQuery {
 	return NewUserClient(_m.config).QueryAiChatSessions(_m)
 }
 
+// QueryAiPersonas queries the "ai_personas" edge of the User entity.
+func (_m *User) QueryAiPersonas() *AIPersonaQuery {
+	return NewUserClient(_m.config).QueryAiPersonas(_m)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (_m *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(_m.config).QueryIdentities(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -273,6 +350,9 @@ func (_m *User) String() string {
 	builder.WriteString("email_verified=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EmailVerified))
 	builder.WriteString(", ")
+	builder.WriteString("email_verify_required=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmailVerifyRequired))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -292,6 +372,16 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("bio=")
 	builder.WriteString(_m.Bio)
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("totp_recovery_codes=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,6 +22,8 @@ const (
 	FieldEmail = "email"
 	// FieldEmailVerified holds the string denoting the email_verified field in the database.
 	FieldEmailVerified = "email_verified"
+	// FieldEmailVerifyRequired holds the string denoting the email_verify_required field in the database.
+	FieldEmailVerifyRequired = "email_verify_required"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldPhoneNumber holds the string denoting the phone_number field in the database.
@@ -36,6 +38,14 @@ const (
 	FieldNickname = "nickname"
 	// FieldBio holds the string denoting the bio field in the database.
 	FieldBio = "bio"
+	// FieldTotpSecret holds the string denoting the totp_secret field in the database.
+	FieldTotpSecret = "totp_secret"
+	// FieldTotpEnabled holds the string denoting the totp_enabled field in the database.
+	FieldTotpEnabled = "totp_enabled"
+	// FieldTotpLastStep holds the string denoting the totp_last_step field in the database.
+	FieldTotpLastStep = "totp_last_step"
+	// FieldTotpRecoveryCodes holds the string denoting the totp_recovery_codes field in the database.
+	FieldTotpRecoveryCodes = "totp_recovery_codes"
 	// EdgeRole holds the string denoting the role edge name in mutations.
 	EdgeRole = "role"
 	// EdgeMember holds the string denoting the member edge name in mutations.
@@ -44,6 +54,10 @@ const (
 	EdgeWallet = "wallet"
 	// EdgeAiChatSessions holds the string denoting the ai_chat_sessions edge name in mutations.
 	EdgeAiChatSessions = "ai_chat_sessions"
+	// EdgeAiPersonas holds the string denoting the ai_personas edge name in mutations.
+	EdgeAiPersonas = "ai_personas"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// RoleTable is the table that holds the role relation/edge.
@@ -74,6 +88,20 @@ const (
 	AiChatSessionsInverseTable = "ai_chat_sessions"
 	// AiChatSessionsColumn is the table column denoting the ai_chat_sessions relation/edge.
 	AiChatSessionsColumn = "user_id"
+	// AiPersonasTable is the table that holds the ai_personas relation/edge.
+	AiPersonasTable = "ai_personas"
+	// AiPersonasInverseTable is the table name for the AIPersona entity.
+	// It exists in this package in order to avoid circular dependency with the "aipersona" package.
+	AiPersonasInverseTable = "ai_personas"
+	// AiPersonasColumn is the table column denoting the ai_personas relation/edge.
+	AiPersonasColumn = "user_id"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "user_identities"
+	// IdentitiesInverseTable is the table name for the UserIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "useridentity" package.
+	IdentitiesInverseTable = "user_identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -83,6 +111,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldEmail,
 	FieldEmailVerified,
+	FieldEmailVerifyRequired,
 	FieldName,
 	FieldPhoneNumber,
 	FieldPhoneNumberVerified,
@@ -90,6 +119,10 @@ var Columns = []string{
 	FieldRoleID,
 	FieldNickname,
 	FieldBio,
+	FieldTotpSecret,
+	FieldTotpEnabled,
+	FieldTotpLastStep,
+	FieldTotpRecoveryCodes,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -113,6 +146,8 @@ var (
 	EmailValidator func(string) error
 	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
 	DefaultEmailVerified bool
+	// DefaultEmailVerifyRequired holds the default value on creation for the "email_verify_required" field.
+	DefaultEmailVerifyRequired bool
 	// DefaultName holds the default value on creation for the "name" field.
 	DefaultName string
 	// PhoneNumberValidator is a validator for the "phone_number" field. It is called by the builders before save.
@@ -125,6 +160,12 @@ var (
 	NicknameValidator func(string) error
 	// BioValidator is a validator for the "bio" field. It is called by the builders before save.
 	BioValidator func(string) error
+	// TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	TotpSecretValidator func(string) error
+	// DefaultTotpEnabled holds the default value on creation for the "totp_enabled" field.
+	DefaultTotpEnabled bool
+	// DefaultTotpLastStep holds the default value on creation for the "totp_last_step" field.
+	DefaultTotpLastStep int64
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -155,6 +196,11 @@ func ByEmailVerified(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmailVerified, opts...).ToFunc()
 }
 
+// ByEmailVerifyRequired orders the results by the email_verify_required field.
+func ByEmailVerifyRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifyRequired, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -190,6 +236,21 @@ func ByBio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBio, opts...).ToFunc()
 }
 
+// ByTotpSecret orders the results by the totp_secret field.
+func ByTotpSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpSecret, opts...).ToFunc()
+}
+
+// ByTotpEnabled orders the results by the totp_enabled field.
+func ByTotpEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpEnabled, opts...).ToFunc()
+}
+
+// ByTotpLastStep orders the results by the totp_last_step field.
+func ByTotpLastStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpLastStep, opts...).ToFunc()
+}
+
 // ByRoleField orders the results by role field.
 func ByRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -224,6 +285,34 @@ func ByAiChatSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAiChatSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAiPersonasCount orders the results by ai_personas count.
+func ByAiPersonasCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAiPersonasStep(), opts...)
+	}
+}
+
+// ByAiPersonas orders the results by ai_personas terms.
+func ByAiPersonas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAiPersonasStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRoleStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -252,3 +341,17 @@ func newAiChatSessionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AiChatSessionsTable, AiChatSessionsColumn),
 	)
 }
+func newAiPersonasStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AiPersonasInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AiPersonasTable, AiPersonasColumn),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
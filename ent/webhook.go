@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,21 +24,50 @@ type WebHook struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
-	// URL holds the value of the "url" field.
+	// 回调地址
 	URL string `json:"url,omitempty"`
-	// Event holds the value of the "event" field.
-	Event        string `json:"event,omitempty"`
+	// 订阅的事件
+	Events []string `json:"events,omitempty"`
+	// 签名密钥
+	Secret string `json:"-"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebHookQuery when eager-loading is set.
+	Edges        WebHookEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// WebHookEdges holds the relations/edges for other nodes in the graph.
+type WebHookEdges struct {
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebHookDelivery `json:"deliveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebHookEdges) DeliveriesOrErr() ([]*WebHookDelivery, error) {
+	if e.loadedTypes[0] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*WebHook) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case webhook.FieldEvents:
+			values[i] = new([]byte)
+		case webhook.FieldEnabled:
+			values[i] = new(sql.NullBool)
 		case webhook.FieldID:
 			values[i] = new(sql.NullInt64)
-		case webhook.FieldName, webhook.FieldURL, webhook.FieldEvent:
+		case webhook.FieldName, webhook.FieldURL, webhook.FieldSecret:
 			values[i] = new(sql.NullString)
 		case webhook.FieldCreatedAt, webhook.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -86,11 +116,25 @@ func (_m *WebHook) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.URL = value.String
 			}
-		case webhook.FieldEvent:
+		case webhook.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		case webhook.FieldSecret:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field event", values[i])
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
 			} else if value.Valid {
-				_m.Event = value.String
+				_m.Secret = value.String
+			}
+		case webhook.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -105,6 +149,11 @@ func (_m *WebHook) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryDeliveries queries the "deliveries" edge of the WebHook entity.
+func (_m *WebHook) QueryDeliveries() *WebHookDeliveryQuery {
+	return NewWebHookClient(_m.config).QueryDeliveries(_m)
+}
+
 // Update returns a builder for updating this WebHook.
 // Note that you need to call WebHook.Unwrap() before calling this method if this WebHook
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -140,8 +189,13 @@ func (_m *WebHook) String() string {
 	builder.WriteString("url=")
 	builder.WriteString(_m.URL)
 	builder.WriteString(", ")
-	builder.WriteString("event=")
-	builder.WriteString(_m.Event)
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Events))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/shuTwT/hoshikuzu/ent/predicate"
 )
 
@@ -74,9 +75,14 @@ func URL(v string) predicate.WebHook {
 	return predicate.WebHook(sql.FieldEQ(FieldURL, v))
 }
 
-// Event applies equality check predicate on the "event" field. It's identical to EventEQ.
-func Event(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldEQ(FieldEvent, v))
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldEQ(FieldSecret, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.WebHook {
+	return predicate.WebHook(sql.FieldEQ(FieldEnabled, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -289,69 +295,112 @@ func URLContainsFold(v string) predicate.WebHook {
 	return predicate.WebHook(sql.FieldContainsFold(FieldURL, v))
 }
 
-// EventEQ applies the EQ predicate on the "event" field.
-func EventEQ(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldEQ(FieldEvent, v))
+// EventsIsNil applies the IsNil predicate on the "events" field.
+func EventsIsNil() predicate.WebHook {
+	return predicate.WebHook(sql.FieldIsNull(FieldEvents))
+}
+
+// EventsNotNil applies the NotNil predicate on the "events" field.
+func EventsNotNil() predicate.WebHook {
+	return predicate.WebHook(sql.FieldNotNull(FieldEvents))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldGT(FieldSecret, v))
 }
 
-// EventNEQ applies the NEQ predicate on the "event" field.
-func EventNEQ(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldNEQ(FieldEvent, v))
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldGTE(FieldSecret, v))
 }
 
-// EventIn applies the In predicate on the "event" field.
-func EventIn(vs ...string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldIn(FieldEvent, vs...))
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldLT(FieldSecret, v))
 }
 
-// EventNotIn applies the NotIn predicate on the "event" field.
-func EventNotIn(vs ...string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldNotIn(FieldEvent, vs...))
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldLTE(FieldSecret, v))
 }
 
-// EventGT applies the GT predicate on the "event" field.
-func EventGT(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldGT(FieldEvent, v))
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldContains(FieldSecret, v))
 }
 
-// EventGTE applies the GTE predicate on the "event" field.
-func EventGTE(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldGTE(FieldEvent, v))
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldHasPrefix(FieldSecret, v))
 }
 
-// EventLT applies the LT predicate on the "event" field.
-func EventLT(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldLT(FieldEvent, v))
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldHasSuffix(FieldSecret, v))
 }
 
-// EventLTE applies the LTE predicate on the "event" field.
-func EventLTE(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldLTE(FieldEvent, v))
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldEqualFold(FieldSecret, v))
 }
 
-// EventContains applies the Contains predicate on the "event" field.
-func EventContains(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldContains(FieldEvent, v))
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.WebHook {
+	return predicate.WebHook(sql.FieldContainsFold(FieldSecret, v))
 }
 
-// EventHasPrefix applies the HasPrefix predicate on the "event" field.
-func EventHasPrefix(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldHasPrefix(FieldEvent, v))
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.WebHook {
+	return predicate.WebHook(sql.FieldEQ(FieldEnabled, v))
 }
 
-// EventHasSuffix applies the HasSuffix predicate on the "event" field.
-func EventHasSuffix(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldHasSuffix(FieldEvent, v))
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.WebHook {
+	return predicate.WebHook(sql.FieldNEQ(FieldEnabled, v))
 }
 
-// EventEqualFold applies the EqualFold predicate on the "event" field.
-func EventEqualFold(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldEqualFold(FieldEvent, v))
+// HasDeliveries applies the HasEdge predicate on the "deliveries" edge.
+func HasDeliveries() predicate.WebHook {
+	return predicate.WebHook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
 }
 
-// EventContainsFold applies the ContainsFold predicate on the "event" field.
-func EventContainsFold(v string) predicate.WebHook {
-	return predicate.WebHook(sql.FieldContainsFold(FieldEvent, v))
+// HasDeliveriesWith applies the HasEdge predicate on the "deliveries" edge with a given conditions (other predicates).
+func HasDeliveriesWith(preds ...predicate.WebHookDelivery) predicate.WebHook {
+	return predicate.WebHook(func(s *sql.Selector) {
+		step := newDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
 }
 
 // And groups predicates with the AND operator between them.
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
 )
 
 // WebHookCreate is the builder for creating a WebHook entity.
@@ -60,9 +61,29 @@ func (_c *WebHookCreate) SetURL(v string) *WebHookCreate {
 	return _c
 }
 
-// SetEvent sets the "event" field.
-func (_c *WebHookCreate) SetEvent(v string) *WebHookCreate {
-	_c.mutation.SetEvent(v)
+// SetEvents sets the "events" field.
+func (_c *WebHookCreate) SetEvents(v []string) *WebHookCreate {
+	_c.mutation.SetEvents(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *WebHookCreate) SetSecret(v string) *WebHookCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *WebHookCreate) SetEnabled(v bool) *WebHookCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *WebHookCreate) SetNillableEnabled(v *bool) *WebHookCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
 	return _c
 }
 
@@ -72,6 +93,21 @@ func (_c *WebHookCreate) SetID(v int) *WebHookCreate {
 	return _c
 }
 
+// AddDeliveryIDs adds the "deliveries" edge to the WebHookDelivery entity by IDs.
+func (_c *WebHookCreate) AddDeliveryIDs(ids ...int) *WebHookCreate {
+	_c.mutation.AddDeliveryIDs(ids...)
+	return _c
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebHookDelivery entity.
+func (_c *WebHookCreate) AddDeliveries(v ...*WebHookDelivery) *WebHookCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the WebHookMutation object of the builder.
 func (_c *WebHookCreate) Mutation() *WebHookMutation {
 	return _c.mutation
@@ -115,6 +151,10 @@ func (_c *WebHookCreate) defaults() {
 		v := webhook.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := webhook.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -141,14 +181,17 @@ func (_c *WebHookCreate) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebHook.url": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.Event(); !ok {
-		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "WebHook.event"`)}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "WebHook.secret"`)}
 	}
-	if v, ok := _c.mutation.Event(); ok {
-		if err := webhook.EventValidator(v); err != nil {
-			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebHook.event": %w`, err)}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WebHook.secret": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "WebHook.enabled"`)}
+	}
 	return nil
 }
 
@@ -197,9 +240,33 @@ func (_c *WebHookCreate) createSpec() (*WebHook, *sqlgraph.CreateSpec) {
 		_spec.SetField(webhook.FieldURL, field.TypeString, value)
 		_node.URL = value
 	}
-	if value, ok := _c.mutation.Event(); ok {
-		_spec.SetField(webhook.FieldEvent, field.TypeString, value)
-		_node.Event = value
+	if value, ok := _c.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if nodes := _c.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
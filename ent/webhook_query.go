@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -13,15 +14,17 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/shuTwT/hoshikuzu/ent/predicate"
 	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
 )
 
 // WebHookQuery is the builder for querying WebHook entities.
 type WebHookQuery struct {
 	config
-	ctx        *QueryContext
-	order      []webhook.OrderOption
-	inters     []Interceptor
-	predicates []predicate.WebHook
+	ctx            *QueryContext
+	order          []webhook.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.WebHook
+	withDeliveries *WebHookDeliveryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +61,28 @@ func (_q *WebHookQuery) Order(o ...webhook.OrderOption) *WebHookQuery {
 	return _q
 }
 
+// QueryDeliveries chains the current query on the "deliveries" edge.
+func (_q *WebHookQuery) QueryDeliveries() *WebHookDeliveryQuery {
+	query := (&WebHookDeliveryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first WebHook entity from the query.
 // Returns a *NotFoundError when no WebHook was found.
 func (_q *WebHookQuery) First(ctx context.Context) (*WebHook, error) {
@@ -245,17 +270,29 @@ func (_q *WebHookQuery) Clone() *WebHookQuery {
 		return nil
 	}
 	return &WebHookQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]webhook.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.WebHook{}, _q.predicates...),
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]webhook.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.WebHook{}, _q.predicates...),
+		withDeliveries: _q.withDeliveries.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WebHookQuery) WithDeliveries(opts ...func(*WebHookDeliveryQuery)) *WebHookQuery {
+	query := (&WebHookDeliveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDeliveries = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +369,11 @@ func (_q *WebHookQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *WebHookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebHook, error) {
 	var (
-		nodes = []*WebHook{}
-		_spec = _q.querySpec()
+		nodes       = []*WebHook{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withDeliveries != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*WebHook).scanValues(nil, columns)
@@ -341,6 +381,7 @@ func (_q *WebHookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebH
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &WebHook{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +393,47 @@ func (_q *WebHookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebH
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withDeliveries; query != nil {
+		if err := _q.loadDeliveries(ctx, query, nodes,
+			func(n *WebHook) { n.Edges.Deliveries = []*WebHookDelivery{} },
+			func(n *WebHook, e *WebHookDelivery) { n.Edges.Deliveries = append(n.Edges.Deliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *WebHookQuery) loadDeliveries(ctx context.Context, query *WebHookDeliveryQuery, nodes []*WebHook, init func(*WebHook), assign func(*WebHook, *WebHookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*WebHook)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookdelivery.FieldWebhookID)
+	}
+	query.Where(predicate.WebHookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(webhook.DeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WebhookID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "webhook_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *WebHookQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
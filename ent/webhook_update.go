@@ -10,9 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/shuTwT/hoshikuzu/ent/predicate"
 	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
 )
 
 // WebHookUpdate is the builder for updating WebHook entities.
@@ -62,25 +64,93 @@ func (_u *WebHookUpdate) SetNillableURL(v *string) *WebHookUpdate {
 	return _u
 }
 
-// SetEvent sets the "event" field.
-func (_u *WebHookUpdate) SetEvent(v string) *WebHookUpdate {
-	_u.mutation.SetEvent(v)
+// SetEvents sets the "events" field.
+func (_u *WebHookUpdate) SetEvents(v []string) *WebHookUpdate {
+	_u.mutation.SetEvents(v)
 	return _u
 }
 
-// SetNillableEvent sets the "event" field if the given value is not nil.
-func (_u *WebHookUpdate) SetNillableEvent(v *string) *WebHookUpdate {
+// AppendEvents appends value to the "events" field.
+func (_u *WebHookUpdate) AppendEvents(v []string) *WebHookUpdate {
+	_u.mutation.AppendEvents(v)
+	return _u
+}
+
+// ClearEvents clears the value of the "events" field.
+func (_u *WebHookUpdate) ClearEvents() *WebHookUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *WebHookUpdate) SetSecret(v string) *WebHookUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *WebHookUpdate) SetNillableSecret(v *string) *WebHookUpdate {
 	if v != nil {
-		_u.SetEvent(*v)
+		_u.SetSecret(*v)
 	}
 	return _u
 }
 
+// SetEnabled sets the "enabled" field.
+func (_u *WebHookUpdate) SetEnabled(v bool) *WebHookUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *WebHookUpdate) SetNillableEnabled(v *bool) *WebHookUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebHookDelivery entity by IDs.
+func (_u *WebHookUpdate) AddDeliveryIDs(ids ...int) *WebHookUpdate {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebHookDelivery entity.
+func (_u *WebHookUpdate) AddDeliveries(v ...*WebHookDelivery) *WebHookUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the WebHookMutation object of the builder.
 func (_u *WebHookUpdate) Mutation() *WebHookMutation {
 	return _u.mutation
 }
 
+// ClearDeliveries clears all "deliveries" edges to the WebHookDelivery entity.
+func (_u *WebHookUpdate) ClearDeliveries() *WebHookUpdate {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebHookDelivery entities by IDs.
+func (_u *WebHookUpdate) RemoveDeliveryIDs(ids ...int) *WebHookUpdate {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebHookDelivery entities.
+func (_u *WebHookUpdate) RemoveDeliveries(v ...*WebHookDelivery) *WebHookUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *WebHookUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -129,9 +199,9 @@ func (_u *WebHookUpdate) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebHook.url": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Event(); ok {
-		if err := webhook.EventValidator(v); err != nil {
-			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebHook.event": %w`, err)}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WebHook.secret": %w`, err)}
 		}
 	}
 	return nil
@@ -158,8 +228,67 @@ func (_u *WebHookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.URL(); ok {
 		_spec.SetField(webhook.FieldURL, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Event(); ok {
-		_spec.SetField(webhook.FieldEvent, field.TypeString, value)
+	if value, ok := _u.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEvents, value)
+		})
+	}
+	if _u.mutation.EventsCleared() {
+		_spec.ClearField(webhook.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -215,25 +344,93 @@ func (_u *WebHookUpdateOne) SetNillableURL(v *string) *WebHookUpdateOne {
 	return _u
 }
 
-// SetEvent sets the "event" field.
-func (_u *WebHookUpdateOne) SetEvent(v string) *WebHookUpdateOne {
-	_u.mutation.SetEvent(v)
+// SetEvents sets the "events" field.
+func (_u *WebHookUpdateOne) SetEvents(v []string) *WebHookUpdateOne {
+	_u.mutation.SetEvents(v)
 	return _u
 }
 
-// SetNillableEvent sets the "event" field if the given value is not nil.
-func (_u *WebHookUpdateOne) SetNillableEvent(v *string) *WebHookUpdateOne {
+// AppendEvents appends value to the "events" field.
+func (_u *WebHookUpdateOne) AppendEvents(v []string) *WebHookUpdateOne {
+	_u.mutation.AppendEvents(v)
+	return _u
+}
+
+// ClearEvents clears the value of the "events" field.
+func (_u *WebHookUpdateOne) ClearEvents() *WebHookUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *WebHookUpdateOne) SetSecret(v string) *WebHookUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *WebHookUpdateOne) SetNillableSecret(v *string) *WebHookUpdateOne {
 	if v != nil {
-		_u.SetEvent(*v)
+		_u.SetSecret(*v)
 	}
 	return _u
 }
 
+// SetEnabled sets the "enabled" field.
+func (_u *WebHookUpdateOne) SetEnabled(v bool) *WebHookUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *WebHookUpdateOne) SetNillableEnabled(v *bool) *WebHookUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebHookDelivery entity by IDs.
+func (_u *WebHookUpdateOne) AddDeliveryIDs(ids ...int) *WebHookUpdateOne {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebHookDelivery entity.
+func (_u *WebHookUpdateOne) AddDeliveries(v ...*WebHookDelivery) *WebHookUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the WebHookMutation object of the builder.
 func (_u *WebHookUpdateOne) Mutation() *WebHookMutation {
 	return _u.mutation
 }
 
+// ClearDeliveries clears all "deliveries" edges to the WebHookDelivery entity.
+func (_u *WebHookUpdateOne) ClearDeliveries() *WebHookUpdateOne {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebHookDelivery entities by IDs.
+func (_u *WebHookUpdateOne) RemoveDeliveryIDs(ids ...int) *WebHookUpdateOne {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebHookDelivery entities.
+func (_u *WebHookUpdateOne) RemoveDeliveries(v ...*WebHookDelivery) *WebHookUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
 // Where appends a list predicates to the WebHookUpdate builder.
 func (_u *WebHookUpdateOne) Where(ps ...predicate.WebHook) *WebHookUpdateOne {
 	_u.mutation.Where(ps...)
@@ -295,9 +492,9 @@ func (_u *WebHookUpdateOne) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebHook.url": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Event(); ok {
-		if err := webhook.EventValidator(v); err != nil {
-			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebHook.event": %w`, err)}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WebHook.secret": %w`, err)}
 		}
 	}
 	return nil
@@ -341,8 +538,67 @@ func (_u *WebHookUpdateOne) sqlSave(ctx context.Context) (_node *WebHook, err er
 	if value, ok := _u.mutation.URL(); ok {
 		_spec.SetField(webhook.FieldURL, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Event(); ok {
-		_spec.SetField(webhook.FieldEvent, field.TypeString, value)
+	if value, ok := _u.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEvents, value)
+		})
+	}
+	if _u.mutation.EventsCleared() {
+		_spec.ClearField(webhook.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &WebHook{config: _u.config}
 	_spec.Assign = _node.assignValues
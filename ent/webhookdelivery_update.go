@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
+)
+
+// WebHookDeliveryUpdate is the builder for updating WebHookDelivery entities.
+type WebHookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebHookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebHookDeliveryUpdate builder.
+func (_u *WebHookDeliveryUpdate) Where(ps ...predicate.WebHookDelivery) *WebHookDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebHookDeliveryUpdate) SetUpdatedAt(v time.Time) *WebHookDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (_u *WebHookDeliveryUpdate) SetWebhookID(v int) *WebHookDeliveryUpdate {
+	_u.mutation.SetWebhookID(v)
+	return _u
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableWebhookID(v *int) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetWebhookID(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *WebHookDeliveryUpdate) SetEvent(v string) *WebHookDeliveryUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableEvent(v *string) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WebHookDeliveryUpdate) SetEventID(v string) *WebHookDeliveryUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableEventID(v *string) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *WebHookDeliveryUpdate) SetPayload(v string) *WebHookDeliveryUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillablePayload(v *string) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WebHookDeliveryUpdate) SetStatus(v webhookdelivery.Status) *WebHookDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableStatus(v *webhookdelivery.Status) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *WebHookDeliveryUpdate) SetAttempts(v int) *WebHookDeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableAttempts(v *int) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *WebHookDeliveryUpdate) AddAttempts(v int) *WebHookDeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_u *WebHookDeliveryUpdate) SetNextRetryAt(v time.Time) *WebHookDeliveryUpdate {
+	_u.mutation.SetNextRetryAt(v)
+	return _u
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableNextRetryAt(v *time.Time) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetNextRetryAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (_u *WebHookDeliveryUpdate) ClearNextRetryAt() *WebHookDeliveryUpdate {
+	_u.mutation.ClearNextRetryAt()
+	return _u
+}
+
+// SetResponseCode sets the "response_code" field.
+func (_u *WebHookDeliveryUpdate) SetResponseCode(v int) *WebHookDeliveryUpdate {
+	_u.mutation.ResetResponseCode()
+	_u.mutation.SetResponseCode(v)
+	return _u
+}
+
+// SetNillableResponseCode sets the "response_code" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableResponseCode(v *int) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetResponseCode(*v)
+	}
+	return _u
+}
+
+// AddResponseCode adds value to the "response_code" field.
+func (_u *WebHookDeliveryUpdate) AddResponseCode(v int) *WebHookDeliveryUpdate {
+	_u.mutation.AddResponseCode(v)
+	return _u
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (_u *WebHookDeliveryUpdate) ClearResponseCode() *WebHookDeliveryUpdate {
+	_u.mutation.ClearResponseCode()
+	return _u
+}
+
+// SetResponseBody sets the "response_body" field.
+func (_u *WebHookDeliveryUpdate) SetResponseBody(v string) *WebHookDeliveryUpdate {
+	_u.mutation.SetResponseBody(v)
+	return _u
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableResponseBody(v *string) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetResponseBody(*v)
+	}
+	return _u
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (_u *WebHookDeliveryUpdate) ClearResponseBody() *WebHookDeliveryUpdate {
+	_u.mutation.ClearResponseBody()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *WebHookDeliveryUpdate) SetError(v string) *WebHookDeliveryUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableError(v *string) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *WebHookDeliveryUpdate) ClearError() *WebHookDeliveryUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *WebHookDeliveryUpdate) SetDuration(v int) *WebHookDeliveryUpdate {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableDuration(v *int) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *WebHookDeliveryUpdate) AddDuration(v int) *WebHookDeliveryUpdate {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *WebHookDeliveryUpdate) ClearDuration() *WebHookDeliveryUpdate {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *WebHookDeliveryUpdate) SetDeliveredAt(v time.Time) *WebHookDeliveryUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdate) SetNillableDeliveredAt(v *time.Time) *WebHookDeliveryUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *WebHookDeliveryUpdate) ClearDeliveredAt() *WebHookDeliveryUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetWebhook sets the "webhook" edge to the WebHook entity.
+func (_u *WebHookDeliveryUpdate) SetWebhook(v *WebHook) *WebHookDeliveryUpdate {
+	return _u.SetWebhookID(v.ID)
+}
+
+// Mutation returns the WebHookDeliveryMutation object of the builder.
+func (_u *WebHookDeliveryUpdate) Mutation() *WebHookDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the WebHook entity.
+func (_u *WebHookDeliveryUpdate) ClearWebhook() *WebHookDeliveryUpdate {
+	_u.mutation.ClearWebhook()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebHookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebHookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebHookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebHookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebHookDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebHookDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.WebhookID(); ok {
+		if err := webhookdelivery.WebhookIDValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_id", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.webhook_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := webhookdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := webhookdelivery.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.event_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WebhookCleared() && len(_u.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebHookDelivery.webhook"`)
+	}
+	return nil
+}
+
+func (_u *WebHookDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRetryAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRetryAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldNextRetryAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResponseCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCode(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if _u.mutation.ResponseCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseBody, field.TypeString, value)
+	}
+	if _u.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(webhookdelivery.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(webhookdelivery.FieldDuration, field.TypeInt, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(webhookdelivery.FieldDuration, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if _u.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebHookDeliveryUpdateOne is the builder for updating a single WebHookDelivery entity.
+type WebHookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebHookDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebHookDeliveryUpdateOne) SetUpdatedAt(v time.Time) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (_u *WebHookDeliveryUpdateOne) SetWebhookID(v int) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetWebhookID(v)
+	return _u
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableWebhookID(v *int) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetWebhookID(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *WebHookDeliveryUpdateOne) SetEvent(v string) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableEvent(v *string) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WebHookDeliveryUpdateOne) SetEventID(v string) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableEventID(v *string) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *WebHookDeliveryUpdateOne) SetPayload(v string) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillablePayload(v *string) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WebHookDeliveryUpdateOne) SetStatus(v webhookdelivery.Status) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableStatus(v *webhookdelivery.Status) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *WebHookDeliveryUpdateOne) SetAttempts(v int) *WebHookDeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableAttempts(v *int) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *WebHookDeliveryUpdateOne) AddAttempts(v int) *WebHookDeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_u *WebHookDeliveryUpdateOne) SetNextRetryAt(v time.Time) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetNextRetryAt(v)
+	return _u
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableNextRetryAt(v *time.Time) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetNextRetryAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (_u *WebHookDeliveryUpdateOne) ClearNextRetryAt() *WebHookDeliveryUpdateOne {
+	_u.mutation.ClearNextRetryAt()
+	return _u
+}
+
+// SetResponseCode sets the "response_code" field.
+func (_u *WebHookDeliveryUpdateOne) SetResponseCode(v int) *WebHookDeliveryUpdateOne {
+	_u.mutation.ResetResponseCode()
+	_u.mutation.SetResponseCode(v)
+	return _u
+}
+
+// SetNillableResponseCode sets the "response_code" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableResponseCode(v *int) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetResponseCode(*v)
+	}
+	return _u
+}
+
+// AddResponseCode adds value to the "response_code" field.
+func (_u *WebHookDeliveryUpdateOne) AddResponseCode(v int) *WebHookDeliveryUpdateOne {
+	_u.mutation.AddResponseCode(v)
+	return _u
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (_u *WebHookDeliveryUpdateOne) ClearResponseCode() *WebHookDeliveryUpdateOne {
+	_u.mutation.ClearResponseCode()
+	return _u
+}
+
+// SetResponseBody sets the "response_body" field.
+func (_u *WebHookDeliveryUpdateOne) SetResponseBody(v string) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetResponseBody(v)
+	return _u
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableResponseBody(v *string) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetResponseBody(*v)
+	}
+	return _u
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (_u *WebHookDeliveryUpdateOne) ClearResponseBody() *WebHookDeliveryUpdateOne {
+	_u.mutation.ClearResponseBody()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *WebHookDeliveryUpdateOne) SetError(v string) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableError(v *string) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *WebHookDeliveryUpdateOne) ClearError() *WebHookDeliveryUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *WebHookDeliveryUpdateOne) SetDuration(v int) *WebHookDeliveryUpdateOne {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableDuration(v *int) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *WebHookDeliveryUpdateOne) AddDuration(v int) *WebHookDeliveryUpdateOne {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *WebHookDeliveryUpdateOne) ClearDuration() *WebHookDeliveryUpdateOne {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *WebHookDeliveryUpdateOne) SetDeliveredAt(v time.Time) *WebHookDeliveryUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *WebHookDeliveryUpdateOne) SetNillableDeliveredAt(v *time.Time) *WebHookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *WebHookDeliveryUpdateOne) ClearDeliveredAt() *WebHookDeliveryUpdateOne {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetWebhook sets the "webhook" edge to the WebHook entity.
+func (_u *WebHookDeliveryUpdateOne) SetWebhook(v *WebHook) *WebHookDeliveryUpdateOne {
+	return _u.SetWebhookID(v.ID)
+}
+
+// Mutation returns the WebHookDeliveryMutation object of the builder.
+func (_u *WebHookDeliveryUpdateOne) Mutation() *WebHookDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the WebHook entity.
+func (_u *WebHookDeliveryUpdateOne) ClearWebhook() *WebHookDeliveryUpdateOne {
+	_u.mutation.ClearWebhook()
+	return _u
+}
+
+// Where appends a list predicates to the WebHookDeliveryUpdate builder.
+func (_u *WebHookDeliveryUpdateOne) Where(ps ...predicate.WebHookDelivery) *WebHookDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebHookDeliveryUpdateOne) Select(field string, fields ...string) *WebHookDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebHookDelivery entity.
+func (_u *WebHookDeliveryUpdateOne) Save(ctx context.Context) (*WebHookDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebHookDeliveryUpdateOne) SaveX(ctx context.Context) *WebHookDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebHookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebHookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebHookDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebHookDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.WebhookID(); ok {
+		if err := webhookdelivery.WebhookIDValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_id", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.webhook_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := webhookdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := webhookdelivery.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.event_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebHookDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WebhookCleared() && len(_u.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebHookDelivery.webhook"`)
+	}
+	return nil
+}
+
+func (_u *WebHookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebHookDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebHookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRetryAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRetryAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldNextRetryAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResponseCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCode(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if _u.mutation.ResponseCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseBody, field.TypeString, value)
+	}
+	if _u.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(webhookdelivery.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(webhookdelivery.FieldDuration, field.TypeInt, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(webhookdelivery.FieldDuration, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if _u.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebHookDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
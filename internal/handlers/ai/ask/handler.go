@@ -0,0 +1,207 @@
+package ask
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
+	infra_ai "github.com/shuTwT/hoshikuzu/internal/infra/ai"
+	ask_service "github.com/shuTwT/hoshikuzu/internal/services/ai/ask"
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/valyala/fasthttp"
+)
+
+type AskHandler struct {
+	service ask_service.AskService
+}
+
+func NewAskHandler(service ask_service.AskService) *AskHandler {
+	return &AskHandler{service: service}
+}
+
+// @Summary 获取站点问答配置
+// @Description 返回站点问答是否开启及问题长度上限，供前台决定是否展示提问框
+// @Tags 公开接口/AI问答
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=model.AIAskConfigResp}
+// @Router /api/v1/public/ai/ask/config [get]
+func (h *AskHandler) Config(c *fiber.Ctx) error {
+	config, err := h.service.Config(c.Context())
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", config))
+}
+
+// @Summary 向本站提问
+// @Description 仅依据已发布且可见的文章回答访客问题，通过 SSE 返回 delta 增量、done 引用来源或 error 事件；按 IP 限制频率并受每日令牌预算约束
+// @Tags 公开接口/AI问答
+// @Accept json
+// @Produce text/event-stream
+// @Param req body model.AIAskReq true "问题"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/public/ai/ask [post]
+func (h *AskHandler) Ask(c *fiber.Ctx) error {
+	var req model.AIAskReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	question, err := h.service.Prepare(c.Context(), req.Question, c.IP(), c.Get(fiber.HeaderUserAgent))
+	if err != nil {
+		status, message := prepareError(err)
+		return c.Status(status).JSON(model.NewError(status, message))
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream; charset=utf-8")
+	c.Set(fiber.HeaderCacheControl, "no-cache, no-transform")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+	c.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(writer *bufio.Writer) {
+		send := func(event string, payload model.AIAskStreamEvent) error {
+			data, marshalErr := json.Marshal(payload)
+			if marshalErr != nil {
+				return marshalErr
+			}
+			if _, writeErr := fmt.Fprintf(writer, "event: %s\ndata: %s\n\n", event, data); writeErr != nil {
+				return writeErr
+			}
+			return writer.Flush()
+		}
+
+		result, answerErr := h.service.Answer(c.Context(), question, func(delta string) error {
+			return send("delta", model.AIAskStreamEvent{Content: delta})
+		})
+		if answerErr != nil {
+			slog.Warn("站点问答失败", "ip", question.IP, "error", answerErr.Error())
+			_ = send("error", model.AIAskStreamEvent{Code: "answer_failed", Message: "回答生成失败，请稍后再试"})
+			return
+		}
+		_ = send("done", model.AIAskStreamEvent{Citations: result.Citations})
+	}))
+	return nil
+}
+
+// prepareError maps a rejected question to an HTTP status and a message that
+// is safe to show to visitors.
+func prepareError(err error) (int, string) {
+	switch {
+	case errors.Is(err, ask_service.ErrAskDisabled):
+		return fiber.StatusForbidden, err.Error()
+	case errors.Is(err, ask_service.ErrInvalidQuestion):
+		return fiber.StatusBadRequest, err.Error()
+	case errors.Is(err, ask_service.ErrAskRateLimited), errors.Is(err, ask_service.ErrAskBudgetExhausted):
+		return fiber.StatusTooManyRequests, err.Error()
+	case errors.Is(err, ai_service.ErrAIEmbeddingNotFound), errors.Is(err, infra_ai.ErrConfigEncryptionKeyUnavailable), errors.Is(err, infra_ai.ErrConfigDecryptionFailed):
+		return fiber.StatusServiceUnavailable, "站点问答暂不可用"
+	default:
+		slog.Error("站点问答预处理失败", "error", err.Error())
+		return fiber.StatusServiceUnavailable, "站点问答暂不可用"
+	}
+}
+
+// @Summary 查询站点问答日志分页
+// @Description 查询访客提问与 AI 回答记录，可按 IP、关键词与结果筛选
+// @Tags 后台管理接口/AI问答
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(10)
+// @Param ip query string false "IP"
+// @Param keyword query string false "问题或回答关键词"
+// @Param status query string false "回答结果" Enums(success, failed)
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[model.AIAskLogResp]}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/ai/ask/log/page [get]
+func (h *AskHandler) ListLogPage(c *fiber.Ctx) error {
+	pageQuery := model.AIAskLogPageQuery{Page: 1, Size: 10}
+	if err := c.QueryParser(&pageQuery); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if pageQuery.Page < 1 || pageQuery.Size < 1 || pageQuery.Size > 100 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的分页参数"))
+	}
+	if pageQuery.Status != "" {
+		if err := aiasklog.StatusValidator(aiasklog.Status(pageQuery.Status)); err != nil {
+			return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的回答结果"))
+		}
+	}
+
+	logs, count, err := h.service.QueryLogPage(c.Context(), pageQuery)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	records := make([]model.AIAskLogResp, 0, len(logs))
+	for _, l := range logs {
+		citations := make([]model.AIKnowledgeCitation, 0, len(l.Citations))
+		for _, ct := range l.Citations {
+			citations = append(citations, model.AIKnowledgeCitation{Kind: ct.Kind, SourceID: ct.SourceID, Title: ct.Title, Slug: ct.Slug})
+		}
+		records = append(records, model.AIAskLogResp{
+			ID:               l.ID,
+			CreatedAt:        model.LocalTime(l.CreatedAt),
+			Question:         l.Question,
+			Answer:           l.Answer,
+			Citations:        citations,
+			Status:           string(l.Status),
+			Error:            l.Error,
+			Model:            l.Model,
+			PromptTokens:     l.PromptTokens,
+			CompletionTokens: l.CompletionTokens,
+			TotalTokens:      l.TotalTokens,
+			IP:               l.IP,
+			UserAgent:        l.UserAgent,
+		})
+	}
+	return c.JSON(model.NewSuccess("success", model.PageResult[model.AIAskLogResp]{
+		Total:   int64(count),
+		Records: records,
+	}))
+}
+
+// @Summary 获取站点问答今日用量
+// @Description 返回今日提问数与令牌用量，以及全站每日令牌预算
+// @Tags 后台管理接口/AI问答
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=model.AIAskUsageResp}
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/ai/ask/usage [get]
+func (h *AskHandler) Usage(c *fiber.Ctx) error {
+	usage, err := h.service.Usage(c.Context())
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", usage))
+}
+
+// @Summary 批量删除站点问答日志
+// @Description 删除选中的问答记录，一次最多 100 条；删除当日记录会释放对应的令牌预算
+// @Tags 后台管理接口/AI问答
+// @Accept json
+// @Produce json
+// @Param req body model.AIAskLogBatchDeleteReq true "日志ID列表"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/ai/ask/log/batch/delete [post]
+func (h *AskHandler) BatchDeleteLogs(c *fiber.Ctx) error {
+	var req model.AIAskLogBatchDeleteReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if len(req.IDs) == 0 || len(req.IDs) > 100 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "请选择 1 到 100 条记录"))
+	}
+	if _, err := h.service.DeleteLogs(c.Context(), req.IDs); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
@@ -14,17 +14,19 @@ import (
 	infra_ai "github.com/shuTwT/hoshikuzu/internal/infra/ai"
 	"github.com/shuTwT/hoshikuzu/internal/middleware"
 	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	knowledge_service "github.com/shuTwT/hoshikuzu/internal/services/ai/knowledge"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 	"github.com/valyala/fasthttp"
 )
 
 type AIHandler struct {
-	service ai_service.AIService
-	client  *ent.Client
+	service          ai_service.AIService
+	knowledgeService knowledge_service.KnowledgeService
+	client           *ent.Client
 }
 
-func NewAIHandler(service ai_service.AIService, client *ent.Client) *AIHandler {
-	return &AIHandler{service: service, client: client}
+func NewAIHandler(service ai_service.AIService, knowledgeService knowledge_service.KnowledgeService, client *ent.Client) *AIHandler {
+	return &AIHandler{service: service, knowledgeService: knowledgeService, client: client}
 }
 
 // @Summary 获取 AI 提供商列表
@@ -283,10 +285,13 @@ func (h *AIHandler) ListSessions(c *fiber.Ctx) error {
 }
 
 // @Summary 创建聊天会话
-// @Description 为当前登录用户创建一个空聊天会话
+// @Description 为当前登录用户创建一个空聊天会话，可同时指定模型、人设、系统提示词与参数覆盖
 // @Tags 后台管理接口/AI聊天
+// @Accept json
 // @Produce json
+// @Param req body model.AIChatSessionSettingsReq false "会话设置"
 // @Success 200 {object} model.HttpSuccess{data=model.AIChatSessionResp}
+// @Failure 400 {object} model.HttpError
 // @Failure 401 {object} model.HttpError
 // @Router /api/v1/ai/chat/sessions [post]
 func (h *AIHandler) CreateSession(c *fiber.Ctx) error {
@@ -294,13 +299,163 @@ func (h *AIHandler) CreateSession(c *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	session, err := h.service.CreateSession(c.Context(), userID)
+	var settings *model.AIChatSessionSettingsReq
+	if len(c.Body()) > 0 {
+		settings = &model.AIChatSessionSettingsReq{}
+		if err := c.BodyParser(settings); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+		}
+	}
+	session, err := h.service.CreateSession(c.Context(), userID, settings)
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", session))
+}
+
+// @Summary 更新聊天会话设置
+// @Description 整体替换会话的模型、人设、系统提示词与温度、最大令牌数覆盖值，未传的字段恢复为人设或提供商的默认值
+// @Tags 后台管理接口/AI聊天
+// @Accept json
+// @Produce json
+// @Param id path int true "会话 ID"
+// @Param req body model.AIChatSessionSettingsReq true "会话设置"
+// @Success 200 {object} model.HttpSuccess{data=model.AIChatSessionResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/ai/chat/sessions/{id}/settings [put]
+func (h *AIHandler) UpdateSessionSettings(c *fiber.Ctx) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+	sessionID, err := parseID(c)
+	if err != nil {
+		return err
+	}
+	var req model.AIChatSessionSettingsReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	session, err := h.service.UpdateSessionSettings(c.Context(), userID, sessionID, req)
 	if err != nil {
 		return h.writeError(c, err)
 	}
 	return c.JSON(model.NewSuccess("success", session))
 }
 
+// @Summary 获取可选聊天模型
+// @Description 列出已启用提供商下已启用的模型，is_default 标记未选择模型时使用的默认模型
+// @Tags 后台管理接口/AI聊天
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.AIChatModelOption}
+// @Router /api/v1/ai/chat/models [get]
+func (h *AIHandler) ListChatModels(c *fiber.Ctx) error {
+	models, err := h.service.ListChatModels(c.Context())
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", models))
+}
+
+// @Summary 获取助手人设列表
+// @Description 获取当前登录用户创建的助手人设
+// @Tags 后台管理接口/AI聊天
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.AIPersonaResp}
+// @Failure 401 {object} model.HttpError
+// @Router /api/v1/ai/chat/personas [get]
+func (h *AIHandler) ListPersonas(c *fiber.Ctx) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+	personas, err := h.service.ListPersonas(c.Context(), userID)
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", personas))
+}
+
+// @Summary 创建助手人设
+// @Description 创建可在多个会话中复用的系统提示词及参数覆盖
+// @Tags 后台管理接口/AI聊天
+// @Accept json
+// @Produce json
+// @Param req body model.AIPersonaReq true "人设"
+// @Success 200 {object} model.HttpSuccess{data=model.AIPersonaResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/ai/chat/personas [post]
+func (h *AIHandler) CreatePersona(c *fiber.Ctx) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+	var req model.AIPersonaReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	persona, err := h.service.CreatePersona(c.Context(), userID, req)
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", persona))
+}
+
+// @Summary 更新助手人设
+// @Description 更新当前用户的助手人设，绑定该人设的会话在下一轮对话生效
+// @Tags 后台管理接口/AI聊天
+// @Accept json
+// @Produce json
+// @Param id path int true "人设 ID"
+// @Param req body model.AIPersonaReq true "人设"
+// @Success 200 {object} model.HttpSuccess{data=model.AIPersonaResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/ai/chat/personas/{id} [put]
+func (h *AIHandler) UpdatePersona(c *fiber.Ctx) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+	personaID, err := parseIDParam(c, "id", "persona")
+	if err != nil {
+		return err
+	}
+	var req model.AIPersonaReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	persona, err := h.service.UpdatePersona(c.Context(), userID, personaID, req)
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", persona))
+}
+
+// @Summary 删除助手人设
+// @Description 删除当前用户的助手人设，并解除其与会话的绑定
+// @Tags 后台管理接口/AI聊天
+// @Produce json
+// @Param id path int true "人设 ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/ai/chat/personas/{id} [delete]
+func (h *AIHandler) DeletePersona(c *fiber.Ctx) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+	personaID, err := parseIDParam(c, "id", "persona")
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeletePersona(c.Context(), userID, personaID); err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
 // @Summary 获取会话消息
 // @Description 获取当前用户会话中的全部消息
 // @Tags 后台管理接口/AI聊天
@@ -372,7 +527,7 @@ func (h *AIHandler) ClearSession(c *fiber.Ctx) error {
 }
 
 // @Summary 流式发送聊天消息
-// @Description 将当前会话全部历史提交给 OpenAI 兼容模型并通过 SSE 返回增量内容
+// @Description 将会话历史按上下文预算裁剪后提交给 OpenAI 兼容模型并通过 SSE 返回增量内容，超出预算的较早消息会被摘要或省略；knowledge 为 true 时先检索站点知识库并在 done 事件中返回引用来源
 // @Tags 后台管理接口/AI聊天
 // @Accept json
 // @Produce text/event-stream
@@ -396,7 +551,76 @@ func (h *AIHandler) Stream(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
 	}
+	knowledge, err := h.knowledgeContext(c, req)
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return h.streamReply(c, func(onDelta func(string) error) (*model.AIChatMessageResp, error) {
+		return h.service.StreamChat(c.Context(), userID, sessionID, req.Content, knowledge, onDelta)
+	})
+}
+
+// @Summary 编辑消息并重新生成回复
+// @Description 从指定的用户消息处分支：删除其后的全部消息，以 content 替换该消息并通过 SSE 流式返回新的回复；content 与原消息相同时即为重新生成
+// @Tags 后台管理接口/AI聊天
+// @Accept json
+// @Produce text/event-stream
+// @Param id path int true "会话 ID"
+// @Param messageId path int true "用户消息 ID"
+// @Param req body model.AIChatStreamReq true "消息内容"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/chat/sessions/{id}/messages/{messageId}/regenerate [post]
+func (h *AIHandler) Regenerate(c *fiber.Ctx) error {
+	userID, err := currentUserID(c)
+	if err != nil {
+		return err
+	}
+	sessionID, err := parseID(c)
+	if err != nil {
+		return err
+	}
+	messageID, err := parseIDParam(c, "messageId", "message")
+	if err != nil {
+		return err
+	}
+	var req model.AIChatStreamReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	knowledge, err := h.knowledgeContext(c, req)
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return h.streamReply(c, func(onDelta func(string) error) (*model.AIChatMessageResp, error) {
+		return h.service.RegenerateChat(c.Context(), userID, sessionID, messageID, req.Content, knowledge, onDelta)
+	})
+}
+
+// knowledgeContext retrieves the site knowledge for a knowledge-mode turn. It
+// returns nil outside knowledge mode. Paid and comment-locked posts are only
+// searched for callers who may view posts in the console; everyone else gets
+// the same public knowledge as the site Q&A.
+func (h *AIHandler) knowledgeContext(c *fiber.Ctx, req model.AIChatStreamReq) (*model.AIKnowledgeContext, error) {
+	if !req.Knowledge {
+		return nil, nil
+	}
+	search := h.knowledgeService.SearchPublic
+	if middleware.HasScope(c, "hoshikuzu:post:view") {
+		search = h.knowledgeService.Search
+	}
+	chunks, err := search(c.Context(), req.Content, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AIKnowledgeContext{Chunks: chunks}, nil
+}
 
+// streamReply runs reply inside an SSE response, forwarding its deltas and
+// finishing with a done or error event.
+func (h *AIHandler) streamReply(c *fiber.Ctx, reply func(onDelta func(string) error) (*model.AIChatMessageResp, error)) error {
 	c.Set(fiber.HeaderContentType, "text/event-stream; charset=utf-8")
 	c.Set(fiber.HeaderCacheControl, "no-cache, no-transform")
 	c.Set(fiber.HeaderConnection, "keep-alive")
@@ -413,18 +637,84 @@ func (h *AIHandler) Stream(c *fiber.Ctx) error {
 			return writer.Flush()
 		}
 
-		assistant, streamErr := h.service.StreamChat(c.Context(), userID, sessionID, req.Content, func(delta string) error {
+		assistant, streamErr := reply(func(delta string) error {
 			return send("delta", model.AIStreamEvent{Content: delta})
 		})
 		if streamErr != nil {
 			_ = send("error", model.AIStreamEvent{Code: streamErrorCode(streamErr), Message: streamErrorMessage(streamErr)})
 			return
 		}
-		_ = send("done", model.AIStreamEvent{MessageID: assistant.ID})
+		_ = send("done", model.AIStreamEvent{MessageID: assistant.ID, Citations: assistant.Citations})
 	}))
 	return nil
 }
 
+// @Summary 获取站点知识库状态
+// @Description 返回知识库中的片段数量及最近一次重建的结果，仅超级管理员可用
+// @Tags 后台管理接口/AI
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=model.AIKnowledgeStatusResp}
+// @Failure 403 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/knowledge/status [get]
+func (h *AIHandler) KnowledgeStatus(c *fiber.Ctx) error {
+	if err := h.requireSuperAdmin(c); err != nil {
+		return err
+	}
+	status, err := h.knowledgeService.Status(c.Context())
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", status))
+}
+
+// @Summary 重建站点知识库
+// @Description 在后台重新嵌入全部已发布且可见的文章，以及设置中启用的说说与商品
+// @Tags 后台管理接口/AI
+// @Produce json
+// @Success 200 {object} model.HttpSuccess
+// @Failure 403 {object} model.HttpError
+// @Failure 409 {object} model.HttpError
+// @Router /api/v1/ai/knowledge/rebuild [post]
+func (h *AIHandler) RebuildKnowledge(c *fiber.Ctx) error {
+	if err := h.requireSuperAdmin(c); err != nil {
+		return err
+	}
+	if err := h.knowledgeService.Rebuild(c.Context()); err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 检索站点知识库
+// @Description 按语义相似度返回与查询最相近的片段，用于调试检索效果
+// @Tags 后台管理接口/AI
+// @Accept json
+// @Produce json
+// @Param req body model.AIKnowledgeSearchReq true "检索条件"
+// @Success 200 {object} model.HttpSuccess{data=[]model.AIKnowledgeChunk}
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/knowledge/search [post]
+func (h *AIHandler) SearchKnowledge(c *fiber.Ctx) error {
+	if err := h.requireSuperAdmin(c); err != nil {
+		return err
+	}
+	var req model.AIKnowledgeSearchReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, "Query is required"))
+	}
+	chunks, err := h.knowledgeService.Search(c.Context(), req.Query, req.TopK)
+	if err != nil {
+		return h.writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", chunks))
+}
+
 func (h *AIHandler) requireSuperAdmin(c *fiber.Ctx) error {
 	loginUser, err := currentUserID(c)
 	if err != nil {
@@ -468,15 +758,24 @@ func (h *AIHandler) writeError(c *fiber.Ctx, err error) error {
 	status := fiber.StatusInternalServerError
 	message := "AI request failed"
 	switch {
-	case errors.Is(err, ai_service.ErrInvalidAIConfig), errors.Is(err, ai_service.ErrInvalidAIChatContent):
+	case errors.Is(err, ai_service.ErrInvalidAIConfig), errors.Is(err, ai_service.ErrInvalidAIChatContent), errors.Is(err, ai_service.ErrInvalidAIChatSettings):
 		status = fiber.StatusBadRequest
 		message = err.Error()
 	case errors.Is(err, ai_service.ErrAIChatSessionNotFound):
 		status = fiber.StatusNotFound
 		message = "AI chat session not found"
+	case errors.Is(err, ai_service.ErrAIPersonaNotFound):
+		status = fiber.StatusNotFound
+		message = "AI persona not found"
 	case errors.Is(err, ai_service.ErrAIProviderNotFound), errors.Is(err, ai_service.ErrAIModelNotFound), errors.Is(err, infra_ai.ErrConfigEncryptionKeyUnavailable), errors.Is(err, infra_ai.ErrConfigDecryptionFailed):
 		status = fiber.StatusServiceUnavailable
 		message = "AI service is not configured"
+	case errors.Is(err, ai_service.ErrAIEmbeddingNotFound):
+		status = fiber.StatusServiceUnavailable
+		message = "No AI provider has an embedding model configured"
+	case errors.Is(err, knowledge_service.ErrKnowledgeRebuilding):
+		status = fiber.StatusConflict
+		message = "Knowledge base rebuild is already running"
 	case errors.Is(err, ai_service.ErrAIProviderEmptyResponse):
 		status = fiber.StatusBadGateway
 		message = "AI provider returned an empty response"
@@ -495,6 +794,8 @@ func streamErrorCode(err error) string {
 		return "configuration_unavailable"
 	case errors.Is(err, ai_service.ErrAIChatSessionNotFound):
 		return "session_not_found"
+	case errors.Is(err, ai_service.ErrAIChatMessageNotFound):
+		return "message_not_found"
 	case errors.Is(err, ai_service.ErrInvalidAIChatContent):
 		return "invalid_content"
 	case errors.Is(err, ai_service.ErrAIProviderEmptyResponse):
@@ -510,6 +811,8 @@ func streamErrorMessage(err error) string {
 		return "AI service is not configured"
 	case errors.Is(err, ai_service.ErrAIChatSessionNotFound):
 		return "AI chat session not found"
+	case errors.Is(err, ai_service.ErrAIChatMessageNotFound):
+		return "AI chat message not found"
 	case errors.Is(err, ai_service.ErrInvalidAIChatContent):
 		return err.Error()
 	case errors.Is(err, ai_service.ErrAIProviderEmptyResponse):
@@ -0,0 +1,238 @@
+package writing
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shuTwT/hoshikuzu/ent"
+	infra_ai "github.com/shuTwT/hoshikuzu/internal/infra/ai"
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	writing_service "github.com/shuTwT/hoshikuzu/internal/services/ai/writing"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/valyala/fasthttp"
+)
+
+type WritingHandler struct {
+	service writing_service.WritingService
+}
+
+func NewWritingHandler(service writing_service.WritingService) *WritingHandler {
+	return &WritingHandler{service: service}
+}
+
+// @Summary AI 建议文章标题
+// @Description 通过 SSE 返回 delta 增量，done 事件的 result.titles 为备选标题
+// @Tags 后台管理接口/AI写作
+// @Accept json
+// @Produce text/event-stream
+// @Param id path int true "文章ID"
+// @Param req body model.AIWritingReq false "编辑器中的标题与内容，不传时使用已保存的文章"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/post/{id}/titles [post]
+func (h *WritingHandler) Titles(c *fiber.Ctx) error {
+	return h.stream(c, writing_service.ActionTitles)
+}
+
+// @Summary AI 生成 SEO 关键词与别名
+// @Description 通过 SSE 返回 delta 增量，done 事件的 result.keywords 与 result.slug 为建议值
+// @Tags 后台管理接口/AI写作
+// @Accept json
+// @Produce text/event-stream
+// @Param id path int true "文章ID"
+// @Param req body model.AIWritingReq false "编辑器中的标题与内容，不传时使用已保存的文章"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/post/{id}/seo [post]
+func (h *WritingHandler) SEO(c *fiber.Ctx) error {
+	return h.stream(c, writing_service.ActionSEO)
+}
+
+// @Summary AI 推荐标签与分类
+// @Description 仅从已启用的标签与分类中挑选，done 事件的 result.tags 与 result.categories 为匹配到的已有项
+// @Tags 后台管理接口/AI写作
+// @Accept json
+// @Produce text/event-stream
+// @Param id path int true "文章ID"
+// @Param req body model.AIWritingReq false "编辑器中的标题与内容，不传时使用已保存的文章"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/post/{id}/taxonomy [post]
+func (h *WritingHandler) Taxonomy(c *fiber.Ctx) error {
+	return h.stream(c, writing_service.ActionTaxonomy)
+}
+
+// @Summary AI 翻译文章
+// @Description 将文章或选中内容翻译为 language 指定的语言，done 事件的 result.content 为译文
+// @Tags 后台管理接口/AI写作
+// @Accept json
+// @Produce text/event-stream
+// @Param id path int true "文章ID"
+// @Param req body model.AIWritingReq true "目标语言及可选的编辑器内容"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/post/{id}/translate [post]
+func (h *WritingHandler) Translate(c *fiber.Ctx) error {
+	return h.stream(c, writing_service.ActionTranslate)
+}
+
+// @Summary AI 校对文章
+// @Description done 事件的 result.content 为校对后的全文，result.diff 为与原文的逐行差异
+// @Tags 后台管理接口/AI写作
+// @Accept json
+// @Produce text/event-stream
+// @Param id path int true "文章ID"
+// @Param req body model.AIWritingReq false "编辑器中的内容或选中内容，不传时使用已保存的文章"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/post/{id}/proofread [post]
+func (h *WritingHandler) Proofread(c *fiber.Ctx) error {
+	return h.stream(c, writing_service.ActionProofread)
+}
+
+// @Summary AI 从光标处续写
+// @Description cursor 为内容中的字符位置，不传时从末尾续写，done 事件的 result.content 为续写内容
+// @Tags 后台管理接口/AI写作
+// @Accept json
+// @Produce text/event-stream
+// @Param id path int true "文章ID"
+// @Param req body model.AIWritingReq false "编辑器内容与光标位置"
+// @Success 200 {string} string
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Failure 503 {object} model.HttpError
+// @Router /api/v1/ai/post/{id}/continue [post]
+func (h *WritingHandler) Continue(c *fiber.Ctx) error {
+	return h.stream(c, writing_service.ActionContinue)
+}
+
+func (h *WritingHandler) stream(c *fiber.Ctx, action string) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, "无效的文章ID"))
+	}
+	var req model.AIWritingReq
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+		}
+	}
+	task, err := h.service.Prepare(c.Context(), action, id, req)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream; charset=utf-8")
+	c.Set(fiber.HeaderCacheControl, "no-cache, no-transform")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+	c.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(writer *bufio.Writer) {
+		send := func(event string, payload model.AIWritingStreamEvent) error {
+			data, marshalErr := json.Marshal(payload)
+			if marshalErr != nil {
+				return marshalErr
+			}
+			if _, writeErr := fmt.Fprintf(writer, "event: %s\ndata: %s\n\n", event, data); writeErr != nil {
+				return writeErr
+			}
+			return writer.Flush()
+		}
+
+		result, runErr := h.service.Run(c.Context(), task, func(delta string) error {
+			return send("delta", model.AIWritingStreamEvent{Content: delta})
+		})
+		if runErr != nil {
+			slog.Warn("AI 写作失败", "action", action, "post_id", id, "error", runErr.Error())
+			code, message := streamError(runErr)
+			_ = send("error", model.AIWritingStreamEvent{Code: code, Message: message})
+			return
+		}
+		_ = send("done", model.AIWritingStreamEvent{Result: result})
+	}))
+	return nil
+}
+
+// @Summary 获取 AI 写作提示词模板
+// @Description 返回各写作操作当前生效的提示词及内置默认值。模板使用 Go text/template 语法，可用字段为 .Title .Content .Language .Tags .Categories .Before .After .Count，以及 join 函数
+// @Tags 后台管理接口/AI写作
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.AIWritingPromptResp}
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/ai/writing/prompts [get]
+func (h *WritingHandler) ListPrompts(c *fiber.Ctx) error {
+	prompts, err := h.service.ListPrompts(c.Context())
+	if err != nil {
+		return writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", prompts))
+}
+
+// @Summary 保存 AI 写作提示词模板
+// @Description 按操作保存自定义提示词，未提交或与默认值相同的操作恢复为内置提示词；模板在保存时校验
+// @Tags 后台管理接口/AI写作
+// @Accept json
+// @Produce json
+// @Param req body model.AIWritingPromptsReq true "按操作名索引的提示词"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/ai/writing/prompts [put]
+func (h *WritingHandler) SavePrompts(c *fiber.Ctx) error {
+	var req model.AIWritingPromptsReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if err := h.service.SavePrompts(c.Context(), req.Prompts); err != nil {
+		return writeError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+func writeError(c *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	message := "AI 写作请求失败"
+	switch {
+	case errors.Is(err, writing_service.ErrInvalidWritingAction), errors.Is(err, writing_service.ErrInvalidWritingInput),
+		errors.Is(err, writing_service.ErrWritingContentEmpty), errors.Is(err, writing_service.ErrWritingContentTooLong),
+		errors.Is(err, writing_service.ErrInvalidPromptTemplate):
+		status = fiber.StatusBadRequest
+		message = err.Error()
+	case ent.IsNotFound(err):
+		status = fiber.StatusNotFound
+		message = "文章不存在"
+	case errors.Is(err, ai_service.ErrAIProviderNotFound), errors.Is(err, ai_service.ErrAIModelNotFound),
+		errors.Is(err, infra_ai.ErrConfigEncryptionKeyUnavailable), errors.Is(err, infra_ai.ErrConfigDecryptionFailed):
+		status = fiber.StatusServiceUnavailable
+		message = "AI 服务未配置"
+	default:
+		slog.Error("AI 写作请求失败", "error", err.Error())
+	}
+	return c.Status(status).JSON(model.NewError(status, message))
+}
+
+func streamError(err error) (string, string) {
+	switch {
+	case errors.Is(err, ai_service.ErrAIProviderNotFound), errors.Is(err, ai_service.ErrAIModelNotFound),
+		errors.Is(err, infra_ai.ErrConfigEncryptionKeyUnavailable), errors.Is(err, infra_ai.ErrConfigDecryptionFailed):
+		return "configuration_unavailable", "AI 服务未配置"
+	case errors.Is(err, ai_service.ErrAIProviderEmptyResponse):
+		return "empty_provider_response", "AI 未返回有效内容，请重试"
+	default:
+		return "provider_error", "AI 服务请求失败，请稍后再试"
+	}
+}
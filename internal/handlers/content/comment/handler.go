@@ -67,7 +67,7 @@ func (h *CommentHandler) GetComment(c *fiber.Ctx) error {
 }
 
 // @Summary 审核通过评论
-// @Description 将评论状态设置为已审核(2)
+// @Description 将评论状态设置为已通过(2)
 // @Tags 后台管理接口/评论
 // @Accept json
 // @Produce json
@@ -81,7 +81,7 @@ func (h *CommentHandler) ApproveComment(c *fiber.Ctx) error {
 	if err != nil {
 		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
 	}
-	resp, err := h.commentService.SetCommentStatus(c.Context(), id, 2)
+	resp, err := h.commentService.SetCommentStatus(c.Context(), id, model.CommentStatusApproved)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return c.JSON(model.NewError(fiber.StatusNotFound, "评论不存在"))
@@ -93,7 +93,7 @@ func (h *CommentHandler) ApproveComment(c *fiber.Ctx) error {
 }
 
 // @Summary 拒绝评论
-// @Description 将评论状态设置为未审核(1)
+// @Description 将评论状态设置为已拒绝(3)，已拒绝的评论不在前台展示
 // @Tags 后台管理接口/评论
 // @Accept json
 // @Produce json
@@ -107,7 +107,7 @@ func (h *CommentHandler) RejectComment(c *fiber.Ctx) error {
 	if err != nil {
 		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
 	}
-	resp, err := h.commentService.SetCommentStatus(c.Context(), id, 1)
+	resp, err := h.commentService.SetCommentStatus(c.Context(), id, model.CommentStatusRejected)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return c.JSON(model.NewError(fiber.StatusNotFound, "评论不存在"))
@@ -143,3 +143,84 @@ func (h *CommentHandler) DeleteComment(c *fiber.Ctx) error {
 
 	return c.JSON(model.NewSuccess("删除成功", nil))
 }
+
+// @Summary 查询审核队列
+// @Description 分页查询待审核或已拒绝的评论，包含审核原因与 AI 评分
+// @Tags 后台管理接口/评论
+// @Accept json
+// @Produce json
+// @Param page query int true "页码"
+// @Param page_size query int true "每页数量"
+// @Param status query int false "评论状态 1待审核 3已拒绝，默认待审核"
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[ent.Comment]}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/comment/moderation/page [get]
+func (h *CommentHandler) QueryModerationPage(c *fiber.Ctx) error {
+	var query model.CommentModerationPageQuery
+	if err := c.QueryParser(&query); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.Size < 1 || query.Size > 100 {
+		query.Size = 10
+	}
+	if query.Status != 0 && query.Status != model.CommentStatusPending && query.Status != model.CommentStatusRejected {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的评论状态"))
+	}
+
+	resp, err := h.commentService.QueryModerationPage(c.Context(), query)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("审核队列获取成功", resp))
+}
+
+// @Summary 批量通过评论
+// @Description 将指定评论批量设置为已通过(2)
+// @Tags 后台管理接口/评论
+// @Accept json
+// @Produce json
+// @Param req body model.CommentBatchReq true "评论ID列表"
+// @Success 200 {object} model.HttpSuccess{data=model.CommentBatchResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/comment/moderation/approve [post]
+func (h *CommentHandler) BatchApproveComment(c *fiber.Ctx) error {
+	return h.batchSetStatus(c, model.CommentStatusApproved, "批量通过成功")
+}
+
+// @Summary 批量拒绝评论
+// @Description 将指定评论批量设置为已拒绝(3)
+// @Tags 后台管理接口/评论
+// @Accept json
+// @Produce json
+// @Param req body model.CommentBatchReq true "评论ID列表"
+// @Success 200 {object} model.HttpSuccess{data=model.CommentBatchResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/comment/moderation/reject [post]
+func (h *CommentHandler) BatchRejectComment(c *fiber.Ctx) error {
+	return h.batchSetStatus(c, model.CommentStatusRejected, "批量拒绝成功")
+}
+
+func (h *CommentHandler) batchSetStatus(c *fiber.Ctx, status int, msg string) error {
+	var req model.CommentBatchReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if len(req.IDs) == 0 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "请选择评论"))
+	}
+	if len(req.IDs) > 100 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "一次最多操作 100 条评论"))
+	}
+
+	updated, err := h.commentService.BatchSetCommentStatus(c.Context(), req.IDs, status)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess(msg, model.CommentBatchResp{Updated: updated}))
+}
@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"github.com/shuTwT/hoshikuzu/ent"
+	ask_handler "github.com/shuTwT/hoshikuzu/internal/handlers/ai/ask"
 	ai_handler "github.com/shuTwT/hoshikuzu/internal/handlers/ai/chat"
+	writing_handler "github.com/shuTwT/hoshikuzu/internal/handlers/ai/writing"
 	album_handler "github.com/shuTwT/hoshikuzu/internal/handlers/content/album"
 	albumphoto_handler "github.com/shuTwT/hoshikuzu/internal/handlers/content/albumphoto"
 	category_handler "github.com/shuTwT/hoshikuzu/internal/handlers/content/category"
@@ -23,33 +25,48 @@ import (
 	storagestrategy "github.com/shuTwT/hoshikuzu/internal/handlers/infra/storagestrategy"
 	theme_handler "github.com/shuTwT/hoshikuzu/internal/handlers/infra/theme"
 	visit_handler "github.com/shuTwT/hoshikuzu/internal/handlers/infra/visit"
+	cart_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/cart"
 	coupon_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/coupon"
 	couponusage_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/couponusage"
+	digital_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/digital"
 	member_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/member"
 	memberlevel_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/memberlevel"
 	payorder_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/payorder"
 	product_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/product"
 	wallet_handler "github.com/shuTwT/hoshikuzu/internal/handlers/mall/wallet"
 	public_handler "github.com/shuTwT/hoshikuzu/internal/handlers/public"
+	account_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/account"
 	auth_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/auth"
 	common_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/common"
 	initialize_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/initialize"
+	loginlog_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/loginlog"
 	notification_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/notification"
+	oauth2_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/oauth2"
+	permission_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/permission"
 	role_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/role"
 	route_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/route"
+	session_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/session"
 	setting_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/setting"
+	social_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/social"
+	twofactor_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/twofactor"
 	user_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/user"
+	webhook_handler "github.com/shuTwT/hoshikuzu/internal/handlers/system/webhook"
 	"github.com/shuTwT/hoshikuzu/pkg"
 )
 
 type HandlerMap struct {
+	AccountHandler          *account_handler.AccountHandler
 	AIHandler               *ai_handler.AIHandler
+	AskHandler              *ask_handler.AskHandler
+	WritingHandler          *writing_handler.WritingHandler
 	AlbumHandler            *album_handler.AlbumHandler
 	AlbumPhotoHandler       *albumphoto_handler.AlbumPhotoHandler
 	AuthHandler             *auth_handler.AuthHandler
 	CategoryHandler         *category_handler.CategoryHandler
 	CommentHandler          *comment_handler.CommentHandler
 	CommonHandler           *common_handler.CommonHandler
+	CartHandler             *cart_handler.CartHandler
+	DigitalHandler          *digital_handler.DigitalHandler
 	CouponHandler           *coupon_handler.CouponHandler
 	CouponUsageHandler      *couponusage_handler.CouponUsageHandler
 	FileHandler             *file_handler.FileHandler
@@ -68,10 +85,16 @@ type HandlerMap struct {
 	PluginHandler           *plugin_handler.PluginHandler
 	PostHandler             *post_handler.PostHandler
 	ProductHandler          *product_handler.ProductHandler
+	PermissionHandler       *permission_handler.PermissionHandler
+	OAuth2Handler           *oauth2_handler.OAuth2Handler
 	RoleHandler             *role_handler.RoleHandler
 	RouteHandler            *route_handler.RouteHandler
 	ScheduleJobHandler      *schedulejob_handler.ScheduleJobHandler
 	SettingHandler          *setting_handler.SettingHandler
+	SocialHandler           *social_handler.SocialHandler
+	TwoFactorHandler        *twofactor_handler.TwoFactorHandler
+	SessionHandler          *session_handler.SessionHandler
+	LoginLogHandler         *loginlog_handler.LoginLogHandler
 	TagHandler              *tag_handler.TagHandler
 	ThemeHandler            *theme_handler.ThemeHandler
 	UserHandler             *user_handler.UserHandler
@@ -79,17 +102,23 @@ type HandlerMap struct {
 	StorageStrategyHandler  *storagestrategy.StorageStrategyHandler
 	VisitHandler            *visit_handler.VisitHandler
 	WalletHandler           *wallet_handler.WalletHandler
+	WebHookHandler          *webhook_handler.WebHookHandler
 	PublicHandler           *public_handler.PublicHandler
 }
 
 func InitHandler(serviceMap pkg.ServiceMap, db *ent.Client) HandlerMap {
-	aiHandler := ai_handler.NewAIHandler(serviceMap.AIService, db)
+	aiHandler := ai_handler.NewAIHandler(serviceMap.AIService, serviceMap.KnowledgeService, db)
+	askHandler := ask_handler.NewAskHandler(serviceMap.AskService)
+	writingHandler := writing_handler.NewWritingHandler(serviceMap.WritingService)
 	albumHandler := album_handler.NewAlbumHandler(serviceMap.AlbumService)
 	albnumPhotoHandler := albumphoto_handler.NewAlbumPhotoHandler(serviceMap.AlbumPhotoService)
+	accountHandler := account_handler.NewAccountHandler(serviceMap.AccountService)
 	authHandler := auth_handler.NewAuthHandler(serviceMap.AuthService)
 	categoryHandler := category_handler.NewCategoryHandler(serviceMap.CategoryService, serviceMap.PostService)
 	commentHandler := comment_handler.NewCommentHandler(serviceMap.CommentService)
 	commonHandler := common_handler.NewCommonHandler(serviceMap.CommonService)
+	cartHandler := cart_handler.NewCartHandler(serviceMap.CartService)
+	digitalHandler := digital_handler.NewDigitalHandler(serviceMap.DigitalService)
 	couponHandler := coupon_handler.NewCouponHandler(serviceMap.CouponService)
 	couponUsageHandler := couponusage_handler.NewCouponUsageHandler(serviceMap.CouponUsageService)
 	fileHandler := file_handler.NewFileHandler(serviceMap.FileService, serviceMap.StorageStrategyService)
@@ -103,9 +132,16 @@ func InitHandler(serviceMap pkg.ServiceMap, db *ent.Client) HandlerMap {
 	payOrderHandler := payorder_handler.NewPayOrderHandler(db, serviceMap.PayOrderService)
 	postHandler := post_handler.NewPostHandler(serviceMap.PostService)
 	productHandler := product_handler.NewProductHandler(serviceMap.ProductService)
+	permissionHandler := permission_handler.NewPermissionHandler(serviceMap.PermissionService)
+	oauth2Handler := oauth2_handler.NewOAuth2Handler(serviceMap.OAuth2Service)
 	roleHandler := role_handler.NewRoleHandler(serviceMap.RoleService)
 	routeHandler := route_handler.NewRouteHandler()
 	settingHandler := setting_handler.NewSettingHandler(serviceMap.SettingService)
+	socialHandler := social_handler.NewSocialHandler(serviceMap.SocialService)
+	twoFactorHandler := twofactor_handler.NewTwoFactorHandler(serviceMap.TwoFactorService)
+	sessionHandler := session_handler.NewSessionHandler(serviceMap.SessionService)
+	webHookHandler := webhook_handler.NewWebHookHandler(serviceMap.WebHookService)
+	loginLogHandler := loginlog_handler.NewLoginLogHandler(serviceMap.LoginLogService, serviceMap.LoginGuardService)
 	tagHandler := tag_handler.NewTagHandler(serviceMap.TagService)
 	userHandler := user_handler.NewUserHandler(serviceMap.UserService, serviceMap.RoleService)
 	essayHandler := essay_handler.NewEssayHandler(serviceMap.EssayService)
@@ -135,16 +171,22 @@ func InitHandler(serviceMap pkg.ServiceMap, db *ent.Client) HandlerMap {
 		serviceMap.ProductService,
 		serviceMap.FlinkApplicationService,
 		serviceMap.PluginService,
-		serviceMap.MenuService)
+		serviceMap.MenuService,
+		serviceMap.PostAccessService)
 
 	handlerMap := HandlerMap{
 		AIHandler:               aiHandler,
+		AskHandler:              askHandler,
+		WritingHandler:          writingHandler,
+		AccountHandler:          accountHandler,
 		AlbumHandler:            albumHandler,
 		AlbumPhotoHandler:       albnumPhotoHandler,
 		AuthHandler:             authHandler,
 		CategoryHandler:         categoryHandler,
 		CommentHandler:          commentHandler,
 		CommonHandler:           commonHandler,
+		CartHandler:             cartHandler,
+		DigitalHandler:          digitalHandler,
 		CouponHandler:           couponHandler,
 		CouponUsageHandler:      couponUsageHandler,
 		FileHandler:             fileHandler,
@@ -163,16 +205,23 @@ func InitHandler(serviceMap pkg.ServiceMap, db *ent.Client) HandlerMap {
 		PluginHandler:           pluginHandler,
 		PostHandler:             postHandler,
 		ProductHandler:          productHandler,
+		PermissionHandler:       permissionHandler,
+		OAuth2Handler:           oauth2Handler,
 		RoleHandler:             roleHandler,
 		RouteHandler:            routeHandler,
 		ScheduleJobHandler:      scheduleJobHandler,
 		SettingHandler:          settingHandler,
+		SocialHandler:           socialHandler,
+		TwoFactorHandler:        twoFactorHandler,
+		SessionHandler:          sessionHandler,
+		LoginLogHandler:         loginLogHandler,
 		TagHandler:              tagHandler,
 		UserHandler:             userHandler,
 		EssayHandler:            essayHandler,
 		StorageStrategyHandler:  storageStrategyHandler,
 		VisitHandler:            visitHandler,
 		WalletHandler:           walletHandler,
+		WebHookHandler:          webHookHandler,
 		ThemeHandler:            themeHandler,
 		PublicHandler:           publicHandler,
 	}
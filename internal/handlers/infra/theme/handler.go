@@ -222,6 +222,66 @@ func (h *ThemeHandler) DisableTheme(c *fiber.Ctx) error {
 	return c.JSON(model.NewSuccess("主题禁用成功", nil))
 }
 
+// @Summary 获取主题配置
+// @Description 获取主题 config.yaml 声明的配置表单及当前配置值
+// @Tags 后台管理接口/主题
+// @Accept json
+// @Produce json
+// @Param id path int true "主题ID"
+// @Success 200 {object} model.HttpSuccess{data=model.ThemeConfigResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/theme/{id}/config [get]
+func (h *ThemeHandler) GetThemeConfig(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		slog.Error("Invalid theme ID", "error", err.Error())
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的主题ID"))
+	}
+
+	resp, err := h.themeService.GetThemeConfig(c.Context(), id)
+	if err != nil {
+		slog.Error("Failed to get theme config", "theme_id", id, "error", err.Error())
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+
+	return c.JSON(model.NewSuccess("主题配置获取成功", resp))
+}
+
+// @Summary 保存主题配置
+// @Description 按主题配置表单校验并保存配置值
+// @Tags 后台管理接口/主题
+// @Accept json
+// @Produce json
+// @Param id path int true "主题ID"
+// @Param req body model.ThemeConfigSaveReq true "主题配置"
+// @Success 200 {object} model.HttpSuccess{data=model.ThemeConfigValues}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/theme/{id}/config [put]
+func (h *ThemeHandler) SaveThemeConfig(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		slog.Error("Invalid theme ID", "error", err.Error())
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的主题ID"))
+	}
+
+	var req model.ThemeConfigSaveReq
+	if err := c.BodyParser(&req); err != nil {
+		slog.Error("Failed to parse request body", "error", err.Error())
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "请求参数解析失败"))
+	}
+
+	values, err := h.themeService.SaveThemeConfig(c.Context(), id, req.Values)
+	if err != nil {
+		slog.Error("Failed to save theme config", "theme_id", id, "error", err.Error())
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+
+	slog.Info("Theme config saved successfully", "theme_id", id)
+	return c.JSON(model.NewSuccess("主题配置保存成功", values))
+}
+
 func (h *ThemeHandler) buildThemeResp(t *ent.Theme) *model.ThemeResp {
 	return &model.ThemeResp{
 		ID:            t.ID,
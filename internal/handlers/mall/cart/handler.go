@@ -0,0 +1,154 @@
+package cart
+
+import (
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
+	cart_service "github.com/shuTwT/hoshikuzu/internal/services/mall/cart"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CartHandler struct {
+	cartService cart_service.CartService
+}
+
+func NewCartHandler(cartService cart_service.CartService) *CartHandler {
+	return &CartHandler{
+		cartService: cartService,
+	}
+}
+
+// @Summary 查询购物车
+// @Description 查询当前用户的购物车，商品按当前价格与库存返回
+// @Tags 后台管理接口/购物车
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=model.CartResp}
+// @Failure 401 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/cart [get]
+func (h *CartHandler) GetCart(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "请先登录"))
+	}
+
+	cart, err := h.cartService.GetCart(c.Context(), loginUser.ID)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", cart))
+}
+
+// @Summary 加入购物车
+// @Description 将商品加入购物车，商品已在购物车中时累加数量
+// @Tags 后台管理接口/购物车
+// @Accept json
+// @Produce json
+// @Param item body model.CartItemAddReq true "购物车商品"
+// @Success 200 {object} model.HttpSuccess{data=model.CartResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 401 {object} model.HttpError
+// @Router /api/v1/cart/add [post]
+func (h *CartHandler) AddItem(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "请先登录"))
+	}
+
+	var req model.CartItemAddReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if req.ProductId <= 0 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "ProductId is required"))
+	}
+
+	cart, err := h.cartService.AddItem(c.Context(), loginUser.ID, &req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", cart))
+}
+
+// @Summary 修改购物车商品数量
+// @Description 修改购物车中指定商品的数量
+// @Tags 后台管理接口/购物车
+// @Accept json
+// @Produce json
+// @Param id path int true "购物车商品ID"
+// @Param item body model.CartItemUpdateReq true "数量"
+// @Success 200 {object} model.HttpSuccess{data=model.CartResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 401 {object} model.HttpError
+// @Router /api/v1/cart/update/{id} [put]
+func (h *CartHandler) UpdateItem(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "请先登录"))
+	}
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	var req model.CartItemUpdateReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+
+	cart, err := h.cartService.UpdateItem(c.Context(), loginUser.ID, id, &req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", cart))
+}
+
+// @Summary 移除购物车商品
+// @Description 从购物车中移除指定商品
+// @Tags 后台管理接口/购物车
+// @Accept json
+// @Produce json
+// @Param id path int true "购物车商品ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 401 {object} model.HttpError
+// @Router /api/v1/cart/delete/{id} [delete]
+func (h *CartHandler) RemoveItem(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "请先登录"))
+	}
+
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	if err := h.cartService.RemoveItem(c.Context(), loginUser.ID, id); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 清空购物车
+// @Description 清空当前用户的购物车
+// @Tags 后台管理接口/购物车
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess
+// @Failure 401 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/cart/clear [delete]
+func (h *CartHandler) ClearCart(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "请先登录"))
+	}
+
+	if err := h.cartService.ClearCart(c.Context(), loginUser.ID); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
@@ -0,0 +1,207 @@
+package digital
+
+import (
+	"path/filepath"
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/storagestrategy"
+	digital_service "github.com/shuTwT/hoshikuzu/internal/services/mall/digital"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DigitalHandler struct {
+	digitalService digital_service.DigitalService
+}
+
+func NewDigitalHandler(digitalService digital_service.DigitalService) *DigitalHandler {
+	return &DigitalHandler{
+		digitalService: digitalService,
+	}
+}
+
+// @Summary 获取数字商品交付内容
+// @Description 获取指定数字商品配置的交付内容（文件、卡密、文本）
+// @Tags 后台管理接口/数字商品
+// @Accept json
+// @Produce json
+// @Param id path int true "商品ID"
+// @Success 200 {object} model.HttpSuccess{data=[]ent.ProductDeliverable}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/product/{id}/deliverables [get]
+func (h *DigitalHandler) ListDeliverables(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	deliverables, err := h.digitalService.ListDeliverables(c.Context(), id)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", deliverables))
+}
+
+// @Summary 新增数字商品交付内容
+// @Description 为数字商品新增交付内容，文件交付需指定文件，文本交付需填写内容，卡密交付从卡密库中分配
+// @Tags 后台管理接口/数字商品
+// @Accept json
+// @Produce json
+// @Param id path int true "商品ID"
+// @Param deliverable body model.ProductDeliverableCreateReq true "交付内容"
+// @Success 200 {object} model.HttpSuccess{data=ent.ProductDeliverable}
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/product/{id}/deliverables [post]
+func (h *DigitalHandler) CreateDeliverable(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	var req model.ProductDeliverableCreateReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	deliverable, err := h.digitalService.CreateDeliverable(c.Context(), id, &req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", deliverable))
+}
+
+// @Summary 删除数字商品交付内容
+// @Description 删除指定的交付内容，已产生的交付记录不受影响
+// @Tags 后台管理接口/数字商品
+// @Accept json
+// @Produce json
+// @Param id path int true "交付内容ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/product/deliverable/delete/{id} [delete]
+func (h *DigitalHandler) DeleteDeliverable(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	if err := h.digitalService.DeleteDeliverable(c.Context(), id); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 获取卡密分页列表
+// @Description 分页获取指定数字商品的卡密库
+// @Tags 后台管理接口/数字商品
+// @Accept json
+// @Produce json
+// @Param id path int true "商品ID"
+// @Param page query int true "页码"
+// @Param page_size query int true "每页数量"
+// @Param status query int false "状态 0 可用 1 已分配 2 已作废"
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[ent.ProductKey]}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/product/{id}/keys [get]
+func (h *DigitalHandler) ListKeysPage(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	var req model.ProductKeyPageQuery
+	if err := c.QueryParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	keys, count, err := h.digitalService.ListKeysPage(c.Context(), id, &req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	pageResult := model.PageResult[*ent.ProductKey]{
+		Total:   int64(count),
+		Records: keys,
+	}
+	return c.JSON(model.NewSuccess("success", pageResult))
+}
+
+// @Summary 导入卡密
+// @Description 向数字商品的卡密库批量导入卡密，重复的卡密会被忽略，导入后自动补发待补货的订单
+// @Tags 后台管理接口/数字商品
+// @Accept json
+// @Produce json
+// @Param id path int true "商品ID"
+// @Param keys body model.ProductKeyImportReq true "卡密列表"
+// @Success 200 {object} model.HttpSuccess{data=model.ProductKeyImportResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/product/{id}/keys [post]
+func (h *DigitalHandler) ImportKeys(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	var req model.ProductKeyImportReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	resp, err := h.digitalService.ImportKeys(c.Context(), id, &req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", resp))
+}
+
+// @Summary 删除卡密
+// @Description 删除卡密库中未分配的卡密
+// @Tags 后台管理接口/数字商品
+// @Accept json
+// @Produce json
+// @Param id path int true "卡密ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/product/key/delete/{id} [delete]
+func (h *DigitalHandler) DeleteKey(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	if err := h.digitalService.DeleteKey(c.Context(), id); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 下载数字商品文件
+// @Description 通过订单中生成的限时签名链接下载已购买的文件，链接过期或订单退款后失效
+// @Tags 公开接口/数字商品
+// @Produce octet-stream
+// @Param id path int true "交付记录ID"
+// @Param expires query int true "过期时间戳"
+// @Param sign query string true "签名"
+// @Success 200 {file} file
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/public/digital/download/{id} [get]
+func (h *DigitalHandler) Download(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusForbidden, "下载链接无效"))
+	}
+	f, err := h.digitalService.ResolveDownload(c.Context(), id, expires, c.Query("sign"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusForbidden, err.Error()))
+	}
+
+	// 本地存储直接输出文件，对象存储跳转到限时有效的预签名地址，不使用文件的公开地址
+	strategy := f.Edges.StorageStrategy
+	if strategy == nil || strategy.Type == storagestrategy.TypeLocal {
+		return c.Download(filepath.Join(f.Path, f.Name), f.Name)
+	}
+	url, err := h.digitalService.ObjectDownloadURL(f)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusForbidden, err.Error()))
+	}
+	return c.Redirect(url, fiber.StatusFound)
+}
@@ -2,12 +2,16 @@ package payorder_handler
 
 import (
 	"html"
+	"log/slog"
+	"net/http"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay/epay"
 	"github.com/shuTwT/hoshikuzu/internal/middleware"
 	payorder_service "github.com/shuTwT/hoshikuzu/internal/services/mall/payorder"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
@@ -48,6 +52,38 @@ func (h *PayOrderHandler) ListPayOrderPage(c *fiber.Ctx) error {
 	return c.JSON(model.NewSuccess("success", pageResult))
 }
 
+// @Summary 我的订单
+// @Description 分页查询当前用户的订单，包含商品明细与数字商品交付内容（卡密、文本、限时下载链接）
+// @Tags 后台管理接口/支付订单
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param size query int false "每页数量" default(10)
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[model.MyPayOrderResp]}
+// @Failure 401 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/pay-order/my [get]
+func (h *PayOrderHandler) ListMyOrders(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "请先登录"))
+	}
+
+	var req model.PageQuery
+	if err := c.QueryParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	orders, count, err := h.payOrderService.ListMyOrders(c.Context(), loginUser.ID, &req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	pageResult := model.PageResult[model.MyPayOrderResp]{
+		Total:   int64(count),
+		Records: orders,
+	}
+	return c.JSON(model.NewSuccess("success", pageResult))
+}
+
 // @Summary 更新支付订单
 // @Description 更新指定支付订单的信息
 // @Tags 后台管理接口/支付订单
@@ -179,24 +215,8 @@ func (h *PayOrderHandler) SubmitPayOrder(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
 	}
-	if req.Money <= 0 {
-		return c.JSON(model.NewError(fiber.StatusBadRequest, "金额必须大于0"))
-	}
-	switch req.OrderType {
-	case model.PayOrderTypePost:
-		if req.PostId <= 0 {
-			return c.JSON(model.NewError(fiber.StatusBadRequest,
-				"PostId is required",
-			))
-		}
-	case model.PayOrderTypeProduct:
-		if req.ProductId <= 0 {
-			return c.JSON(model.NewError(fiber.StatusBadRequest,
-				"ProductId is required",
-			))
-		}
-	default:
-		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的订单类型"))
+	if msg := checkOrderTarget(req.OrderType, req.PostId, req.ProductId, len(req.Items)); msg != "" {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, msg))
 	}
 
 	resp, err := h.payOrderService.SubmitPayOrder(c.Context(), loginUser.ID, &req)
@@ -206,14 +226,65 @@ func (h *PayOrderHandler) SubmitPayOrder(c *fiber.Ctx) error {
 	return c.JSON(model.NewSuccess("success", resp))
 }
 
+// @Summary 订单询价
+// @Description 按文章或商品当前价格、会员折扣和优惠券计算应付金额，提交订单前调用
+// @Tags 后台管理接口/支付订单
+// @Accept json
+// @Produce json
+// @Param payorder body model.PayOrderQuoteReq true "订单询价请求"
+// @Success 200 {object} model.HttpSuccess{data=schema.PayOrderPriceDetail}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/pay-order/quote [post]
+func (h *PayOrderHandler) QuotePayOrder(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "请先登录"))
+	}
+
+	var req model.PayOrderQuoteReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if msg := checkOrderTarget(req.OrderType, req.PostId, req.ProductId, len(req.Items)); msg != "" {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, msg))
+	}
+
+	detail, err := h.payOrderService.QuoteOrder(c.Context(), loginUser.ID, &req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", detail))
+}
+
+// checkOrderTarget 校验订单类型与购买对象，返回错误信息
+func checkOrderTarget(orderType string, postID, productID, itemCount int) string {
+	switch orderType {
+	case model.PayOrderTypePost:
+		if postID <= 0 {
+			return "PostId is required"
+		}
+	case model.PayOrderTypeProduct:
+		if productID <= 0 && itemCount == 0 {
+			return "ProductId or Items is required"
+		}
+	default:
+		return "无效的订单类型"
+	}
+	return ""
+}
+
 // @Summary 支付回调
-// @Description 易支付异步通知回调，公开接口
+// @Description 支付网关异步通知回调，公开接口。易支付使用 /pay-order/notify，其他网关使用 /pay-order/notify/{gateway}
 // @Tags 后台管理接口/支付订单
 // @Accept json
 // @Produce plain
+// @Param gateway path string false "网关名称，如 epay、stripe"
 // @Success 200 {string} string
-// @Router /api/v1/pay-order/notify [post]
+// @Router /api/v1/pay-order/notify/{gateway} [post]
 func (h *PayOrderHandler) NotifyPayOrder(c *fiber.Ctx) error {
+	gatewayName := c.Params("gateway", epay.GatewayName)
+
 	params := make(map[string]string)
 	c.Context().QueryArgs().VisitAll(func(k, v []byte) {
 		params[string(k)] = string(v)
@@ -221,15 +292,25 @@ func (h *PayOrderHandler) NotifyPayOrder(c *fiber.Ctx) error {
 	c.Context().PostArgs().VisitAll(func(k, v []byte) {
 		params[string(k)] = string(v)
 	})
+	header := make(http.Header)
+	c.Request().Header.VisitAll(func(k, v []byte) {
+		header.Add(string(k), string(v))
+	})
 
-	if err := h.payOrderService.HandleNotify(c.Context(), params); err != nil {
+	req := &pay.NotifyReq{
+		Params: params,
+		Header: header,
+		Body:   append([]byte(nil), c.Body()...),
+	}
+	if err := h.payOrderService.HandleNotify(c.Context(), gatewayName, req); err != nil {
+		slog.Warn("支付回调处理失败", "gateway", gatewayName, "error", err)
 		return c.Status(fiber.StatusInternalServerError).SendString("fail")
 	}
 	return c.SendString("success")
 }
 
 // @Summary 查询订单状态
-// @Description 主动同步易支付订单状态并返回
+// @Description 主动同步支付网关订单状态并返回
 // @Tags 后台管理接口/支付订单
 // @Accept json
 // @Produce json
@@ -14,6 +14,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/internal/infra/logger"
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
 	"github.com/shuTwT/hoshikuzu/internal/services/content/album"
 	"github.com/shuTwT/hoshikuzu/internal/services/content/albumphoto"
 	"github.com/shuTwT/hoshikuzu/internal/services/content/category"
@@ -24,6 +25,7 @@ import (
 	"github.com/shuTwT/hoshikuzu/internal/services/content/friendcircle"
 	"github.com/shuTwT/hoshikuzu/internal/services/content/menu"
 	"github.com/shuTwT/hoshikuzu/internal/services/content/post"
+	"github.com/shuTwT/hoshikuzu/internal/services/content/postaccess"
 	"github.com/shuTwT/hoshikuzu/internal/services/content/tag"
 	"github.com/shuTwT/hoshikuzu/internal/services/infra/plugin"
 	"github.com/shuTwT/hoshikuzu/internal/services/infra/visit"
@@ -51,10 +53,11 @@ type PublicHandler struct {
 	flinkApplicationService flinkapplication.FlinkApplicationService
 	pluginService           plugin.PluginService
 	menuService             menu.MenuService
+	postAccessService       postaccess.PostAccessService
 }
 
-func NewPublicHandler(visitService visit.VisitService, commentService comment.CommentService, albumService album.AlbumService, albumPhotoService albumphoto.AlbumPhotoService, flinkService flink.FlinkService, client *ent.Client, friendCircleService friendcircle.FriendCircleService, essayService essay.EssayService, postService post.PostService, categoryService category.CategoryService, tagService tag.TagService, userService user.UserService, productService product.ProductService, flinkApplicationService flinkapplication.FlinkApplicationService, pluginService plugin.PluginService, menuService menu.MenuService) *PublicHandler {
-	return &PublicHandler{visitService: visitService, commentService: commentService, albumService: albumService, albumPhotoService: albumPhotoService, flinkService: flinkService, client: client, friendCircleService: friendCircleService, essayService: essayService, postService: postService, categoryService: categoryService, tagService: tagService, userService: userService, productService: productService, flinkApplicationService: flinkApplicationService, pluginService: pluginService, menuService: menuService}
+func NewPublicHandler(visitService visit.VisitService, commentService comment.CommentService, albumService album.AlbumService, albumPhotoService albumphoto.AlbumPhotoService, flinkService flink.FlinkService, client *ent.Client, friendCircleService friendcircle.FriendCircleService, essayService essay.EssayService, postService post.PostService, categoryService category.CategoryService, tagService tag.TagService, userService user.UserService, productService product.ProductService, flinkApplicationService flinkapplication.FlinkApplicationService, pluginService plugin.PluginService, menuService menu.MenuService, postAccessService postaccess.PostAccessService) *PublicHandler {
+	return &PublicHandler{visitService: visitService, commentService: commentService, albumService: albumService, albumPhotoService: albumPhotoService, flinkService: flinkService, client: client, friendCircleService: friendCircleService, essayService: essayService, postService: postService, categoryService: categoryService, tagService: tagService, userService: userService, productService: productService, flinkApplicationService: flinkApplicationService, pluginService: pluginService, menuService: menuService, postAccessService: postAccessService}
 }
 
 // @Summary 处理访客访问
@@ -152,7 +155,7 @@ func (h *PublicHandler) HandleTwikoo(c *fiber.Ctx) error {
 	// 提交评论
 	if reqBody.Event == model.TWIKOO_EVENT.CommentSubmit {
 		ipAddress := c.IP()
-		id, err := h.commentService.CreateComment(c.Context(), *reqBody.Comment, *reqBody.Href, *reqBody.Link, *reqBody.Mail, *reqBody.Nick, *reqBody.UA, *reqBody.Url, ipAddress)
+		id, err := h.commentService.CreateComment(c.Context(), *reqBody.Comment, *reqBody.Href, *reqBody.Link, *reqBody.Mail, *reqBody.Nick, *reqBody.UA, *reqBody.Url, ipAddress, currentUserID(c))
 		if err != nil {
 			return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
 		}
@@ -544,6 +547,9 @@ func (h *PublicHandler) ListPost(c *fiber.Ctx) error {
 	req.Status = &status
 	req.IsVisible = ptr.Bool(true)
 	posts, err := h.postService.QueryPostList(c.Context(), req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
 	postResps := make([]*model.PostResp, 0, len(posts))
 	for _, post := range posts {
 		postResps = append(postResps, &model.PostResp{
@@ -589,7 +595,7 @@ func (h *PublicHandler) ListPost(c *fiber.Ctx) error {
 			}(),
 		})
 	}
-	if err != nil {
+	if err := h.postAccessService.GuardPosts(c.Context(), currentUserID(c), postResps...); err != nil {
 		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
 	}
 
@@ -664,6 +670,9 @@ func (h *PublicHandler) ListPostPage(c *fiber.Ctx) error {
 			}(),
 		})
 	}
+	if err := h.postAccessService.GuardPosts(c.Context(), currentUserID(c), postResp...); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
 	return c.JSON(model.NewSuccess("success", model.PageResult[*model.PostResp]{
 		Total:   int64(count),
 		Records: postResp,
@@ -694,11 +703,11 @@ func simulateAIProcessing(targetText string, ch chan model.AIResponse) {
 // @Failure 500 {object} model.HttpError
 // @Router /api/v1/public/post/{id}/summary/stream [get]
 func (h *PublicHandler) GetSummaryForStream(c *fiber.Ctx) error {
-	// postId, err := strconv.Atoi(c.Params("id"))
-	// if err != nil {
-	// 	return c.JSON(model.NewError(fiber.StatusBadRequest,
-	// 		"Invalid ID format"))
-	// }
+	postId, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest,
+			"Invalid ID format"))
+	}
 	c.Set("Content-Type", "text/event-stream")
 	c.Set("Cache-Control", "no-cache")
 	c.Set("Connection", "keep-alive")
@@ -706,9 +715,19 @@ func (h *PublicHandler) GetSummaryForStream(c *fiber.Ctx) error {
 
 	responseChan := make(chan model.AIResponse)
 	var targetStr string
-	post, err := h.postService.GetRandomPost(c.Context())
+	post, err := h.postService.QueryPostById(c.Context(), postId)
+	if err == nil && (string(post.Status) != "published" || !post.IsVisible) {
+		err = fmt.Errorf("文章不存在")
+	}
+	var lock *model.PostLock
+	if err == nil {
+		lock, err = h.postAccessService.GuardPost(c.Context(), currentUserID(c), post)
+	}
 	if err != nil {
 		targetStr = "看来遇到了点问题，这不是你的问题" + err.Error()
+	} else if lock != nil {
+		// 摘要由全文生成，未解锁时不输出
+		targetStr = "该文章需要解锁后才能查看摘要"
 	} else {
 		if post.Summary != "" {
 			targetStr = post.Summary
@@ -783,6 +802,9 @@ func (h *PublicHandler) GetRandomPost(c *fiber.Ctx) error {
 	if post == nil {
 		return c.JSON(model.NewError(fiber.StatusNotFound, "No posts found"))
 	}
+	if _, err := h.postAccessService.GuardPost(c.Context(), currentUserID(c), post); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
 	return c.JSON(model.NewSuccess("success", post))
 }
 
@@ -812,6 +834,9 @@ func (h *PublicHandler) GetRandomPosts(c *fiber.Ctx) error {
 	for _, post := range posts {
 		postResps = append(postResps, buildPostResp(post))
 	}
+	if err := h.postAccessService.GuardPosts(c.Context(), currentUserID(c), postResps...); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
 	return c.JSON(model.NewSuccess("success", postResps))
 }
 
@@ -843,9 +868,25 @@ func (h *PublicHandler) GetRelatedPosts(c *fiber.Ctx) error {
 	if err != nil {
 		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
 	}
+	postResps := make([]*model.PostResp, 0, len(posts))
+	for _, p := range posts {
+		postResps = append(postResps, &p.PostResp)
+	}
+	if err := h.postAccessService.GuardPosts(c.Context(), currentUserID(c), postResps...); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
 	return c.JSON(model.NewSuccess("success", posts))
 }
 
+// currentUserID 当前请求的用户ID，匿名访问时返回 nil
+func currentUserID(c *fiber.Ctx) *int {
+	user := middleware.GetCurrentUser(c)
+	if user == nil {
+		return nil
+	}
+	return &user.ID
+}
+
 // buildPostResp 将 ent.Post 转换为文章响应模型
 func buildPostResp(post *ent.Post) *model.PostResp {
 	return &model.PostResp{
@@ -914,6 +955,10 @@ func (h *PublicHandler) SearchPosts(c *fiber.Ctx) error {
 	if err != nil {
 		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
 	}
+	results, err = h.postAccessService.GuardSearchResults(c.Context(), currentUserID(c), results)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
 
 	pageResult := model.PageResult[*model.PostSearchResp]{
 		Total:   int64(total),
@@ -989,6 +1034,9 @@ func (h *PublicHandler) QueryPostBySlug(c *fiber.Ctx) error {
 		}(),
 		CreatedAt: (model.LocalTime)(post.CreatedAt),
 	}
+	if err := h.postAccessService.GuardPosts(c.Context(), currentUserID(c), &postResp); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
 	return c.JSON(model.NewSuccess("success", postResp))
 }
 
@@ -0,0 +1,128 @@
+package account_handler
+
+import (
+	"errors"
+
+	"github.com/shuTwT/hoshikuzu/internal/services/system/account"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AccountHandler struct {
+	accountService account.AccountService
+}
+
+func NewAccountHandler(accountService account.AccountService) *AccountHandler {
+	return &AccountHandler{accountService: accountService}
+}
+
+// @Summary 用户注册
+// @Description 自助注册账号，需要在站点设置中开启注册。开启邮箱验证时发送验证邮件，验证后才能登录，邮箱已注册时同样返回需要验证，不暴露注册情况
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.RegisterReq true "注册请求"
+// @Success 200 {object} model.HttpSuccess{data=model.RegisterResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Router /api/auth/register [post]
+func (h *AccountHandler) Register(c *fiber.Ctx) error {
+	var req model.RegisterReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	resp, err := h.accountService.Register(c.Context(), req)
+	if err != nil {
+		if errors.Is(err, account.ErrRegistrationClosed) {
+			return c.JSON(model.NewError(fiber.StatusForbidden, err.Error()))
+		}
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", resp))
+}
+
+// @Summary 验证邮箱
+// @Description 使用验证邮件中的令牌完成邮箱验证
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.VerifyEmailReq true "验证请求"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Router /api/auth/email/verify [post]
+func (h *AccountHandler) VerifyEmail(c *fiber.Ctx) error {
+	var req model.VerifyEmailReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	if err := h.accountService.VerifyEmail(c.Context(), req.Token); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 重新发送验证邮件
+// @Description 为尚未验证的邮箱重新发送验证邮件，邮箱未注册时同样返回成功
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.EmailReq true "邮箱"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Router /api/auth/email/resend [post]
+func (h *AccountHandler) ResendVerification(c *fiber.Ctx) error {
+	var req model.EmailReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	if err := h.accountService.ResendVerification(c.Context(), req.Email); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 找回密码
+// @Description 向邮箱发送重置密码链接，邮箱未注册时同样返回成功
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.EmailReq true "邮箱"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Router /api/auth/password/forgot [post]
+func (h *AccountHandler) ForgotPassword(c *fiber.Ctx) error {
+	var req model.EmailReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	if err := h.accountService.ForgotPassword(c.Context(), req.Email); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 重置密码
+// @Description 使用重置邮件中的令牌设置新密码，令牌只能使用一次，成功后已登录的会话全部失效
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.ResetPasswordReq true "重置请求"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Router /api/auth/password/reset [post]
+func (h *AccountHandler) ResetPassword(c *fiber.Ctx) error {
+	var req model.ResetPasswordReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	if err := h.accountService.ResetPassword(c.Context(), req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
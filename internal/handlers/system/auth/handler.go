@@ -1,7 +1,10 @@
 package auth_handler
 
 import (
+	"errors"
+
 	"github.com/shuTwT/hoshikuzu/internal/services/system/auth"
+	"github.com/shuTwT/hoshikuzu/internal/services/system/loginguard"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 
 	"github.com/gofiber/fiber/v2"
@@ -24,6 +27,8 @@ func NewAuthHandler(authService auth.AuthService) *AuthHandler {
 // @Success 200 {object} model.HttpSuccess
 // @Failure 400 {object} model.HttpError
 // @Failure 401 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
 // @Failure 500 {object} model.HttpError
 // @Router /api/auth/login/password [post]
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
@@ -36,6 +41,13 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	}
 
 	loginResp, err := h.authService.Login(c.Context(), req, c.Get("User-Agent"), c.IP())
+	if errors.Is(err, auth.ErrEmailNotVerified) {
+		return c.JSON(model.NewError(fiber.StatusForbidden, err.Error()))
+	}
+	var locked *loginguard.LockedError
+	if errors.As(err, &locked) {
+		return c.JSON(model.NewError(fiber.StatusTooManyRequests, err.Error()))
+	}
 	if err != nil {
 		return c.JSON(model.NewError(
 			fiber.StatusUnauthorized,
@@ -89,3 +101,52 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 	}
 	return c.JSON(model.NewSuccess("Logout successful", nil))
 }
+
+// @Summary 两步验证登录
+// @Description 使用登录返回的挑战令牌与 TOTP 验证码或恢复码完成登录。需要绑定验证器时同时启用两步验证并返回恢复码
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.TwoFactorLoginReq true "验证请求"
+// @Success 200 {object} model.HttpSuccess{data=model.LoginResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Router /api/auth/login/two-factor [post]
+func (h *AuthHandler) LoginTwoFactor(c *fiber.Ctx) error {
+	var req model.TwoFactorLoginReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	resp, err := h.authService.LoginTwoFactor(c.Context(), &req, c.Get("User-Agent"), c.IP())
+	var locked *loginguard.LockedError
+	if errors.As(err, &locked) {
+		return c.JSON(model.NewError(fiber.StatusTooManyRequests, err.Error()))
+	}
+	if err != nil {
+		// 前端将 401 视为登录态失效，验证失败使用 400 以展示具体原因
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("Login successful", resp))
+}
+
+// @Summary 登录时绑定验证器
+// @Description 角色要求两步验证但尚未启用时，使用挑战令牌获取待绑定的 TOTP 密钥
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.TwoFactorChallengeReq true "挑战令牌"
+// @Success 200 {object} model.HttpSuccess{data=model.TwoFactorSetupResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 429 {object} model.HttpError
+// @Router /api/auth/login/two-factor/setup [post]
+func (h *AuthHandler) TwoFactorSetup(c *fiber.Ctx) error {
+	var req model.TwoFactorChallengeReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	resp, err := h.authService.TwoFactorSetup(c.Context(), req.ChallengeToken)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", resp))
+}
@@ -94,20 +94,23 @@ func (h *InitializeHandler) initRole(c *fiber.Ctx) *ent.Role {
 	role := h.client.Role.Create().
 		SetID(1).
 		SetName("超级管理员").
-		SetCode("superAdmin").
+		SetCode(model.RoleCodeSuperAdmin).
 		SetIsDefault(true).
+		SetPermissions(model.DefaultRoleScopes[model.RoleCodeSuperAdmin]).
 		SaveX(c.UserContext())
 	h.client.Role.Create().
 		SetID(2).
 		SetName("访客").
-		SetCode("guest").
+		SetCode(model.RoleCodeGuest).
 		SetIsDefault(true).
+		SetPermissions(model.DefaultRoleScopes[model.RoleCodeGuest]).
 		SaveX(c.UserContext())
 	h.client.Role.Create().
 		SetID(3).
 		SetName("普通用户").
-		SetCode("common").
+		SetCode(model.RoleCodeCommon).
 		SetIsDefault(true).
+		SetPermissions(model.DefaultRoleScopes[model.RoleCodeCommon]).
 		SaveX(c.UserContext())
 
 	return role
@@ -0,0 +1,101 @@
+package loginlog_handler
+
+import (
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/loginlog"
+	"github.com/shuTwT/hoshikuzu/internal/services/system/loginguard"
+	loginlog_service "github.com/shuTwT/hoshikuzu/internal/services/system/loginlog"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type LoginLogHandler struct {
+	loginLogService   loginlog_service.LoginLogService
+	loginGuardService loginguard.LoginGuardService
+}
+
+func NewLoginLogHandler(loginLogService loginlog_service.LoginLogService, loginGuardService loginguard.LoginGuardService) *LoginLogHandler {
+	return &LoginLogHandler{loginLogService: loginLogService, loginGuardService: loginGuardService}
+}
+
+// @Summary 查询登录日志分页
+// @Description 查询登录审计日志，可按用户、邮箱、IP 与登录结果筛选
+// @Tags 后台管理接口/登录日志
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(10)
+// @Param user_id query int false "用户ID"
+// @Param email query string false "邮箱"
+// @Param ip query string false "IP"
+// @Param outcome query string false "登录结果" Enums(success, failure, locked, challenge)
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[model.LoginLogResp]}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/login-log/page [get]
+func (h *LoginLogHandler) ListLoginLogPage(c *fiber.Ctx) error {
+	pageQuery := model.LoginLogPageQuery{Page: 1, Size: 10}
+	if err := c.QueryParser(&pageQuery); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if pageQuery.Page < 1 || pageQuery.Size < 1 || pageQuery.Size > 100 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的分页参数"))
+	}
+	if pageQuery.Outcome != "" {
+		if err := loginlog.OutcomeValidator(loginlog.Outcome(pageQuery.Outcome)); err != nil {
+			return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的登录结果"))
+		}
+	}
+
+	logs, count, err := h.loginLogService.QueryLoginLogPage(c.Context(), pageQuery)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	records := make([]model.LoginLogResp, 0, len(logs))
+	for _, l := range logs {
+		records = append(records, model.LoginLogResp{
+			ID:        l.ID,
+			CreatedAt: model.LocalTime(l.CreatedAt),
+			UserID:    l.UserID,
+			Email:     l.Email,
+			Method:    string(l.Method),
+			Outcome:   string(l.Outcome),
+			Reason:    l.Reason,
+			IP:        l.IP,
+			UserAgent: l.UserAgent,
+			Browser:   l.Browser,
+			OS:        l.Os,
+		})
+	}
+	return c.JSON(model.NewSuccess("success", model.PageResult[model.LoginLogResp]{
+		Total:   int64(count),
+		Records: records,
+	}))
+}
+
+// @Summary 解除登录锁定
+// @Description 清除用户账号的登录失败次数并解除锁定
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param id path int true "用户ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/user/unlock/{id} [post]
+func (h *LoginLogHandler) UnlockUser(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	if err := h.loginGuardService.Unlock(c.Context(), id); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(model.NewError(fiber.StatusNotFound, "用户不存在"))
+		}
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
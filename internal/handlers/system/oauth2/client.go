@@ -0,0 +1,177 @@
+package oauth2_handler
+
+import (
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Summary 查询 OAuth2 客户端分页列表
+// @Description 查询已注册的 OAuth2 客户端分页列表
+// @Tags 后台管理接口/OAuth2客户端
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(10)
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[model.Oauth2ClientResp]}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/oauth2-client/page [get]
+func (h *OAuth2Handler) ListClientPage(c *fiber.Ctx) error {
+	var pageQuery = model.PageQuery{}
+	if err := c.QueryParser(&pageQuery); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+
+	clients, count, err := h.oauth2Service.QueryClientPage(c.Context(), pageQuery)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+
+	resps := make([]model.Oauth2ClientResp, 0, len(clients))
+	for _, client := range clients {
+		resps = append(resps, toClientResp(client))
+	}
+	return c.JSON(model.NewSuccess("success", model.PageResult[model.Oauth2ClientResp]{
+		Total:   int64(count),
+		Records: resps,
+	}))
+}
+
+// @Summary 查询 OAuth2 客户端
+// @Description 查询指定 OAuth2 客户端
+// @Tags 后台管理接口/OAuth2客户端
+// @Accept json
+// @Produce json
+// @Param id path int true "客户端ID"
+// @Success 200 {object} model.HttpSuccess{data=model.Oauth2ClientResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/oauth2-client/query/{id} [get]
+func (h *OAuth2Handler) QueryClient(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	client, err := h.oauth2Service.QueryClient(c.Context(), id)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", toClientResp(client)))
+}
+
+// @Summary 注册 OAuth2 客户端
+// @Description 注册 OAuth2 客户端，机密客户端的密钥仅在创建时返回一次
+// @Tags 后台管理接口/OAuth2客户端
+// @Accept json
+// @Produce json
+// @Param createReq body model.Oauth2ClientCreateReq true "客户端创建请求"
+// @Success 200 {object} model.HttpSuccess{data=model.Oauth2ClientSecretResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/oauth2-client/create [post]
+func (h *OAuth2Handler) CreateClient(c *fiber.Ctx) error {
+	var req model.Oauth2ClientCreateReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	client, secret, err := h.oauth2Service.CreateClient(c.Context(), req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", model.Oauth2ClientSecretResp{
+		Oauth2ClientResp: toClientResp(client),
+		ClientSecret:     secret,
+	}))
+}
+
+// @Summary 更新 OAuth2 客户端
+// @Description 更新 OAuth2 客户端，停用时吊销该客户端已签发的全部令牌
+// @Tags 后台管理接口/OAuth2客户端
+// @Accept json
+// @Produce json
+// @Param id path int true "客户端ID"
+// @Param updateReq body model.Oauth2ClientUpdateReq true "客户端更新请求"
+// @Success 200 {object} model.HttpSuccess{data=model.Oauth2ClientResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/oauth2-client/update/{id} [put]
+func (h *OAuth2Handler) UpdateClient(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	var req model.Oauth2ClientUpdateReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	client, err := h.oauth2Service.UpdateClient(c.Context(), id, req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", toClientResp(client)))
+}
+
+// @Summary 重置 OAuth2 客户端密钥
+// @Description 重置机密客户端的密钥，新密钥仅返回一次
+// @Tags 后台管理接口/OAuth2客户端
+// @Accept json
+// @Produce json
+// @Param id path int true "客户端ID"
+// @Success 200 {object} model.HttpSuccess{data=model.Oauth2ClientSecretResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/oauth2-client/reset-secret/{id} [post]
+func (h *OAuth2Handler) ResetClientSecret(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	client, secret, err := h.oauth2Service.ResetClientSecret(c.Context(), id)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", model.Oauth2ClientSecretResp{
+		Oauth2ClientResp: toClientResp(client),
+		ClientSecret:     secret,
+	}))
+}
+
+// @Summary 删除 OAuth2 客户端
+// @Description 删除 OAuth2 客户端及其已签发的全部令牌
+// @Tags 后台管理接口/OAuth2客户端
+// @Accept json
+// @Produce json
+// @Param id path int true "客户端ID"
+// @Success 200 {object} model.HttpSuccess{data=nil}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/oauth2-client/delete/{id} [delete]
+func (h *OAuth2Handler) DeleteClient(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	if err := h.oauth2Service.DeleteClient(c.Context(), id); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+func toClientResp(client *ent.Oauth2Client) model.Oauth2ClientResp {
+	return model.Oauth2ClientResp{
+		ID:           client.ID,
+		CreatedAt:    model.LocalTime(client.CreatedAt),
+		ClientID:     client.ClientID,
+		Name:         client.Name,
+		Description:  client.Description,
+		Logo:         client.Logo,
+		RedirectURIs: client.RedirectUris,
+		Scopes:       client.Scopes,
+		Public:       client.Public,
+		Enabled:      client.Enabled,
+	}
+}
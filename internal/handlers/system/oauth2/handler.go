@@ -0,0 +1,381 @@
+package oauth2_handler
+
+import (
+	"encoding/base64"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
+	"github.com/shuTwT/hoshikuzu/internal/services/system/oauth2"
+	"github.com/shuTwT/hoshikuzu/pkg/config"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// consentPath 控制台中的授权确认页
+const consentPath = "/console/oauth2/authorize"
+
+type OAuth2Handler struct {
+	oauth2Service oauth2.OAuth2Service
+}
+
+func NewOAuth2Handler(oauth2Service oauth2.OAuth2Service) *OAuth2Handler {
+	return &OAuth2Handler{oauth2Service: oauth2Service}
+}
+
+// @Summary OIDC 发现文档
+// @Description 返回 OpenID Connect 发现文档
+// @Tags 公开接口/OAuth2
+// @Produce json
+// @Success 200 {object} model.OIDCDiscoveryResp
+// @Router /.well-known/openid-configuration [get]
+func (h *OAuth2Handler) Discovery(c *fiber.Ctx) error {
+	iss := issuer(c)
+	scopes := []string{model.OIDCScopeOpenID, model.OIDCScopeProfile, model.OIDCScopeEmail}
+	return c.JSON(model.OIDCDiscoveryResp{
+		Issuer:                            iss,
+		AuthorizationEndpoint:             iss + "/api/oauth2/authorize",
+		TokenEndpoint:                     iss + "/api/oauth2/token",
+		UserinfoEndpoint:                  iss + "/api/oauth2/userinfo",
+		JwksURI:                           iss + "/api/oauth2/jwks",
+		RevocationEndpoint:                iss + "/api/oauth2/revoke",
+		IntrospectionEndpoint:             iss + "/api/oauth2/introspect",
+		ScopesSupported:                   append(scopes, permission.DefinedScopes()...),
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{"RS256"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "name", "preferred_username", "nickname", "email", "email_verified"},
+	})
+}
+
+// @Summary JWKS
+// @Description 返回用于校验 id_token 签名的公钥
+// @Tags 公开接口/OAuth2
+// @Produce json
+// @Success 200 {object} model.JWKSResp
+// @Failure 500 {object} model.OAuth2ErrorResp
+// @Router /api/oauth2/jwks [get]
+func (h *OAuth2Handler) JWKS(c *fiber.Ctx) error {
+	jwks, err := h.oauth2Service.JWKS()
+	if err != nil {
+		return h.protocolError(c, err)
+	}
+	return c.JSON(jwks)
+}
+
+// @Summary 授权端点
+// @Description 校验授权请求后跳转到控制台授权确认页，客户端或回调地址无效时直接返回错误
+// @Tags 公开接口/OAuth2
+// @Produce json
+// @Param response_type query string true "固定为 code"
+// @Param client_id query string true "客户端ID"
+// @Param redirect_uri query string true "回调地址"
+// @Param scope query string true "授权范围,空格分隔"
+// @Param state query string false "客户端状态"
+// @Param code_challenge query string false "PKCE 挑战码,公开客户端必填"
+// @Param code_challenge_method query string false "PKCE 挑战方式,仅支持 S256"
+// @Param nonce query string false "OIDC nonce"
+// @Success 302
+// @Failure 400 {object} model.OAuth2ErrorResp
+// @Router /api/oauth2/authorize [get]
+func (h *OAuth2Handler) Authorize(c *fiber.Ctx) error {
+	var req model.OAuth2AuthorizeReq
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.OAuth2ErrorResp{Error: oauth2.ErrInvalidRequest, ErrorDescription: err.Error()})
+	}
+	if _, _, err := h.oauth2Service.CheckAuthorizeRequest(c.Context(), &req); err != nil {
+		if e, ok := oauth2.IsError(err); ok {
+			return c.Redirect(redirectWithError(req.RedirectURI, e, req.State))
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(model.OAuth2ErrorResp{Error: oauth2.ErrInvalidRequest, ErrorDescription: err.Error()})
+	}
+	return c.Redirect(consentPath + "?" + string(c.Context().QueryArgs().QueryString()))
+}
+
+// @Summary 查询授权确认信息
+// @Description 校验授权请求并返回客户端信息与当前用户可授予的授权范围
+// @Tags 后台管理接口/OAuth2授权
+// @Produce json
+// @Param response_type query string true "固定为 code"
+// @Param client_id query string true "客户端ID"
+// @Param redirect_uri query string true "回调地址"
+// @Param scope query string true "授权范围,空格分隔"
+// @Param code_challenge query string false "PKCE 挑战码"
+// @Param code_challenge_method query string false "PKCE 挑战方式"
+// @Success 200 {object} model.HttpSuccess{data=model.OAuth2ConsentResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/oauth2/authorize [get]
+func (h *OAuth2Handler) GetConsent(c *fiber.Ctx) error {
+	var req model.OAuth2AuthorizeReq
+	if err := c.QueryParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	client, scopes, err := h.oauth2Service.CheckAuthorizeRequest(c.Context(), &req)
+	if err != nil {
+		if e, ok := oauth2.IsError(err); ok {
+			return c.JSON(model.NewError(fiber.StatusBadRequest, e.Description))
+		}
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	user := middleware.GetCurrentUser(c)
+	granted, err := h.oauth2Service.GrantedScopes(c.Context(), user.ID, scopes)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+
+	items := make([]model.OAuth2ScopeResp, 0, len(granted))
+	for _, scope := range granted {
+		title, ok := model.OIDCScopes[scope]
+		if !ok {
+			title = permission.ScopeTitle(scope)
+		}
+		items = append(items, model.OAuth2ScopeResp{Scope: scope, Title: title})
+	}
+	return c.JSON(model.NewSuccess("success", model.OAuth2ConsentResp{
+		ClientName:  client.Name,
+		Description: client.Description,
+		Logo:        client.Logo,
+		RedirectURI: req.RedirectURI,
+		Scopes:      items,
+	}))
+}
+
+// @Summary 确认授权
+// @Description 用户同意或拒绝授权，返回携带授权码或错误的客户端回调地址。仅支持登录会话调用。
+// @Tags 后台管理接口/OAuth2授权
+// @Accept json
+// @Produce json
+// @Param body body model.OAuth2ConsentReq true "授权确认请求"
+// @Success 200 {object} model.HttpSuccess{data=model.OAuth2AuthorizeResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/oauth2/authorize [post]
+func (h *OAuth2Handler) Consent(c *fiber.Ctx) error {
+	// 令牌可能只被授予了部分权限，不能用来签发新的授权
+	if c.Locals("authType") != "jwt" {
+		return c.JSON(model.NewError(fiber.StatusForbidden, "请使用登录会话确认授权"))
+	}
+	var req model.OAuth2ConsentReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+
+	if !req.Approve {
+		if _, _, err := h.oauth2Service.CheckAuthorizeRequest(c.Context(), &req.OAuth2AuthorizeReq); err != nil {
+			if _, ok := oauth2.IsError(err); !ok {
+				return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+			}
+		}
+		denied := &oauth2.Error{Code: oauth2.ErrAccessDenied, Description: "用户拒绝授权"}
+		return c.JSON(model.NewSuccess("success", model.OAuth2AuthorizeResp{
+			RedirectURI: redirectWithError(req.RedirectURI, denied, req.State),
+		}))
+	}
+
+	user := middleware.GetCurrentUser(c)
+	code, err := h.oauth2Service.Authorize(c.Context(), user.ID, &req.OAuth2AuthorizeReq)
+	if err != nil {
+		if e, ok := oauth2.IsError(err); ok {
+			return c.JSON(model.NewSuccess("success", model.OAuth2AuthorizeResp{
+				RedirectURI: redirectWithError(req.RedirectURI, e, req.State),
+			}))
+		}
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	params := url.Values{"code": {code}}
+	if req.State != "" {
+		params.Set("state", req.State)
+	}
+	return c.JSON(model.NewSuccess("success", model.OAuth2AuthorizeResp{
+		RedirectURI: appendQuery(req.RedirectURI, params),
+	}))
+}
+
+// @Summary 令牌端点
+// @Description 使用授权码或刷新令牌换取访问令牌，客户端凭据可通过 Basic 认证或表单提交
+// @Tags 公开接口/OAuth2
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param grant_type formData string true "authorization_code 或 refresh_token"
+// @Param code formData string false "授权码"
+// @Param redirect_uri formData string false "回调地址,与授权请求一致"
+// @Param code_verifier formData string false "PKCE 校验码"
+// @Param refresh_token formData string false "刷新令牌"
+// @Param scope formData string false "刷新时申请的更小授权范围"
+// @Param client_id formData string false "客户端ID"
+// @Param client_secret formData string false "客户端密钥"
+// @Success 200 {object} model.OAuth2TokenResp
+// @Failure 400 {object} model.OAuth2ErrorResp
+// @Failure 401 {object} model.OAuth2ErrorResp
+// @Router /api/oauth2/token [post]
+func (h *OAuth2Handler) Token(c *fiber.Ctx) error {
+	var req model.OAuth2TokenReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.OAuth2ErrorResp{Error: oauth2.ErrInvalidRequest, ErrorDescription: err.Error()})
+	}
+	basicAuth(c, &req.ClientID, &req.ClientSecret)
+
+	resp, err := h.oauth2Service.Exchange(c.Context(), &req, issuer(c))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Set("Pragma", "no-cache")
+	if err != nil {
+		return h.protocolError(c, err)
+	}
+	return c.JSON(resp)
+}
+
+// @Summary 吊销令牌
+// @Description 吊销访问令牌或刷新令牌（RFC 7009），令牌无效时同样返回成功
+// @Tags 公开接口/OAuth2
+// @Accept x-www-form-urlencoded
+// @Param token formData string true "令牌"
+// @Param token_type_hint formData string false "令牌类型提示"
+// @Param client_id formData string false "客户端ID"
+// @Param client_secret formData string false "客户端密钥"
+// @Success 200
+// @Failure 401 {object} model.OAuth2ErrorResp
+// @Router /api/oauth2/revoke [post]
+func (h *OAuth2Handler) Revoke(c *fiber.Ctx) error {
+	var req model.OAuth2TokenActionReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.OAuth2ErrorResp{Error: oauth2.ErrInvalidRequest, ErrorDescription: err.Error()})
+	}
+	basicAuth(c, &req.ClientID, &req.ClientSecret)
+
+	if err := h.oauth2Service.Revoke(c.Context(), &req); err != nil {
+		return h.protocolError(c, err)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// @Summary 令牌内省
+// @Description 查询令牌状态（RFC 7662），仅机密客户端可调用
+// @Tags 公开接口/OAuth2
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param token formData string true "令牌"
+// @Param token_type_hint formData string false "令牌类型提示"
+// @Param client_id formData string false "客户端ID"
+// @Param client_secret formData string false "客户端密钥"
+// @Success 200 {object} model.OAuth2IntrospectResp
+// @Failure 401 {object} model.OAuth2ErrorResp
+// @Router /api/oauth2/introspect [post]
+func (h *OAuth2Handler) Introspect(c *fiber.Ctx) error {
+	var req model.OAuth2TokenActionReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(model.OAuth2ErrorResp{Error: oauth2.ErrInvalidRequest, ErrorDescription: err.Error()})
+	}
+	basicAuth(c, &req.ClientID, &req.ClientSecret)
+
+	resp, err := h.oauth2Service.Introspect(c.Context(), &req, issuer(c))
+	if err != nil {
+		return h.protocolError(c, err)
+	}
+	return c.JSON(resp)
+}
+
+// @Summary 用户信息
+// @Description 使用包含 openid 授权范围的访问令牌查询用户信息
+// @Tags 公开接口/OAuth2
+// @Produce json
+// @Param Authorization header string true "Bearer 访问令牌"
+// @Success 200 {object} model.OIDCUserInfoResp
+// @Failure 401 {object} model.OAuth2ErrorResp
+// @Failure 403 {object} model.OAuth2ErrorResp
+// @Router /api/oauth2/userinfo [get]
+func (h *OAuth2Handler) UserInfo(c *fiber.Ctx) error {
+	token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if !ok || token == "" {
+		c.Set(fiber.HeaderWWWAuthenticate, `Bearer realm="hoshikuzu"`)
+		return c.Status(fiber.StatusUnauthorized).JSON(model.OAuth2ErrorResp{Error: oauth2.ErrInvalidToken, ErrorDescription: "缺少访问令牌"})
+	}
+	info, err := h.oauth2Service.UserInfo(c.Context(), token)
+	if err != nil {
+		if e, ok := oauth2.IsError(err); ok {
+			status := fiber.StatusUnauthorized
+			if e.Code == oauth2.ErrInsufficientScope {
+				status = fiber.StatusForbidden
+			}
+			c.Set(fiber.HeaderWWWAuthenticate, `Bearer error="`+e.Code+`"`)
+			return c.Status(status).JSON(model.OAuth2ErrorResp{Error: e.Code, ErrorDescription: e.Description})
+		}
+		return h.protocolError(c, err)
+	}
+	return c.JSON(info)
+}
+
+// protocolError 按 OAuth2 规范返回错误，客户端认证失败为 401，其余协议错误为 400
+func (h *OAuth2Handler) protocolError(c *fiber.Ctx, err error) error {
+	e, ok := oauth2.IsError(err)
+	if !ok {
+		slog.Error("OAuth2 请求处理失败", "path", c.Path(), "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(model.OAuth2ErrorResp{Error: "server_error"})
+	}
+	status := fiber.StatusBadRequest
+	if e.Code == oauth2.ErrInvalidClient {
+		status = fiber.StatusUnauthorized
+		c.Set(fiber.HeaderWWWAuthenticate, `Basic realm="hoshikuzu"`)
+	}
+	return c.Status(status).JSON(model.OAuth2ErrorResp{Error: e.Code, ErrorDescription: e.Description})
+}
+
+// issuer 签发者地址，未配置时按请求地址推断
+func issuer(c *fiber.Ctx) string {
+	if iss := config.GetString(config.OAUTH2_ISSUER); iss != "" {
+		return strings.TrimSuffix(iss, "/")
+	}
+	return c.BaseURL()
+}
+
+// basicAuth 读取 client_secret_basic 方式提交的客户端凭据，覆盖表单中的凭据
+func basicAuth(c *fiber.Ctx, clientID, clientSecret *string) {
+	encoded, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Basic ")
+	if !ok {
+		return
+	}
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return
+	}
+	id, secret, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return
+	}
+	// RFC 6749 2.3.1：凭据先经过表单编码再做 Base64
+	if id, err = url.QueryUnescape(id); err != nil {
+		return
+	}
+	if secret, err = url.QueryUnescape(secret); err != nil {
+		return
+	}
+	*clientID, *clientSecret = id, secret
+}
+
+// redirectWithError 将协议错误通过回调地址带回客户端
+func redirectWithError(redirectURI string, e *oauth2.Error, state string) string {
+	params := url.Values{"error": {e.Code}, "error_description": {e.Description}}
+	if state != "" {
+		params.Set("state", state)
+	}
+	return appendQuery(redirectURI, params)
+}
+
+// appendQuery 在回调地址原有查询参数后追加参数
+func appendQuery(redirectURI string, params url.Values) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,60 @@
+package permission
+
+import (
+	permission_service "github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PermissionHandler struct {
+	permissionService permission_service.PermissionService
+}
+
+func NewPermissionHandler(permissionService permission_service.PermissionService) *PermissionHandler {
+	return &PermissionHandler{
+		permissionService: permissionService,
+	}
+}
+
+// @Summary 查询权限列表
+// @Description 按模块分组列出全部已定义的权限，用于角色授权
+// @Tags 后台管理接口/权限
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.PermissionGroupResp}
+// @Router /api/v1/permission/list [get]
+func (h *PermissionHandler) ListPermissions(c *fiber.Ctx) error {
+	return c.JSON(model.NewSuccess("success", h.permissionService.ListPermissionGroups()))
+}
+
+// @Summary 查询当前用户可授予的权限
+// @Description 按模块分组列出当前用户角色拥有的权限，用于创建个人访问令牌时选择权限范围
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.PermissionGroupResp}
+// @Router /api/v1/user/personal-access-token/scopes [get]
+func (h *PermissionHandler) ListGrantedPermissions(c *fiber.Ctx) error {
+	roleScopes, _ := c.Locals("scopes").([]string)
+	tokenScopes, _ := c.Locals("tokenScopes").([]string)
+
+	groups := []model.PermissionGroupResp{}
+	for _, group := range h.permissionService.ListPermissionGroups() {
+		items := make([]model.PermissionResp, 0, len(group.Permissions))
+		for _, p := range group.Permissions {
+			if !permission_service.HasScope(roleScopes, p.Scope) {
+				continue
+			}
+			if tokenScopes != nil && !permission_service.HasScope(tokenScopes, p.Scope) {
+				continue
+			}
+			items = append(items, p)
+		}
+		if len(items) > 0 {
+			group.Permissions = items
+			groups = append(groups, group)
+		}
+	}
+	return c.JSON(model.NewSuccess("success", groups))
+}
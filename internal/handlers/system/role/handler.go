@@ -39,11 +39,13 @@ func (h *RoleHandler) ListRole(c *fiber.Ctx) error {
 
 	for _, role := range roles {
 		resps = append(resps, model.RoleResp{
-			ID:        role.ID,
-			Name:      role.Name,
-			Code:      role.Code,
-			CreatedAt: model.LocalTime(role.CreatedAt),
-			IsDefault: role.IsDefault,
+			ID:               role.ID,
+			Name:             role.Name,
+			Code:             role.Code,
+			CreatedAt:        model.LocalTime(role.CreatedAt),
+			IsDefault:        role.IsDefault,
+			Permissions:      role.Permissions,
+			RequireTwoFactor: role.RequireTwoFactor,
 		})
 	}
 
@@ -82,11 +84,13 @@ func (h *RoleHandler) ListRolePage(c *fiber.Ctx) error {
 
 	for _, role := range roles {
 		resps = append(resps, model.RoleResp{
-			ID:        role.ID,
-			Name:      role.Name,
-			Code:      role.Code,
-			CreatedAt: model.LocalTime(role.CreatedAt),
-			IsDefault: role.IsDefault,
+			ID:               role.ID,
+			Name:             role.Name,
+			Code:             role.Code,
+			CreatedAt:        model.LocalTime(role.CreatedAt),
+			IsDefault:        role.IsDefault,
+			Permissions:      role.Permissions,
+			RequireTwoFactor: role.RequireTwoFactor,
 		})
 	}
 
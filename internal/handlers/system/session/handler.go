@@ -0,0 +1,105 @@
+package session_handler
+
+import (
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
+	"github.com/shuTwT/hoshikuzu/internal/services/system/session"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SessionHandler struct {
+	sessionService session.SessionService
+}
+
+func NewSessionHandler(sessionService session.SessionService) *SessionHandler {
+	return &SessionHandler{sessionService: sessionService}
+}
+
+// @Summary 登录会话列表
+// @Description 列出当前用户已登录的设备，current 标记发起请求的会话
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.SessionResp}
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/user/sessions [get]
+func (h *SessionHandler) ListSessions(c *fiber.Ctx) error {
+	user := middleware.GetCurrentUser(c)
+	sid, _ := c.Locals("sessionId").(string)
+	sessions, err := h.sessionService.ListSessions(c.Context(), user.ID, sid)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", sessions))
+}
+
+// @Summary 退出登录会话
+// @Description 吊销当前用户的指定会话，该设备需要重新登录
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param id path int true "会话ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/user/sessions/{id} [delete]
+func (h *SessionHandler) RevokeSession(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	user := middleware.GetCurrentUser(c)
+	if err := h.sessionService.RevokeSession(c.Context(), user.ID, id); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(model.NewError(fiber.StatusNotFound, "会话不存在"))
+		}
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 退出其他会话
+// @Description 吊销当前用户除当前会话外的全部会话。仅支持登录会话调用。
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=model.SessionRevokeResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/user/sessions/revoke-others [post]
+func (h *SessionHandler) RevokeOtherSessions(c *fiber.Ctx) error {
+	sid, _ := c.Locals("sessionId").(string)
+	if sid == "" {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "请使用登录会话操作"))
+	}
+	user := middleware.GetCurrentUser(c)
+	n, err := h.sessionService.RevokeOtherSessions(c.Context(), user.ID, sid)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", model.SessionRevokeResp{Revoked: n}))
+}
+
+// @Summary 强制用户下线
+// @Description 管理员吊销指定用户的全部会话，该用户所有设备需要重新登录
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param id path int true "用户ID"
+// @Success 200 {object} model.HttpSuccess{data=model.SessionRevokeResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/user/sessions/revoke-user/{id} [post]
+func (h *SessionHandler) RevokeUserSessions(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	n, err := h.sessionService.RevokeUserSessions(c.Context(), id)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", model.SessionRevokeResp{Revoked: n}))
+}
@@ -26,6 +26,13 @@ var retiredAISettingKeys = map[string]struct{}{
 	"openai_presence_penalty":  {},
 }
 
+// privateSettingKeys 包含密钥或屏蔽名单的设置，只能通过需要权限的 JSON 设置接口读取
+var privateSettingKeys = map[string]struct{}{
+	model.SettingKeySocialLogin:       {},
+	model.SettingKeyEmail:             {},
+	model.SettingKeyCommentModeration: {},
+}
+
 func NewSettingHandler(settingService setting_service.SettingService) *SettingHandler {
 	return &SettingHandler{
 		settingService: settingService,
@@ -60,6 +67,9 @@ func (h *SettingHandler) GetSettings(c *fiber.Ctx) error {
 		if isRetiredAISettingKey(s.Key) {
 			continue
 		}
+		if _, ok := privateSettingKeys[s.Key]; ok {
+			continue
+		}
 		settingsMap[s.Key] = s.Value
 	}
 
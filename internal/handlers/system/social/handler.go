@@ -0,0 +1,200 @@
+package social_handler
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
+	"github.com/shuTwT/hoshikuzu/internal/services/system/social"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// stateCookie 保存授权上下文的 Cookie，只在本浏览器发起的授权回调中有效
+const stateCookie = "hoshikuzu_social_state"
+
+type SocialHandler struct {
+	socialService social.SocialService
+}
+
+func NewSocialHandler(socialService social.SocialService) *SocialHandler {
+	return &SocialHandler{socialService: socialService}
+}
+
+// @Summary 社交登录方式列表
+// @Description 获取已启用的第三方登录方式
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.SocialProviderResp}
+// @Failure 500 {object} model.HttpError
+// @Router /api/auth/social/providers [get]
+func (h *SocialHandler) ListProviders(c *fiber.Ctx) error {
+	providers, err := h.socialService.ListProviders(c.Context())
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", providers))
+}
+
+// @Summary 发起社交登录
+// @Description 返回第三方授权页地址，授权上下文写入 HttpOnly Cookie
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param provider path string true "登录方式标识"
+// @Param body body model.SocialAuthorizeReq true "授权请求"
+// @Success 200 {object} model.HttpSuccess{data=model.SocialAuthorizeResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/auth/social/{provider}/authorize [post]
+func (h *SocialHandler) Authorize(c *fiber.Ctx) error {
+	return h.authorize(c, 0)
+}
+
+// @Summary 社交登录回调
+// @Description 使用第三方授权码登录，未关联的账号按设置自动注册
+// @Tags 公开接口/认证
+// @Accept json
+// @Produce json
+// @Param body body model.SocialCallbackReq true "授权回调参数"
+// @Success 200 {object} model.HttpSuccess{data=model.LoginResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/auth/social/login [post]
+func (h *SocialHandler) Login(c *fiber.Ctx) error {
+	var req model.SocialCallbackReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	stateToken := c.Cookies(stateCookie)
+	clearStateCookie(c)
+	resp, err := h.socialService.Login(c.Context(), stateToken, req, c.Get("User-Agent"), c.IP())
+	if err != nil {
+		// 前端将登录接口的 401 视为登录态失效，第三方登录失败使用 400 以展示具体原因
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("Login successful", resp))
+}
+
+// @Summary 第三方账号列表
+// @Description 获取当前用户绑定的第三方账号
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.UserIdentityResp}
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/user/identity/list [get]
+func (h *SocialHandler) ListIdentities(c *fiber.Ctx) error {
+	user := middleware.GetCurrentUser(c)
+	if user == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "Unauthorized"))
+	}
+	identities, err := h.socialService.ListIdentities(c.Context(), user.ID)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", identities))
+}
+
+// @Summary 发起绑定第三方账号
+// @Description 返回第三方授权页地址，授权上下文写入 HttpOnly Cookie。仅支持登录会话调用。
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param provider path string true "登录方式标识"
+// @Param body body model.SocialAuthorizeReq true "授权请求"
+// @Success 200 {object} model.HttpSuccess{data=model.SocialAuthorizeResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/user/identity/authorize/{provider} [post]
+func (h *SocialHandler) LinkAuthorize(c *fiber.Ctx) error {
+	// 绑定后可通过第三方账号登录，不能由只被授予部分权限的令牌发起
+	if c.Locals("authType") != "jwt" {
+		return c.JSON(model.NewError(fiber.StatusForbidden, "请使用登录会话绑定第三方账号"))
+	}
+	user := middleware.GetCurrentUser(c)
+	return h.authorize(c, user.ID)
+}
+
+// @Summary 绑定第三方账号
+// @Description 使用第三方授权码为当前用户绑定第三方账号。仅支持登录会话调用。
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param body body model.SocialCallbackReq true "授权回调参数"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/user/identity/link [post]
+func (h *SocialHandler) Link(c *fiber.Ctx) error {
+	if c.Locals("authType") != "jwt" {
+		return c.JSON(model.NewError(fiber.StatusForbidden, "请使用登录会话绑定第三方账号"))
+	}
+	var req model.SocialCallbackReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	user := middleware.GetCurrentUser(c)
+	stateToken := c.Cookies(stateCookie)
+	clearStateCookie(c)
+	if _, err := h.socialService.Link(c.Context(), user.ID, stateToken, req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 解绑第三方账号
+// @Description 解绑当前用户的第三方账号
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param id path int true "第三方账号ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/user/identity/delete/{id} [delete]
+func (h *SocialHandler) Unlink(c *fiber.Ctx) error {
+	user := middleware.GetCurrentUser(c)
+	if user == nil {
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "Unauthorized"))
+	}
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if err := h.socialService.Unlink(c.Context(), user.ID, id); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+func (h *SocialHandler) authorize(c *fiber.Ctx, userId int) error {
+	var req model.SocialAuthorizeReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	authURL, stateToken, err := h.socialService.AuthCodeURL(c.Context(), c.Params("provider"), req, userId)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	c.Cookie(&fiber.Cookie{
+		Name:     stateCookie,
+		Value:    stateToken,
+		Path:     "/api",
+		Expires:  time.Now().Add(10 * time.Minute),
+		Secure:   c.Protocol() == "https",
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+	return c.JSON(model.NewSuccess("success", model.SocialAuthorizeResp{AuthorizeURL: authURL}))
+}
+
+// clearStateCookie 授权上下文只能使用一次
+func clearStateCookie(c *fiber.Ctx) {
+	c.Cookie(&fiber.Cookie{
+		Name:     stateCookie,
+		Path:     "/api",
+		Expires:  time.Unix(0, 0),
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+}
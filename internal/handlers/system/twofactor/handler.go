@@ -0,0 +1,157 @@
+package twofactor_handler
+
+import (
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
+	"github.com/shuTwT/hoshikuzu/internal/services/system/twofactor"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// sessionRequired 修改两步验证只能由登录会话发起，不能使用只被授予部分权限的令牌
+const sessionRequired = "请使用登录会话修改两步验证"
+
+type TwoFactorHandler struct {
+	twoFactorService twofactor.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorService twofactor.TwoFactorService) *TwoFactorHandler {
+	return &TwoFactorHandler{twoFactorService: twoFactorService}
+}
+
+// @Summary 两步验证状态
+// @Description 获取当前用户的两步验证状态
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=model.TwoFactorStatusResp}
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/user/two-factor/status [get]
+func (h *TwoFactorHandler) Status(c *fiber.Ctx) error {
+	user := middleware.GetCurrentUser(c)
+	status, err := h.twoFactorService.Status(c.Context(), user.ID)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", status))
+}
+
+// @Summary 获取两步验证密钥
+// @Description 生成待绑定的 TOTP 密钥，使用验证器应用中的验证码启用后生效。仅支持登录会话调用。
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=model.TwoFactorSetupResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/user/two-factor/setup [post]
+func (h *TwoFactorHandler) Setup(c *fiber.Ctx) error {
+	if c.Locals("authType") != "jwt" {
+		return c.JSON(model.NewError(fiber.StatusForbidden, sessionRequired))
+	}
+	user := middleware.GetCurrentUser(c)
+	resp, err := h.twoFactorService.Setup(c.Context(), user.ID)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", resp))
+}
+
+// @Summary 启用两步验证
+// @Description 校验验证码后启用两步验证，返回的恢复码只显示一次。仅支持登录会话调用。
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param body body model.TwoFactorCodeReq true "验证码"
+// @Success 200 {object} model.HttpSuccess{data=model.TwoFactorRecoveryCodesResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/user/two-factor/enable [post]
+func (h *TwoFactorHandler) Enable(c *fiber.Ctx) error {
+	if c.Locals("authType") != "jwt" {
+		return c.JSON(model.NewError(fiber.StatusForbidden, sessionRequired))
+	}
+	var req model.TwoFactorCodeReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	user := middleware.GetCurrentUser(c)
+	codes, err := h.twoFactorService.Enable(c.Context(), user.ID, req.Code)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", model.TwoFactorRecoveryCodesResp{RecoveryCodes: codes}))
+}
+
+// @Summary 关闭两步验证
+// @Description 校验验证码或恢复码后关闭两步验证，角色要求两步验证时不能关闭。仅支持登录会话调用。
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param body body model.TwoFactorCodeReq true "验证码"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/user/two-factor/disable [post]
+func (h *TwoFactorHandler) Disable(c *fiber.Ctx) error {
+	if c.Locals("authType") != "jwt" {
+		return c.JSON(model.NewError(fiber.StatusForbidden, sessionRequired))
+	}
+	var req model.TwoFactorCodeReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	user := middleware.GetCurrentUser(c)
+	if err := h.twoFactorService.Disable(c.Context(), user.ID, req.Code); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 重新生成恢复码
+// @Description 校验验证码后重新生成恢复码，旧恢复码全部失效。仅支持登录会话调用。
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param body body model.TwoFactorCodeReq true "验证码"
+// @Success 200 {object} model.HttpSuccess{data=model.TwoFactorRecoveryCodesResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 403 {object} model.HttpError
+// @Router /api/v1/user/two-factor/recovery-codes [post]
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	if c.Locals("authType") != "jwt" {
+		return c.JSON(model.NewError(fiber.StatusForbidden, sessionRequired))
+	}
+	var req model.TwoFactorCodeReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid request body"))
+	}
+	user := middleware.GetCurrentUser(c)
+	codes, err := h.twoFactorService.RegenerateRecoveryCodes(c.Context(), user.ID, req.Code)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", model.TwoFactorRecoveryCodesResp{RecoveryCodes: codes}))
+}
+
+// @Summary 重置用户两步验证
+// @Description 管理员为丢失验证器与恢复码的用户清除两步验证，角色要求两步验证时用户下次登录需重新绑定
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param id path int true "用户ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/user/two-factor/reset/{id} [post]
+func (h *TwoFactorHandler) Reset(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	if err := h.twoFactorService.Reset(c.Context(), id); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
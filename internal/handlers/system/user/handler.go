@@ -7,6 +7,7 @@ import (
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/internal/middleware"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
 	role_service "github.com/shuTwT/hoshikuzu/internal/services/system/role"
 	user_service "github.com/shuTwT/hoshikuzu/internal/services/system/user"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
@@ -44,6 +45,7 @@ func (h *UserHandler) ListUser(c *fiber.Ctx) error {
 			Name:        user.Name,
 			Email:       user.Email,
 			PhoneNumber: user.PhoneNumber,
+			TotpEnabled: user.TotpEnabled,
 			RoleID:      &user.RoleID,
 			CreatedAt:   model.LocalTime(user.CreatedAt),
 		})
@@ -84,6 +86,7 @@ func (h *UserHandler) ListUserPage(c *fiber.Ctx) error {
 			Name:        user.Name,
 			Email:       user.Email,
 			PhoneNumber: user.PhoneNumber,
+			TotpEnabled: user.TotpEnabled,
 			RoleID:      &user.RoleID,
 			CreatedAt:   model.LocalTime(user.CreatedAt),
 		}
@@ -194,6 +197,7 @@ func (h *UserHandler) QueryUser(c *fiber.Ctx) error {
 		Name:        user.Name,
 		Email:       user.Email,
 		PhoneNumber: user.PhoneNumber,
+		TotpEnabled: user.TotpEnabled,
 		RoleID:      &user.RoleID,
 		CreatedAt:   model.LocalTime(user.CreatedAt),
 	}
@@ -256,12 +260,7 @@ func (h *UserHandler) GetPersonalAccessTokenList(c *fiber.Ctx) error {
 	result := []model.PersonalAccessTokenListResp{}
 
 	for _, token := range tokens {
-		result = append(result, model.PersonalAccessTokenListResp{
-			ID:          token.ID,
-			Name:        token.Name,
-			Expires:     model.ParseTime(token.Expires),
-			Description: token.Name,
-		})
+		result = append(result, toPersonalAccessTokenResp(token))
 	}
 
 	return c.JSON(model.NewSuccess("success", result))
@@ -273,7 +272,7 @@ func (h *UserHandler) GetPersonalAccessTokenList(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param id path string true "个人令牌ID"
-// @Success 200 {object} model.HttpSuccess{data=model.PersonalAccessTokenResp}
+// @Success 200 {object} model.HttpSuccess{data=model.PersonalAccessTokenListResp}
 // @Failure 400 {object} model.HttpError
 // @Failure 404 {object} model.HttpError
 // @Failure 500 {object} model.HttpError
@@ -298,23 +297,16 @@ func (h *UserHandler) GetPersonalAccessToken(c *fiber.Ctx) error {
 			fiber.StatusBadRequest, err.Error(),
 		))
 	}
-	result := model.PersonalAccessTokenResp{
-		ID:          token.ID,
-		Name:        token.Name,
-		Expires:     model.ParseTime(token.Expires),
-		Description: token.Description,
-		Token:       token.Token,
-	}
-	return c.JSON(model.NewSuccess("success", result))
+	return c.JSON(model.NewSuccess("success", toPersonalAccessTokenResp(token)))
 }
 
 // @Summary 创建 personalAccessToken 个人令牌
-// @Description 创建一个新的个人令牌
+// @Description 创建一个新的个人令牌，权限范围不能超出当前角色，明文令牌仅在创建时返回一次
 // @Tags 后台管理接口/用户
 // @Accept json
 // @Produce json
 // @Param createReq body model.PersonalAccessTokenCreateReq true "个人令牌创建请求"
-// @Success 200 {object} model.HttpSuccess
+// @Success 200 {object} model.HttpSuccess{data=model.PersonalAccessTokenCreateResp}
 // @Failure 400 {object} model.HttpError
 // @Failure 500 {object} model.HttpError
 // @Router /api/v1/user/personal-access-token/create [post]
@@ -333,17 +325,76 @@ func (h *UserHandler) CreatePat(c *fiber.Ctx) error {
 			fiber.StatusBadRequest, err.Error(),
 		))
 	}
-	// 查找用户
-	_, err = h.userService.CreatePersonalAccessToken(c.Context(), userId, createReq)
+	// 使用个人访问令牌或 OAuth2 访问令牌创建新令牌时，新令牌的权限不能超出当前令牌
+	if tokenScopes, ok := c.Locals("tokenScopes").([]string); ok && tokenScopes != nil {
+		for _, scope := range createReq.Scopes {
+			if !permission.HasScope(tokenScopes, scope) {
+				return c.JSON(model.NewError(fiber.StatusForbidden, "令牌权限不能超出当前访问令牌的权限: "+scope))
+			}
+		}
+	}
+	pat, token, err := h.userService.CreatePersonalAccessToken(c.Context(), userId, createReq)
 	if err != nil {
 		return c.JSON(model.NewError(fiber.StatusInternalServerError,
 			err.Error(),
 		))
 	}
 
+	return c.JSON(model.NewSuccess("success", model.PersonalAccessTokenCreateResp{
+		PersonalAccessTokenListResp: toPersonalAccessTokenResp(pat),
+		Token:                       token,
+	}))
+}
+
+// @Summary 吊销个人令牌
+// @Description 删除当前用户的指定个人令牌，删除后令牌立即失效
+// @Tags 后台管理接口/用户
+// @Accept json
+// @Produce json
+// @Param id path string true "个人令牌ID"
+// @Success 200 {object} model.HttpSuccess
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/user/personal-access-token/delete/{id} [delete]
+func (h *UserHandler) DeletePat(c *fiber.Ctx) error {
+	loginUser := middleware.GetCurrentUser(c)
+	if loginUser == nil {
+		return c.JSON(model.NewError(
+			fiber.StatusUnauthorized, "Unauthorized",
+		))
+	}
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(
+			fiber.StatusBadRequest, err.Error(),
+		))
+	}
+	if err := h.userService.DeletePersonalAccessToken(c.Context(), loginUser.ID, id); err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError,
+			err.Error(),
+		))
+	}
 	return c.JSON(model.NewSuccess("success", nil))
 }
 
+func toPersonalAccessTokenResp(token *ent.PersonalAccessToken) model.PersonalAccessTokenListResp {
+	resp := model.PersonalAccessTokenListResp{
+		ID:          token.ID,
+		Name:        token.Name,
+		Description: token.Description,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      token.Scopes,
+		LastUsedIP:  token.LastUsedIP,
+	}
+	if !token.Expires.IsZero() {
+		resp.Expires = model.ParseTime(token.Expires)
+	}
+	if token.LastUsedAt != nil {
+		resp.LastUsedAt = model.ParseTime(*token.LastUsedAt)
+	}
+	return resp
+}
+
 // @Summary 查询用户个人信息
 // @Description 查询指定用户的个人信息
 // @Tags 后台管理接口/用户
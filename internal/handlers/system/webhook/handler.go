@@ -0,0 +1,340 @@
+package webhook_handler
+
+import (
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	webhook_service "github.com/shuTwT/hoshikuzu/internal/services/system/webhook"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WebHookHandler struct {
+	webHookService webhook_service.WebHookService
+}
+
+func NewWebHookHandler(webHookService webhook_service.WebHookService) *WebHookHandler {
+	return &WebHookHandler{webHookService: webHookService}
+}
+
+// @Summary 查询可订阅的事件
+// @Description 查询 Webhook 可订阅的领域事件
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.HttpSuccess{data=[]model.WebHookEventResp}
+// @Router /api/v1/webhook/events [get]
+func (h *WebHookHandler) ListEvents(c *fiber.Ctx) error {
+	events := make([]model.WebHookEventResp, 0, len(event.Definitions))
+	for _, d := range event.Definitions {
+		events = append(events, model.WebHookEventResp{Name: d.Name, Title: d.Title})
+	}
+	return c.JSON(model.NewSuccess("success", events))
+}
+
+// @Summary 查询 Webhook 分页列表
+// @Description 查询 Webhook 分页列表
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(10)
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[model.WebHookResp]}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/webhook/page [get]
+func (h *WebHookHandler) ListWebHookPage(c *fiber.Ctx) error {
+	pageQuery := model.PageQuery{Page: 1, Size: 10}
+	if err := c.QueryParser(&pageQuery); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if pageQuery.Page < 1 || pageQuery.Size < 1 || pageQuery.Size > 100 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的分页参数"))
+	}
+
+	hooks, count, err := h.webHookService.QueryWebHookPage(c.Context(), pageQuery)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	records := make([]model.WebHookResp, 0, len(hooks))
+	for _, hook := range hooks {
+		records = append(records, toWebHookResp(hook))
+	}
+	return c.JSON(model.NewSuccess("success", model.PageResult[model.WebHookResp]{
+		Total:   int64(count),
+		Records: records,
+	}))
+}
+
+// @Summary 查询 Webhook
+// @Description 查询指定 Webhook
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} model.HttpSuccess{data=model.WebHookResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/webhook/query/{id} [get]
+func (h *WebHookHandler) QueryWebHook(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	hook, err := h.webHookService.QueryWebHook(c.Context(), id)
+	if err != nil {
+		return h.notFoundOrError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", toWebHookResp(hook)))
+}
+
+// @Summary 创建 Webhook
+// @Description 创建 Webhook，签名密钥仅在创建时返回
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param createReq body model.WebHookCreateReq true "Webhook 创建请求"
+// @Success 200 {object} model.HttpSuccess{data=model.WebHookSecretResp}
+// @Failure 400 {object} model.HttpError
+// @Router /api/v1/webhook/create [post]
+func (h *WebHookHandler) CreateWebHook(c *fiber.Ctx) error {
+	var req model.WebHookCreateReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if req.Name == "" {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "请填写名称"))
+	}
+	hook, secret, err := h.webHookService.CreateWebHook(c.Context(), req)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", model.WebHookSecretResp{
+		WebHookResp: toWebHookResp(hook),
+		Secret:      secret,
+	}))
+}
+
+// @Summary 更新 Webhook
+// @Description 更新 Webhook，停用期间待重试的投递暂停，重新启用后继续
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param updateReq body model.WebHookUpdateReq true "Webhook 更新请求"
+// @Success 200 {object} model.HttpSuccess{data=model.WebHookResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/webhook/update/{id} [put]
+func (h *WebHookHandler) UpdateWebHook(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	var req model.WebHookUpdateReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if req.Name == "" {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "请填写名称"))
+	}
+	hook, err := h.webHookService.UpdateWebHook(c.Context(), id, req)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(model.NewError(fiber.StatusNotFound, "Webhook 不存在"))
+		}
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", toWebHookResp(hook)))
+}
+
+// @Summary 重置 Webhook 签名密钥
+// @Description 重置签名密钥，旧密钥立即失效，新密钥仅返回一次
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} model.HttpSuccess{data=model.WebHookSecretResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/webhook/reset-secret/{id} [post]
+func (h *WebHookHandler) ResetWebHookSecret(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	hook, secret, err := h.webHookService.ResetWebHookSecret(c.Context(), id)
+	if err != nil {
+		return h.notFoundOrError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", model.WebHookSecretResp{
+		WebHookResp: toWebHookResp(hook),
+		Secret:      secret,
+	}))
+}
+
+// @Summary 删除 Webhook
+// @Description 删除 Webhook 及其投递记录
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} model.HttpSuccess{data=nil}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/webhook/delete/{id} [delete]
+func (h *WebHookHandler) DeleteWebHook(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	if err := h.webHookService.DeleteWebHook(c.Context(), id); err != nil {
+		return h.notFoundOrError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", nil))
+}
+
+// @Summary 发送测试事件
+// @Description 立即向 Webhook 发送一条 ping 测试事件并返回投递结果，失败不重试
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} model.HttpSuccess{data=model.WebHookDeliveryResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/webhook/test/{id} [post]
+func (h *WebHookHandler) SendTestEvent(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	d, err := h.webHookService.SendTestEvent(c.Context(), id)
+	if err != nil {
+		return h.notFoundOrError(c, err)
+	}
+	return c.JSON(model.NewSuccess("success", toDeliveryResp(d)))
+}
+
+// @Summary 查询投递记录分页
+// @Description 查询 Webhook 的投递记录，可按状态与事件筛选
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(10)
+// @Param status query string false "投递状态" Enums(pending, success, failed)
+// @Param event query string false "事件名称"
+// @Success 200 {object} model.HttpSuccess{data=model.PageResult[model.WebHookDeliveryResp]}
+// @Failure 400 {object} model.HttpError
+// @Failure 500 {object} model.HttpError
+// @Router /api/v1/webhook/deliveries/{id} [get]
+func (h *WebHookHandler) ListDeliveryPage(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	pageQuery := model.WebHookDeliveryPageQuery{Page: 1, Size: 10}
+	if err := c.QueryParser(&pageQuery); err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, err.Error()))
+	}
+	if pageQuery.Page < 1 || pageQuery.Size < 1 || pageQuery.Size > 100 {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的分页参数"))
+	}
+	if pageQuery.Status != "" {
+		if err := webhookdelivery.StatusValidator(webhookdelivery.Status(pageQuery.Status)); err != nil {
+			return c.JSON(model.NewError(fiber.StatusBadRequest, "无效的投递状态"))
+		}
+	}
+
+	deliveries, count, err := h.webHookService.QueryDeliveryPage(c.Context(), id, pageQuery)
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	records := make([]model.WebHookDeliveryResp, 0, len(deliveries))
+	for _, d := range deliveries {
+		records = append(records, toDeliveryResp(d))
+	}
+	return c.JSON(model.NewSuccess("success", model.PageResult[model.WebHookDeliveryResp]{
+		Total:   int64(count),
+		Records: records,
+	}))
+}
+
+// @Summary 重新投递
+// @Description 以相同的事件ID与请求体重新投递，立即投递一次并返回结果
+// @Tags 后台管理接口/Webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "投递记录ID"
+// @Success 200 {object} model.HttpSuccess{data=model.WebHookDeliveryResp}
+// @Failure 400 {object} model.HttpError
+// @Failure 404 {object} model.HttpError
+// @Router /api/v1/webhook/redeliver/{id} [post]
+func (h *WebHookHandler) Redeliver(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.JSON(model.NewError(fiber.StatusBadRequest, "Invalid ID format"))
+	}
+	d, err := h.webHookService.Redeliver(c.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(model.NewError(fiber.StatusNotFound, "投递记录不存在"))
+		}
+		return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+	}
+	return c.JSON(model.NewSuccess("success", toDeliveryResp(d)))
+}
+
+func (h *WebHookHandler) notFoundOrError(c *fiber.Ctx, err error) error {
+	if ent.IsNotFound(err) {
+		return c.JSON(model.NewError(fiber.StatusNotFound, "Webhook 不存在"))
+	}
+	return c.JSON(model.NewError(fiber.StatusInternalServerError, err.Error()))
+}
+
+func toWebHookResp(hook *ent.WebHook) model.WebHookResp {
+	events := hook.Events
+	if events == nil {
+		events = []string{}
+	}
+	return model.WebHookResp{
+		ID:        hook.ID,
+		CreatedAt: model.LocalTime(hook.CreatedAt),
+		Name:      hook.Name,
+		URL:       hook.URL,
+		Events:    events,
+		Enabled:   hook.Enabled,
+	}
+}
+
+func toDeliveryResp(d *ent.WebHookDelivery) model.WebHookDeliveryResp {
+	resp := model.WebHookDeliveryResp{
+		ID:           d.ID,
+		CreatedAt:    model.LocalTime(d.CreatedAt),
+		WebhookID:    d.WebhookID,
+		Event:        d.Event,
+		EventID:      d.EventID,
+		Payload:      d.Payload,
+		Status:       string(d.Status),
+		Attempts:     d.Attempts,
+		ResponseCode: d.ResponseCode,
+		ResponseBody: d.ResponseBody,
+		Error:        d.Error,
+		Duration:     d.Duration,
+	}
+	// 待重试的记录才有下次重试时间
+	if d.NextRetryAt != nil && d.Status == webhookdelivery.StatusPending {
+		t := model.LocalTime(*d.NextRetryAt)
+		resp.NextRetryAt = &t
+	}
+	if d.DeliveredAt != nil {
+		t := model.LocalTime(*d.DeliveredAt)
+		resp.DeliveredAt = &t
+	}
+	return resp
+}
@@ -1,25 +1,39 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/philippgille/chromem-go"
 )
 
+// KnowledgeBaseCollection 站点知识库集合名称
+const KnowledgeBaseCollection = "knowledge-base"
+
 var collections = map[string]*chromem.Collection{}
 
 var db *chromem.DB
 
-func NewChromemDB() (*chromem.DB, error) {
+// errEmbeddingRequired 集合不自行生成向量，写入与查询时必须传入由 AI 提供商生成的向量
+var errEmbeddingRequired = errors.New("chromem 集合需要预先计算的向量")
+
+// NewChromemDB 创建向量数据库，path 为空时仅保存在内存中，否则持久化到该目录
+func NewChromemDB(path string) (*chromem.DB, error) {
 	if db != nil {
 		return nil, fmt.Errorf("请勿重复创建 Chromem DB")
 	}
-	db = chromem.NewDB()
-	c, err := db.CreateCollection("knowledge-base", nil, nil)
+	var err error
+	if path == "" {
+		db = chromem.NewDB()
+	} else if db, err = chromem.NewPersistentDB(path, false); err != nil {
+		return nil, fmt.Errorf("打开 Chromem DB 失败: %w", err)
+	}
+	c, err := db.GetOrCreateCollection(KnowledgeBaseCollection, nil, requireEmbedding)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	collections["knowledge-base"] = c
+	collections[KnowledgeBaseCollection] = c
 	return db, nil
 }
 
@@ -32,3 +46,7 @@ func GetCollection(name string) (*chromem.Collection, error) {
 	}
 	return nil, fmt.Errorf("未找到 Chromem 集合 %s", name)
 }
+
+func requireEmbedding(context.Context, string) ([]float32, error) {
+	return nil, errEmbeddingRequired
+}
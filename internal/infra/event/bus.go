@@ -0,0 +1,140 @@
+package event
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// 领域事件名称
+const (
+	PostPublished           = "post.published"
+	PostUpdated             = "post.updated"
+	PostUnpublished         = "post.unpublished"
+	PostDeleted             = "post.deleted"
+	EssayCreated            = "essay.created"
+	EssayUpdated            = "essay.updated"
+	EssayDeleted            = "essay.deleted"
+	ProductCreated          = "product.created"
+	ProductUpdated          = "product.updated"
+	ProductDeleted          = "product.deleted"
+	CommentCreated          = "comment.created"
+	PayOrderPaid            = "pay_order.paid"
+	PayOrderRefunded        = "pay_order.refunded"
+	FlinkApplicationCreated = "flink_application.created"
+	UserRegistered          = "user.registered"
+	Ping                    = "ping"
+)
+
+// Definition 事件定义
+type Definition struct {
+	Name  string
+	Title string
+}
+
+// Definitions 可订阅的领域事件，ping 仅用于发送测试事件，不可订阅
+var Definitions = []Definition{
+	{Name: PostPublished, Title: "文章发布"},
+	{Name: PostUpdated, Title: "文章更新"},
+	{Name: PostUnpublished, Title: "文章取消发布"},
+	{Name: PostDeleted, Title: "文章删除"},
+	{Name: EssayCreated, Title: "说说发布"},
+	{Name: EssayUpdated, Title: "说说更新"},
+	{Name: EssayDeleted, Title: "说说删除"},
+	{Name: ProductCreated, Title: "商品创建"},
+	{Name: ProductUpdated, Title: "商品更新"},
+	{Name: ProductDeleted, Title: "商品删除"},
+	{Name: CommentCreated, Title: "新评论"},
+	{Name: PayOrderPaid, Title: "订单支付成功"},
+	{Name: PayOrderRefunded, Title: "订单退款"},
+	{Name: FlinkApplicationCreated, Title: "友链申请"},
+	{Name: UserRegistered, Title: "用户注册"},
+}
+
+// IsDefined 事件是否可订阅
+func IsDefined(name string) bool {
+	for _, d := range Definitions {
+		if d.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Event 领域事件
+type Event struct {
+	ID         string
+	Name       string
+	OccurredAt time.Time
+	Data       any
+}
+
+// Handler 事件处理函数
+type Handler func(ctx context.Context, e Event) error
+
+// Bus 进程内事件总线，事件在发布方的业务提交后同步分发给订阅者，
+// 订阅者应尽快返回，耗时的工作自行转入后台
+type Bus struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+var (
+	busInstance *Bus
+	busOnce     sync.Once
+)
+
+// GetBus 返回全局事件总线
+func GetBus() *Bus {
+	busOnce.Do(func() {
+		busInstance = &Bus{}
+	})
+	return busInstance
+}
+
+// Subscribe 订阅全部事件
+func (b *Bus) Subscribe(h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, h)
+}
+
+// Publish 发布事件，订阅者的错误只记录日志，不影响发布方
+func (b *Bus) Publish(ctx context.Context, name string, data any) {
+	e := NewEvent(name, data)
+	// 请求结束后上下文会被取消，订阅者的写库不应随之失败
+	ctx = context.WithoutCancel(ctx)
+
+	b.mu.RLock()
+	handlers := append([]Handler(nil), b.handlers...)
+	b.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, e); err != nil {
+			slog.Error("处理领域事件失败", "event", e.Name, "id", e.ID, "error", err.Error())
+		}
+	}
+}
+
+// Publish 向全局事件总线发布事件
+func Publish(ctx context.Context, name string, data any) {
+	GetBus().Publish(ctx, name, data)
+}
+
+// NewEvent 创建事件并生成事件ID
+func NewEvent(name string, data any) Event {
+	return Event{
+		ID:         newEventID(),
+		Name:       name,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
+
+func newEventID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return "evt_" + hex.EncodeToString(b)
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBusPublish(t *testing.T) {
+	bus := &Bus{}
+	var got []Event
+	bus.Subscribe(func(ctx context.Context, e Event) error {
+		if ctx.Err() != nil {
+			t.Errorf("订阅者收到已取消的上下文: %v", ctx.Err())
+		}
+		got = append(got, e)
+		return errors.New("订阅者失败不影响其他订阅者")
+	})
+	bus.Subscribe(func(ctx context.Context, e Event) error {
+		got = append(got, e)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	bus.Publish(ctx, PostPublished, 1)
+
+	if len(got) != 2 {
+		t.Fatalf("订阅者收到 %d 个事件, want 2", len(got))
+	}
+	if got[0].ID != got[1].ID || !strings.HasPrefix(got[0].ID, "evt_") {
+		t.Errorf("事件ID = %q, %q", got[0].ID, got[1].ID)
+	}
+	if got[0].Name != PostPublished || got[0].Data != 1 {
+		t.Errorf("事件 = %+v", got[0])
+	}
+}
+
+func TestIsDefined(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{PostPublished, true},
+		{UserRegistered, true},
+		{Ping, false},
+		{"post.archived", false},
+	}
+	for _, tt := range tests {
+		if got := IsDefined(tt.name); got != tt.want {
+			t.Errorf("IsDefined(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
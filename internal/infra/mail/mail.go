@@ -0,0 +1,225 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 加密方式
+const (
+	// EncryptionTLS 明文连接后通过 STARTTLS 升级，通常使用 587 端口
+	EncryptionTLS = "tls"
+	// EncryptionSSL 直接建立 TLS 连接，通常使用 465 端口
+	EncryptionSSL = "ssl"
+	// EncryptionNone 不加密，仅用于本地或内网邮件服务
+	EncryptionNone = "none"
+)
+
+// ErrNotConfigured 未配置邮件服务
+var ErrNotConfigured = errors.New("邮件服务未配置，请先在系统设置-通知设置中配置 SMTP")
+
+// Message 待发送的邮件
+type Message struct {
+	To      []string
+	Subject string
+	// 纯文本正文
+	Text string
+	// HTML 正文，为空时只发送纯文本
+	HTML string
+}
+
+// Sender 邮件发送器
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// SMTPConfig SMTP 配置
+type SMTPConfig struct {
+	Host string
+	Port int
+	// 用户名为空时不进行认证
+	Username string
+	Password string
+	// 加密方式 tls、ssl、none，为空时使用 tls
+	Encryption string
+	// 发件人邮箱
+	From string
+	// 发件人名称
+	FromName string
+}
+
+// SMTPSender 通过 SMTP 发送邮件
+type SMTPSender struct {
+	config SMTPConfig
+	// 连接与发送的超时时间
+	timeout time.Duration
+}
+
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	if config.Encryption == "" {
+		config.Encryption = EncryptionTLS
+	}
+	return &SMTPSender{config: config, timeout: 30 * time.Second}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	if s.config.Host == "" || s.config.Port == 0 || s.config.From == "" {
+		return ErrNotConfigured
+	}
+	if len(msg.To) == 0 {
+		return errors.New("缺少收件人")
+	}
+	for _, addr := range append([]string{s.config.From}, msg.To...) {
+		if _, err := mail.ParseAddress(addr); err != nil || strings.ContainsAny(addr, "\r\n") {
+			return fmt.Errorf("无效的邮箱地址: %s", addr)
+		}
+	}
+	data, err := s.build(msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	client, err := s.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("连接邮件服务器失败: %w", err)
+	}
+	defer client.Close()
+
+	if s.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return fmt.Errorf("邮件服务器认证失败: %w", err)
+		}
+	}
+	if err := client.Mail(s.config.From); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// dial 按加密方式建立连接，连接的读写截止时间跟随 ctx
+func (s *SMTPSender) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	tlsConfig := &tls.Config{ServerName: s.config.Host}
+	var conn net.Conn
+	var err error
+	if s.config.Encryption == EncryptionSSL {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if s.config.Encryption == EncryptionTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			_ = client.Close()
+			return nil, errors.New("邮件服务器不支持 STARTTLS")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// build 生成 MIME 邮件，同时提供纯文本与 HTML 正文
+func (s *SMTPSender) build(msg *Message) ([]byte, error) {
+	var buf bytes.Buffer
+	from := (&mail.Address{Name: s.config.FromName, Address: s.config.From}).String()
+	writeHeader(&buf, "From", from)
+	writeHeader(&buf, "To", strings.Join(msg.To, ", "))
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", strings.NewReplacer("\r", "", "\n", "").Replace(msg.Subject)))
+	writeHeader(&buf, "Date", time.Now().Format(time.RFC1123Z))
+	writeHeader(&buf, "Message-ID", fmt.Sprintf("<%s@%s>", randomID(), domain(s.config.From)))
+	writeHeader(&buf, "MIME-Version", "1.0")
+
+	if msg.HTML == "" {
+		writeHeader(&buf, "Content-Type", "text/plain; charset=utf-8")
+		writeHeader(&buf, "Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		return buf.Bytes(), writeQuotedPrintable(&buf, msg.Text)
+	}
+
+	boundary := "hoshikuzu-" + randomID()
+	writeHeader(&buf, "Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", boundary))
+	buf.WriteString("\r\n")
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		writeHeader(&buf, "Content-Type", part.contentType)
+		writeHeader(&buf, "Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, part.body); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+func writeHeader(buf *bytes.Buffer, key, value string) {
+	buf.WriteString(key + ": " + value + "\r\n")
+}
+
+func writeQuotedPrintable(buf *bytes.Buffer, body string) error {
+	w := quotedprintable.NewWriter(buf)
+	if _, err := w.Write([]byte(body)); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func randomID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func domain(addr string) string {
+	if i := strings.LastIndex(addr, "@"); i >= 0 {
+		return addr[i+1:]
+	}
+	return "localhost"
+}
@@ -0,0 +1,168 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+// sinkMessage 本地 SMTP 接收端收到的邮件
+type sinkMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSink 启动只支持基础命令与 AUTH PLAIN 的本地 SMTP 接收端
+func startSink(t *testing.T) (string, int, <-chan sinkMessage) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	messages := make(chan sinkMessage, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { io.WriteString(conn, s+"\r\n") }
+		reply("220 sink ready")
+		var msg sinkMessage
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.TrimRight(line, "\r\n")
+			switch upper := strings.ToUpper(cmd); {
+			case strings.HasPrefix(upper, "EHLO"):
+				reply("250-sink")
+				reply("250 AUTH PLAIN")
+			case strings.HasPrefix(upper, "AUTH PLAIN"):
+				reply("235 ok")
+			case strings.HasPrefix(upper, "MAIL FROM:"):
+				msg.from = strings.Trim(cmd[len("MAIL FROM:"):], "<> ")
+				reply("250 ok")
+			case strings.HasPrefix(upper, "RCPT TO:"):
+				msg.to = append(msg.to, strings.Trim(cmd[len("RCPT TO:"):], "<> "))
+				reply("250 ok")
+			case upper == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(strings.TrimPrefix(l, "."))
+				}
+				msg.data = data.String()
+				messages <- msg
+				reply("250 queued")
+			case upper == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, messages
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	host, port, messages := startSink(t)
+	sender := NewSMTPSender(SMTPConfig{
+		Host:       host,
+		Port:       port,
+		Username:   "user",
+		Password:   "pass",
+		Encryption: EncryptionNone,
+		From:       "noreply@example.com",
+		FromName:   "星空",
+	})
+	err := sender.Send(context.Background(), &Message{
+		To:      []string{"alice@example.com"},
+		Subject: "验证邮箱\r\nBcc: evil@example.com",
+		Text:    "点击链接完成验证",
+		HTML:    "<p>点击链接完成验证</p>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := <-messages
+	if got.from != "noreply@example.com" || len(got.to) != 1 || got.to[0] != "alice@example.com" {
+		t.Fatalf("unexpected envelope: %+v", got)
+	}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(got.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Header.Get("Bcc") != "" {
+		t.Fatal("subject injected a header")
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != "验证邮箱Bcc: evil@example.com" {
+		t.Fatalf("subject = %q, %v", subject, err)
+	}
+	from, err := mail.ParseAddress(parsed.Header.Get("From"))
+	if err != nil || from.Name != "星空" {
+		t.Fatalf("from = %v, %v", from, err)
+	}
+
+	_, params, _ := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	mr := multipart.NewReader(parsed.Body, params["boundary"])
+	var types []string
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(part)
+		if !strings.Contains(string(body), "点击链接完成验证") {
+			t.Fatalf("part body = %q", body)
+		}
+		types = append(types, part.Header.Get("Content-Type"))
+	}
+	if len(types) != 2 || !strings.HasPrefix(types[0], "text/plain") || !strings.HasPrefix(types[1], "text/html") {
+		t.Fatalf("parts = %v", types)
+	}
+}
+
+func TestSMTPSenderValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config SMTPConfig
+		to     []string
+	}{
+		{"not configured", SMTPConfig{}, []string{"alice@example.com"}},
+		{"no recipient", SMTPConfig{Host: "127.0.0.1", Port: 25, From: "a@example.com"}, nil},
+		{"bad recipient", SMTPConfig{Host: "127.0.0.1", Port: 25, From: "a@example.com"}, []string{"alice@example.com\r\nRCPT TO:<x@y>"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewSMTPSender(tt.config).Send(context.Background(), &Message{To: tt.to, Subject: "s", Text: "t"})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 隐藏内容块标记，支持 <!-- paywall -->...<!-- /paywall --> 与 {% hide %}...{% endhide %} 两种写法
+const (
+	HiddenStart = "<!-- paywall -->"
+	HiddenEnd   = "<!-- /paywall -->"
+)
+
+var (
+	hiddenBlockPattern  = regexp.MustCompile(`(?s)<!--\s*paywall\s*-->(.*?)<!--\s*/paywall\s*-->|\{%\s*hide\s*%\}(.*?)\{%\s*endhide\s*%\}`)
+	hiddenMarkerPattern = regexp.MustCompile(`<!--\s*/?paywall\s*-->|\{%\s*(?:end)?hide\s*%\}`)
+)
+
+// segment 按隐藏标记切分后的片段
+type segment struct {
+	text   string
+	hidden bool
+}
+
+// HasHidden 内容中是否包含隐藏内容块
+func HasHidden(src string) bool {
+	return hiddenBlockPattern.MatchString(src)
+}
+
+// ValidateHidden 校验隐藏标记成对出现且没有嵌套
+func ValidateHidden(src string) error {
+	rest := hiddenBlockPattern.ReplaceAllString(src, "")
+	if marker := hiddenMarkerPattern.FindString(rest); marker != "" {
+		return fmt.Errorf("隐藏内容标记 %s 未正确闭合或存在嵌套", marker)
+	}
+	return nil
+}
+
+// StripHidden 将所有隐藏内容块替换为占位内容
+func StripHidden(src string, placeholder string) string {
+	return hiddenBlockPattern.ReplaceAllLiteralString(src, placeholder)
+}
+
+// splitHidden 按隐藏标记切分内容，标记本身不保留
+func splitHidden(src string) []segment {
+	var segments []segment
+	last := 0
+	for _, loc := range hiddenBlockPattern.FindAllStringSubmatchIndex(src, -1) {
+		if loc[0] > last {
+			segments = append(segments, segment{text: src[last:loc[0]]})
+		}
+		start, end := loc[2], loc[3]
+		if start < 0 {
+			start, end = loc[4], loc[5]
+		}
+		if inner := src[start:end]; strings.TrimSpace(inner) != "" {
+			segments = append(segments, segment{text: inner, hidden: true})
+		}
+		last = loc[1]
+	}
+	if last < len(src) {
+		segments = append(segments, segment{text: src[last:]})
+	}
+	return segments
+}
@@ -0,0 +1,69 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateHidden(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr bool
+	}{
+		{"注释写法", "a<!-- paywall -->b<!-- /paywall -->c", false},
+		{"标签写法", "a{% hide %}b{% endhide %}c", false},
+		{"多个隐藏块", "{% hide %}a{% endhide %}<!--paywall-->b<!-- /paywall -->", false},
+		{"缺少结束标记", "a<!-- paywall -->b", true},
+		{"多余的结束标记", "a{% endhide %}", true},
+		{"嵌套", "{% hide %}a{% hide %}b{% endhide %}c{% endhide %}", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateHidden(tt.src); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateHidden() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStripHidden(t *testing.T) {
+	src := "开头\n<!-- paywall -->\n秘密一\n<!-- /paywall -->\n中间{% hide %}秘密二{% endhide %}结尾"
+	got := StripHidden(src, "[隐藏]")
+	if strings.Contains(got, "秘密") {
+		t.Fatalf("StripHidden() 未移除隐藏内容: %q", got)
+	}
+	if got != "开头\n[隐藏]\n中间[隐藏]结尾" {
+		t.Errorf("StripHidden() = %q", got)
+	}
+}
+
+func TestRenderKeepsHiddenMarkers(t *testing.T) {
+	html, err := Render([]byte("公开段落\n\n{% hide %}\n**隐藏段落**\n{% endhide %}\n\n结尾"))
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(html, HiddenStart+"\n<p><strong>隐藏段落</strong></p>") || !strings.Contains(html, HiddenEnd) {
+		t.Fatalf("Render() 未保留隐藏标记: %q", html)
+	}
+	if got := StripHidden(html, ""); strings.Contains(got, "隐藏段落") || !strings.Contains(got, "<p>结尾</p>") {
+		t.Errorf("渲染结果移除隐藏块后 = %q", got)
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		isHTML bool
+		want   string
+	}{
+		{"markdown", "# 标题\n\n**加粗** 与 [链接](https://example.com)\n\n- 列表", false, "标题 加粗 与 链接 列表"},
+		{"html", `<p>一段<strong>文字</strong></p><script>alert(1)</script><p>a &amp; b</p>`, true, "一段文字 a & b"},
+	}
+	for _, tt := range tests {
+		if got := PlainText(tt.src, tt.isHTML); got != tt.want {
+			t.Errorf("%s: PlainText() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
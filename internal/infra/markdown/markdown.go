@@ -0,0 +1,71 @@
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			extension.Table,
+			extension.Strikethrough,
+			extension.TaskList,
+		),
+		goldmark.WithRendererOptions(
+			html.WithHardWraps(),
+			html.WithXHTML(),
+		),
+	)
+	htmlSanitizer = newSanitizer()
+)
+
+func newSanitizer() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowElements("a", "abbr", "b", "blockquote", "br", "caption", "cite", "code", "col", "colgroup",
+		"dd", "del", "details", "div", "dl", "dt", "em", "figcaption", "figure", "h1", "h2", "h3", "h4", "h5", "h6",
+		"hr", "i", "img", "ins", "kbd", "li", "ol", "p", "pre", "q", "rp", "rt", "ruby", "s", "samp",
+		"section", "small", "span", "strong", "sub", "sup", "table", "tbody", "td", "tfoot", "th", "thead",
+		"tr", "u", "ul", "var", "wbr")
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`[\w\s\-_]+`)).OnElements("div", "span", "p", "td", "th", "li", "code", "pre")
+	policy.AllowAttrs("href").OnElements("a")
+	policy.AllowAttrs("src", "alt", "title", "width", "height").OnElements("img")
+	policy.AllowAttrs("colspan", "rowspan").OnElements("td", "th")
+	return policy
+}
+
+// Sanitize 按渲染 markdown 时相同的规则清洗 HTML
+func Sanitize(src string) string {
+	return htmlSanitizer.Sanitize(src)
+}
+
+// Render 将 markdown 转为经过清洗的 HTML，隐藏内容块单独渲染并以 <!-- paywall --> 标记包裹
+func Render(src []byte) (string, error) {
+	if err := ValidateHidden(string(src)); err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	for _, seg := range splitHidden(string(src)) {
+		var buf bytes.Buffer
+		if err := md.Convert([]byte(seg.text), &buf); err != nil {
+			return "", err
+		}
+		rendered := htmlSanitizer.SanitizeBytes(buf.Bytes())
+		if seg.hidden {
+			// 注释在清洗之后写入，保证标记保留在最终 HTML 中
+			out.WriteString(HiddenStart + "\n")
+			out.Write(rendered)
+			out.WriteString(HiddenEnd + "\n")
+			continue
+		}
+		out.Write(rendered)
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,29 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+var (
+	textSanitizer = bluemonday.StrictPolicy()
+	// blockBoundaryPattern 块级元素的结束位置，去掉标签前在此处换行，避免相邻段落的文字粘连
+	blockBoundaryPattern = regexp.MustCompile(`(?i)</(?:p|div|h[1-6]|li|blockquote|pre|tr|td|th|dd|dt|figcaption|section)\s*>|<(?:br|hr)\b[^>]*>`)
+)
+
+// PlainText 将 markdown 或 HTML 内容转为纯文本，连续空白合并为一个空格，用于预览和搜索
+func PlainText(src string, isHTML bool) string {
+	if !isHTML {
+		var buf bytes.Buffer
+		if err := md.Convert([]byte(src), &buf); err == nil {
+			src = buf.String()
+		}
+	}
+	src = blockBoundaryPattern.ReplaceAllString(src, "$0\n")
+	text := html.UnescapeString(textSanitizer.Sanitize(src))
+	return strings.Join(strings.Fields(text), " ")
+}
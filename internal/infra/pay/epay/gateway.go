@@ -0,0 +1,207 @@
+package epay
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+)
+
+const (
+	// GatewayName 易支付网关名称
+	GatewayName = "epay"
+
+	// 易支付接口版本
+	VersionV1 = "v1"
+	VersionV2 = "v2"
+)
+
+// Gateway 易支付网关，按配置的接口版本调用 V1 或 V2 接口
+type Gateway struct {
+	config  Config
+	version string
+}
+
+// NewGateway 创建易支付网关，version 为空时使用 V1 接口
+func NewGateway(config Config, version string) *Gateway {
+	if version != VersionV2 {
+		version = VersionV1
+	}
+	return &Gateway{config: config, version: version}
+}
+
+func (g *Gateway) Name() string {
+	return GatewayName
+}
+
+func (g *Gateway) CreateOrder(ctx context.Context, req *pay.CreateOrderReq) (*pay.CreateOrderResp, error) {
+	if g.version == VersionV2 {
+		resp, err := NewV2Client(g.config).CreateOrder(req.ChannelType, req.OutTradeNo, req.Subject, req.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return &pay.CreateOrderResp{PayURL: resp.Data.PayInfo, TradeNo: resp.Data.OrderNo}, nil
+	}
+
+	resp, err := NewV1Client(g.config).CreateOrder(V1PayRequestParams{
+		PID:        g.config.MchID,
+		Type:       req.ChannelType,
+		OutTradeNo: req.OutTradeNo,
+		Name:       req.Subject,
+		Money:      fenToYuan(req.Amount),
+		ReturnURL:  &req.ReturnURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	payURL := ""
+	if resp.Payurl != nil {
+		payURL = *resp.Payurl
+	}
+	return &pay.CreateOrderResp{PayURL: payURL, TradeNo: resp.TradeNO}, nil
+}
+
+func (g *Gateway) QueryOrder(ctx context.Context, req *pay.QueryOrderReq) (*pay.QueryOrderResp, error) {
+	if g.version == VersionV2 {
+		resp, err := NewV2Client(g.config).QueryOrder(req.OutTradeNo, req.TradeNo)
+		if err != nil {
+			return nil, err
+		}
+		return &pay.QueryOrderResp{
+			Paid:    resp.Data.Status == 1,
+			TradeNo: resp.Data.OrderNo,
+			Amount:  resp.Data.TotalFee,
+		}, nil
+	}
+
+	pid, err := g.pid()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := NewV1Client(g.config).QueryOrder(pid, g.config.Key, req.TradeNo, req.OutTradeNo)
+	if err != nil {
+		return nil, err
+	}
+	// 易支付 status=2 表示已支付
+	return &pay.QueryOrderResp{
+		Paid:    resp.Status == 2,
+		TradeNo: resp.TradeNO,
+		Amount:  yuanToFen(resp.Money),
+	}, nil
+}
+
+func (g *Gateway) Refund(ctx context.Context, req *pay.RefundReq) (*pay.RefundResp, error) {
+	if g.version == VersionV2 {
+		return nil, fmt.Errorf("易支付 V2 接口不支持退款: %w", pay.ErrNotSupported)
+	}
+
+	pid, err := g.pid()
+	if err != nil {
+		return nil, err
+	}
+	// 易支付订单号本地缺失时先查单补齐（部分订单经回调支付成功但未落库 trade_no）
+	tradeNo := req.TradeNo
+	if tradeNo == "" {
+		if req.OutTradeNo == "" {
+			return nil, fmt.Errorf("订单缺少交易号")
+		}
+		resp, err := NewV1Client(g.config).QueryOrder(pid, g.config.Key, "", req.OutTradeNo)
+		if err != nil {
+			return nil, err
+		}
+		if resp.TradeNO == "" {
+			return nil, fmt.Errorf("易支付未找到对应订单")
+		}
+		tradeNo = resp.TradeNO
+	}
+
+	if _, err := NewV1Client(g.config).RefundOrder(pid, g.config.Key, tradeNo, req.RefundNo, fenToYuan(req.Amount)); err != nil {
+		return nil, err
+	}
+	return &pay.RefundResp{RefundID: req.RefundNo}, nil
+}
+
+// VerifyNotify 校验易支付异步通知，支付通知与退款通知共用回调地址，退款通知带 refund_no 参数
+func (g *Gateway) VerifyNotify(ctx context.Context, req *pay.NotifyReq) (*pay.Notify, error) {
+	params := req.Params
+	if g.version == VersionV2 {
+		totalFee, _ := strconv.Atoi(params["total_fee"])
+		status, _ := strconv.Atoi(params["status"])
+		notify := NotifyParams{
+			MchID:      params["mch_id"],
+			OutTradeNo: params["out_trade_no"],
+			OrderNo:    params["order_no"],
+			TotalFee:   totalFee,
+			Status:     status,
+			Sign:       params["sign"],
+		}
+		if !NewV2Client(g.config).VerifyNotify(notify) {
+			return nil, fmt.Errorf("签名校验失败")
+		}
+		if notify.Status != 1 {
+			return &pay.Notify{Type: pay.NotifyIgnored}, nil
+		}
+		return &pay.Notify{
+			Type:       pay.NotifyPaid,
+			OutTradeNo: notify.OutTradeNo,
+			TradeNo:    notify.OrderNo,
+			Amount:     notify.TotalFee,
+		}, nil
+	}
+
+	sign, ok := params["sign"]
+	if !ok {
+		return nil, fmt.Errorf("缺少签名参数")
+	}
+	if !VerifySign(params, g.config.Key, sign) {
+		return nil, fmt.Errorf("签名校验失败")
+	}
+
+	if params["refund_no"] != "" {
+		return &pay.Notify{
+			Type:       pay.NotifyRefunded,
+			OutTradeNo: params["out_trade_no"],
+			TradeNo:    params["trade_no"],
+			RefundNo:   params["refund_no"],
+			Amount:     yuanToFen(params["money"]),
+		}, nil
+	}
+	// 仅处理支付成功的通知
+	if params["trade_status"] != "TRADE_SUCCESS" {
+		return &pay.Notify{Type: pay.NotifyIgnored}, nil
+	}
+	return &pay.Notify{
+		Type:       pay.NotifyPaid,
+		OutTradeNo: params["out_trade_no"],
+		TradeNo:    params["trade_no"],
+		Amount:     yuanToFen(params["money"]),
+	}, nil
+}
+
+// CloseOrder 易支付没有关单接口，未支付订单在网关侧自动过期
+func (g *Gateway) CloseOrder(ctx context.Context, req *pay.QueryOrderReq) error {
+	return nil
+}
+
+func (g *Gateway) pid() (int, error) {
+	pid, err := strconv.Atoi(g.config.MchID)
+	if err != nil {
+		return 0, fmt.Errorf("商户ID无效: %w", err)
+	}
+	return pid, nil
+}
+
+func fenToYuan(fen int) string {
+	return strconv.FormatFloat(float64(fen)/100, 'f', 2, 64)
+}
+
+// yuanToFen 解析以元为单位的金额，解析失败返回 0
+func yuanToFen(yuan string) int {
+	f, err := strconv.ParseFloat(yuan, 64)
+	if err != nil {
+		return 0
+	}
+	return int(math.Round(f * 100))
+}
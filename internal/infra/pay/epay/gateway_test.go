@@ -0,0 +1,51 @@
+package epay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+)
+
+func signedParams(params map[string]string, key string) map[string]string {
+	params["sign"] = signParams(params, key)
+	params["sign_type"] = "MD5"
+	return params
+}
+
+func TestGatewayVerifyNotify(t *testing.T) {
+	const key = "merchant-key"
+	g := NewGateway(Config{MchID: "1000", Key: key}, "")
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		params   map[string]string
+		wantErr  bool
+		wantType pay.NotifyType
+		wantFen  int
+	}{
+		{"支付成功", signedParams(map[string]string{"out_trade_no": "T1", "trade_no": "E1", "money": "19.99", "trade_status": "TRADE_SUCCESS"}, key), false, pay.NotifyPaid, 1999},
+		{"退款通知", signedParams(map[string]string{"out_trade_no": "T1", "trade_no": "E1", "refund_no": "R1", "money": "0.10"}, key), false, pay.NotifyRefunded, 10},
+		{"未支付", signedParams(map[string]string{"out_trade_no": "T1", "trade_status": "WAIT"}, key), false, pay.NotifyIgnored, 0},
+		{"签名错误", signedParams(map[string]string{"out_trade_no": "T1", "trade_status": "TRADE_SUCCESS"}, "wrong"), true, "", 0},
+		{"缺少签名", map[string]string{"out_trade_no": "T1", "trade_status": "TRADE_SUCCESS"}, true, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := g.VerifyNotify(ctx, &pay.NotifyReq{Params: tt.params})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("VerifyNotify() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyNotify() error = %v", err)
+			}
+			if n.Type != tt.wantType || n.Amount != tt.wantFen {
+				t.Fatalf("VerifyNotify() = %+v, want type %q amount %d", n, tt.wantType, tt.wantFen)
+			}
+		})
+	}
+}
@@ -113,11 +113,11 @@ func NewV1Client(config Config) *V1Client {
 	return &V1Client{config: config}
 }
 
-// signParams 按易支付规则生成签名：排除 sign 与空值，按 key 排序拼接后追加 key，MD5。
+// signParams 按易支付规则生成签名：排除 sign、sign_type 与空值，按 key 排序拼接后追加 key，MD5。
 func signParams(params map[string]string, key string) string {
 	var pairs []string
 	for k, v := range params {
-		if k == "sign" || v == "" {
+		if k == "sign" || k == "sign_type" || v == "" {
 			continue
 		}
 		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
@@ -0,0 +1,150 @@
+package pay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotSupported 支付网关不支持该操作
+var ErrNotSupported = errors.New("支付网关不支持该操作")
+
+// PaymentGateway 第三方支付网关，金额统一使用分
+type PaymentGateway interface {
+	// Name 网关名称，同时作为异步通知路由 /pay-order/notify/{name} 的路径参数
+	Name() string
+	// CreateOrder 在网关下单，返回支付地址与网关订单号
+	CreateOrder(ctx context.Context, req *CreateOrderReq) (*CreateOrderResp, error)
+	// QueryOrder 查询网关订单的支付状态
+	QueryOrder(ctx context.Context, req *QueryOrderReq) (*QueryOrderResp, error)
+	// Refund 发起退款
+	Refund(ctx context.Context, req *RefundReq) (*RefundResp, error)
+	// VerifyNotify 校验异步通知签名并解析通知内容
+	VerifyNotify(ctx context.Context, req *NotifyReq) (*Notify, error)
+	// CloseOrder 关闭网关订单，网关没有关单接口时直接返回 nil
+	CloseOrder(ctx context.Context, req *QueryOrderReq) error
+}
+
+// CreateOrderReq 网关下单请求
+type CreateOrderReq struct {
+	// 本地订单 ID
+	OrderID int
+	// 支付渠道，如 alipay、wxpay、stripe
+	ChannelType string
+	// 商户订单号
+	OutTradeNo string
+	Subject    string
+	// 金额,单位分
+	Amount int
+	// 支付完成后的跳转地址
+	ReturnURL string
+}
+
+// CreateOrderResp 网关下单结果
+type CreateOrderResp struct {
+	PayURL string
+	// 网关订单号
+	TradeNo string
+}
+
+// QueryOrderReq 网关订单查询请求，商户订单号与网关订单号至少提供一个
+type QueryOrderReq struct {
+	OutTradeNo string
+	TradeNo    string
+}
+
+// QueryOrderResp 网关订单查询结果
+type QueryOrderResp struct {
+	Paid    bool
+	TradeNo string
+	// 实付金额,单位分，网关未返回时为 0
+	Amount int
+}
+
+// RefundReq 退款请求
+type RefundReq struct {
+	OutTradeNo string
+	TradeNo    string
+	RefundNo   string
+	// 退款金额,单位分
+	Amount int
+}
+
+// RefundResp 退款结果
+type RefundResp struct {
+	// 网关退款单号
+	RefundID string
+}
+
+// NotifyReq 网关异步通知的原始请求
+type NotifyReq struct {
+	// 查询参数与表单参数
+	Params map[string]string
+	Header http.Header
+	Body   []byte
+}
+
+// NotifyType 异步通知类型
+type NotifyType string
+
+const (
+	// NotifyIgnored 无需处理的通知
+	NotifyIgnored NotifyType = ""
+	// NotifyPaid 支付成功
+	NotifyPaid NotifyType = "paid"
+	// NotifyRefunded 退款成功
+	NotifyRefunded NotifyType = "refunded"
+)
+
+// Notify 解析后的异步通知
+type Notify struct {
+	Type       NotifyType
+	OutTradeNo string
+	TradeNo    string
+	RefundNo   string
+	// 通知金额,单位分，网关未返回时为 0
+	Amount int
+}
+
+// Registry 按支付渠道注册的网关
+type Registry struct {
+	channels map[string]PaymentGateway
+	names    map[string]PaymentGateway
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		channels: make(map[string]PaymentGateway),
+		names:    make(map[string]PaymentGateway),
+	}
+}
+
+// Register 注册网关处理的支付渠道，同一渠道后注册的覆盖先注册的
+func (r *Registry) Register(gateway PaymentGateway, channelTypes ...string) {
+	r.names[gateway.Name()] = gateway
+	for _, channelType := range channelTypes {
+		r.channels[channelType] = gateway
+	}
+}
+
+// Get 获取支付渠道对应的网关
+func (r *Registry) Get(channelType string) (PaymentGateway, error) {
+	gateway, ok := r.channels[channelType]
+	if !ok {
+		return nil, fmt.Errorf("支付渠道 %s 未启用", channelType)
+	}
+	return gateway, nil
+}
+
+// ByName 按网关名称获取网关，用于异步通知
+func (r *Registry) ByName(name string) (PaymentGateway, bool) {
+	gateway, ok := r.names[name]
+	return gateway, ok
+}
+
+// Has 支付渠道是否可用
+func (r *Registry) Has(channelType string) bool {
+	_, ok := r.channels[channelType]
+	return ok
+}
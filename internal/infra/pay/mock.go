@@ -0,0 +1,44 @@
+package pay
+
+import (
+	"context"
+	"fmt"
+)
+
+// MockGatewayName 模拟支付网关名称
+const MockGatewayName = "mock"
+
+// MockGateway 模拟支付网关，不调用任何外部接口，下单返回本地模拟支付页（仅测试环境使用）
+type MockGateway struct{}
+
+func NewMockGateway() *MockGateway {
+	return &MockGateway{}
+}
+
+func (g *MockGateway) Name() string {
+	return MockGatewayName
+}
+
+func (g *MockGateway) CreateOrder(ctx context.Context, req *CreateOrderReq) (*CreateOrderResp, error) {
+	return &CreateOrderResp{
+		PayURL:  fmt.Sprintf("/api/v1/pay-order/mock-pay/%d", req.OrderID),
+		TradeNo: fmt.Sprintf("MOCK%d", req.OrderID),
+	}, nil
+}
+
+// QueryOrder 模拟支付的结果由模拟支付页直接落库，查询始终返回未支付
+func (g *MockGateway) QueryOrder(ctx context.Context, req *QueryOrderReq) (*QueryOrderResp, error) {
+	return &QueryOrderResp{TradeNo: req.TradeNo}, nil
+}
+
+func (g *MockGateway) Refund(ctx context.Context, req *RefundReq) (*RefundResp, error) {
+	return &RefundResp{RefundID: req.RefundNo}, nil
+}
+
+func (g *MockGateway) VerifyNotify(ctx context.Context, req *NotifyReq) (*Notify, error) {
+	return nil, ErrNotSupported
+}
+
+func (g *MockGateway) CloseOrder(ctx context.Context, req *QueryOrderReq) error {
+	return nil
+}
@@ -0,0 +1,306 @@
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+)
+
+const (
+	// GatewayName Stripe 网关名称
+	GatewayName = "stripe"
+	// DefaultAPIURL Stripe 接口地址
+	DefaultAPIURL = "https://api.stripe.com"
+	// DefaultCurrency 默认结算币种，人民币最小货币单位为分
+	DefaultCurrency = "cny"
+
+	// webhookTolerance Webhook 签名时间戳允许的误差，超出视为重放
+	webhookTolerance = 5 * time.Minute
+)
+
+// Config Stripe 配置
+type Config struct {
+	// 接口地址，为空时使用 DefaultAPIURL
+	APIURL string
+	// 密钥 sk_xxx
+	SecretKey string
+	// Webhook 签名密钥 whsec_xxx
+	WebhookSecret string
+	// 结算币种，为空时使用 DefaultCurrency
+	Currency string
+	// 请求未携带跳转地址时使用的支付完成跳转地址
+	ReturnURL string
+}
+
+// checkoutSession Checkout Session 对象，仅声明使用到的字段
+type checkoutSession struct {
+	ID                string `json:"id"`
+	URL               string `json:"url"`
+	Status            string `json:"status"`
+	PaymentStatus     string `json:"payment_status"`
+	PaymentIntent     string `json:"payment_intent"`
+	AmountTotal       int    `json:"amount_total"`
+	ClientReferenceID string `json:"client_reference_id"`
+}
+
+type refund struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type event struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+type apiError struct {
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Client Stripe Checkout 网关，支付通过托管收银台完成，结果通过签名 Webhook 通知
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+func NewClient(config Config) *Client {
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+	if config.Currency == "" {
+		config.Currency = DefaultCurrency
+	}
+	config.APIURL = strings.TrimRight(config.APIURL, "/")
+	return &Client{config: config, httpClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (c *Client) Name() string {
+	return GatewayName
+}
+
+// CreateOrder 创建 Checkout Session，网关订单号为 Session ID
+func (c *Client) CreateOrder(ctx context.Context, req *pay.CreateOrderReq) (*pay.CreateOrderResp, error) {
+	returnURL := req.ReturnURL
+	if returnURL == "" {
+		returnURL = c.config.ReturnURL
+	}
+	if returnURL == "" {
+		return nil, fmt.Errorf("缺少支付完成跳转地址")
+	}
+
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("client_reference_id", req.OutTradeNo)
+	form.Set("success_url", returnURL)
+	form.Set("cancel_url", returnURL)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", c.config.Currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.Itoa(req.Amount))
+	form.Set("line_items[0][price_data][product_data][name]", req.Subject)
+	form.Set("metadata[out_trade_no]", req.OutTradeNo)
+
+	var session checkoutSession
+	if err := c.do(ctx, http.MethodPost, "/v1/checkout/sessions", form, "", &session); err != nil {
+		return nil, err
+	}
+	return &pay.CreateOrderResp{PayURL: session.URL, TradeNo: session.ID}, nil
+}
+
+func (c *Client) QueryOrder(ctx context.Context, req *pay.QueryOrderReq) (*pay.QueryOrderResp, error) {
+	session, err := c.getSession(ctx, req.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	return &pay.QueryOrderResp{
+		Paid:    session.PaymentStatus == "paid",
+		TradeNo: session.ID,
+		Amount:  session.AmountTotal,
+	}, nil
+}
+
+// Refund 按 Session 关联的 PaymentIntent 退款，退款单号作为幂等键避免重复退款
+func (c *Client) Refund(ctx context.Context, req *pay.RefundReq) (*pay.RefundResp, error) {
+	session, err := c.getSession(ctx, req.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	if session.PaymentIntent == "" {
+		return nil, fmt.Errorf("Stripe 订单未支付，无法退款")
+	}
+
+	form := url.Values{}
+	form.Set("payment_intent", session.PaymentIntent)
+	form.Set("amount", strconv.Itoa(req.Amount))
+	form.Set("metadata[refund_no]", req.RefundNo)
+
+	var r refund
+	if err := c.do(ctx, http.MethodPost, "/v1/refunds", form, req.RefundNo, &r); err != nil {
+		return nil, err
+	}
+	if r.Status == "failed" || r.Status == "canceled" {
+		return nil, fmt.Errorf("Stripe 退款失败: %s", r.Status)
+	}
+	return &pay.RefundResp{RefundID: r.ID}, nil
+}
+
+// VerifyNotify 校验 Stripe-Signature 并解析 Webhook 事件，仅处理 Checkout 支付成功事件
+func (c *Client) VerifyNotify(ctx context.Context, req *pay.NotifyReq) (*pay.Notify, error) {
+	if err := verifySignature(req.Body, req.Header.Get("Stripe-Signature"), c.config.WebhookSecret, time.Now()); err != nil {
+		return nil, err
+	}
+
+	var evt event
+	if err := json.Unmarshal(req.Body, &evt); err != nil {
+		return nil, fmt.Errorf("解析 Webhook 事件失败: %w", err)
+	}
+	switch evt.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+	default:
+		return &pay.Notify{Type: pay.NotifyIgnored}, nil
+	}
+
+	var session checkoutSession
+	if err := json.Unmarshal(evt.Data.Object, &session); err != nil {
+		return nil, fmt.Errorf("解析 Checkout Session 失败: %w", err)
+	}
+	// 异步支付方式在 completed 事件时可能尚未到账
+	if session.PaymentStatus != "paid" {
+		return &pay.Notify{Type: pay.NotifyIgnored}, nil
+	}
+	return &pay.Notify{
+		Type:       pay.NotifyPaid,
+		OutTradeNo: session.ClientReferenceID,
+		TradeNo:    session.ID,
+		Amount:     session.AmountTotal,
+	}, nil
+}
+
+// CloseOrder 使未支付的 Checkout Session 过期，避免关单后用户仍能完成支付
+func (c *Client) CloseOrder(ctx context.Context, req *pay.QueryOrderReq) error {
+	if req.TradeNo == "" {
+		return nil
+	}
+	var session checkoutSession
+	return c.do(ctx, http.MethodPost, "/v1/checkout/sessions/"+url.PathEscape(req.TradeNo)+"/expire", nil, "", &session)
+}
+
+func (c *Client) getSession(ctx context.Context, id string) (*checkoutSession, error) {
+	if id == "" {
+		return nil, fmt.Errorf("订单缺少 Stripe 交易号")
+	}
+	var session checkoutSession
+	if err := c.do(ctx, http.MethodGet, "/v1/checkout/sessions/"+url.PathEscape(id), nil, "", &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// do 发送表单请求并解析 JSON 响应，非 2xx 响应解析为 Stripe 错误
+func (c *Client) do(ctx context.Context, method, path string, form url.Values, idempotencyKey string, out any) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.config.APIURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.config.SecretKey)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求失败: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("读取响应失败: %v", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr apiError
+		if err := json.Unmarshal(bodyBytes, &apiErr); err == nil && apiErr.Error.Message != "" {
+			return fmt.Errorf("接口返回错误: %s", apiErr.Error.Message)
+		}
+		return fmt.Errorf("接口返回错误: HTTP %d", resp.StatusCode)
+	}
+	if err := json.Unmarshal(bodyBytes, out); err != nil {
+		return fmt.Errorf("解析响应失败: %v, 响应内容: %s", err, string(bodyBytes))
+	}
+	return nil
+}
+
+// verifySignature 校验 Stripe-Signature 头：t=时间戳,v1=HMAC-SHA256(secret, "时间戳.请求体")
+func verifySignature(payload []byte, header, secret string, now time.Time) error {
+	if secret == "" {
+		return fmt.Errorf("未配置 Webhook 签名密钥")
+	}
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			timestamp = v
+		case "v1":
+			signatures = append(signatures, v)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return fmt.Errorf("缺少签名参数")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("签名时间戳无效")
+	}
+
+	expected := computeSignature(payload, timestamp, secret)
+	matched := false
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return fmt.Errorf("签名校验失败")
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > webhookTolerance || d < -webhookTolerance {
+		return fmt.Errorf("签名已过期")
+	}
+	return nil
+}
+
+func computeSignature(payload []byte, timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
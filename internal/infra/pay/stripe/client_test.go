@@ -0,0 +1,231 @@
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+)
+
+const (
+	testSecretKey     = "sk_test_123"
+	testWebhookSecret = "whsec_test_123"
+)
+
+// fakeStripe 模拟 Stripe Checkout 与退款接口
+type fakeStripe struct {
+	mu       sync.Mutex
+	sessions map[string]*checkoutSession
+	refunds  map[string]*refund
+}
+
+func newFakeStripe(t *testing.T) (*fakeStripe, *httptest.Server) {
+	f := &fakeStripe{sessions: map[string]*checkoutSession{}, refunds: map[string]*refund{}}
+	srv := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeStripe) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+testSecretKey {
+		writeError(w, http.StatusUnauthorized, "Invalid API Key provided")
+		return
+	}
+	_ = r.ParseForm()
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	switch {
+	case r.Method == http.MethodPost && path == "checkout/sessions":
+		amount, _ := strconv.Atoi(r.PostForm.Get("line_items[0][price_data][unit_amount]"))
+		if amount <= 0 || r.PostForm.Get("success_url") == "" {
+			writeError(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+		id := fmt.Sprintf("cs_test_%d", len(f.sessions)+1)
+		s := &checkoutSession{
+			ID:                id,
+			URL:               "https://checkout.stripe.test/" + id,
+			Status:            "open",
+			PaymentStatus:     "unpaid",
+			AmountTotal:       amount,
+			ClientReferenceID: r.PostForm.Get("client_reference_id"),
+		}
+		f.sessions[id] = s
+		writeJSON(w, s)
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "checkout/sessions/"):
+		s, ok := f.sessions[strings.TrimPrefix(path, "checkout/sessions/")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "No such checkout.session")
+			return
+		}
+		writeJSON(w, s)
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/expire"):
+		s, ok := f.sessions[strings.TrimSuffix(strings.TrimPrefix(path, "checkout/sessions/"), "/expire")]
+		if !ok || s.Status != "open" {
+			writeError(w, http.StatusBadRequest, "Only Checkout Sessions with a status of open can be expired")
+			return
+		}
+		s.Status = "expired"
+		writeJSON(w, s)
+	case r.Method == http.MethodPost && path == "refunds":
+		key := r.Header.Get("Idempotency-Key")
+		if existing, ok := f.refunds[key]; ok {
+			writeJSON(w, existing)
+			return
+		}
+		if r.PostForm.Get("payment_intent") == "" {
+			writeError(w, http.StatusBadRequest, "Missing payment_intent")
+			return
+		}
+		rf := &refund{ID: fmt.Sprintf("re_test_%d", len(f.refunds)+1), Status: "succeeded"}
+		f.refunds[key] = rf
+		writeJSON(w, rf)
+	default:
+		writeError(w, http.StatusNotFound, "Unrecognized request URL")
+	}
+}
+
+func (f *fakeStripe) pay(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := f.sessions[id]
+	s.Status = "complete"
+	s.PaymentStatus = "paid"
+	s.PaymentIntent = "pi_" + id
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.WriteHeader(status)
+	writeJSON(w, map[string]any{"error": map[string]string{"type": "invalid_request_error", "message": msg}})
+}
+
+func TestClientCheckoutFlow(t *testing.T) {
+	f, srv := newFakeStripe(t)
+	c := NewClient(Config{APIURL: srv.URL, SecretKey: testSecretKey, WebhookSecret: testWebhookSecret})
+	ctx := context.Background()
+
+	created, err := c.CreateOrder(ctx, &pay.CreateOrderReq{OrderID: 1, ChannelType: GatewayName, OutTradeNo: "T1", Subject: "商品", Amount: 1999, ReturnURL: "https://example.com/done"})
+	if err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+	if created.TradeNo == "" || !strings.HasPrefix(created.PayURL, "https://checkout.stripe.test/") {
+		t.Fatalf("CreateOrder() = %+v", created)
+	}
+
+	q, err := c.QueryOrder(ctx, &pay.QueryOrderReq{OutTradeNo: "T1", TradeNo: created.TradeNo})
+	if err != nil || q.Paid {
+		t.Fatalf("QueryOrder() before pay = %+v, %v", q, err)
+	}
+	if _, err := c.Refund(ctx, &pay.RefundReq{TradeNo: created.TradeNo, RefundNo: "R1", Amount: 1999}); err == nil {
+		t.Fatalf("Refund() of unpaid session error = nil")
+	}
+
+	f.pay(created.TradeNo)
+	q, err = c.QueryOrder(ctx, &pay.QueryOrderReq{TradeNo: created.TradeNo})
+	if err != nil || !q.Paid || q.Amount != 1999 {
+		t.Fatalf("QueryOrder() after pay = %+v, %v", q, err)
+	}
+
+	r1, err := c.Refund(ctx, &pay.RefundReq{TradeNo: created.TradeNo, RefundNo: "R1", Amount: 1999})
+	if err != nil {
+		t.Fatalf("Refund() error = %v", err)
+	}
+	r2, err := c.Refund(ctx, &pay.RefundReq{TradeNo: created.TradeNo, RefundNo: "R1", Amount: 1999})
+	if err != nil || r2.RefundID != r1.RefundID {
+		t.Fatalf("Refund() retry = %+v, %v, want idempotent %s", r2, err, r1.RefundID)
+	}
+
+	if err := c.CloseOrder(ctx, &pay.QueryOrderReq{TradeNo: created.TradeNo}); err == nil {
+		t.Fatalf("CloseOrder() of completed session error = nil")
+	}
+	open, err := c.CreateOrder(ctx, &pay.CreateOrderReq{OutTradeNo: "T2", Subject: "商品", Amount: 100, ReturnURL: "https://example.com/done"})
+	if err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+	if err := c.CloseOrder(ctx, &pay.QueryOrderReq{TradeNo: open.TradeNo}); err != nil {
+		t.Fatalf("CloseOrder() error = %v", err)
+	}
+}
+
+func TestClientAPIError(t *testing.T) {
+	_, srv := newFakeStripe(t)
+	c := NewClient(Config{APIURL: srv.URL, SecretKey: "sk_wrong"})
+	_, err := c.CreateOrder(context.Background(), &pay.CreateOrderReq{OutTradeNo: "T1", Subject: "商品", Amount: 100, ReturnURL: "https://example.com"})
+	if err == nil || !strings.Contains(err.Error(), "Invalid API Key") {
+		t.Fatalf("CreateOrder() error = %v, want API error message", err)
+	}
+}
+
+func signedNotify(body string, ts time.Time, secret string) *pay.NotifyReq {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	header := http.Header{}
+	header.Set("Stripe-Signature", "t="+t+",v1="+computeSignature([]byte(body), t, secret))
+	return &pay.NotifyReq{Header: header, Body: []byte(body)}
+}
+
+func TestClientVerifyNotify(t *testing.T) {
+	c := NewClient(Config{SecretKey: testSecretKey, WebhookSecret: testWebhookSecret})
+	ctx := context.Background()
+	paid := `{"id":"evt_1","type":"checkout.session.completed","data":{"object":{"id":"cs_1","payment_status":"paid","amount_total":1999,"client_reference_id":"T1"}}}`
+	unpaid := `{"id":"evt_2","type":"checkout.session.completed","data":{"object":{"id":"cs_1","payment_status":"unpaid","client_reference_id":"T1"}}}`
+	other := `{"id":"evt_3","type":"payment_intent.created","data":{"object":{}}}`
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		req      *pay.NotifyReq
+		wantErr  bool
+		wantType pay.NotifyType
+	}{
+		{"支付成功", signedNotify(paid, now, testWebhookSecret), false, pay.NotifyPaid},
+		{"未到账", signedNotify(unpaid, now, testWebhookSecret), false, pay.NotifyIgnored},
+		{"其他事件", signedNotify(other, now, testWebhookSecret), false, pay.NotifyIgnored},
+		{"密钥错误", signedNotify(paid, now, "whsec_wrong"), true, ""},
+		{"签名过期", signedNotify(paid, now.Add(-10*time.Minute), testWebhookSecret), true, ""},
+		{"缺少签名", &pay.NotifyReq{Header: http.Header{}, Body: []byte(paid)}, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := c.VerifyNotify(ctx, tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("VerifyNotify() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyNotify() error = %v", err)
+			}
+			if n.Type != tt.wantType {
+				t.Fatalf("VerifyNotify() type = %q, want %q", n.Type, tt.wantType)
+			}
+		})
+	}
+
+	// 请求体被篡改
+	req := signedNotify(paid, now, testWebhookSecret)
+	req.Body = []byte(strings.Replace(paid, "1999", "1", 1))
+	if _, err := c.VerifyNotify(ctx, req); err == nil {
+		t.Fatalf("VerifyNotify() with tampered body error = nil")
+	}
+
+	n, err := c.VerifyNotify(ctx, signedNotify(paid, now, testWebhookSecret))
+	if err != nil || n.OutTradeNo != "T1" || n.TradeNo != "cs_1" || n.Amount != 1999 {
+		t.Fatalf("VerifyNotify() = %+v, %v", n, err)
+	}
+}
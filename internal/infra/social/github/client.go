@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/social"
+)
+
+const (
+	// DefaultBaseURL GitHub 授权页与令牌端点地址
+	DefaultBaseURL = "https://github.com"
+	// DefaultAPIURL GitHub 接口地址
+	DefaultAPIURL = "https://api.github.com"
+)
+
+// DefaultScopes 默认申请的权限，读取用户资料与邮箱
+var DefaultScopes = []string{"read:user", "user:email"}
+
+// Config GitHub OAuth App 配置
+type Config struct {
+	// 登录方式标识
+	Name         string
+	ClientID     string
+	ClientSecret string
+	// 申请的权限，为空时使用 DefaultScopes
+	Scopes []string
+	// 授权页与令牌端点地址，为空时使用 DefaultBaseURL，GitHub Enterprise 填写实例地址
+	BaseURL string
+	// 接口地址，为空时使用 DefaultAPIURL
+	APIURL string
+}
+
+type user struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type email struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// Client GitHub 第三方登录
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+func NewClient(config Config) *Client {
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	config.APIURL = strings.TrimRight(config.APIURL, "/")
+	return &Client{config: config, httpClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (c *Client) Name() string {
+	return c.config.Name
+}
+
+func (c *Client) AuthCodeURL(ctx context.Context, req *social.AuthCodeReq) (string, error) {
+	q := url.Values{}
+	q.Set("client_id", c.config.ClientID)
+	q.Set("redirect_uri", req.RedirectURI)
+	q.Set("scope", strings.Join(c.config.Scopes, " "))
+	q.Set("state", req.State)
+	q.Set("allow_signup", "true")
+	if req.CodeChallenge != "" {
+		q.Set("code_challenge", req.CodeChallenge)
+		q.Set("code_challenge_method", "S256")
+	}
+	return c.config.BaseURL + "/login/oauth/authorize?" + q.Encode(), nil
+}
+
+// Exchange 换取访问令牌后读取用户资料，邮箱取已验证的主邮箱
+func (c *Client) Exchange(ctx context.Context, req *social.ExchangeReq) (*social.Identity, error) {
+	form := url.Values{}
+	form.Set("code", req.Code)
+	form.Set("redirect_uri", req.RedirectURI)
+	if req.CodeVerifier != "" {
+		form.Set("code_verifier", req.CodeVerifier)
+	}
+	token, err := social.ExchangeCode(ctx, c.httpClient, c.config.BaseURL+"/login/oauth/access_token", form, c.config.ClientID, c.config.ClientSecret, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var u user
+	if err := social.GetJSON(ctx, c.httpClient, c.config.APIURL+"/user", token.AccessToken, &u); err != nil {
+		return nil, fmt.Errorf("获取 GitHub 用户信息失败: %w", err)
+	}
+	if u.ID == 0 {
+		return nil, fmt.Errorf("获取 GitHub 用户信息失败: 缺少用户 ID")
+	}
+	identity := &social.Identity{
+		Subject:  strconv.FormatInt(u.ID, 10),
+		Name:     u.Name,
+		Username: u.Login,
+		Avatar:   u.AvatarURL,
+	}
+	if identity.Name == "" {
+		identity.Name = u.Login
+	}
+
+	// 公开邮箱不保证已验证，未授权 user:email 时只记录不视为已验证
+	var emails []email
+	if err := social.GetJSON(ctx, c.httpClient, c.config.APIURL+"/user/emails", token.AccessToken, &emails); err == nil {
+		for _, e := range emails {
+			if e.Primary && e.Verified {
+				identity.Email = e.Email
+				identity.EmailVerified = true
+				break
+			}
+		}
+	}
+	if identity.Email == "" {
+		identity.Email = u.Email
+	}
+	return identity, nil
+}
@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/social"
+)
+
+// newFakeGitHub 模拟 GitHub 令牌端点与用户接口
+func newFakeGitHub(t *testing.T, emails []email) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/login/oauth/access_token":
+			_ = r.ParseForm()
+			// GitHub 授权码错误时同样返回 200
+			if r.PostForm.Get("client_secret") != "secret" || r.PostForm.Get("code") != "code-1" {
+				_ = json.NewEncoder(w).Encode(map[string]string{"error": "bad_verification_code"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "gho_1", "token_type": "bearer"})
+		case "/user":
+			_ = json.NewEncoder(w).Encode(user{ID: 42, Login: "octocat", Email: "public@example.com", AvatarURL: "https://avatars.example.com/42"})
+		case "/user/emails":
+			_ = json.NewEncoder(w).Encode(emails)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestExchange(t *testing.T) {
+	tests := []struct {
+		name         string
+		code         string
+		emails       []email
+		wantEmail    string
+		wantVerified bool
+		wantErr      bool
+	}{
+		{
+			name:         "verified primary email",
+			code:         "code-1",
+			emails:       []email{{Email: "other@example.com", Verified: true}, {Email: "octo@example.com", Primary: true, Verified: true}},
+			wantEmail:    "octo@example.com",
+			wantVerified: true,
+		},
+		{
+			name:      "unverified primary falls back to public email",
+			code:      "code-1",
+			emails:    []email{{Email: "octo@example.com", Primary: true}},
+			wantEmail: "public@example.com",
+		},
+		{name: "bad code", code: "other", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeGitHub(t, tt.emails)
+			c := NewClient(Config{Name: "github", ClientID: "id", ClientSecret: "secret", BaseURL: srv.URL, APIURL: srv.URL})
+			identity, err := c.Exchange(context.Background(), &social.ExchangeReq{Code: tt.code, RedirectURI: "https://blog.example.com/console/social-login"})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", identity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if identity.Subject != "42" || identity.Name != "octocat" || identity.Email != tt.wantEmail || identity.EmailVerified != tt.wantVerified {
+				t.Fatalf("unexpected identity %+v", identity)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/social"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultScopes 默认申请的权限
+var DefaultScopes = []string{"openid", "profile", "email"}
+
+// Config OpenID Connect 提供方配置
+type Config struct {
+	// 登录方式标识
+	Name string
+	// 签发者地址，通过 {Issuer}/.well-known/openid-configuration 发现端点
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// 申请的权限，为空时使用 DefaultScopes，始终包含 openid
+	Scopes []string
+}
+
+// discovery OIDC 发现文档，仅声明使用到的字段
+type discovery struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// claims ID Token 与 UserInfo 中使用到的声明
+type claims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     any    `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+// Client 通用 OpenID Connect 第三方登录，身份以签名校验通过的 ID Token 为准
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+func NewClient(config Config) *Client {
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+	if !slices.Contains(config.Scopes, "openid") {
+		config.Scopes = append([]string{"openid"}, config.Scopes...)
+	}
+	config.Issuer = strings.TrimRight(config.Issuer, "/")
+	return &Client{config: config, httpClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (c *Client) Name() string {
+	return c.config.Name
+}
+
+func (c *Client) AuthCodeURL(ctx context.Context, req *social.AuthCodeReq) (string, error) {
+	doc, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	endpoint, err := url.Parse(doc.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("无效的授权端点: %w", err)
+	}
+	q := endpoint.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.config.ClientID)
+	q.Set("redirect_uri", req.RedirectURI)
+	q.Set("scope", strings.Join(c.config.Scopes, " "))
+	q.Set("state", req.State)
+	if req.Nonce != "" {
+		q.Set("nonce", req.Nonce)
+	}
+	if req.CodeChallenge != "" {
+		q.Set("code_challenge", req.CodeChallenge)
+		q.Set("code_challenge_method", "S256")
+	}
+	endpoint.RawQuery = q.Encode()
+	return endpoint.String(), nil
+}
+
+// Exchange 换取令牌并校验 ID Token，ID Token 未携带邮箱时从 UserInfo 端点补全资料
+func (c *Client) Exchange(ctx context.Context, req *social.ExchangeReq) (*social.Identity, error) {
+	doc, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("code", req.Code)
+	form.Set("redirect_uri", req.RedirectURI)
+	if req.CodeVerifier != "" {
+		form.Set("code_verifier", req.CodeVerifier)
+	}
+	basicAuth := len(doc.TokenEndpointAuthMethodsSupported) == 0 || slices.Contains(doc.TokenEndpointAuthMethodsSupported, "client_secret_basic")
+	token, err := social.ExchangeCode(ctx, c.httpClient, doc.TokenEndpoint, form, c.config.ClientID, c.config.ClientSecret, basicAuth)
+	if err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("令牌响应缺少 ID Token")
+	}
+
+	idClaims, err := c.verifyIDToken(ctx, doc, token.IDToken, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if idClaims.Email == "" && doc.UserinfoEndpoint != "" {
+		var info claims
+		if err := social.GetJSON(ctx, c.httpClient, doc.UserinfoEndpoint, token.AccessToken, &info); err != nil {
+			return nil, fmt.Errorf("获取用户信息失败: %w", err)
+		}
+		// UserInfo 的 sub 必须与 ID Token 一致，否则可能是被替换的响应
+		if info.Subject != idClaims.Subject {
+			return nil, errors.New("用户信息与 ID Token 不属于同一账号")
+		}
+		idClaims.Email = info.Email
+		idClaims.EmailVerified = info.EmailVerified
+		if idClaims.Name == "" {
+			idClaims.Name = info.Name
+		}
+		if idClaims.PreferredUsername == "" {
+			idClaims.PreferredUsername = info.PreferredUsername
+		}
+		if idClaims.Picture == "" {
+			idClaims.Picture = info.Picture
+		}
+	}
+
+	identity := &social.Identity{
+		Subject:       idClaims.Subject,
+		Email:         idClaims.Email,
+		EmailVerified: isTrue(idClaims.EmailVerified),
+		Name:          idClaims.Name,
+		Username:      idClaims.PreferredUsername,
+		Avatar:        idClaims.Picture,
+	}
+	if identity.Name == "" {
+		identity.Name = identity.Username
+	}
+	return identity, nil
+}
+
+// discover 读取发现文档，发现文档中的签发者必须与配置一致
+func (c *Client) discover(ctx context.Context) (*discovery, error) {
+	if c.config.Issuer == "" {
+		return nil, errors.New("未配置 OIDC 签发者地址")
+	}
+	var doc discovery
+	if err := social.GetJSON(ctx, c.httpClient, c.config.Issuer+"/.well-known/openid-configuration", "", &doc); err != nil {
+		return nil, fmt.Errorf("获取 OIDC 发现文档失败: %w", err)
+	}
+	if strings.TrimRight(doc.Issuer, "/") != c.config.Issuer {
+		return nil, fmt.Errorf("OIDC 发现文档签发者 %s 与配置不一致", doc.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("OIDC 发现文档缺少必要端点")
+	}
+	return &doc, nil
+}
+
+// verifyIDToken 校验 ID Token 的签名、签发者、受众、有效期与 nonce
+func (c *Client) verifyIDToken(ctx context.Context, doc *discovery, raw, nonce string) (*claims, error) {
+	var keys jwks
+	if err := social.GetJSON(ctx, c.httpClient, doc.JWKSURI, "", &keys); err != nil {
+		return nil, fmt.Errorf("获取 OIDC 公钥失败: %w", err)
+	}
+
+	var idClaims claims
+	_, err := jwt.ParseWithClaims(raw, &idClaims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return keys.lookup(kid, t.Method.Alg())
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(c.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ID Token 校验失败: %w", err)
+	}
+	if idClaims.Subject == "" {
+		return nil, errors.New("ID Token 缺少 sub")
+	}
+	if nonce != "" && idClaims.Nonce != nonce {
+		return nil, errors.New("ID Token nonce 不匹配")
+	}
+	return &idClaims, nil
+}
+
+// lookup 按 kid 查找签名公钥，未携带 kid 时仅在只有一个可用公钥时使用该公钥
+func (s *jwks) lookup(kid, alg string) (any, error) {
+	var candidates []jwk
+	for _, k := range s.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		if kid == "" || k.Kid == kid {
+			candidates = append(candidates, k)
+		}
+	}
+	if len(candidates) != 1 {
+		return nil, fmt.Errorf("找不到 ID Token 签名公钥 %s", kid)
+	}
+	k := candidates[0]
+	switch {
+	case k.Kty == "RSA" && (strings.HasPrefix(alg, "RS") || strings.HasPrefix(alg, "PS")):
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case k.Kty == "EC" && strings.HasPrefix(alg, "ES"):
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("不支持的椭圆曲线 %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("公钥 %s 类型 %s 与签名算法 %s 不匹配", k.Kid, k.Kty, alg)
+}
+
+// isTrue email_verified 兼容布尔值与字符串两种写法
+func isTrue(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return b == "true"
+	}
+	return false
+}
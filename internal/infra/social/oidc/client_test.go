@@ -0,0 +1,182 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/social"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testClientID     = "hoshikuzu"
+	testClientSecret = "secret"
+	testCode         = "code-1"
+	testVerifier     = "verifier-0123456789-0123456789-0123456789"
+	testNonce        = "nonce-1"
+)
+
+// stubOIDC 模拟 OIDC 提供方的发现、公钥、令牌与用户信息端点
+type stubOIDC struct {
+	srv    *httptest.Server
+	key    *rsa.PrivateKey
+	claims jwt.MapClaims
+	// 令牌端点签发 ID Token 使用的私钥，为空时使用 key
+	signKey *rsa.PrivateKey
+}
+
+func newStubOIDC(t *testing.T) *stubOIDC {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &stubOIDC{key: key}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.srv.Close)
+	s.claims = jwt.MapClaims{
+		"iss":   s.srv.URL,
+		"sub":   "user-1",
+		"aud":   testClientID,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": testNonce,
+		"name":  "Alice",
+	}
+	return s
+}
+
+func (s *stubOIDC) serve(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/.well-known/openid-configuration":
+		writeJSON(w, map[string]any{
+			"issuer":                 s.srv.URL,
+			"authorization_endpoint": s.srv.URL + "/authorize",
+			"token_endpoint":         s.srv.URL + "/token",
+			"userinfo_endpoint":      s.srv.URL + "/userinfo",
+			"jwks_uri":               s.srv.URL + "/jwks",
+		})
+	case "/jwks":
+		writeJSON(w, map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "k1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+		}}})
+	case "/token":
+		id, secret, ok := r.BasicAuth()
+		_ = r.ParseForm()
+		if !ok || id != testClientID || secret != testClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			writeJSON(w, map[string]string{"error": "invalid_client"})
+			return
+		}
+		if r.PostForm.Get("code") != testCode || r.PostForm.Get("code_verifier") != testVerifier {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		signKey := s.signKey
+		if signKey == nil {
+			signKey = s.key
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, s.claims)
+		token.Header["kid"] = "k1"
+		idToken, _ := token.SignedString(signKey)
+		writeJSON(w, map[string]string{"access_token": "at-1", "token_type": "Bearer", "id_token": idToken})
+	case "/userinfo":
+		if r.Header.Get("Authorization") != "Bearer at-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]any{"sub": "user-1", "email": "alice@example.com", "email_verified": true})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	stub := newStubOIDC(t)
+	c := NewClient(Config{Name: "sso", Issuer: stub.srv.URL, ClientID: testClientID, Scopes: []string{"email"}})
+
+	authURL, err := c.AuthCodeURL(context.Background(), &social.AuthCodeReq{
+		RedirectURI:   "https://blog.example.com/console/social-login",
+		State:         "state-1",
+		Nonce:         testNonce,
+		CodeChallenge: social.CodeChallenge(testVerifier),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(authURL, stub.srv.URL+"/authorize?") {
+		t.Fatalf("authURL = %s", authURL)
+	}
+	u, _ := url.Parse(authURL)
+	q := u.Query()
+	if q.Get("scope") != "openid email" || q.Get("state") != "state-1" || q.Get("nonce") != testNonce || q.Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected query %v", q)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(s *stubOIDC)
+		code    string
+		wantErr bool
+	}{
+		{name: "ok", code: testCode},
+		{name: "wrong code", code: "other", wantErr: true},
+		{name: "wrong nonce", code: testCode, mutate: func(s *stubOIDC) { s.claims["nonce"] = "other" }, wantErr: true},
+		{name: "wrong audience", code: testCode, mutate: func(s *stubOIDC) { s.claims["aud"] = "other" }, wantErr: true},
+		{name: "wrong issuer", code: testCode, mutate: func(s *stubOIDC) { s.claims["iss"] = "https://evil.example.com" }, wantErr: true},
+		{name: "expired", code: testCode, mutate: func(s *stubOIDC) { s.claims["exp"] = time.Now().Add(-time.Hour).Unix() }, wantErr: true},
+		{name: "forged signature", code: testCode, mutate: func(s *stubOIDC) {
+			s.signKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newStubOIDC(t)
+			if tt.mutate != nil {
+				tt.mutate(stub)
+			}
+			c := NewClient(Config{Name: "sso", Issuer: stub.srv.URL, ClientID: testClientID, ClientSecret: testClientSecret})
+			identity, err := c.Exchange(context.Background(), &social.ExchangeReq{
+				Code:         tt.code,
+				RedirectURI:  "https://blog.example.com/console/social-login",
+				Nonce:        testNonce,
+				CodeVerifier: testVerifier,
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", identity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 邮箱由 UserInfo 端点补全
+			if identity.Subject != "user-1" || identity.Email != "alice@example.com" || !identity.EmailVerified || identity.Name != "Alice" {
+				t.Fatalf("unexpected identity %+v", identity)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package social
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Provider 第三方登录提供方，通过 OAuth2 授权码流程获取第三方账号身份
+type Provider interface {
+	// Name 登录方式标识，同时作为身份关联记录的 provider 字段
+	Name() string
+	// AuthCodeURL 生成第三方授权页地址
+	AuthCodeURL(ctx context.Context, req *AuthCodeReq) (string, error)
+	// Exchange 使用授权码换取令牌并解析第三方账号身份
+	Exchange(ctx context.Context, req *ExchangeReq) (*Identity, error)
+}
+
+// AuthCodeReq 授权请求参数
+type AuthCodeReq struct {
+	RedirectURI string
+	State       string
+	// OIDC nonce，用于校验 ID Token 属于本次授权
+	Nonce string
+	// PKCE code_challenge，固定使用 S256
+	CodeChallenge string
+}
+
+// ExchangeReq 授权码换取参数
+type ExchangeReq struct {
+	Code         string
+	RedirectURI  string
+	Nonce        string
+	CodeVerifier string
+}
+
+// Identity 第三方账号身份
+type Identity struct {
+	// 第三方账号的唯一标识
+	Subject string
+	Email   string
+	// 第三方是否确认邮箱归该账号所有
+	EmailVerified bool
+	Name          string
+	Username      string
+	Avatar        string
+}
+
+// Token 令牌端点响应，仅声明使用到的字段
+type Token struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// CodeChallenge 计算 PKCE S256 code_challenge
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ExchangeCode 请求令牌端点，basicAuth 为 true 时使用 client_secret_basic 认证，否则将凭据放在表单中
+func ExchangeCode(ctx context.Context, httpClient *http.Client, tokenURL string, form url.Values, clientID, clientSecret string, basicAuth bool) (*Token, error) {
+	form.Set("grant_type", "authorization_code")
+	if !basicAuth {
+		form.Set("client_id", clientID)
+		form.Set("client_secret", clientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if basicAuth {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	}
+
+	var token Token
+	status, err := doJSON(httpClient, req, &token)
+	if err != nil {
+		return nil, fmt.Errorf("请求令牌失败: %w", err)
+	}
+	if token.Error != "" {
+		return nil, fmt.Errorf("请求令牌失败: %s %s", token.Error, token.ErrorDescription)
+	}
+	if status != http.StatusOK || token.AccessToken == "" {
+		return nil, fmt.Errorf("请求令牌失败: HTTP %d", status)
+	}
+	return &token, nil
+}
+
+// GetJSON 发送 GET 请求并解析 JSON 响应，accessToken 不为空时作为 Bearer 令牌携带
+func GetJSON(ctx context.Context, httpClient *http.Client, endpoint, accessToken string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	status, err := doJSON(httpClient, req, v)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("请求 %s 失败: HTTP %d", endpoint, status)
+	}
+	return nil
+}
+
+func doJSON(httpClient *http.Client, req *http.Request, v any) (int, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if err := json.Unmarshal(body, v); err != nil && resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("解析响应失败: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -64,14 +64,21 @@ func (s *S3Storage) Upload(path string, reader io.Reader, size int64, contentTyp
 	return path, err
 }
 
-// GetURL 获取S3文件访问URL
+// GetURL 获取S3文件访问URL，expiry 大于 0 时返回限时有效的预签名URL
 func (s *S3Storage) GetURL(path string, expiry time.Duration) (string, error) {
 	// 如果expiry为0，则返回公共URL
 	if expiry == 0 {
 		return "https://" + s.BucketName + "." + s.Endpoint + "/" + path, nil
 	}
 
-	return "https://" + s.BucketName + "." + s.Endpoint + "/" + path, nil
+	req, err := s3.NewPresignClient(s.client).PresignGetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(s.BucketName),
+		Key:    aws.String(path),
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", err
+	}
+	return req.URL, nil
 }
 
 // Delete 删除S3上的文件
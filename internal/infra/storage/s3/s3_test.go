@@ -0,0 +1,30 @@
+package s3
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetURLPresignsWithExpiry(t *testing.T) {
+	s, err := NewS3Storage("https://s3.example.com", "us-east-1", "ak", "sk", "bucket")
+	if err != nil {
+		t.Fatalf("NewS3Storage() error = %v", err)
+	}
+	raw, err := s.GetURL("files/a.zip", 30*time.Minute)
+	if err != nil {
+		t.Fatalf("GetURL() error = %v", err)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	q := u.Query()
+	if q.Get("X-Amz-Expires") != "1800" || q.Get("X-Amz-Signature") == "" {
+		t.Errorf("GetURL() = %q, want a presigned URL valid for 1800s", raw)
+	}
+	if !strings.Contains(u.Host+u.Path, "files/a.zip") {
+		t.Errorf("GetURL() = %q, want the object key in the URL", raw)
+	}
+}
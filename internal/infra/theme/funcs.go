@@ -0,0 +1,165 @@
+package theme
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/internal/infra/markdown"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+const defaultDateLayout = "2006-01-02"
+
+// FuncMap 主题模板可用的函数
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"formatDate":  FormatDate,
+		"markdown":    Markdown,
+		"postContent": PostContent,
+		"postURL":     PostURL,
+		"categoryURL": CategoryURL,
+		"tagURL":      TagURL,
+		"authorURL":   AuthorURL,
+		"pageURL":     PageURL,
+		"pageRange":   PageRange,
+		"truncate":    Truncate,
+		"add":         func(a, b int) int { return a + b },
+		"sub":         func(a, b int) int { return a - b },
+	}
+}
+
+// FormatDate 格式化时间，layout 为空时使用 2006-01-02
+func FormatDate(v any, layout ...string) string {
+	l := defaultDateLayout
+	if len(layout) > 0 && layout[0] != "" {
+		l = layout[0]
+	}
+
+	var t time.Time
+	switch tv := v.(type) {
+	case time.Time:
+		t = tv
+	case *time.Time:
+		if tv == nil {
+			return ""
+		}
+		t = *tv
+	case model.LocalTime:
+		t = tv.Time()
+	case *model.LocalTime:
+		if tv == nil {
+			return ""
+		}
+		t = tv.Time()
+	default:
+		return ""
+	}
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(l)
+}
+
+// Markdown 将 markdown 渲染为经过清洗的 HTML，与文章保存时使用同一套渲染和清洗规则
+func Markdown(src string) template.HTML {
+	rendered, err := markdown.Render([]byte(src))
+	if err != nil {
+		return template.HTML(template.HTMLEscapeString(src))
+	}
+	return template.HTML(rendered)
+}
+
+// PostContent 输出文章正文，优先使用已保存的 HTML，否则渲染 markdown
+func PostContent(p model.PostResp) template.HTML {
+	if p.HtmlContent != nil && *p.HtmlContent != "" {
+		return template.HTML(markdown.Sanitize(*p.HtmlContent))
+	}
+	if p.MdContent != nil && *p.MdContent != "" {
+		return Markdown(*p.MdContent)
+	}
+	return Markdown(p.Content)
+}
+
+// PostURL 文章链接，参数可以是别名字符串或 PostResp
+func PostURL(v any) string {
+	var slug string
+	switch pv := v.(type) {
+	case string:
+		slug = pv
+	case *string:
+		if pv != nil {
+			slug = *pv
+		}
+	case model.PostResp:
+		if pv.Slug != nil {
+			slug = *pv.Slug
+		}
+	case *model.PostResp:
+		if pv != nil && pv.Slug != nil {
+			slug = *pv.Slug
+		}
+	}
+	return "/post/" + url.PathEscape(slug)
+}
+
+func CategoryURL(name string) string {
+	return "/category/" + url.PathEscape(name)
+}
+
+func TagURL(name string) string {
+	return "/tag/" + url.PathEscape(name)
+}
+
+func AuthorURL(id int) string {
+	return fmt.Sprintf("/author/%d", id)
+}
+
+// PageURL 分页链接，第一页不带 page 参数
+func PageURL(p model.Pagination, page int) string {
+	base := p.BasePath
+	if base == "" {
+		base = "/"
+	}
+	if page <= 1 {
+		return base
+	}
+	return fmt.Sprintf("%s?page=%d", base, page)
+}
+
+// PageRange 返回当前页附近的页码，最多 5 个
+func PageRange(p model.Pagination) []int {
+	const window = 5
+	if p.TotalPages <= 0 {
+		return []int{}
+	}
+	start := p.Page - window/2
+	if start < 1 {
+		start = 1
+	}
+	end := start + window - 1
+	if end > p.TotalPages {
+		end = p.TotalPages
+		start = end - window + 1
+		if start < 1 {
+			start = 1
+		}
+	}
+	pages := make([]int, 0, end-start+1)
+	for i := start; i <= end; i++ {
+		pages = append(pages, i)
+	}
+	return pages
+}
+
+// Truncate 按字符截断字符串，超出部分以 ... 结尾
+func Truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if n <= 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n]) + "..."
+}
@@ -0,0 +1,95 @@
+package theme
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+func TestPageRange(t *testing.T) {
+	tests := []struct {
+		name string
+		page int
+		max  int
+		want []int
+	}{
+		{"无数据", 1, 0, []int{}},
+		{"总页数小于窗口", 2, 3, []int{1, 2, 3}},
+		{"居中", 5, 10, []int{3, 4, 5, 6, 7}},
+		{"靠近开头", 1, 10, []int{1, 2, 3, 4, 5}},
+		{"靠近结尾", 10, 10, []int{6, 7, 8, 9, 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PageRange(model.Pagination{Page: tt.page, TotalPages: tt.max})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PageRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageURL(t *testing.T) {
+	p := model.NewPagination(1, 10, 35, "/tag/go")
+	if got := PageURL(p, 1); got != "/tag/go" {
+		t.Errorf("PageURL(1) = %q", got)
+	}
+	if got := PageURL(p, 3); got != "/tag/go?page=3" {
+		t.Errorf("PageURL(3) = %q", got)
+	}
+	if p.TotalPages != 4 {
+		t.Errorf("TotalPages = %d, want 4", p.TotalPages)
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	ts := time.Date(2024, 3, 5, 8, 30, 0, 0, time.UTC)
+	lt := model.LocalTime(ts)
+	tests := []struct {
+		name   string
+		v      any
+		layout []string
+		want   string
+	}{
+		{"time.Time 默认格式", ts, nil, "2024-03-05"},
+		{"LocalTime 指针自定义格式", &lt, []string{"2006/01/02 15:04"}, "2024/03/05 08:30"},
+		{"空指针", (*model.LocalTime)(nil), nil, ""},
+		{"不支持的类型", 42, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatDate(tt.v, tt.layout...); got != tt.want {
+				t.Errorf("FormatDate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostURL(t *testing.T) {
+	slug := "hello world"
+	if got := PostURL(model.PostResp{Slug: &slug}); got != "/post/hello%20world" {
+		t.Errorf("PostURL() = %q", got)
+	}
+	if got := CategoryURL("随笔"); got != "/category/%E9%9A%8F%E7%AC%94" {
+		t.Errorf("CategoryURL() = %q", got)
+	}
+}
+
+func TestMarkdownSanitized(t *testing.T) {
+	got := string(Markdown("# 标题\n\n<script>alert(1)</script>"))
+	if !strings.Contains(got, "<h1") || strings.Contains(got, "<script>") {
+		t.Errorf("Markdown() = %q", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := Truncate("你好世界", 2); got != "你好..." {
+		t.Errorf("Truncate() = %q", got)
+	}
+	if got := Truncate("abc", 5); got != "abc" {
+		t.Errorf("Truncate() = %q", got)
+	}
+}
@@ -0,0 +1,242 @@
+package theme
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/shuTwT/hoshikuzu/ent"
+)
+
+const (
+	layoutsDir  = "layouts"
+	partialsDir = "partials"
+)
+
+// Loader 主题模板加载器，按主题名称和版本缓存已编译的模板集合
+//
+// 每个页面模板都会与 templates/layouts/*.html、templates/partials/*.html 一起解析，
+// 页面通过 {{ define }} 覆盖布局中的 {{ block }} 实现继承。
+type Loader struct {
+	mu      sync.RWMutex
+	sets    map[string]*themeSet
+	dev     bool
+	watcher *fsnotify.Watcher
+	watched map[string]string // 监听目录 -> 主题名称
+	// 缓存清除代数，Invalidate 递增对应主题，InvalidateAll 递增全局，
+	// 解析前后代数不同说明期间发生过清除，解析结果可能已过期，不再写入缓存
+	generation  uint64
+	generations map[string]uint64
+	parse       func(themePath, page string) (*template.Template, error)
+}
+
+// themeSet 单个主题版本下已编译的页面模板
+type themeSet struct {
+	name  string
+	pages map[string]*template.Template
+}
+
+var (
+	loaderOnce    sync.Once
+	defaultLoader *Loader
+)
+
+// GetLoader 获取全局模板加载器
+func GetLoader() *Loader {
+	loaderOnce.Do(func() {
+		defaultLoader = NewLoader()
+	})
+	return defaultLoader
+}
+
+func NewLoader() *Loader {
+	return &Loader{
+		sets:        make(map[string]*themeSet),
+		watched:     make(map[string]string),
+		generations: make(map[string]uint64),
+		parse:       parsePage,
+	}
+}
+
+// EnableDevMode 开启开发模式，监听已加载主题的模板目录，文件变化时清除对应缓存
+func (l *Loader) EnableDevMode() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.dev {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("创建主题目录监听失败: %w", err)
+	}
+	l.dev = true
+	l.watcher = watcher
+	go l.watch()
+	return nil
+}
+
+// Close 停止目录监听
+func (l *Loader) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.watcher == nil {
+		return nil
+	}
+	err := l.watcher.Close()
+	l.watcher = nil
+	l.dev = false
+	return err
+}
+
+// Lookup 获取页面模板，命中缓存时直接返回，否则解析并写入缓存
+func (l *Loader) Lookup(theme *ent.Theme, page string) (*template.Template, error) {
+	key := cacheKey(theme)
+
+	l.mu.RLock()
+	if set, ok := l.sets[key]; ok {
+		if tmpl, ok := set.pages[page]; ok {
+			l.mu.RUnlock()
+			return tmpl, nil
+		}
+	}
+	gen := l.generationOf(theme.Name)
+	l.mu.RUnlock()
+
+	tmpl, err := l.parse(theme.Path, page)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.generationOf(theme.Name) != gen {
+		// 解析期间主题已被清除缓存，本次结果可能读到了旧文件，只返回不缓存
+		return tmpl, nil
+	}
+	set, ok := l.sets[key]
+	if !ok {
+		set = &themeSet{name: theme.Name, pages: make(map[string]*template.Template)}
+		l.sets[key] = set
+	}
+	set.pages[page] = tmpl
+	if l.dev {
+		l.watchTheme(theme)
+	}
+	return tmpl, nil
+}
+
+// Invalidate 清除指定主题所有版本的缓存
+func (l *Loader) Invalidate(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.generations[name]++
+	for key, set := range l.sets {
+		if set.name == name {
+			delete(l.sets, key)
+		}
+	}
+}
+
+// InvalidateAll 清除全部缓存
+func (l *Loader) InvalidateAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.generation++
+	l.sets = make(map[string]*themeSet)
+}
+
+// generationOf 主题当前的缓存清除代数，两个计数都只增不减，和变化即发生过清除，调用方需持有锁
+func (l *Loader) generationOf(name string) uint64 {
+	return l.generation + l.generations[name]
+}
+
+// watchTheme 监听主题模板目录，调用方需持有写锁
+func (l *Loader) watchTheme(theme *ent.Theme) {
+	if l.watcher == nil {
+		return
+	}
+	root := filepath.Join(theme.Path, "templates")
+	for _, dir := range []string{root, filepath.Join(root, layoutsDir), filepath.Join(root, partialsDir)} {
+		if _, ok := l.watched[dir]; ok {
+			continue
+		}
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		if err := l.watcher.Add(dir); err != nil {
+			slog.Warn("监听主题目录失败", "dir", dir, "error", err.Error())
+			continue
+		}
+		l.watched[dir] = theme.Name
+	}
+}
+
+func (l *Loader) watch() {
+	l.mu.RLock()
+	watcher := l.watcher
+	l.mu.RUnlock()
+	if watcher == nil {
+		return
+	}
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			l.mu.RLock()
+			name, ok := l.watched[filepath.Dir(event.Name)]
+			l.mu.RUnlock()
+			if ok {
+				slog.Info("主题模板已变更，重新加载", "theme", name, "file", event.Name)
+				l.Invalidate(name)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			slog.Warn("主题目录监听出错", "error", err.Error())
+		}
+	}
+}
+
+// parsePage 按 布局 -> 片段 -> 页面 的顺序解析，页面中的 define 会覆盖布局中的 block 默认内容
+func parsePage(themePath, page string) (*template.Template, error) {
+	root := filepath.Join(themePath, "templates")
+	pagePath := filepath.Join(root, page)
+	if _, err := os.Stat(pagePath); err != nil {
+		return nil, fmt.Errorf("模板文件不存在: %w", err)
+	}
+
+	tmpl := template.New(page).Funcs(FuncMap())
+	for _, dir := range []string{layoutsDir, partialsDir} {
+		files, err := filepath.Glob(filepath.Join(root, dir, "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			continue
+		}
+		if _, err := tmpl.ParseFiles(files...); err != nil {
+			return nil, fmt.Errorf("解析%s模板失败: %w", dir, err)
+		}
+	}
+
+	if _, err := tmpl.ParseFiles(pagePath); err != nil {
+		return nil, fmt.Errorf("解析页面模板失败: %w", err)
+	}
+	return tmpl, nil
+}
+
+func cacheKey(theme *ent.Theme) string {
+	return theme.Name + "@" + theme.Version
+}
@@ -0,0 +1,116 @@
+package theme
+
+import (
+	"bytes"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+)
+
+func writeTemplate(t *testing.T, root, name, content string) {
+	t.Helper()
+	path := filepath.Join(root, "templates", name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func render(t *testing.T, l *Loader, theme *ent.Theme, page string) string {
+	t.Helper()
+	tmpl, err := l.Lookup(theme, page)
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, page, nil); err != nil {
+		t.Fatalf("ExecuteTemplate() error = %v", err)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func TestLoaderLayoutInheritance(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "layouts/base.html", `{{ define "base" }}<main>{{ block "content" . }}默认{{ end }}</main>{{ template "footer" . }}{{ end }}`)
+	writeTemplate(t, dir, "partials/footer.html", `{{ define "footer" }}<footer>页脚</footer>{{ end }}`)
+	writeTemplate(t, dir, "post.html", `{{ template "base" . }}{{ define "content" }}文章{{ end }}`)
+	writeTemplate(t, dir, "tags.html", `{{ template "base" . }}`)
+
+	l := NewLoader()
+	theme := &ent.Theme{Name: "demo", Version: "1.0.0", Path: dir}
+
+	if got := render(t, l, theme, "post.html"); got != "<main>文章</main><footer>页脚</footer>" {
+		t.Errorf("post.html = %q", got)
+	}
+	// 每个页面独立解析，其他页面的 define 不会互相覆盖
+	if got := render(t, l, theme, "tags.html"); got != "<main>默认</main><footer>页脚</footer>" {
+		t.Errorf("tags.html = %q", got)
+	}
+}
+
+func TestLoaderCacheInvalidate(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "index.html", `v1`)
+
+	l := NewLoader()
+	theme := &ent.Theme{Name: "demo", Version: "1.0.0", Path: dir}
+
+	if got := render(t, l, theme, "index.html"); got != "v1" {
+		t.Fatalf("index.html = %q", got)
+	}
+
+	writeTemplate(t, dir, "index.html", `v2`)
+	if got := render(t, l, theme, "index.html"); got != "v1" {
+		t.Errorf("缓存未命中: %q", got)
+	}
+
+	// 版本变化视为新的模板集合
+	upgraded := &ent.Theme{Name: "demo", Version: "1.0.1", Path: dir}
+	if got := render(t, l, upgraded, "index.html"); got != "v2" {
+		t.Errorf("新版本应重新解析: %q", got)
+	}
+
+	writeTemplate(t, dir, "index.html", `v3`)
+	l.Invalidate("demo")
+	if got := render(t, l, theme, "index.html"); got != "v3" {
+		t.Errorf("Invalidate 后应重新解析: %q", got)
+	}
+}
+
+func TestLoaderMissingPage(t *testing.T) {
+	l := NewLoader()
+	theme := &ent.Theme{Name: "demo", Version: "1.0.0", Path: t.TempDir()}
+	if _, err := l.Lookup(theme, "post.html"); err == nil {
+		t.Error("Lookup() 缺少页面模板时应返回错误")
+	}
+}
+
+// 解析期间主题被清除缓存时，解析结果可能来自旧文件，不能写入缓存
+func TestLoaderInvalidateDuringParse(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "index.html", `v1`)
+
+	l := NewLoader()
+	theme := &ent.Theme{Name: "demo", Version: "1.0.0", Path: dir}
+	l.parse = func(themePath, page string) (*template.Template, error) {
+		tmpl, err := parsePage(themePath, page)
+		// 模拟解析完成后、写入缓存前文件变更触发的清除
+		writeTemplate(t, dir, "index.html", `v2`)
+		l.Invalidate("demo")
+		return tmpl, err
+	}
+	if got := render(t, l, theme, "index.html"); got != "v1" {
+		t.Fatalf("index.html = %q", got)
+	}
+
+	l.parse = parsePage
+	if got := render(t, l, theme, "index.html"); got != "v2" {
+		t.Errorf("清除期间的解析结果被缓存: %q", got)
+	}
+}
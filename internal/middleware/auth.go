@@ -1,18 +1,28 @@
 package middleware
 
 import (
+	"encoding/json"
+	"log/slog"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2accesstoken"
 	"github.com/shuTwT/hoshikuzu/ent/personalaccesstoken"
+	session_service "github.com/shuTwT/hoshikuzu/internal/services/system/session"
 	"github.com/shuTwT/hoshikuzu/pkg/config"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
 
 	jwtware "github.com/gofiber/contrib/jwt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// patUsageInterval 个人访问令牌使用信息的最小记录间隔
+const patUsageInterval = time.Minute
+
 // Protected 保护需要认证的路由
 func Protected() fiber.Handler {
 	// 从配置中获取密钥
@@ -47,7 +57,7 @@ func jwtSuccess(c *fiber.Ctx) error {
 	return c.Next()
 }
 
-// FlexibleAuth 支持JWT或个人访问令牌任意一种认证方式
+// FlexibleAuth 支持JWT、个人访问令牌或 OAuth2 访问令牌任意一种认证方式
 func FlexibleAuth(dbClient *ent.Client) fiber.Handler {
 	var client = dbClient
 	return func(c *fiber.Ctx) error {
@@ -62,60 +72,180 @@ func FlexibleAuth(dbClient *ent.Client) fiber.Handler {
 			return c.JSON(model.NewError(fiber.StatusUnauthorized, "Invalid token format"))
 		}
 
-		secret := config.GetString(config.AUTH_TOKEN_SECRET)
-		patSecret := config.GetString(config.AUTH_PAT_SECRET)
+		if resolveToken(c, client, tokenString) && loadScopes(c, client) {
+			return c.Next()
+		}
 
-		hasAuth := false
+		return c.JSON(model.NewError(fiber.StatusUnauthorized, "Authentication required"))
+	}
+}
 
-		token, err := jwt.ParseWithClaims(tokenString, jwt.MapClaims{}, func(token *jwt.Token) (interface{}, error) {
-			return []byte(secret), nil
-		})
+// OptionalAuth 可选认证，携带有效令牌时写入当前用户信息，无令牌或令牌无效时按匿名访问放行
+func OptionalAuth(dbClient *ent.Client) fiber.Handler {
+	var client = dbClient
+	return func(c *fiber.Ctx) error {
+		tokenString := strings.TrimPrefix(c.Get("Authorization"), "Bearer ")
+		if tokenString != "" {
+			resolveToken(c, client, tokenString)
+		}
+		return c.Next()
+	}
+}
 
-		if err == nil && token.Valid {
-			claims, ok := token.Claims.(jwt.MapClaims)
-			if !ok {
-				return c.JSON(model.NewError(fiber.StatusInternalServerError, "Invalid claims type"))
-			}
-			c.Locals("userId", claims["id"])
-			c.Locals("userEmail", claims["email"])
-			c.Locals("userName", claims["name"])
-			c.Locals("authSuccess", true)
-			c.Locals("authType", "jwt")
-			hasAuth = true
+// pageTokenCookie 控制台登录后写入的令牌 Cookie，值为经 URL 编码、包含 accessToken 的 JSON
+const pageTokenCookie = "authorized-token"
+
+// OptionalPageAuth 站点页面使用的可选认证。浏览器直接打开页面时不会携带 Authorization 头，
+// 因此未携带时读取控制台登录写入的令牌 Cookie，使已登录、已付费或已评论的访问者看到全文。
+// Cookie 会随跨站请求自动发送，只能用于只读的 GET 页面，API 路由仍只接受 Authorization 头
+func OptionalPageAuth(dbClient *ent.Client) fiber.Handler {
+	var client = dbClient
+	return func(c *fiber.Ctx) error {
+		tokenString := strings.TrimPrefix(c.Get("Authorization"), "Bearer ")
+		if tokenString == "" {
+			tokenString = cookieAccessToken(c.Cookies(pageTokenCookie))
+		}
+		if tokenString != "" && resolveToken(c, client, tokenString) {
+			// 页面内容随访问者身份变化，不能被共享缓存
+			c.Set(fiber.HeaderCacheControl, "private, no-cache")
 		}
+		return c.Next()
+	}
+}
+
+// cookieAccessToken 从令牌 Cookie 中取出访问令牌，格式不正确时返回空字符串
+func cookieAccessToken(value string) string {
+	if value == "" {
+		return ""
+	}
+	if unescaped, err := url.PathUnescape(value); err == nil {
+		value = unescaped
+	}
+	var data struct {
+		AccessToken string `json:"accessToken"`
+	}
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return ""
+	}
+	return data.AccessToken
+}
 
-		if !hasAuth {
-			token, err := jwt.ParseWithClaims(tokenString, jwt.MapClaims{}, func(token *jwt.Token) (interface{}, error) {
-				return []byte(patSecret), nil
-			})
-
-			if err == nil && token.Valid {
-				claims := token.Claims.(jwt.MapClaims)
-
-				pat, err := client.PersonalAccessToken.Query().
-					Where(personalaccesstoken.Token(tokenString)).
-					First(c.Context())
-
-				if err == nil {
-					if pat.Expires.IsZero() || pat.Expires.After(c.Context().Time()) {
-						c.Locals("userId", claims["id"])
-						c.Locals("userEmail", claims["email"])
-						c.Locals("userName", claims["name"])
-						c.Locals("patId", pat.ID)
-						c.Locals("authSuccess", true)
-						c.Locals("authType", "pat")
-						hasAuth = true
-					}
-				}
+// resolveToken 依次按JWT、个人访问令牌、OAuth2 访问令牌校验，成功时写入当前用户信息
+func resolveToken(c *fiber.Ctx, client *ent.Client, tokenString string) bool {
+	if strings.HasPrefix(tokenString, model.PersonalAccessTokenPrefix) {
+		return resolvePersonalAccessToken(c, client, tokenString)
+	}
+	if strings.HasPrefix(tokenString, model.OAuth2AccessTokenPrefix) {
+		return resolveOAuth2AccessToken(c, client, tokenString)
+	}
+
+	secret := config.GetString(config.AUTH_TOKEN_SECRET)
+	token, err := jwt.ParseWithClaims(tokenString, jwt.MapClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	})
+
+	if err == nil && token.Valid {
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return false
+		}
+		// 升级前签发的登录令牌没有会话ID，不做会话校验
+		if sid, _ := claims["sid"].(string); sid != "" {
+			active, err := session_service.IsActive(c.Context(), client, sid)
+			if err != nil || !active {
+				return false
 			}
+			c.Locals("sessionId", sid)
 		}
+		c.Locals("userId", claims["id"])
+		c.Locals("userEmail", claims["email"])
+		c.Locals("userName", claims["name"])
+		c.Locals("authSuccess", true)
+		c.Locals("authType", "jwt")
+		return true
+	}
 
-		if hasAuth {
-			return c.Next()
+	// 旧版个人访问令牌为 PAT 密钥签名的 JWT
+	patSecret := config.GetString(config.AUTH_PAT_SECRET)
+	token, err = jwt.ParseWithClaims(tokenString, jwt.MapClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return []byte(patSecret), nil
+	})
+	if err != nil || !token.Valid {
+		return false
+	}
+	return resolvePersonalAccessToken(c, client, tokenString)
+}
+
+// resolvePersonalAccessToken 按哈希查找个人访问令牌，校验有效期并记录最后使用时间与 IP
+func resolvePersonalAccessToken(c *fiber.Ctx, client *ent.Client, tokenString string) bool {
+	pat, err := client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.TokenHash(utils.HashToken(tokenString))).
+		Only(c.Context())
+	if err != nil {
+		return false
+	}
+	now := time.Now()
+	if !pat.Expires.IsZero() && !pat.Expires.After(now) {
+		return false
+	}
+	u, err := client.User.Get(c.Context(), pat.UserID)
+	if err != nil {
+		return false
+	}
+
+	// 同一 IP 短时间内的连续请求不重复写库
+	if pat.LastUsedAt == nil || now.Sub(*pat.LastUsedAt) > patUsageInterval || pat.LastUsedIP != c.IP() {
+		if err := client.PersonalAccessToken.UpdateOne(pat).
+			SetLastUsedAt(now).
+			SetLastUsedIP(c.IP()).
+			Exec(c.Context()); err != nil {
+			slog.Warn("记录个人访问令牌使用信息失败", "pat_id", pat.ID, "error", err)
 		}
+	}
 
-		return c.JSON(model.NewError(fiber.StatusUnauthorized, "Authentication required"))
+	// 与 JWT claims 中的数字类型保持一致
+	c.Locals("userId", float64(u.ID))
+	c.Locals("userEmail", u.Email)
+	c.Locals("userName", u.Name)
+	c.Locals("patId", pat.ID)
+	c.Locals("tokenScopes", pat.Scopes)
+	c.Locals("authSuccess", true)
+	c.Locals("authType", "pat")
+	return true
+}
+
+// resolveOAuth2AccessToken 按哈希查找 OAuth2 访问令牌，令牌只能访问授权范围中的接口权限
+func resolveOAuth2AccessToken(c *fiber.Ctx, client *ent.Client, tokenString string) bool {
+	at, err := client.Oauth2AccessToken.Query().
+		Where(
+			oauth2accesstoken.AccessToken(utils.HashToken(tokenString)),
+			oauth2accesstoken.ExpireAtGT(time.Now()),
+		).
+		Only(c.Context())
+	if err != nil {
+		return false
+	}
+	u, err := client.User.Get(c.Context(), at.UserID)
+	if err != nil {
+		return false
+	}
+
+	// 只授权了 openid 等标准范围的令牌不能访问任何接口
+	scopes := []string{}
+	for _, scope := range strings.Fields(at.Scope) {
+		if _, ok := model.OIDCScopes[scope]; !ok {
+			scopes = append(scopes, scope)
+		}
 	}
+
+	c.Locals("userId", float64(u.ID))
+	c.Locals("userEmail", u.Email)
+	c.Locals("userName", u.Name)
+	c.Locals("oauth2ClientId", at.ClientID)
+	c.Locals("tokenScopes", scopes)
+	c.Locals("authSuccess", true)
+	c.Locals("authType", "oauth2")
+	return true
 }
 
 // GetCurrentUser 获取当前登录用户信息
@@ -142,36 +272,8 @@ func PersonalAccessTokenProtected(dbClient *ent.Client) fiber.Handler {
 		if tokenString == "" {
 			return c.JSON(model.NewError(fiber.StatusUnauthorized, "Invalid token format"))
 		}
-		patSecret := config.GetString(config.AUTH_PAT_SECRET)
-
-		hasAuth := false
-
-		if !hasAuth {
-			token, err := jwt.ParseWithClaims(tokenString, jwt.MapClaims{}, func(token *jwt.Token) (interface{}, error) {
-				return []byte(patSecret), nil
-			})
-
-			if err == nil && token.Valid {
-				claims := token.Claims.(jwt.MapClaims)
-
-				pat, err := client.PersonalAccessToken.Query().
-					Where(personalaccesstoken.Token(tokenString)).
-					First(c.Context())
-
-				if err == nil {
-					if pat.Expires.IsZero() || pat.Expires.After(c.Context().Time()) {
-						c.Locals("userId", claims["id"])
-						c.Locals("userEmail", claims["email"])
-						c.Locals("userName", claims["name"])
-						c.Locals("patId", pat.ID)
-						c.Locals("authSuccess", true)
-						c.Locals("authType", "pat")
-						hasAuth = true
-					}
-				}
-			}
-		}
-		if hasAuth {
+
+		if resolvePersonalAccessToken(c, client, tokenString) {
 			return c.Next()
 		}
 
@@ -0,0 +1,22 @@
+package middleware
+
+import "testing"
+
+func TestCookieAccessToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"js-cookie encoded", `{%22accessToken%22:%22a.b.c%22%2C%22refreshToken%22:%22r%22%2C%22expires%22:1}`, "a.b.c"},
+		{"plain json", `{"accessToken":"a.b.c"}`, "a.b.c"},
+		{"empty", "", ""},
+		{"not json", "a.b.c", ""},
+		{"missing token", `{"refreshToken":"r"}`, ""},
+	}
+	for _, tt := range tests {
+		if got := cookieAccessToken(tt.value); got != tt.want {
+			t.Errorf("%s: cookieAccessToken() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// declaredScopes 路由声明过的权限范围
+var declaredScopes = map[string]struct{}{}
+
+// RequireScope 要求当前用户角色拥有指定权限，使用个人访问令牌或 OAuth2 访问令牌时令牌也必须包含该权限。
+// 需注册在 FlexibleAuth 之后，由 FlexibleAuth 写入角色权限。
+func RequireScope(scope string) fiber.Handler {
+	declaredScopes[scope] = struct{}{}
+	return func(c *fiber.Ctx) error {
+		roleScopes, _ := c.Locals("scopes").([]string)
+		if !permission.HasScope(roleScopes, scope) {
+			return c.JSON(model.NewError(fiber.StatusForbidden, "权限不足: "+scope))
+		}
+		if tokenScopes, ok := c.Locals("tokenScopes").([]string); ok && tokenScopes != nil && !permission.HasScope(tokenScopes, scope) {
+			return c.JSON(model.NewError(fiber.StatusForbidden, "访问令牌权限不足: "+scope))
+		}
+		return c.Next()
+	}
+}
+
+// HasScope 当前请求是否拥有指定权限，规则与 RequireScope 相同，用于接口内按权限调整返回内容
+func HasScope(c *fiber.Ctx, scope string) bool {
+	roleScopes, _ := c.Locals("scopes").([]string)
+	if !permission.HasScope(roleScopes, scope) {
+		return false
+	}
+	tokenScopes, ok := c.Locals("tokenScopes").([]string)
+	return !ok || tokenScopes == nil || permission.HasScope(tokenScopes, scope)
+}
+
+// DeclaredScopes 返回路由声明过的全部权限范围
+func DeclaredScopes() []string {
+	scopes := make([]string, 0, len(declaredScopes))
+	for scope := range declaredScopes {
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+// loadScopes 读取当前用户角色的权限写入上下文，用户不存在时返回 false
+func loadScopes(c *fiber.Ctx, client *ent.Client) bool {
+	id, ok := c.Locals("userId").(float64)
+	if !ok {
+		return false
+	}
+	u, err := client.User.Query().
+		Where(user.IDEQ(int(id))).
+		WithRole().
+		Only(c.Context())
+	if err != nil {
+		return false
+	}
+	var scopes []string
+	if u.Edges.Role != nil {
+		scopes = u.Edges.Role.Permissions
+	}
+	c.Locals("scopes", scopes)
+	return true
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestRequireScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		scopes    []string
+		tokenScopes []string
+		wantCode  int
+	}{
+		{"角色拥有权限", []string{"hoshikuzu:user:*"}, nil, fiber.StatusOK},
+		{"角色缺少权限", []string{"hoshikuzu:user:view"}, nil, fiber.StatusForbidden},
+		{"令牌拥有权限", []string{"*"}, []string{"hoshikuzu:user:delete"}, fiber.StatusOK},
+		{"令牌缺少权限", []string{"*"}, []string{"hoshikuzu:user:view"}, fiber.StatusForbidden},
+		{"令牌权限不超出角色", []string{"hoshikuzu:user:view"}, []string{"hoshikuzu:user:delete"}, fiber.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				c.Locals("scopes", tt.scopes)
+				c.Locals("tokenScopes", tt.tokenScopes)
+				return c.Next()
+			})
+			app.Delete("/user/:id", RequireScope("hoshikuzu:user:delete"), func(c *fiber.Ctx) error {
+				return c.JSON(fiber.Map{"code": fiber.StatusOK})
+			})
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodDelete, "/user/1", nil))
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			var body struct {
+				Code int `json:"code"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Fatalf("code = %d, want %d", body.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		name        string
+		scopes      []string
+		tokenScopes []string
+		want        bool
+	}{
+		{"角色拥有权限", []string{"hoshikuzu:post:*"}, nil, true},
+		{"未登录", nil, nil, false},
+		{"令牌缺少权限", []string{"*"}, []string{"hoshikuzu:ai-chat:use"}, false},
+	}
+	for _, tt := range tests {
+		app := fiber.New()
+		app.Get("/", func(c *fiber.Ctx) error {
+			c.Locals("scopes", tt.scopes)
+			c.Locals("tokenScopes", tt.tokenScopes)
+			if got := HasScope(c, "hoshikuzu:post:view"); got != tt.want {
+				t.Errorf("%s: HasScope() = %v, want %v", tt.name, got, tt.want)
+			}
+			return nil
+		})
+		if _, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil)); err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+	}
+}
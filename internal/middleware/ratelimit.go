@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// RateLimit 按客户端 IP 限制接口在时间窗口内的请求次数，每次调用使用独立的计数
+func RateLimit(max int, window time.Duration) fiber.Handler {
+	return limiter.New(limiter.Config{
+		Max:               max,
+		Expiration:        window,
+		LimiterMiddleware: limiter.SlidingWindow{},
+		LimitReached: func(c *fiber.Ctx) error {
+			return c.JSON(model.NewError(fiber.StatusTooManyRequests, "请求过于频繁，请稍后再试"))
+		},
+	})
+}
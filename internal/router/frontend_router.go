@@ -1,23 +1,26 @@
 package router
 
 import (
+	"bytes"
 	"context"
 	"embed"
-	"fmt"
-	"html/template"
 	"io"
 	"io/fs"
 	"log"
 	"mime"
+	"net/url"
 	"os"
 	"path/filepath"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/shuTwT/hoshikuzu/ent"
+	theme_infra "github.com/shuTwT/hoshikuzu/internal/infra/theme"
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
 	"github.com/shuTwT/hoshikuzu/pkg"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 )
 
-func InitFrontendRes(app *fiber.App, frontendRes embed.FS, serviceMap pkg.ServiceMap) {
+func InitFrontendRes(app *fiber.App, frontendRes embed.FS, serviceMap pkg.ServiceMap, dbClient *ent.Client) {
 
 	distDir, err := fs.Sub(frontendRes, "ui/dist")
 	if err != nil {
@@ -68,10 +71,10 @@ func InitFrontendRes(app *fiber.App, frontendRes embed.FS, serviceMap pkg.Servic
 		return c.SendStatus(fiber.StatusNotFound)
 	})
 
-	initFrontendRoutes(app, serviceMap)
+	initFrontendRoutes(app, serviceMap, dbClient)
 }
 
-func initFrontendRoutes(app *fiber.App, serviceMap pkg.ServiceMap) {
+func initFrontendRoutes(app *fiber.App, serviceMap pkg.ServiceMap, dbClient *ent.Client) {
 	ctx := context.Background()
 
 	previewTheme := func(c *fiber.Ctx) (*ent.Theme, error) {
@@ -126,15 +129,43 @@ func initFrontendRoutes(app *fiber.App, serviceMap pkg.ServiceMap) {
 		return c.Next()
 	})
 
-	app.Get("/", renderTemplate(serviceMap, "index.html"))
-	app.Get("/archives", renderTemplate(serviceMap, "archives.html"))
-	app.Get("/author/:userId", renderTemplate(serviceMap, "author.html"))
-	app.Get("/categories", renderTemplate(serviceMap, "categories.html"))
-	app.Get("/category/:categoryName", renderTemplate(serviceMap, "category.html"))
-	app.Get("/post/:slug", renderTemplate(serviceMap, "post.html"))
-	app.Get("/tags", renderTemplate(serviceMap, "tags.html"))
-	app.Get("/tag/:tagName", renderTemplate(serviceMap, "tag.html"))
-	app.Get("/404", renderTemplate(serviceMap, "404.html"))
+	siteService := serviceMap.SiteService
+	// 页面渲染时识别访问者身份，用于付费、评论可见文章的权限判断。
+	// 浏览器导航不带 Authorization 头，身份取自控制台登录写入的令牌 Cookie
+	optionalAuth := middleware.OptionalPageAuth(dbClient)
+
+	app.Get("/", optionalAuth, renderTemplate(serviceMap, "index.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		return siteService.BuildIndexPage(c, base, c.QueryInt("page", 1))
+	}))
+	app.Get("/archives", optionalAuth, renderTemplate(serviceMap, "archives.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		return siteService.BuildArchivesPage(c, base)
+	}))
+	app.Get("/author/:userId", optionalAuth, renderTemplate(serviceMap, "author.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		userId, err := c.ParamsInt("userId")
+		if err != nil {
+			return nil, &ent.NotFoundError{}
+		}
+		return siteService.BuildAuthorPage(c, base, userId, c.QueryInt("page", 1))
+	}))
+	app.Get("/categories", optionalAuth, renderTemplate(serviceMap, "categories.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		return siteService.BuildCategoriesPage(c, base)
+	}))
+	app.Get("/category/:categoryName", optionalAuth, renderTemplate(serviceMap, "category.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		return siteService.BuildCategoryPage(c, base, routeParam(c, "categoryName"), c.QueryInt("page", 1))
+	}))
+	app.Get("/post/:slug", optionalAuth, renderTemplate(serviceMap, "post.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		return siteService.BuildPostPage(c, base, routeParam(c, "slug"))
+	}))
+	app.Get("/tags", optionalAuth, renderTemplate(serviceMap, "tags.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		return siteService.BuildTagsPage(c, base)
+	}))
+	app.Get("/tag/:tagName", optionalAuth, renderTemplate(serviceMap, "tag.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		return siteService.BuildTagPage(c, base, routeParam(c, "tagName"), c.QueryInt("page", 1))
+	}))
+	app.Get("/404", optionalAuth, renderTemplate(serviceMap, "404.html", func(c *fiber.Ctx, base model.SitePage) (any, error) {
+		c.Status(fiber.StatusNotFound)
+		return siteService.BuildBasePage(c, base), nil
+	}))
 
 	app.Use(func(c *fiber.Ctx) error {
 		theme := c.Locals("theme")
@@ -165,7 +196,10 @@ func initFrontendRoutes(app *fiber.App, serviceMap pkg.ServiceMap) {
 	})
 }
 
-func renderTemplate(serviceMap pkg.ServiceMap, templateName string) fiber.Handler {
+// pageBuilder 根据请求组装模板数据
+type pageBuilder func(c *fiber.Ctx, base model.SitePage) (any, error)
+
+func renderTemplate(serviceMap pkg.ServiceMap, templateName string, build pageBuilder) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		theme := c.Locals("theme")
 		if theme == nil {
@@ -182,35 +216,54 @@ func renderTemplate(serviceMap pkg.ServiceMap, templateName string) fiber.Handle
 
 		templatePath := filepath.Join(themeEntity.Path, "templates", templateName)
 
-		log.Printf("%s", templatePath)
-
 		if _, err := os.Stat(templatePath); os.IsNotExist(err) {
 			log.Printf("模板文件不存在: %s", templatePath)
 			return c.Status(fiber.StatusNotFound).SendString("模板文件不存在")
 		}
 
-		tmpl, err := template.ParseFiles(templatePath)
+		tmpl, err := theme_infra.GetLoader().Lookup(themeEntity, templateName)
 		if err != nil {
 			log.Printf("解析模板失败: %v", err)
 			return c.Status(fiber.StatusInternalServerError).SendString("解析模板失败")
 		}
 
-		c.Set("Content-Type", "text/html; charset=utf-8")
+		base := model.SitePage{
+			Title:       getTemplateTitle(templateName),
+			Theme:       themeEntity,
+			ThemeConfig: serviceMap.ThemeService.GetThemeConfigValues(c.Context(), themeEntity),
+			Path:        c.Path(),
+			Params:      c.AllParams(),
+			CurrentUser: middleware.GetCurrentUser(c),
+		}
 
-		data := map[string]interface{}{
-			"Title":  fmt.Sprintf("%s - %s", getTemplateTitle(templateName), themeEntity.DisplayName),
-			"Theme":  themeEntity,
-			"Path":   c.Path(),
-			"Params": c.AllParams(),
+		data, err := build(c, base)
+		if err != nil {
+			if ent.IsNotFound(err) && templateName != "404.html" {
+				return c.Redirect("/404")
+			}
+			log.Printf("获取页面数据失败: %v", err)
+			return c.Status(fiber.StatusInternalServerError).SendString("获取页面数据失败")
 		}
 
-		if err := tmpl.Execute(c.Response().BodyWriter(), data); err != nil {
+		// 先渲染到缓冲区，避免模板执行出错时输出半截页面
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, templateName, data); err != nil {
 			log.Printf("渲染模板失败: %v", err)
 			return c.Status(fiber.StatusInternalServerError).SendString("渲染模板失败")
 		}
 
-		return nil
+		c.Set("Content-Type", "text/html; charset=utf-8")
+		return c.Send(buf.Bytes())
+	}
+}
+
+// routeParam 获取解码后的路由参数，中文分类和标签名在路径中会被转义
+func routeParam(c *fiber.Ctx, key string) string {
+	value := c.Params(key)
+	if decoded, err := url.PathUnescape(value); err == nil {
+		return decoded
 	}
+	return value
 }
 
 func getTemplateTitle(templateName string) string {
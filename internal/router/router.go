@@ -1,6 +1,8 @@
 package router
 
 import (
+	"time"
+
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/internal/handlers"
 	"github.com/shuTwT/hoshikuzu/internal/middleware"
@@ -12,60 +14,130 @@ import (
 func initSystemRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 	settingsApi := router.Group("/settings")
 	{
-		settingsApi.Get("/json/:key", handlerMap.SettingHandler.GetJsonSettingsMap)
-		settingsApi.Post("/json/save/:key", handlerMap.SettingHandler.SaveSettings)
+		settingsApi.Get("/json/:key", middleware.RequireScope("hoshikuzu:setting:view"), handlerMap.SettingHandler.GetJsonSettingsMap)
+		settingsApi.Post("/json/save/:key", middleware.RequireScope("hoshikuzu:setting:update"), handlerMap.SettingHandler.SaveSettings)
 	}
 	roleApi := router.Group("/role")
 	{
-		roleApi.Get("/list", handlerMap.RoleHandler.ListRole)
-		roleApi.Get("/page", handlerMap.RoleHandler.ListRolePage)
-		roleApi.Post("/create", handlerMap.RoleHandler.CreateRole)
-		roleApi.Put("/update/:id", handlerMap.RoleHandler.UpdateRole)
-		roleApi.Get("/query/:id", handlerMap.RoleHandler.QueryRole)
-		roleApi.Delete("/delete/:id", handlerMap.RoleHandler.DeleteRole)
+		roleApi.Get("/list", middleware.RequireScope("hoshikuzu:role:view"), handlerMap.RoleHandler.ListRole)
+		roleApi.Get("/page", middleware.RequireScope("hoshikuzu:role:view"), handlerMap.RoleHandler.ListRolePage)
+		roleApi.Post("/create", middleware.RequireScope("hoshikuzu:role:create"), handlerMap.RoleHandler.CreateRole)
+		roleApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:role:update"), handlerMap.RoleHandler.UpdateRole)
+		roleApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:role:view"), handlerMap.RoleHandler.QueryRole)
+		roleApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:role:delete"), handlerMap.RoleHandler.DeleteRole)
+	}
+	permissionApi := router.Group("/permission")
+	{
+		permissionApi.Get("/list", middleware.RequireScope("hoshikuzu:role:view"), handlerMap.PermissionHandler.ListPermissions)
 	}
 	userApi := router.Group("/user")
 	{
-		userApi.Get("/profile", handlerMap.UserHandler.GetUserProfile)
-		userApi.Put("/profile/update", handlerMap.UserHandler.UpdateProfile)
-		userApi.Get("/list", handlerMap.UserHandler.ListUser)
-		userApi.Get("/page", handlerMap.UserHandler.ListUserPage)
-		userApi.Post("/create", handlerMap.UserHandler.CreateUser)
-		userApi.Put("/update/:id", handlerMap.UserHandler.UpdateUser)
-		userApi.Get("/query/:id", handlerMap.UserHandler.QueryUser)
-		userApi.Delete("/delete/:id", handlerMap.UserHandler.DeleteUser)
+		userApi.Get("/profile", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.UserHandler.GetUserProfile)
+		userApi.Put("/profile/update", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.UserHandler.UpdateProfile)
+		userApi.Get("/identity/list", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.SocialHandler.ListIdentities)
+		userApi.Post("/identity/authorize/:provider", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.SocialHandler.LinkAuthorize)
+		userApi.Post("/identity/link", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.SocialHandler.Link)
+		userApi.Delete("/identity/delete/:id", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.SocialHandler.Unlink)
+		userApi.Get("/two-factor/status", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.TwoFactorHandler.Status)
+		userApi.Post("/two-factor/setup", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.TwoFactorHandler.Setup)
+		userApi.Post("/two-factor/enable", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.TwoFactorHandler.Enable)
+		userApi.Post("/two-factor/disable", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.TwoFactorHandler.Disable)
+		userApi.Post("/two-factor/recovery-codes", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.TwoFactorHandler.RegenerateRecoveryCodes)
+		userApi.Post("/two-factor/reset/:id", middleware.RequireScope("hoshikuzu:user:update"), handlerMap.TwoFactorHandler.Reset)
+		userApi.Get("/sessions", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.SessionHandler.ListSessions)
+		userApi.Post("/sessions/revoke-others", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.SessionHandler.RevokeOtherSessions)
+		userApi.Post("/sessions/revoke-user/:id", middleware.RequireScope("hoshikuzu:user:update"), handlerMap.SessionHandler.RevokeUserSessions)
+		userApi.Delete("/sessions/:id", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.SessionHandler.RevokeSession)
+		userApi.Post("/unlock/:id", middleware.RequireScope("hoshikuzu:user:update"), handlerMap.LoginLogHandler.UnlockUser)
+		userApi.Get("/list", middleware.RequireScope("hoshikuzu:user:view"), handlerMap.UserHandler.ListUser)
+		userApi.Get("/page", middleware.RequireScope("hoshikuzu:user:view"), handlerMap.UserHandler.ListUserPage)
+		userApi.Post("/create", middleware.RequireScope("hoshikuzu:user:create"), handlerMap.UserHandler.CreateUser)
+		userApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:user:update"), handlerMap.UserHandler.UpdateUser)
+		userApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:user:view"), handlerMap.UserHandler.QueryUser)
+		userApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:user:delete"), handlerMap.UserHandler.DeleteUser)
+	}
+	oauth2ClientApi := router.Group("/oauth2-client")
+	{
+		oauth2ClientApi.Get("/page", middleware.RequireScope("hoshikuzu:oauth2-client:view"), handlerMap.OAuth2Handler.ListClientPage)
+		oauth2ClientApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:oauth2-client:view"), handlerMap.OAuth2Handler.QueryClient)
+		oauth2ClientApi.Post("/create", middleware.RequireScope("hoshikuzu:oauth2-client:create"), handlerMap.OAuth2Handler.CreateClient)
+		oauth2ClientApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:oauth2-client:update"), handlerMap.OAuth2Handler.UpdateClient)
+		oauth2ClientApi.Post("/reset-secret/:id", middleware.RequireScope("hoshikuzu:oauth2-client:update"), handlerMap.OAuth2Handler.ResetClientSecret)
+		oauth2ClientApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:oauth2-client:delete"), handlerMap.OAuth2Handler.DeleteClient)
+	}
+	// 第三方应用授权确认
+	oauth2Api := router.Group("/oauth2")
+	{
+		oauth2Api.Get("/authorize", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.OAuth2Handler.GetConsent)
+		oauth2Api.Post("/authorize", middleware.RequireScope("hoshikuzu:account:profile"), handlerMap.OAuth2Handler.Consent)
 	}
 	notificationApi := router.Group("/notifications")
 	{
-		notificationApi.Get("/page", handlerMap.NotificationHandler.ListNotificationPage)
-		notificationApi.Get("/query/:id", handlerMap.NotificationHandler.QueryNotification)
-		notificationApi.Delete("/delete/:id", handlerMap.NotificationHandler.DeleteNotification)
-		notificationApi.Post("/batch/read", handlerMap.NotificationHandler.BatchMarkAsRead)
+		notificationApi.Get("/page", middleware.RequireScope("hoshikuzu:notification:view"), handlerMap.NotificationHandler.ListNotificationPage)
+		notificationApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:notification:view"), handlerMap.NotificationHandler.QueryNotification)
+		notificationApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:notification:delete"), handlerMap.NotificationHandler.DeleteNotification)
+		notificationApi.Post("/batch/read", middleware.RequireScope("hoshikuzu:notification:update"), handlerMap.NotificationHandler.BatchMarkAsRead)
 	}
 }
 
-// 注册 AI 路由。该组位于 FlexibleAuth 之后，聊天和管理接口都必须先通过登录认证。
+// 注册 AI 路由。该组位于 FlexibleAuth 之后，聊天和管理接口都必须先通过登录认证并具备对应权限。
 func initAIRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 	aiProvider := router.Group("/ai/providers")
 	{
-		router.Get("/ai/providers/list", handlerMap.AIHandler.ListProviders)
-		aiProvider.Post("/create", handlerMap.AIHandler.CreateProvider)
-		aiProvider.Put("/update/:id", handlerMap.AIHandler.UpdateProvider)
-		aiProvider.Delete("/delete/:id", handlerMap.AIHandler.DeleteProvider)
-		aiProvider.Post("/test", handlerMap.AIHandler.TestProvider)
-		aiProvider.Post("/:id/models/sync", handlerMap.AIHandler.SyncProviderModels)
-		aiProvider.Post("/:id/models/create", handlerMap.AIHandler.CreateProviderModel)
-		aiProvider.Put("/:id/models/update/:modelId", handlerMap.AIHandler.UpdateProviderModel)
-		aiProvider.Delete("/:id/models/delete/:modelId", handlerMap.AIHandler.DeleteProviderModel)
+		router.Get("/ai/providers/list", middleware.RequireScope("hoshikuzu:ai-provider:view"), handlerMap.AIHandler.ListProviders)
+		aiProvider.Post("/create", middleware.RequireScope("hoshikuzu:ai-provider:create"), handlerMap.AIHandler.CreateProvider)
+		aiProvider.Put("/update/:id", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.AIHandler.UpdateProvider)
+		aiProvider.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:ai-provider:delete"), handlerMap.AIHandler.DeleteProvider)
+		aiProvider.Post("/test", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.AIHandler.TestProvider)
+		aiProvider.Post("/:id/models/sync", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.AIHandler.SyncProviderModels)
+		aiProvider.Post("/:id/models/create", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.AIHandler.CreateProviderModel)
+		aiProvider.Put("/:id/models/update/:modelId", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.AIHandler.UpdateProviderModel)
+		aiProvider.Delete("/:id/models/delete/:modelId", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.AIHandler.DeleteProviderModel)
+	}
+	aiKnowledge := router.Group("/ai/knowledge")
+	{
+		aiKnowledge.Get("/status", middleware.RequireScope("hoshikuzu:ai-provider:view"), handlerMap.AIHandler.KnowledgeStatus)
+		aiKnowledge.Post("/rebuild", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.AIHandler.RebuildKnowledge)
+		aiKnowledge.Post("/search", middleware.RequireScope("hoshikuzu:ai-provider:view"), handlerMap.AIHandler.SearchKnowledge)
+	}
+	aiAsk := router.Group("/ai/ask")
+	{
+		aiAsk.Get("/usage", middleware.RequireScope("hoshikuzu:ai-ask:view"), handlerMap.AskHandler.Usage)
+		aiAsk.Get("/log/page", middleware.RequireScope("hoshikuzu:ai-ask:view"), handlerMap.AskHandler.ListLogPage)
+		aiAsk.Post("/log/batch/delete", middleware.RequireScope("hoshikuzu:ai-ask:delete"), handlerMap.AskHandler.BatchDeleteLogs)
+	}
+	aiPost := router.Group("/ai/post")
+	{
+		aiPost.Post("/:id/titles", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.WritingHandler.Titles)
+		aiPost.Post("/:id/seo", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.WritingHandler.SEO)
+		aiPost.Post("/:id/taxonomy", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.WritingHandler.Taxonomy)
+		aiPost.Post("/:id/translate", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.WritingHandler.Translate)
+		aiPost.Post("/:id/proofread", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.WritingHandler.Proofread)
+		aiPost.Post("/:id/continue", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.WritingHandler.Continue)
+	}
+	aiWriting := router.Group("/ai/writing")
+	{
+		aiWriting.Get("/prompts", middleware.RequireScope("hoshikuzu:ai-provider:view"), handlerMap.WritingHandler.ListPrompts)
+		aiWriting.Put("/prompts", middleware.RequireScope("hoshikuzu:ai-provider:update"), handlerMap.WritingHandler.SavePrompts)
 	}
 	aiChat := router.Group("/ai/chat/sessions")
 	{
-		router.Get("/ai/chat/sessions", handlerMap.AIHandler.ListSessions)
-		router.Post("/ai/chat/sessions", handlerMap.AIHandler.CreateSession)
-		aiChat.Get("/:id/messages", handlerMap.AIHandler.ListMessages)
-		aiChat.Delete("/:id", handlerMap.AIHandler.DeleteSession)
-		aiChat.Delete("/:id/messages", handlerMap.AIHandler.ClearSession)
-		aiChat.Post("/:id/stream", handlerMap.AIHandler.Stream)
+		router.Get("/ai/chat/sessions", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.ListSessions)
+		router.Post("/ai/chat/sessions", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.CreateSession)
+		aiChat.Get("/:id/messages", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.ListMessages)
+		aiChat.Delete("/:id", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.DeleteSession)
+		aiChat.Delete("/:id/messages", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.ClearSession)
+		aiChat.Put("/:id/settings", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.UpdateSessionSettings)
+		aiChat.Post("/:id/stream", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.Stream)
+		aiChat.Post("/:id/messages/:messageId/regenerate", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.Regenerate)
+	}
+	router.Get("/ai/chat/models", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.ListChatModels)
+	aiPersona := router.Group("/ai/chat/personas")
+	{
+		router.Get("/ai/chat/personas", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.ListPersonas)
+		router.Post("/ai/chat/personas", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.CreatePersona)
+		aiPersona.Put("/:id", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.UpdatePersona)
+		aiPersona.Delete("/:id", middleware.RequireScope("hoshikuzu:ai-chat:use"), handlerMap.AIHandler.DeletePersona)
 	}
 }
 
@@ -73,106 +145,109 @@ func initAIRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 func initContentRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 	commentApi := router.Group("/comment")
 	{
-		commentApi.Get("/page", handlerMap.CommentHandler.ListCommentPage)
-		commentApi.Get("/query/:id", handlerMap.CommentHandler.GetComment)
-		commentApi.Put("/approve/:id", handlerMap.CommentHandler.ApproveComment)
-		commentApi.Put("/reject/:id", handlerMap.CommentHandler.RejectComment)
-		commentApi.Delete("/delete/:id", handlerMap.CommentHandler.DeleteComment)
+		commentApi.Get("/page", middleware.RequireScope("hoshikuzu:comment:view"), handlerMap.CommentHandler.ListCommentPage)
+		commentApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:comment:view"), handlerMap.CommentHandler.GetComment)
+		commentApi.Put("/approve/:id", middleware.RequireScope("hoshikuzu:comment:update"), handlerMap.CommentHandler.ApproveComment)
+		commentApi.Put("/reject/:id", middleware.RequireScope("hoshikuzu:comment:update"), handlerMap.CommentHandler.RejectComment)
+		commentApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:comment:delete"), handlerMap.CommentHandler.DeleteComment)
+		commentApi.Get("/moderation/page", middleware.RequireScope("hoshikuzu:comment:view"), handlerMap.CommentHandler.QueryModerationPage)
+		commentApi.Post("/moderation/approve", middleware.RequireScope("hoshikuzu:comment:update"), handlerMap.CommentHandler.BatchApproveComment)
+		commentApi.Post("/moderation/reject", middleware.RequireScope("hoshikuzu:comment:update"), handlerMap.CommentHandler.BatchRejectComment)
 	}
 	albumApi := router.Group("/album")
 	{
-		albumApi.Get("/list", handlerMap.AlbumHandler.ListAlbum)
-		albumApi.Get("/page", handlerMap.AlbumHandler.ListAlbumPage)
-		albumApi.Post("/create", handlerMap.AlbumHandler.CreateAlbum)
-		albumApi.Put("/update/:id", handlerMap.AlbumHandler.UpdateAlbum)
-		albumApi.Get("/query/:id", handlerMap.AlbumHandler.QueryAlbum)
-		albumApi.Delete("/delete/:id", handlerMap.AlbumHandler.DeleteAlbum)
+		albumApi.Get("/list", middleware.RequireScope("hoshikuzu:album:view"), handlerMap.AlbumHandler.ListAlbum)
+		albumApi.Get("/page", middleware.RequireScope("hoshikuzu:album:view"), handlerMap.AlbumHandler.ListAlbumPage)
+		albumApi.Post("/create", middleware.RequireScope("hoshikuzu:album:create"), handlerMap.AlbumHandler.CreateAlbum)
+		albumApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:album:update"), handlerMap.AlbumHandler.UpdateAlbum)
+		albumApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:album:view"), handlerMap.AlbumHandler.QueryAlbum)
+		albumApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:album:delete"), handlerMap.AlbumHandler.DeleteAlbum)
 	}
 	albumPhotoApi := router.Group("/album-photo")
 	{
-		albumPhotoApi.Get("/list", handlerMap.AlbumPhotoHandler.ListAlbumPhoto)
-		albumPhotoApi.Get("/page", handlerMap.AlbumPhotoHandler.ListAlbumPhotoPage)
-		albumPhotoApi.Post("/create", handlerMap.AlbumPhotoHandler.CreateAlbumPhoto)
-		albumPhotoApi.Put("/update/:id", handlerMap.AlbumPhotoHandler.UpdateAlbumPhoto)
-		albumPhotoApi.Get("/query/:id", handlerMap.AlbumPhotoHandler.QueryAlbumPhoto)
-		albumPhotoApi.Delete("/delete/:id", handlerMap.AlbumPhotoHandler.DeleteAlbumPhoto)
+		albumPhotoApi.Get("/list", middleware.RequireScope("hoshikuzu:album:view"), handlerMap.AlbumPhotoHandler.ListAlbumPhoto)
+		albumPhotoApi.Get("/page", middleware.RequireScope("hoshikuzu:album:view"), handlerMap.AlbumPhotoHandler.ListAlbumPhotoPage)
+		albumPhotoApi.Post("/create", middleware.RequireScope("hoshikuzu:album:create"), handlerMap.AlbumPhotoHandler.CreateAlbumPhoto)
+		albumPhotoApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:album:update"), handlerMap.AlbumPhotoHandler.UpdateAlbumPhoto)
+		albumPhotoApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:album:view"), handlerMap.AlbumPhotoHandler.QueryAlbumPhoto)
+		albumPhotoApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:album:delete"), handlerMap.AlbumPhotoHandler.DeleteAlbumPhoto)
 	}
 	flinkApi := router.Group("/flink")
 	{
-		flinkApi.Get("/list", handlerMap.FlinkHandler.ListFlink)
-		flinkApi.Get("/page", handlerMap.FlinkHandler.ListFlinkPage)
-		flinkApi.Post("/create", handlerMap.FlinkHandler.CreateFlink)
-		flinkApi.Put("/update/:id", handlerMap.FlinkHandler.UpdateFlink)
-		flinkApi.Get("/query/:id", handlerMap.FlinkHandler.QueryFlink)
-		flinkApi.Delete("/delete/:id", handlerMap.FlinkHandler.DeleteFlink)
+		flinkApi.Get("/list", middleware.RequireScope("hoshikuzu:flink:view"), handlerMap.FlinkHandler.ListFlink)
+		flinkApi.Get("/page", middleware.RequireScope("hoshikuzu:flink:view"), handlerMap.FlinkHandler.ListFlinkPage)
+		flinkApi.Post("/create", middleware.RequireScope("hoshikuzu:flink:create"), handlerMap.FlinkHandler.CreateFlink)
+		flinkApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:flink:update"), handlerMap.FlinkHandler.UpdateFlink)
+		flinkApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:flink:view"), handlerMap.FlinkHandler.QueryFlink)
+		flinkApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:flink:delete"), handlerMap.FlinkHandler.DeleteFlink)
 	}
 	flinkGroupApi := router.Group("/flink-group")
 	{
-		flinkGroupApi.Get("/list", handlerMap.FlinkGroupHandler.ListFLinkGroup)
-		flinkGroupApi.Post("/create", handlerMap.FlinkGroupHandler.CreateFlinkGroup)
-		flinkGroupApi.Put("/update/:id", handlerMap.FlinkGroupHandler.UpdateFlinkGroup)
-		flinkGroupApi.Delete("/delete/:id", handlerMap.FlinkGroupHandler.DeleteFLinkGroup)
+		flinkGroupApi.Get("/list", middleware.RequireScope("hoshikuzu:flink:view"), handlerMap.FlinkGroupHandler.ListFLinkGroup)
+		flinkGroupApi.Post("/create", middleware.RequireScope("hoshikuzu:flink:create"), handlerMap.FlinkGroupHandler.CreateFlinkGroup)
+		flinkGroupApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:flink:update"), handlerMap.FlinkGroupHandler.UpdateFlinkGroup)
+		flinkGroupApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:flink:delete"), handlerMap.FlinkGroupHandler.DeleteFLinkGroup)
 	}
 	friendCircleRecordApi := router.Group("/friend-circle")
 	{
-		friendCircleRecordApi.Get("/page", handlerMap.FriendCircleHandler.ListFriendCircleRecordPage)
-		friendCircleRecordApi.Post("/create", handlerMap.FriendCircleHandler.CreateFriendCircleRecord)
-		friendCircleRecordApi.Put("/update/:id", handlerMap.FriendCircleHandler.UpdateFriendCircleRecord)
-		friendCircleRecordApi.Delete("/delete/:id", handlerMap.FriendCircleHandler.DeleteFriendCircleRecord)
+		friendCircleRecordApi.Get("/page", middleware.RequireScope("hoshikuzu:friend-circle:view"), handlerMap.FriendCircleHandler.ListFriendCircleRecordPage)
+		friendCircleRecordApi.Post("/create", middleware.RequireScope("hoshikuzu:friend-circle:create"), handlerMap.FriendCircleHandler.CreateFriendCircleRecord)
+		friendCircleRecordApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:friend-circle:update"), handlerMap.FriendCircleHandler.UpdateFriendCircleRecord)
+		friendCircleRecordApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:friend-circle:delete"), handlerMap.FriendCircleHandler.DeleteFriendCircleRecord)
 	}
 	essayApi := router.Group("/essay")
 	{
-		essayApi.Get("/list", handlerMap.EssayHandler.ListEssay)
-		essayApi.Get("/page", handlerMap.EssayHandler.GetEssayPage)
-		essayApi.Post("/create", handlerMap.EssayHandler.CreateEssay)
-		essayApi.Put("/update/:id", handlerMap.EssayHandler.UpdateEssay)
-		essayApi.Delete("/delete/:id", handlerMap.EssayHandler.DeleteEssay)
+		essayApi.Get("/list", middleware.RequireScope("hoshikuzu:essay:view"), handlerMap.EssayHandler.ListEssay)
+		essayApi.Get("/page", middleware.RequireScope("hoshikuzu:essay:view"), handlerMap.EssayHandler.GetEssayPage)
+		essayApi.Post("/create", middleware.RequireScope("hoshikuzu:essay:create"), handlerMap.EssayHandler.CreateEssay)
+		essayApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:essay:update"), handlerMap.EssayHandler.UpdateEssay)
+		essayApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:essay:delete"), handlerMap.EssayHandler.DeleteEssay)
 	}
 	postApi := router.Group("/post")
 	{
-		postApi.Get("/list", handlerMap.PostHandler.ListPost)
-		postApi.Get("/page", handlerMap.PostHandler.ListPostPage)
-		postApi.Post("/create", handlerMap.PostHandler.CreatePost)
-		postApi.Put("/update/content/:id", handlerMap.PostHandler.UpdatePostContent)
-		postApi.Put("/update/setting/:id", handlerMap.PostHandler.UpdatePostSetting)
-		postApi.Put("/publish/:id", handlerMap.PostHandler.PublishPost)
-		postApi.Put("/unpublish/:id", handlerMap.PostHandler.UnpublishPost)
-		postApi.Get("/query/:id", handlerMap.PostHandler.QueryPost)
-		postApi.Delete("/delete/:id", handlerMap.PostHandler.DeletePost)
+		postApi.Get("/list", middleware.RequireScope("hoshikuzu:post:view"), handlerMap.PostHandler.ListPost)
+		postApi.Get("/page", middleware.RequireScope("hoshikuzu:post:view"), handlerMap.PostHandler.ListPostPage)
+		postApi.Post("/create", middleware.RequireScope("hoshikuzu:post:create"), handlerMap.PostHandler.CreatePost)
+		postApi.Put("/update/content/:id", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.PostHandler.UpdatePostContent)
+		postApi.Put("/update/setting/:id", middleware.RequireScope("hoshikuzu:post:update"), handlerMap.PostHandler.UpdatePostSetting)
+		postApi.Put("/publish/:id", middleware.RequireScope("hoshikuzu:post:publish"), handlerMap.PostHandler.PublishPost)
+		postApi.Put("/unpublish/:id", middleware.RequireScope("hoshikuzu:post:publish"), handlerMap.PostHandler.UnpublishPost)
+		postApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:post:view"), handlerMap.PostHandler.QueryPost)
+		postApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:post:delete"), handlerMap.PostHandler.DeletePost)
 
 	}
 	categoryApi := router.Group("/category")
 	{
-		categoryApi.Get("/query/:id", handlerMap.CategoryHandler.QueryCategory)
-		categoryApi.Get("/list", handlerMap.CategoryHandler.QueryCategoryList)
-		categoryApi.Get("/page", handlerMap.CategoryHandler.QueryCategoryPage)
-		categoryApi.Post("/create", handlerMap.CategoryHandler.CreateCategory)
-		categoryApi.Put("/update/:id", handlerMap.CategoryHandler.UpdateCategory)
-		categoryApi.Delete("/delete/:id", handlerMap.CategoryHandler.DeleteCategory)
+		categoryApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:category:view"), handlerMap.CategoryHandler.QueryCategory)
+		categoryApi.Get("/list", middleware.RequireScope("hoshikuzu:category:view"), handlerMap.CategoryHandler.QueryCategoryList)
+		categoryApi.Get("/page", middleware.RequireScope("hoshikuzu:category:view"), handlerMap.CategoryHandler.QueryCategoryPage)
+		categoryApi.Post("/create", middleware.RequireScope("hoshikuzu:category:create"), handlerMap.CategoryHandler.CreateCategory)
+		categoryApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:category:update"), handlerMap.CategoryHandler.UpdateCategory)
+		categoryApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:category:delete"), handlerMap.CategoryHandler.DeleteCategory)
 	}
 	tagApi := router.Group("/tag")
 	{
-		tagApi.Get("/query/:id", handlerMap.TagHandler.QueryTag)
-		tagApi.Get("/list", handlerMap.TagHandler.QueryTagList)
-		tagApi.Get("/page", handlerMap.TagHandler.QueryTagPage)
-		tagApi.Post("/create", handlerMap.TagHandler.CreateTag)
-		tagApi.Put("/update/:id", handlerMap.TagHandler.UpdateTag)
-		tagApi.Delete("/delete/:id", handlerMap.TagHandler.DeleteTag)
+		tagApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:tag:view"), handlerMap.TagHandler.QueryTag)
+		tagApi.Get("/list", middleware.RequireScope("hoshikuzu:tag:view"), handlerMap.TagHandler.QueryTagList)
+		tagApi.Get("/page", middleware.RequireScope("hoshikuzu:tag:view"), handlerMap.TagHandler.QueryTagPage)
+		tagApi.Post("/create", middleware.RequireScope("hoshikuzu:tag:create"), handlerMap.TagHandler.CreateTag)
+		tagApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:tag:update"), handlerMap.TagHandler.UpdateTag)
+		tagApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:tag:delete"), handlerMap.TagHandler.DeleteTag)
 	}
 	flinkApplicationApi := router.Group("/flink-application")
 	{
-		flinkApplicationApi.Get("/page", handlerMap.FlinkApplicationHandler.ListFlinkApplicationPage)
-		flinkApplicationApi.Get("/query/:id", handlerMap.FlinkApplicationHandler.QueryFlinkApplication)
-		flinkApplicationApi.Put("/update/:id", handlerMap.FlinkApplicationHandler.ApproveFlinkApplication)
+		flinkApplicationApi.Get("/page", middleware.RequireScope("hoshikuzu:flink:view"), handlerMap.FlinkApplicationHandler.ListFlinkApplicationPage)
+		flinkApplicationApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:flink:view"), handlerMap.FlinkApplicationHandler.QueryFlinkApplication)
+		flinkApplicationApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:flink:update"), handlerMap.FlinkApplicationHandler.ApproveFlinkApplication)
 	}
 	menuApi := router.Group("/menu")
 	{
-		menuApi.Get("/query/:id", handlerMap.MenuHandler.QueryMenu)
-		menuApi.Get("/list", handlerMap.MenuHandler.QueryMenuList)
-		menuApi.Get("/page", handlerMap.MenuHandler.QueryMenuPage)
-		menuApi.Post("/create", handlerMap.MenuHandler.CreateMenu)
-		menuApi.Put("/update/:id", handlerMap.MenuHandler.UpdateMenu)
-		menuApi.Delete("/delete/:id", handlerMap.MenuHandler.DeleteMenu)
+		menuApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:menu:view"), handlerMap.MenuHandler.QueryMenu)
+		menuApi.Get("/list", middleware.RequireScope("hoshikuzu:menu:view"), handlerMap.MenuHandler.QueryMenuList)
+		menuApi.Get("/page", middleware.RequireScope("hoshikuzu:menu:view"), handlerMap.MenuHandler.QueryMenuPage)
+		menuApi.Post("/create", middleware.RequireScope("hoshikuzu:menu:create"), handlerMap.MenuHandler.CreateMenu)
+		menuApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:menu:update"), handlerMap.MenuHandler.UpdateMenu)
+		menuApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:menu:delete"), handlerMap.MenuHandler.DeleteMenu)
 	}
 }
 
@@ -180,73 +255,92 @@ func initContentRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 func initInfraRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 	storageStrategyApi := router.Group("/storage-strategy")
 	{
-		storageStrategyApi.Get("/page", handlerMap.StorageStrategyHandler.ListStorageStrategyPage)
-		storageStrategyApi.Get("/list", handlerMap.StorageStrategyHandler.ListStorageStrategy)
-		storageStrategyApi.Post("/create", handlerMap.StorageStrategyHandler.CreateStorageStrategy)
-		storageStrategyApi.Put("/update/:id", handlerMap.StorageStrategyHandler.UpdateStorageStrategy)
-		storageStrategyApi.Get("/query/:id", handlerMap.StorageStrategyHandler.QueryStorageStrategy)
-		storageStrategyApi.Delete("/delete/:id", handlerMap.StorageStrategyHandler.DeleteStorageStrategy)
-		storageStrategyApi.Put("/default/:id", handlerMap.StorageStrategyHandler.SetDefaultStorageStrategy)
+		storageStrategyApi.Get("/page", middleware.RequireScope("hoshikuzu:storage:view"), handlerMap.StorageStrategyHandler.ListStorageStrategyPage)
+		storageStrategyApi.Get("/list", middleware.RequireScope("hoshikuzu:storage:view"), handlerMap.StorageStrategyHandler.ListStorageStrategy)
+		storageStrategyApi.Post("/create", middleware.RequireScope("hoshikuzu:storage:create"), handlerMap.StorageStrategyHandler.CreateStorageStrategy)
+		storageStrategyApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:storage:update"), handlerMap.StorageStrategyHandler.UpdateStorageStrategy)
+		storageStrategyApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:storage:view"), handlerMap.StorageStrategyHandler.QueryStorageStrategy)
+		storageStrategyApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:storage:delete"), handlerMap.StorageStrategyHandler.DeleteStorageStrategy)
+		storageStrategyApi.Put("/default/:id", middleware.RequireScope("hoshikuzu:storage:update"), handlerMap.StorageStrategyHandler.SetDefaultStorageStrategy)
 	}
 	fileApi := router.Group("/file")
 	{
-		fileApi.Get("/list", handlerMap.FileHandler.ListFile)
-		fileApi.Get("/page", handlerMap.FileHandler.ListFilePage)
-		fileApi.Get("/query/:id", handlerMap.FileHandler.QueryFile)
-		fileApi.Delete("/delete/:id", handlerMap.FileHandler.DeleteFile)
-		fileApi.Post("/upload", handlerMap.FileHandler.Upload)
+		fileApi.Get("/list", middleware.RequireScope("hoshikuzu:file:view"), handlerMap.FileHandler.ListFile)
+		fileApi.Get("/page", middleware.RequireScope("hoshikuzu:file:view"), handlerMap.FileHandler.ListFilePage)
+		fileApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:file:view"), handlerMap.FileHandler.QueryFile)
+		fileApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:file:delete"), handlerMap.FileHandler.DeleteFile)
+		fileApi.Post("/upload", middleware.RequireScope("hoshikuzu:file:upload"), handlerMap.FileHandler.Upload)
 	}
 	scheduleJobApi := router.Group("/schedule-job")
 	{
-		scheduleJobApi.Post("/create", handlerMap.ScheduleJobHandler.CreateScheduleJob)
-		scheduleJobApi.Get("/page", handlerMap.ScheduleJobHandler.ListScheduleJobPage)
-		scheduleJobApi.Get("/query/:id", handlerMap.ScheduleJobHandler.QueryScheduleJob)
-		scheduleJobApi.Put("/update/:id", handlerMap.ScheduleJobHandler.UpdateScheduleJob)
-		scheduleJobApi.Delete("/delete/:id", handlerMap.ScheduleJobHandler.DeleteScheduleJob)
-		scheduleJobApi.Post("/execute/:id", handlerMap.ScheduleJobHandler.ExecuteScheduleJobNow)
+		scheduleJobApi.Post("/create", middleware.RequireScope("hoshikuzu:schedule-job:create"), handlerMap.ScheduleJobHandler.CreateScheduleJob)
+		scheduleJobApi.Get("/page", middleware.RequireScope("hoshikuzu:schedule-job:view"), handlerMap.ScheduleJobHandler.ListScheduleJobPage)
+		scheduleJobApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:schedule-job:view"), handlerMap.ScheduleJobHandler.QueryScheduleJob)
+		scheduleJobApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:schedule-job:update"), handlerMap.ScheduleJobHandler.UpdateScheduleJob)
+		scheduleJobApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:schedule-job:delete"), handlerMap.ScheduleJobHandler.DeleteScheduleJob)
+		scheduleJobApi.Post("/execute/:id", middleware.RequireScope("hoshikuzu:schedule-job:execute"), handlerMap.ScheduleJobHandler.ExecuteScheduleJobNow)
 	}
 	migrationApi := router.Group("/migration")
 	{
-		migrationApi.Post("/md", handlerMap.MigrationHandler.ImportMarkdown)
-		migrationApi.Post("/check-duplicate", handlerMap.MigrationHandler.CheckDuplicate)
+		migrationApi.Post("/md", middleware.RequireScope("hoshikuzu:migration:import"), handlerMap.MigrationHandler.ImportMarkdown)
+		migrationApi.Post("/check-duplicate", middleware.RequireScope("hoshikuzu:migration:import"), handlerMap.MigrationHandler.CheckDuplicate)
+	}
+	loginLogApi := router.Group("/login-log")
+	{
+		loginLogApi.Get("/page", middleware.RequireScope("hoshikuzu:login-log:view"), handlerMap.LoginLogHandler.ListLoginLogPage)
+	}
+	webHookApi := router.Group("/webhook")
+	{
+		webHookApi.Get("/events", middleware.RequireScope("hoshikuzu:webhook:view"), handlerMap.WebHookHandler.ListEvents)
+		webHookApi.Get("/page", middleware.RequireScope("hoshikuzu:webhook:view"), handlerMap.WebHookHandler.ListWebHookPage)
+		webHookApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:webhook:view"), handlerMap.WebHookHandler.QueryWebHook)
+		webHookApi.Post("/create", middleware.RequireScope("hoshikuzu:webhook:create"), handlerMap.WebHookHandler.CreateWebHook)
+		webHookApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:webhook:update"), handlerMap.WebHookHandler.UpdateWebHook)
+		webHookApi.Post("/reset-secret/:id", middleware.RequireScope("hoshikuzu:webhook:update"), handlerMap.WebHookHandler.ResetWebHookSecret)
+		webHookApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:webhook:delete"), handlerMap.WebHookHandler.DeleteWebHook)
+		webHookApi.Post("/test/:id", middleware.RequireScope("hoshikuzu:webhook:update"), handlerMap.WebHookHandler.SendTestEvent)
+		webHookApi.Get("/deliveries/:id", middleware.RequireScope("hoshikuzu:webhook:view"), handlerMap.WebHookHandler.ListDeliveryPage)
+		webHookApi.Post("/redeliver/:id", middleware.RequireScope("hoshikuzu:webhook:update"), handlerMap.WebHookHandler.Redeliver)
 	}
 	visitLogApi := router.Group("/visit-log")
 	{
-		visitLogApi.Get("/page", handlerMap.VisitHandler.ListVisitLogPage)
-		visitLogApi.Get("/query/:id", handlerMap.VisitHandler.QueryVisitLog)
-		visitLogApi.Delete("/delete/:id", handlerMap.VisitHandler.DeleteVisitLog)
-		visitLogApi.Post("/batch/delete", handlerMap.VisitHandler.BatchDeleteVisitLog)
+		visitLogApi.Get("/page", middleware.RequireScope("hoshikuzu:visit-log:view"), handlerMap.VisitHandler.ListVisitLogPage)
+		visitLogApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:visit-log:view"), handlerMap.VisitHandler.QueryVisitLog)
+		visitLogApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:visit-log:delete"), handlerMap.VisitHandler.DeleteVisitLog)
+		visitLogApi.Post("/batch/delete", middleware.RequireScope("hoshikuzu:visit-log:delete"), handlerMap.VisitHandler.BatchDeleteVisitLog)
 	}
 	pluginApi := router.Group("/plugin")
 	{
-		pluginApi.Post("/create", handlerMap.PluginHandler.CreatePlugin)
-		pluginApi.Get("/page", handlerMap.PluginHandler.ListPluginPage)
-		pluginApi.Get("/query/:id", handlerMap.PluginHandler.QueryPlugin)
-		pluginApi.Delete("/delete/:id", handlerMap.PluginHandler.DeletePlugin)
-		pluginApi.Post("/:id/start", handlerMap.PluginHandler.StartPlugin)
-		pluginApi.Post("/:id/stop", handlerMap.PluginHandler.StopPlugin)
-		pluginApi.Post("/:id/restart", handlerMap.PluginHandler.RestartPlugin)
-		pluginApi.Get("/:id/resource/*", handlerMap.PluginHandler.GetPluginResource)
-		pluginApi.Post("/:id/call", handlerMap.PluginHandler.CallPlugin)
+		pluginApi.Post("/create", middleware.RequireScope("hoshikuzu:plugin:create"), handlerMap.PluginHandler.CreatePlugin)
+		pluginApi.Get("/page", middleware.RequireScope("hoshikuzu:plugin:view"), handlerMap.PluginHandler.ListPluginPage)
+		pluginApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:plugin:view"), handlerMap.PluginHandler.QueryPlugin)
+		pluginApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:plugin:delete"), handlerMap.PluginHandler.DeletePlugin)
+		pluginApi.Post("/:id/start", middleware.RequireScope("hoshikuzu:plugin:manage"), handlerMap.PluginHandler.StartPlugin)
+		pluginApi.Post("/:id/stop", middleware.RequireScope("hoshikuzu:plugin:manage"), handlerMap.PluginHandler.StopPlugin)
+		pluginApi.Post("/:id/restart", middleware.RequireScope("hoshikuzu:plugin:manage"), handlerMap.PluginHandler.RestartPlugin)
+		pluginApi.Get("/:id/resource/*", middleware.RequireScope("hoshikuzu:plugin:view"), handlerMap.PluginHandler.GetPluginResource)
+		pluginApi.Post("/:id/call", middleware.RequireScope("hoshikuzu:plugin:manage"), handlerMap.PluginHandler.CallPlugin)
 	}
 	themeApi := router.Group("/theme")
 	{
-		themeApi.Post("/upload", handlerMap.ThemeHandler.UploadThemeFile)
-		themeApi.Post("/create", handlerMap.ThemeHandler.CreateTheme)
-		themeApi.Get("/page", handlerMap.ThemeHandler.ListThemePage)
-		themeApi.Get("/query/:id", handlerMap.ThemeHandler.QueryTheme)
-		themeApi.Delete("/delete/:id", handlerMap.ThemeHandler.DeleteTheme)
-		themeApi.Post("/:id/enable", handlerMap.ThemeHandler.EnableTheme)
-		themeApi.Post("/:id/disable", handlerMap.ThemeHandler.DisableTheme)
+		themeApi.Post("/upload", middleware.RequireScope("hoshikuzu:theme:create"), handlerMap.ThemeHandler.UploadThemeFile)
+		themeApi.Post("/create", middleware.RequireScope("hoshikuzu:theme:create"), handlerMap.ThemeHandler.CreateTheme)
+		themeApi.Get("/page", middleware.RequireScope("hoshikuzu:theme:view"), handlerMap.ThemeHandler.ListThemePage)
+		themeApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:theme:view"), handlerMap.ThemeHandler.QueryTheme)
+		themeApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:theme:delete"), handlerMap.ThemeHandler.DeleteTheme)
+		themeApi.Post("/:id/enable", middleware.RequireScope("hoshikuzu:theme:update"), handlerMap.ThemeHandler.EnableTheme)
+		themeApi.Post("/:id/disable", middleware.RequireScope("hoshikuzu:theme:update"), handlerMap.ThemeHandler.DisableTheme)
+		themeApi.Get("/:id/config", middleware.RequireScope("hoshikuzu:theme:view"), handlerMap.ThemeHandler.GetThemeConfig)
+		themeApi.Put("/:id/config", middleware.RequireScope("hoshikuzu:theme:update"), handlerMap.ThemeHandler.SaveThemeConfig)
 	}
 	licenseApi := router.Group("/license")
 	{
-		licenseApi.Get("/page", handlerMap.LicenseHandler.ListLicensePage)
-		licenseApi.Get("/query/:id", handlerMap.LicenseHandler.QueryLicense)
-		licenseApi.Post("/create", handlerMap.LicenseHandler.CreateLicense)
-		licenseApi.Put("/update/:id", handlerMap.LicenseHandler.UpdateLicense)
-		licenseApi.Delete("/delete/:id", handlerMap.LicenseHandler.DeleteLicense)
-		licenseApi.Post("/verify", handlerMap.LicenseHandler.VerifyLicense)
+		licenseApi.Get("/page", middleware.RequireScope("hoshikuzu:license:view"), handlerMap.LicenseHandler.ListLicensePage)
+		licenseApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:license:view"), handlerMap.LicenseHandler.QueryLicense)
+		licenseApi.Post("/create", middleware.RequireScope("hoshikuzu:license:create"), handlerMap.LicenseHandler.CreateLicense)
+		licenseApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:license:update"), handlerMap.LicenseHandler.UpdateLicense)
+		licenseApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:license:delete"), handlerMap.LicenseHandler.DeleteLicense)
+		licenseApi.Post("/verify", middleware.RequireScope("hoshikuzu:license:view"), handlerMap.LicenseHandler.VerifyLicense)
 	}
 }
 
@@ -254,84 +348,102 @@ func initInfraRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 func initMallRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 	productApi := router.Group("/product")
 	{
-		productApi.Get("/list", handlerMap.ProductHandler.ListProducts).Name("productList")
-		productApi.Get("/page", handlerMap.ProductHandler.ListProductsPage).Name("productPage")
-		productApi.Post("/create", handlerMap.ProductHandler.CreateProduct).Name("productCreate")
-		productApi.Put("/update/:id", handlerMap.ProductHandler.UpdateProduct).Name("productUpdate")
-		productApi.Get("/query/:id", handlerMap.ProductHandler.QueryProduct).Name("productQuery")
-		productApi.Delete("/delete/:id", handlerMap.ProductHandler.DeleteProduct).Name("productDelete")
-		productApi.Put("/batch", handlerMap.ProductHandler.BatchUpdateProducts).Name("productBatchUpdate")
-		productApi.Post("/batch/delete", handlerMap.ProductHandler.BatchDeleteProducts).Name("productBatchDelete")
+		productApi.Get("/list", middleware.RequireScope("hoshikuzu:product:view"), handlerMap.ProductHandler.ListProducts).Name("productList")
+		productApi.Get("/page", middleware.RequireScope("hoshikuzu:product:view"), handlerMap.ProductHandler.ListProductsPage).Name("productPage")
+		productApi.Post("/create", middleware.RequireScope("hoshikuzu:product:create"), handlerMap.ProductHandler.CreateProduct).Name("productCreate")
+		productApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:product:update"), handlerMap.ProductHandler.UpdateProduct).Name("productUpdate")
+		productApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:product:view"), handlerMap.ProductHandler.QueryProduct).Name("productQuery")
+		productApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:product:delete"), handlerMap.ProductHandler.DeleteProduct).Name("productDelete")
+		productApi.Put("/batch", middleware.RequireScope("hoshikuzu:product:update"), handlerMap.ProductHandler.BatchUpdateProducts).Name("productBatchUpdate")
+		productApi.Post("/batch/delete", middleware.RequireScope("hoshikuzu:product:delete"), handlerMap.ProductHandler.BatchDeleteProducts).Name("productBatchDelete")
+		productApi.Get("/:id/deliverables", middleware.RequireScope("hoshikuzu:product:view"), handlerMap.DigitalHandler.ListDeliverables).Name("productDeliverableList")
+		productApi.Post("/:id/deliverables", middleware.RequireScope("hoshikuzu:product:update"), handlerMap.DigitalHandler.CreateDeliverable).Name("productDeliverableCreate")
+		productApi.Delete("/deliverable/delete/:id", middleware.RequireScope("hoshikuzu:product:update"), handlerMap.DigitalHandler.DeleteDeliverable).Name("productDeliverableDelete")
+		productApi.Get("/:id/keys", middleware.RequireScope("hoshikuzu:product:view"), handlerMap.DigitalHandler.ListKeysPage).Name("productKeyPage")
+		productApi.Post("/:id/keys", middleware.RequireScope("hoshikuzu:product:update"), handlerMap.DigitalHandler.ImportKeys).Name("productKeyImport")
+		productApi.Delete("/key/delete/:id", middleware.RequireScope("hoshikuzu:product:update"), handlerMap.DigitalHandler.DeleteKey).Name("productKeyDelete")
 	}
 	memberApi := router.Group("/member")
 	{
-		memberApi.Get("/query/:user_id", handlerMap.MemberHandler.QueryMember).Name("memberQuery")
-		memberApi.Get("/page", handlerMap.MemberHandler.QueryMemberPage).Name("memberPage")
-		memberApi.Post("/create", handlerMap.MemberHandler.CreateMember).Name("memberCreate")
-		memberApi.Put("/update/:id", handlerMap.MemberHandler.UpdateMember).Name("memberUpdate")
-		memberApi.Delete("/delete/:id", handlerMap.MemberHandler.DeleteMember).Name("memberDelete")
+		memberApi.Get("/query/:user_id", middleware.RequireScope("hoshikuzu:member:view"), handlerMap.MemberHandler.QueryMember).Name("memberQuery")
+		memberApi.Get("/page", middleware.RequireScope("hoshikuzu:member:view"), handlerMap.MemberHandler.QueryMemberPage).Name("memberPage")
+		memberApi.Post("/create", middleware.RequireScope("hoshikuzu:member:create"), handlerMap.MemberHandler.CreateMember).Name("memberCreate")
+		memberApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:member:update"), handlerMap.MemberHandler.UpdateMember).Name("memberUpdate")
+		memberApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:member:delete"), handlerMap.MemberHandler.DeleteMember).Name("memberDelete")
 	}
 	memberLevelApi := router.Group("/member-level")
 	{
-		memberLevelApi.Get("/query/:id", handlerMap.MemberLevelHandler.QueryMemberLevel).Name("memberLevelQuery")
-		memberLevelApi.Get("/list", handlerMap.MemberLevelHandler.QueryMemberLevelList).Name("memberLevelList")
-		memberLevelApi.Get("/page", handlerMap.MemberLevelHandler.QueryMemberLevelPage).Name("memberLevelPage")
-		memberLevelApi.Post("/create", handlerMap.MemberLevelHandler.CreateMemberLevel).Name("memberLevelCreate")
-		memberLevelApi.Put("/update/:id", handlerMap.MemberLevelHandler.UpdateMemberLevel).Name("memberLevelUpdate")
-		memberLevelApi.Delete("/delete/:id", handlerMap.MemberLevelHandler.DeleteMemberLevel).Name("memberLevelDelete")
+		memberLevelApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:member-level:view"), handlerMap.MemberLevelHandler.QueryMemberLevel).Name("memberLevelQuery")
+		memberLevelApi.Get("/list", middleware.RequireScope("hoshikuzu:member-level:view"), handlerMap.MemberLevelHandler.QueryMemberLevelList).Name("memberLevelList")
+		memberLevelApi.Get("/page", middleware.RequireScope("hoshikuzu:member-level:view"), handlerMap.MemberLevelHandler.QueryMemberLevelPage).Name("memberLevelPage")
+		memberLevelApi.Post("/create", middleware.RequireScope("hoshikuzu:member-level:create"), handlerMap.MemberLevelHandler.CreateMemberLevel).Name("memberLevelCreate")
+		memberLevelApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:member-level:update"), handlerMap.MemberLevelHandler.UpdateMemberLevel).Name("memberLevelUpdate")
+		memberLevelApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:member-level:delete"), handlerMap.MemberLevelHandler.DeleteMemberLevel).Name("memberLevelDelete")
 	}
 	payOrderApi := router.Group("/pay-order")
 	{
-		payOrderApi.Get("/page", handlerMap.PayOrderHandler.ListPayOrderPage).Name("payOrderPage")
-		payOrderApi.Put("/update/:id", handlerMap.PayOrderHandler.UpdatePayOrder).Name("payOrderUpdate")
-		payOrderApi.Get("/query/:id", handlerMap.PayOrderHandler.QueryPayOrder).Name("payOrderQuery")
-		payOrderApi.Delete("/delete/:id", handlerMap.PayOrderHandler.DeletePayOrder).Name("payOrderDelete")
-		payOrderApi.Post("/submit", handlerMap.PayOrderHandler.SubmitPayOrder).Name("payOrderSubmit")
-		payOrderApi.Post("/recharge", handlerMap.PayOrderHandler.RechargePayOrder).Name("payOrderRecharge")
-		payOrderApi.Get("/pay-methods", handlerMap.PayOrderHandler.GetPayMethods).Name("payOrderPayMethods")
-		payOrderApi.Post("/refund/:id", handlerMap.PayOrderHandler.RefundPayOrder).Name("payOrderRefund")
-		payOrderApi.Get("/status/:id", handlerMap.PayOrderHandler.QueryOrderStatus).Name("payOrderStatus")
-		payOrderApi.Get("/today-stats", handlerMap.PayOrderHandler.GetTodayStats).Name("payOrderTodayStats")
+		payOrderApi.Get("/page", middleware.RequireScope("hoshikuzu:pay-order:view"), handlerMap.PayOrderHandler.ListPayOrderPage).Name("payOrderPage")
+		payOrderApi.Get("/my", middleware.RequireScope("hoshikuzu:shop:order"), handlerMap.PayOrderHandler.ListMyOrders).Name("payOrderMy")
+		payOrderApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:pay-order:update"), handlerMap.PayOrderHandler.UpdatePayOrder).Name("payOrderUpdate")
+		payOrderApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:pay-order:view"), handlerMap.PayOrderHandler.QueryPayOrder).Name("payOrderQuery")
+		payOrderApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:pay-order:delete"), handlerMap.PayOrderHandler.DeletePayOrder).Name("payOrderDelete")
+		payOrderApi.Post("/submit", middleware.RequireScope("hoshikuzu:shop:order"), handlerMap.PayOrderHandler.SubmitPayOrder).Name("payOrderSubmit")
+		payOrderApi.Post("/quote", middleware.RequireScope("hoshikuzu:shop:order"), handlerMap.PayOrderHandler.QuotePayOrder).Name("payOrderQuote")
+		payOrderApi.Post("/recharge", middleware.RequireScope("hoshikuzu:shop:order"), handlerMap.PayOrderHandler.RechargePayOrder).Name("payOrderRecharge")
+		payOrderApi.Get("/pay-methods", middleware.RequireScope("hoshikuzu:shop:order"), handlerMap.PayOrderHandler.GetPayMethods).Name("payOrderPayMethods")
+		payOrderApi.Post("/refund/:id", middleware.RequireScope("hoshikuzu:pay-order:refund"), handlerMap.PayOrderHandler.RefundPayOrder).Name("payOrderRefund")
+		payOrderApi.Get("/status/:id", middleware.RequireScope("hoshikuzu:shop:order"), handlerMap.PayOrderHandler.QueryOrderStatus).Name("payOrderStatus")
+		payOrderApi.Get("/today-stats", middleware.RequireScope("hoshikuzu:pay-order:view"), handlerMap.PayOrderHandler.GetTodayStats).Name("payOrderTodayStats")
+	}
+	cartApi := router.Group("/cart")
+	{
+		router.Get("/cart", middleware.RequireScope("hoshikuzu:shop:cart"), handlerMap.CartHandler.GetCart).Name("cartQuery")
+		cartApi.Post("/add", middleware.RequireScope("hoshikuzu:shop:cart"), handlerMap.CartHandler.AddItem).Name("cartAdd")
+		cartApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:shop:cart"), handlerMap.CartHandler.UpdateItem).Name("cartUpdate")
+		cartApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:shop:cart"), handlerMap.CartHandler.RemoveItem).Name("cartDelete")
+		cartApi.Delete("/clear", middleware.RequireScope("hoshikuzu:shop:cart"), handlerMap.CartHandler.ClearCart).Name("cartClear")
 	}
 
 	walletApi := router.Group("/wallet")
 	{
-		walletApi.Get("/query/:user_id", handlerMap.WalletHandler.QueryWallet).Name("walletQuery")
-		walletApi.Get("/page", handlerMap.WalletHandler.QueryWalletPage).Name("walletPage")
-		walletApi.Put("/update/:id", handlerMap.WalletHandler.UpdateWallet).Name("walletUpdate")
+		walletApi.Get("/query/:user_id", middleware.RequireScope("hoshikuzu:wallet:view"), handlerMap.WalletHandler.QueryWallet).Name("walletQuery")
+		walletApi.Get("/page", middleware.RequireScope("hoshikuzu:wallet:view"), handlerMap.WalletHandler.QueryWalletPage).Name("walletPage")
+		walletApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:wallet:update"), handlerMap.WalletHandler.UpdateWallet).Name("walletUpdate")
 	}
 	couponApi := router.Group("/coupon")
 	{
-		couponApi.Get("/list", handlerMap.CouponHandler.ListCoupons).Name("couponList")
-		couponApi.Get("/page", handlerMap.CouponHandler.ListCouponsPage).Name("couponPage")
-		couponApi.Post("/create", handlerMap.CouponHandler.CreateCoupon).Name("couponCreate")
-		couponApi.Put("/update/:id", handlerMap.CouponHandler.UpdateCoupon).Name("couponUpdate")
-		couponApi.Get("/query/:id", handlerMap.CouponHandler.QueryCoupon).Name("couponQuery")
-		couponApi.Delete("/delete/:id", handlerMap.CouponHandler.DeleteCoupon).Name("couponDelete")
-		couponApi.Put("/batch", handlerMap.CouponHandler.BatchUpdateCoupons).Name("couponBatchUpdate")
-		couponApi.Post("/batch/delete", handlerMap.CouponHandler.BatchDeleteCoupons).Name("couponBatchDelete")
-		couponApi.Get("/search", handlerMap.CouponHandler.SearchCoupons).Name("couponSearch")
+		couponApi.Get("/list", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponHandler.ListCoupons).Name("couponList")
+		couponApi.Get("/page", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponHandler.ListCouponsPage).Name("couponPage")
+		couponApi.Post("/create", middleware.RequireScope("hoshikuzu:coupon:create"), handlerMap.CouponHandler.CreateCoupon).Name("couponCreate")
+		couponApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:coupon:update"), handlerMap.CouponHandler.UpdateCoupon).Name("couponUpdate")
+		couponApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponHandler.QueryCoupon).Name("couponQuery")
+		couponApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:coupon:delete"), handlerMap.CouponHandler.DeleteCoupon).Name("couponDelete")
+		couponApi.Put("/batch", middleware.RequireScope("hoshikuzu:coupon:update"), handlerMap.CouponHandler.BatchUpdateCoupons).Name("couponBatchUpdate")
+		couponApi.Post("/batch/delete", middleware.RequireScope("hoshikuzu:coupon:delete"), handlerMap.CouponHandler.BatchDeleteCoupons).Name("couponBatchDelete")
+		couponApi.Get("/search", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponHandler.SearchCoupons).Name("couponSearch")
 	}
 	couponUsageApi := router.Group("/coupon-usage")
 	{
-		couponUsageApi.Get("/list", handlerMap.CouponUsageHandler.ListCouponUsages).Name("couponUsageList")
-		couponUsageApi.Get("/page", handlerMap.CouponUsageHandler.ListCouponUsagesPage).Name("couponUsagePage")
-		couponUsageApi.Post("/create", handlerMap.CouponUsageHandler.CreateCouponUsage).Name("couponUsageCreate")
-		couponUsageApi.Put("/update/:id", handlerMap.CouponUsageHandler.UpdateCouponUsage).Name("couponUsageUpdate")
-		couponUsageApi.Get("/query/:id", handlerMap.CouponUsageHandler.QueryCouponUsage).Name("couponUsageQuery")
-		couponUsageApi.Delete("/delete/:id", handlerMap.CouponUsageHandler.DeleteCouponUsage).Name("couponUsageDelete")
-		couponUsageApi.Put("/batch", handlerMap.CouponUsageHandler.BatchUpdateCouponUsages).Name("couponUsageBatchUpdate")
-		couponUsageApi.Post("/batch/delete", handlerMap.CouponUsageHandler.BatchDeleteCouponUsages).Name("couponUsageBatchDelete")
-		couponUsageApi.Get("/search", handlerMap.CouponUsageHandler.SearchCouponUsages).Name("couponUsageSearch")
+		couponUsageApi.Get("/list", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponUsageHandler.ListCouponUsages).Name("couponUsageList")
+		couponUsageApi.Get("/page", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponUsageHandler.ListCouponUsagesPage).Name("couponUsagePage")
+		couponUsageApi.Post("/create", middleware.RequireScope("hoshikuzu:coupon:create"), handlerMap.CouponUsageHandler.CreateCouponUsage).Name("couponUsageCreate")
+		couponUsageApi.Put("/update/:id", middleware.RequireScope("hoshikuzu:coupon:update"), handlerMap.CouponUsageHandler.UpdateCouponUsage).Name("couponUsageUpdate")
+		couponUsageApi.Get("/query/:id", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponUsageHandler.QueryCouponUsage).Name("couponUsageQuery")
+		couponUsageApi.Delete("/delete/:id", middleware.RequireScope("hoshikuzu:coupon:delete"), handlerMap.CouponUsageHandler.DeleteCouponUsage).Name("couponUsageDelete")
+		couponUsageApi.Put("/batch", middleware.RequireScope("hoshikuzu:coupon:update"), handlerMap.CouponUsageHandler.BatchUpdateCouponUsages).Name("couponUsageBatchUpdate")
+		couponUsageApi.Post("/batch/delete", middleware.RequireScope("hoshikuzu:coupon:delete"), handlerMap.CouponUsageHandler.BatchDeleteCouponUsages).Name("couponUsageBatchDelete")
+		couponUsageApi.Get("/search", middleware.RequireScope("hoshikuzu:coupon:view"), handlerMap.CouponUsageHandler.SearchCouponUsages).Name("couponUsageSearch")
 	}
 }
 
-// 公开路由无需认证
-func initPublicRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
-	publicApi := router.Group("/public")
+// 公开路由无需认证，携带令牌时识别当前用户
+func initPublicRouter(router fiber.Router, handlerMap handlers.HandlerMap, dbClient *ent.Client) {
+	publicApi := router.Group("/public", middleware.OptionalAuth(dbClient))
 	{
 		// 访问统计接口
 		publicApi.Post("/visit", handlerMap.PublicHandler.HandleVisitor)
+		// 数字商品下载，凭订单中的签名链接访问
+		publicApi.Get("/digital/download/:id", handlerMap.DigitalHandler.Download)
 		// twikoo 接口
 		publicApi.All("/twikoo", handlerMap.PublicHandler.HandleTwikoo)
 		// 最近评论接口
@@ -386,6 +498,10 @@ func initPublicRouter(router fiber.Router, handlerMap handlers.HandlerMap) {
 		publicApi.Get("/flink/random", handlerMap.PublicHandler.RandomFlink)
 		// 文章摘要接口
 		publicApi.Get("/post/:id/summary/stream", handlerMap.PublicHandler.GetSummaryForStream)
+		// 站点问答配置接口
+		publicApi.Get("/ai/ask/config", handlerMap.AskHandler.Config)
+		// 站点问答接口，服务内另有按 IP 的频率限制与每日令牌预算
+		publicApi.Post("/ai/ask", middleware.RateLimit(20, time.Minute), handlerMap.AskHandler.Ask)
 		// 商品搜索接口
 		publicApi.Get("/product/search", handlerMap.PublicHandler.SearchProducts)
 		// 友链申请接口
@@ -407,39 +523,68 @@ func Initialize(router *fiber.App, handlerMap handlers.HandlerMap, dbClient *ent
 	auth := router.Group("/api/auth")
 	{
 		auth.Post("/login/password", handlerMap.AuthHandler.Login)
+		auth.Post("/login/two-factor", middleware.RateLimit(10, 5*time.Minute), handlerMap.AuthHandler.LoginTwoFactor)
+		auth.Post("/login/two-factor/setup", middleware.RateLimit(10, 5*time.Minute), handlerMap.AuthHandler.TwoFactorSetup)
 		auth.Post("/refresh-token", handlerMap.AuthHandler.RefreshToken)
 		auth.Post("/logout", handlerMap.AuthHandler.Logout)
+		// 注册与找回密码接口会发送邮件，按 IP 限制请求频率
+		auth.Post("/register", middleware.RateLimit(5, time.Hour), handlerMap.AccountHandler.Register)
+		auth.Post("/email/verify", middleware.RateLimit(20, 10*time.Minute), handlerMap.AccountHandler.VerifyEmail)
+		auth.Post("/email/resend", middleware.RateLimit(5, 10*time.Minute), handlerMap.AccountHandler.ResendVerification)
+		auth.Post("/password/forgot", middleware.RateLimit(5, 10*time.Minute), handlerMap.AccountHandler.ForgotPassword)
+		auth.Post("/password/reset", middleware.RateLimit(10, 10*time.Minute), handlerMap.AccountHandler.ResetPassword)
+		auth.Get("/social/providers", handlerMap.SocialHandler.ListProviders)
+		auth.Post("/social/login", handlerMap.SocialHandler.Login)
+		auth.Post("/social/:provider/authorize", handlerMap.SocialHandler.Authorize)
+	}
+
+	// OAuth2/OIDC 协议端点，客户端凭据或访问令牌由各端点自行校验
+	router.Get("/.well-known/openid-configuration", handlerMap.OAuth2Handler.Discovery)
+	oauth2 := router.Group("/api/oauth2")
+	{
+		oauth2.Get("/authorize", handlerMap.OAuth2Handler.Authorize)
+		oauth2.Post("/token", handlerMap.OAuth2Handler.Token)
+		oauth2.Post("/revoke", handlerMap.OAuth2Handler.Revoke)
+		oauth2.Post("/introspect", handlerMap.OAuth2Handler.Introspect)
+		oauth2.Get("/userinfo", handlerMap.OAuth2Handler.UserInfo)
+		oauth2.Post("/userinfo", handlerMap.OAuth2Handler.UserInfo)
+		oauth2.Get("/jwks", handlerMap.OAuth2Handler.JWKS)
 	}
 
 	api := router.Group("/api")
 
 	{
 		apiV1 := api.Group("/v1")
-		initPublicRouter(apiV1, handlerMap)
+		initPublicRouter(apiV1, handlerMap, dbClient)
 		{
 
 			// 路由列表接口
 			apiV1.Get("/routes", handlerMap.RouteHandler.GetRoutes)
 			apiV1.Get("/settings", handlerMap.SettingHandler.GetSettings)
 
-			// 支付回调（公开，易支付服务器不带认证）
+			// 支付回调（公开，支付网关服务器不带认证）
 			apiV1.Post("/pay-order/notify", handlerMap.PayOrderHandler.NotifyPayOrder)
 			apiV1.Get("/pay-order/notify", handlerMap.PayOrderHandler.NotifyPayOrder)
+			apiV1.Post("/pay-order/notify/:gateway", handlerMap.PayOrderHandler.NotifyPayOrder)
+			apiV1.Get("/pay-order/notify/:gateway", handlerMap.PayOrderHandler.NotifyPayOrder)
 
 			// 模拟支付（公开，仅测试环境开启 mock 开关后使用）
 			apiV1.Get("/pay-order/mock-pay/:id", handlerMap.PayOrderHandler.MockPayPage)
 			apiV1.Post("/pay-order/mock-pay/:id/success", handlerMap.PayOrderHandler.MockPaySuccess)
 			apiV1.Post("/pay-order/mock-pay/:id/fail", handlerMap.PayOrderHandler.MockPayFail)
 
+			// 以下接口需要登录，且每个路由通过 RequireScope 声明所需权限
 			apiV1.Use(middleware.FlexibleAuth(dbClient))
 			initAIRouter(apiV1, handlerMap)
 
 			// 首页统计信息接口
-			apiV1.Get("/common/statistic", handlerMap.CommonHandler.GetHomeStatistics)
+			apiV1.Get("/common/statistic", middleware.RequireScope("hoshikuzu:dashboard:view"), handlerMap.CommonHandler.GetHomeStatistics)
 
-			apiV1.Get("/user/personal-access-token/list", handlerMap.UserHandler.GetPersonalAccessTokenList)
-			apiV1.Get("/user/personal-access-token/query/:id", handlerMap.UserHandler.GetPersonalAccessToken)
-			apiV1.Post("/user/personal-access-token/create", handlerMap.UserHandler.CreatePat)
+			apiV1.Get("/user/personal-access-token/list", middleware.RequireScope("hoshikuzu:account:token"), handlerMap.UserHandler.GetPersonalAccessTokenList)
+			apiV1.Get("/user/personal-access-token/query/:id", middleware.RequireScope("hoshikuzu:account:token"), handlerMap.UserHandler.GetPersonalAccessToken)
+			apiV1.Post("/user/personal-access-token/create", middleware.RequireScope("hoshikuzu:account:token"), handlerMap.UserHandler.CreatePat)
+			apiV1.Get("/user/personal-access-token/scopes", middleware.RequireScope("hoshikuzu:account:token"), handlerMap.PermissionHandler.ListGrantedPermissions)
+			apiV1.Delete("/user/personal-access-token/delete/:id", middleware.RequireScope("hoshikuzu:account:token"), handlerMap.UserHandler.DeletePat)
 
 			initContentRouter(apiV1, handlerMap)
 			initInfraRouter(apiV1, handlerMap)
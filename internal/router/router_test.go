@@ -0,0 +1,54 @@
+package router
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/internal/handlers"
+	"github.com/shuTwT/hoshikuzu/internal/middleware"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// publicPrefixes 无需登录的 /api/v1 路由
+var publicPrefixes = []string{
+	"/api/v1/public/",
+	"/api/v1/routes",
+	"/api/v1/settings",
+	"/api/v1/pay-order/notify",
+	"/api/v1/pay-order/mock-pay/",
+}
+
+func TestRoutesDeclareScope(t *testing.T) {
+	app := fiber.New()
+	Initialize(app, handlers.HandlerMap{}, nil)
+
+	guard := reflect.ValueOf(middleware.RequireScope("hoshikuzu:post:view")).Pointer()
+	for _, route := range app.GetRoutes(true) {
+		if !strings.HasPrefix(route.Path, "/api/v1/") || isPublic(route.Path) {
+			continue
+		}
+		if len(route.Handlers) < 2 || reflect.ValueOf(route.Handlers[0]).Pointer() != guard {
+			t.Errorf("%s %s 未声明所需权限", route.Method, route.Path)
+		}
+	}
+
+	permission.NewPermissionServiceImpl(nil).LoadPermissionsFromDef(os.DirFS("../.."))
+	for _, scope := range middleware.DeclaredScopes() {
+		if !permission.IsDefined(scope) {
+			t.Errorf("权限 %s 未在 assets/moduleDefs 中定义", scope)
+		}
+	}
+}
+
+func isPublic(path string) bool {
+	for _, prefix := range publicPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,365 @@
+package ask
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	knowledge_service "github.com/shuTwT/hoshikuzu/internal/services/ai/knowledge"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	"github.com/shuTwT/hoshikuzu/pkg/cache"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+const (
+	rateKeyPrefix = "ai:ask:ip:"
+	rateWindow    = time.Hour
+	// reserveKeyPrefix counts the tokens held by questions still being
+	// answered, per day for the whole site and per visitor.
+	reserveKeyPrefix = "ai:ask:reserved:"
+	reserveWindow    = 24 * time.Hour
+
+	defaultMaxQuestionLength = 300
+	defaultIPHourlyLimit     = 10
+	defaultIPDailyTokens     = 20000
+	defaultDailyTokens       = 200000
+	defaultMaxAnswerTokens   = 800
+	defaultTopK              = 5
+	maxErrorRunes            = 1024
+)
+
+var (
+	ErrAskDisabled        = errors.New("站点问答未开启")
+	ErrInvalidQuestion    = errors.New("问题不能为空或过长")
+	ErrAskRateLimited     = errors.New("提问过于频繁，请稍后再试")
+	ErrAskBudgetExhausted = errors.New("今日问答额度已用完，请明天再来")
+)
+
+// askSettings mirrors the JSON stored under key 'ai_ask'. Zero limits and
+// budgets mean unlimited.
+type askSettings struct {
+	Enabled           bool `json:"enabled"`
+	MaxQuestionLength int  `json:"maxQuestionLength"`
+	IPHourlyLimit     int  `json:"ipHourlyLimit"`
+	IPDailyTokens     int  `json:"ipDailyTokens"`
+	DailyTokens       int  `json:"dailyTokens"`
+	MaxAnswerTokens   int  `json:"maxAnswerTokens"`
+	TopK              int  `json:"topK"`
+}
+
+func defaultSettings() *askSettings {
+	return &askSettings{
+		MaxQuestionLength: defaultMaxQuestionLength,
+		IPHourlyLimit:     defaultIPHourlyLimit,
+		IPDailyTokens:     defaultIPDailyTokens,
+		DailyTokens:       defaultDailyTokens,
+		MaxAnswerTokens:   defaultMaxAnswerTokens,
+		TopK:              defaultTopK,
+	}
+}
+
+// Question is a visitor question that passed the limits and has its context
+// retrieved, ready to be answered.
+type Question struct {
+	Text      string
+	IP        string
+	UserAgent string
+	chunks    []model.AIKnowledgeChunk
+	settings  *askSettings
+	// reserved tokens are held under reservedKeys until the answer is logged.
+	reserved     int64
+	reservedKeys []string
+}
+
+// AskService answers anonymous visitor questions from published posts.
+type AskService interface {
+	Config(ctx context.Context) (*model.AIAskConfigResp, error)
+	// Prepare checks the settings, rate limit and budgets and retrieves the
+	// posts relevant to the question. It is separate from Answer so that
+	// rejections can be reported before the response starts streaming.
+	Prepare(ctx context.Context, text, ip, userAgent string) (*Question, error)
+	// Answer streams the answer and logs the question with its token usage.
+	Answer(ctx context.Context, q *Question, onDelta func(string) error) (*model.AIAnswerResult, error)
+	Usage(ctx context.Context) (*model.AIAskUsageResp, error)
+	QueryLogPage(ctx context.Context, req model.AIAskLogPageQuery) ([]*ent.AIAskLog, int, error)
+	DeleteLogs(ctx context.Context, ids []int) (int, error)
+}
+
+type AskServiceImpl struct {
+	client           *ent.Client
+	settingService   setting_service.SettingService
+	aiService        ai_service.AIService
+	knowledgeService knowledge_service.KnowledgeService
+	counter          cache.Counter
+	now              func() time.Time
+}
+
+func NewAskServiceImpl(client *ent.Client, settingService setting_service.SettingService, aiService ai_service.AIService, knowledgeService knowledge_service.KnowledgeService, counter cache.Counter) *AskServiceImpl {
+	return &AskServiceImpl{
+		client:           client,
+		settingService:   settingService,
+		aiService:        aiService,
+		knowledgeService: knowledgeService,
+		counter:          counter,
+		now:              time.Now,
+	}
+}
+
+func (s *AskServiceImpl) Config(ctx context.Context) (*model.AIAskConfigResp, error) {
+	settings, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AIAskConfigResp{
+		Enabled:           settings.Enabled,
+		MaxQuestionLength: settings.MaxQuestionLength,
+	}, nil
+}
+
+func (s *AskServiceImpl) Prepare(ctx context.Context, text, ip, userAgent string) (*Question, error) {
+	settings, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !settings.Enabled {
+		return nil, ErrAskDisabled
+	}
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > settings.MaxQuestionLength {
+		return nil, ErrInvalidQuestion
+	}
+	reserved, keys, err := s.reserveBudgets(ctx, settings, ip)
+	if err != nil {
+		return nil, err
+	}
+	question, err := s.prepare(ctx, settings, text, ip, userAgent)
+	if err != nil {
+		s.release(ctx, reserved, keys)
+		return nil, err
+	}
+	question.reserved = reserved
+	question.reservedKeys = keys
+	return question, nil
+}
+
+func (s *AskServiceImpl) prepare(ctx context.Context, settings *askSettings, text, ip, userAgent string) (*Question, error) {
+	if settings.IPHourlyLimit > 0 {
+		count, err := s.counter.Incr(ctx, rateKeyPrefix+ip, rateWindow)
+		if err != nil {
+			return nil, err
+		}
+		if count > int64(settings.IPHourlyLimit) {
+			return nil, ErrAskRateLimited
+		}
+	}
+
+	chunks, err := s.knowledgeService.SearchPublic(ctx, text, settings.TopK)
+	if err != nil {
+		return nil, err
+	}
+	return &Question{
+		Text:      text,
+		IP:        ip,
+		UserAgent: userAgent,
+		chunks:    chunks,
+		settings:  settings,
+	}, nil
+}
+
+func (s *AskServiceImpl) Answer(ctx context.Context, q *Question, onDelta func(string) error) (*model.AIAnswerResult, error) {
+	result, err := s.aiService.AnswerQuestion(ctx, q.Text, &model.AIKnowledgeContext{Chunks: q.chunks}, q.settings.MaxAnswerTokens, onDelta)
+	// The visitor may have disconnected, which must not lose the log entry.
+	ctx = context.WithoutCancel(ctx)
+	s.record(ctx, q, result, err)
+	s.release(ctx, q.reserved, q.reservedKeys)
+	return result, err
+}
+
+func (s *AskServiceImpl) record(ctx context.Context, q *Question, result *model.AIAnswerResult, answerErr error) {
+	create := s.client.AIAskLog.Create().
+		SetQuestion(q.Text).
+		SetIP(q.IP).
+		SetUserAgent(q.UserAgent).
+		SetStatus(aiasklog.StatusSuccess)
+	if result != nil {
+		citations := make([]schema.AIChatCitation, 0, len(result.Citations))
+		for _, c := range result.Citations {
+			citations = append(citations, schema.AIChatCitation{Kind: c.Kind, SourceID: c.SourceID, Title: c.Title, Slug: c.Slug})
+		}
+		create.SetAnswer(result.Content).
+			SetCitations(citations).
+			SetModel(result.Model).
+			SetPromptTokens(result.PromptTokens).
+			SetCompletionTokens(result.CompletionTokens).
+			SetTotalTokens(result.PromptTokens + result.CompletionTokens)
+	}
+	if answerErr != nil {
+		reason := answerErr.Error()
+		if utf8.RuneCountInString(reason) > maxErrorRunes {
+			reason = string([]rune(reason)[:maxErrorRunes])
+		}
+		create.SetStatus(aiasklog.StatusFailed).SetError(reason)
+	}
+	if err := create.Exec(ctx); err != nil {
+		slog.Error("记录站点问答日志失败", "ip", q.IP, "error", err.Error())
+	}
+}
+
+// reserveBudgets holds the estimated answer tokens against today's site and
+// visitor budgets and rejects the question once the logged usage plus the
+// tokens held by other questions still being answered reaches a budget. The
+// logs are only written after an answer finishes, so without the reservation
+// concurrent questions would all pass the check.
+func (s *AskServiceImpl) reserveBudgets(ctx context.Context, settings *askSettings, ip string) (int64, []string, error) {
+	reserved := int64(settings.MaxAnswerTokens)
+	if reserved <= 0 {
+		reserved = defaultMaxAnswerTokens
+	}
+	day := s.startOfDay()
+	budgets := []struct {
+		limit int
+		ip    string
+		key   string
+	}{
+		{settings.DailyTokens, "", reserveKeyPrefix + day.Format("20060102")},
+		{settings.IPDailyTokens, ip, reserveKeyPrefix + day.Format("20060102") + ":" + ip},
+	}
+
+	var keys []string
+	for _, b := range budgets {
+		if b.limit <= 0 {
+			continue
+		}
+		held, err := s.counter.IncrBy(ctx, b.key, reserved, reserveWindow)
+		if err != nil {
+			s.release(ctx, reserved, keys)
+			return 0, nil, err
+		}
+		keys = append(keys, b.key)
+		used, err := s.tokensSince(ctx, day, b.ip)
+		if err != nil {
+			s.release(ctx, reserved, keys)
+			return 0, nil, err
+		}
+		if int64(used)+held-reserved >= int64(b.limit) {
+			s.release(ctx, reserved, keys)
+			return 0, nil, ErrAskBudgetExhausted
+		}
+	}
+	return reserved, keys, nil
+}
+
+// release gives back the tokens held by reserveBudgets.
+func (s *AskServiceImpl) release(ctx context.Context, reserved int64, keys []string) {
+	for _, key := range keys {
+		if _, err := s.counter.IncrBy(ctx, key, -reserved, reserveWindow); err != nil {
+			slog.Error("释放站点问答预留额度失败", "key", key, "error", err.Error())
+		}
+	}
+}
+
+func (s *AskServiceImpl) tokensSince(ctx context.Context, since time.Time, ip string) (int, error) {
+	query := s.client.AIAskLog.Query().Where(aiasklog.CreatedAtGTE(since))
+	if ip != "" {
+		query.Where(aiasklog.IPEQ(ip))
+	}
+	// SUM over no rows is NULL.
+	var rows []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	if err := query.Aggregate(ent.Sum(aiasklog.FieldTotalTokens)).Scan(ctx, &rows); err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return int(rows[0].Sum.Int64), nil
+}
+
+func (s *AskServiceImpl) startOfDay() time.Time {
+	now := s.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+func (s *AskServiceImpl) Usage(ctx context.Context) (*model.AIAskUsageResp, error) {
+	settings, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	since := s.startOfDay()
+	questions, err := s.client.AIAskLog.Query().Where(aiasklog.CreatedAtGTE(since)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.tokensSince(ctx, since, "")
+	if err != nil {
+		return nil, err
+	}
+	return &model.AIAskUsageResp{
+		TodayQuestions: questions,
+		TodayTokens:    tokens,
+		DailyTokens:    settings.DailyTokens,
+	}, nil
+}
+
+func (s *AskServiceImpl) QueryLogPage(ctx context.Context, req model.AIAskLogPageQuery) ([]*ent.AIAskLog, int, error) {
+	query := s.client.AIAskLog.Query()
+	if req.IP != "" {
+		query.Where(aiasklog.IPContains(req.IP))
+	}
+	if req.Keyword != "" {
+		query.Where(aiasklog.Or(aiasklog.QuestionContains(req.Keyword), aiasklog.AnswerContains(req.Keyword)))
+	}
+	if req.Status != "" {
+		query.Where(aiasklog.StatusEQ(aiasklog.Status(req.Status)))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	logs, err := query.
+		Order(ent.Desc(aiasklog.FieldID)).
+		Limit(req.Size).
+		Offset((req.Page - 1) * req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, count, nil
+}
+
+func (s *AskServiceImpl) DeleteLogs(ctx context.Context, ids []int) (int, error) {
+	return s.client.AIAskLog.Delete().Where(aiasklog.IDIn(ids...)).Exec(ctx)
+}
+
+func (s *AskServiceImpl) loadSettings(ctx context.Context) (*askSettings, error) {
+	settings := defaultSettings()
+	setting, err := s.settingService.GetSettingByKey(ctx, model.SettingKeyAIAsk)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return settings, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(setting.Value), settings); err != nil {
+		return nil, fmt.Errorf("解析站点问答配置失败: %w", err)
+	}
+	if settings.MaxQuestionLength <= 0 {
+		settings.MaxQuestionLength = defaultMaxQuestionLength
+	}
+	if settings.TopK <= 0 {
+		settings.TopK = defaultTopK
+	}
+	return settings, nil
+}
@@ -0,0 +1,120 @@
+package ask
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/aiasklog"
+	"github.com/shuTwT/hoshikuzu/ent/enttest"
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	knowledge_service "github.com/shuTwT/hoshikuzu/internal/services/ai/knowledge"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	"github.com/shuTwT/hoshikuzu/pkg/cache"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type fakeAI struct {
+	ai_service.AIService
+	totalTokens int
+}
+
+func (f *fakeAI) AnswerQuestion(_ context.Context, _ string, _ *model.AIKnowledgeContext, _ int, _ func(string) error) (*model.AIAnswerResult, error) {
+	return &model.AIAnswerResult{Content: "回答", CompletionTokens: f.totalTokens}, nil
+}
+
+type fakeKnowledge struct {
+	knowledge_service.KnowledgeService
+}
+
+func (fakeKnowledge) SearchPublic(context.Context, string, int) ([]model.AIKnowledgeChunk, error) {
+	return nil, nil
+}
+
+func newTestService(t *testing.T, settings askSettings) (*AskServiceImpl, *ent.Client) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "ask.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	// The in-process counter is shared by the whole package.
+	cache.GetCache().Clear()
+
+	settings.Enabled = true
+	value, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Setting.Create().SetKey(model.SettingKeyAIAsk).SetValue(string(value)).SaveX(context.Background())
+	s := NewAskServiceImpl(client, setting_service.NewSettingServiceImpl(client), &fakeAI{totalTokens: 300}, fakeKnowledge{}, cache.NewCounter(nil))
+	return s, client
+}
+
+func logTokens(ctx context.Context, client *ent.Client, ip string, tokens int) {
+	client.AIAskLog.Create().
+		SetQuestion("问题").
+		SetStatus(aiasklog.StatusSuccess).
+		SetIP(ip).
+		SetTotalTokens(tokens).
+		SaveX(ctx)
+}
+
+func TestPrepareIPHourlyLimit(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, askSettings{IPHourlyLimit: 2})
+
+	for i := range 2 {
+		if _, err := s.Prepare(ctx, "问题", "1.1.1.1", ""); err != nil {
+			t.Fatalf("question %d: Prepare() error = %v", i+1, err)
+		}
+	}
+	if _, err := s.Prepare(ctx, "问题", "1.1.1.1", ""); !errors.Is(err, ErrAskRateLimited) {
+		t.Errorf("third question: Prepare() error = %v, want ErrAskRateLimited", err)
+	}
+	if _, err := s.Prepare(ctx, "问题", "2.2.2.2", ""); err != nil {
+		t.Errorf("another visitor: Prepare() error = %v", err)
+	}
+}
+
+func TestPrepareDailyBudgets(t *testing.T) {
+	ctx := context.Background()
+	s, client := newTestService(t, askSettings{IPDailyTokens: 1000, DailyTokens: 3000})
+
+	logTokens(ctx, client, "1.1.1.1", 1000)
+	if _, err := s.Prepare(ctx, "问题", "1.1.1.1", ""); !errors.Is(err, ErrAskBudgetExhausted) {
+		t.Errorf("visitor over budget: Prepare() error = %v, want ErrAskBudgetExhausted", err)
+	}
+	if _, err := s.Prepare(ctx, "问题", "2.2.2.2", ""); err != nil {
+		t.Fatalf("another visitor: Prepare() error = %v", err)
+	}
+
+	logTokens(ctx, client, "3.3.3.3", 2000)
+	if _, err := s.Prepare(ctx, "问题", "4.4.4.4", ""); !errors.Is(err, ErrAskBudgetExhausted) {
+		t.Errorf("site over budget: Prepare() error = %v, want ErrAskBudgetExhausted", err)
+	}
+}
+
+// TestPrepareReservesAnswerTokens 回答写入日志前，进行中的问题已占用预算
+func TestPrepareReservesAnswerTokens(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, askSettings{IPDailyTokens: 800, MaxAnswerTokens: 800})
+
+	first, err := s.Prepare(ctx, "问题", "1.1.1.1", "")
+	if err != nil {
+		t.Fatalf("first question: Prepare() error = %v", err)
+	}
+	if _, err := s.Prepare(ctx, "问题", "1.1.1.1", ""); !errors.Is(err, ErrAskBudgetExhausted) {
+		t.Errorf("question while the first is answering: Prepare() error = %v, want ErrAskBudgetExhausted", err)
+	}
+
+	// Answering logs 300 tokens and gives back the reservation.
+	if _, err := s.Answer(ctx, first, func(string) error { return nil }); err != nil {
+		t.Fatalf("Answer() error = %v", err)
+	}
+	if _, err := s.Prepare(ctx, "问题", "1.1.1.1", ""); err != nil {
+		t.Errorf("question after the answer: Prepare() error = %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
+	"github.com/shuTwT/hoshikuzu/ent/setting"
+	"github.com/shuTwT/hoshikuzu/internal/infra/logger"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const (
+	defaultContextTokens = 8000
+	defaultSummaryTokens = 512
+	maxSummaryTokens     = 4096
+	// messageTokenOverhead approximates the tokens the chat format adds
+	// around every message.
+	messageTokenOverhead        = 4
+	maxContextSummaryInputRunes = 24000
+	contextSummarySystemPrompt  = "你负责压缩一段对话的早期内容。根据已有摘要和新的对话记录，输出一份更新后的摘要，" +
+		"保留用户的目标、关键事实、结论、约定和尚未解决的问题，省略寒暄与重复内容。使用对话所用的语言，只输出摘要本身。"
+	contextSummaryPrompt = "以下是本次对话较早内容的摘要，这些内容已不在下方的消息中：\n\n"
+)
+
+// chatContextSettings mirrors the JSON stored under key 'ai_chat_context'.
+type chatContextSettings struct {
+	// ContextTokens is the budget of the prompt sent for a chat turn. 0
+	// leaves the context window of the model as the only limit.
+	ContextTokens int `json:"contextTokens"`
+	// Summarize folds the turns that fall out of the budget into a stored
+	// summary instead of dropping them.
+	Summarize     bool `json:"summarize"`
+	SummaryTokens int  `json:"summaryTokens"`
+}
+
+func defaultContextSettings() *chatContextSettings {
+	return &chatContextSettings{
+		ContextTokens: defaultContextTokens,
+		Summarize:     true,
+		SummaryTokens: defaultSummaryTokens,
+	}
+}
+
+func (s *AIServiceImpl) loadContextSettings(ctx context.Context) (*chatContextSettings, error) {
+	settings := defaultContextSettings()
+	row, err := s.client.Setting.Query().Where(setting.KeyEQ(model.SettingKeyAIChatContext)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return settings, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(row.Value), settings); err != nil {
+		return nil, fmt.Errorf("解析 AI 对话上下文配置失败: %w", err)
+	}
+	settings.ContextTokens = max(settings.ContextTokens, 0)
+	if settings.SummaryTokens <= 0 {
+		settings.SummaryTokens = defaultSummaryTokens
+	}
+	settings.SummaryTokens = min(settings.SummaryTokens, maxSummaryTokens)
+	return settings, nil
+}
+
+// contextBudget combines the configured budget with the model's context
+// window, of which the reply's max_tokens is reserved. 0 means unlimited.
+func contextBudget(budget, contextWindow, maxTokens int) int {
+	if contextWindow <= 0 {
+		return budget
+	}
+	available := max(contextWindow-maxTokens, contextWindow/2)
+	if budget == 0 || available < budget {
+		return available
+	}
+	return budget
+}
+
+func messageTokens(content string) int {
+	return estimateTokens(content) + messageTokenOverhead
+}
+
+func requestTokens(messages []openai.ChatCompletionMessage) int {
+	total := 0
+	for _, message := range messages {
+		total += messageTokens(message.Content)
+	}
+	return total
+}
+
+// tokenRatio is the ratio between the prompt tokens a model's provider
+// reported and the generic estimate, learned from previous requests.
+func (s *AIServiceImpl) tokenRatio(modelName string) float64 {
+	if ratio, ok := s.tokenRatios.Load(modelName); ok {
+		return ratio.(float64)
+	}
+	return 1
+}
+
+func (s *AIServiceImpl) calibrateTokens(modelName string, estimated, reported int) {
+	if estimated <= 0 || reported <= 0 {
+		return
+	}
+	ratio := math.Min(math.Max(float64(reported)/float64(estimated), 0.5), 2)
+	if previous, ok := s.tokenRatios.Load(modelName); ok {
+		ratio = 0.8*previous.(float64) + 0.2*ratio
+	}
+	s.tokenRatios.Store(modelName, ratio)
+}
+
+// windowStart returns the index of the first turn that still fits into limit
+// estimated tokens when filling from the newest turn backwards. The newest
+// turn is always kept and the window never starts with an assistant reply
+// whose question was cut off.
+func windowStart(history []*ent.AIChatMessage, limit int) int {
+	start, used := len(history), 0
+	for start > 0 {
+		tokens := messageTokens(history[start-1].Content)
+		if used+tokens > limit && start < len(history) {
+			break
+		}
+		used += tokens
+		start--
+	}
+	for start < len(history)-1 && history[start].Role != aichatmessage.RoleUser {
+		start++
+	}
+	return start
+}
+
+// chatHistory returns the latest summary of the session, if any, and the
+// turns after it, oldest first. A positive lastID ends the history at that
+// message and ignores a summary that covers it.
+func (s *AIServiceImpl) chatHistory(ctx context.Context, sessionID, lastID int) ([]*ent.AIChatMessage, *ent.AIChatMessage, error) {
+	summaryQuery := s.client.AIChatMessage.Query().
+		Where(aichatmessage.SessionIDEQ(sessionID), aichatmessage.RoleEQ(aichatmessage.RoleSummary))
+	if lastID > 0 {
+		summaryQuery = summaryQuery.Where(aichatmessage.SummaryUntilLT(lastID))
+	}
+	summary, err := summaryQuery.Order(ent.Desc(aichatmessage.FieldID)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, nil, err
+	}
+	query := s.client.AIChatMessage.Query().
+		Where(aichatmessage.SessionIDEQ(sessionID), aichatmessage.RoleNEQ(aichatmessage.RoleSummary))
+	if summary != nil && summary.SummaryUntil != nil {
+		query = query.Where(aichatmessage.IDGT(*summary.SummaryUntil))
+	}
+	if lastID > 0 {
+		query = query.Where(aichatmessage.IDLTE(lastID))
+	}
+	history, err := query.Order(ent.Asc(aichatmessage.FieldCreatedAt), ent.Asc(aichatmessage.FieldID)).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return history, summary, nil
+}
+
+// fitContext trims the history to the context budget. fixed is the estimate
+// of the system messages sent before the history. With summarisation enabled
+// the oldest turns are folded into the session summary, down to half of the
+// budget so that the summary is not regenerated on every turn; otherwise, or
+// when summarising fails, they are dropped.
+func (s *AIServiceImpl) fitContext(ctx context.Context, session *ent.AIChatSession, provider *providerConfig, settings *chatContextSettings, fixed int, history []*ent.AIChatMessage, summary *ent.AIChatMessage) ([]*ent.AIChatMessage, *ent.AIChatMessage) {
+	budget := contextBudget(settings.ContextTokens, provider.ContextWindow, provider.MaxTokens)
+	if budget <= 0 {
+		return history, summary
+	}
+	limit := int(float64(budget)/s.tokenRatio(provider.Model)) - fixed
+	available := func(summary *ent.AIChatMessage) int {
+		if summary == nil {
+			return limit
+		}
+		return limit - messageTokens(contextSummaryPrompt+summary.Content)
+	}
+	start := windowStart(history, available(summary))
+	if start == 0 {
+		return history, summary
+	}
+	if settings.Summarize {
+		keep := max(windowStart(history, (limit-settings.SummaryTokens)/2), start)
+		updated, err := s.summarizeTurns(ctx, session.ID, provider, settings.SummaryTokens, summary, history[:keep])
+		if err != nil {
+			logger.Warn("对话摘要生成失败，改为丢弃较早的消息", "session_id", session.ID, "error", err.Error())
+		} else {
+			history, summary = history[keep:], updated
+			start = windowStart(history, available(summary))
+		}
+	}
+	if start > 0 {
+		logger.Info("对话超出上下文预算，已省略较早的消息", "session_id", session.ID, "omitted", start)
+	}
+	return history[start:], summary
+}
+
+// summarizeTurns folds turns into the previous summary and stores the result
+// as the only summary of the session.
+func (s *AIServiceImpl) summarizeTurns(ctx context.Context, sessionID int, provider *providerConfig, maxTokens int, previous *ent.AIChatMessage, turns []*ent.AIChatMessage) (*ent.AIChatMessage, error) {
+	var transcript strings.Builder
+	for _, turn := range turns {
+		role := "用户"
+		if turn.Role == aichatmessage.RoleAssistant {
+			role = "助手"
+		}
+		fmt.Fprintf(&transcript, "%s：%s\n\n", role, turn.Content)
+	}
+	// 过长时保留最近的对话记录
+	input := transcript.String()
+	if utf8.RuneCountInString(input) > maxContextSummaryInputRunes {
+		runes := []rune(input)
+		input = string(runes[len(runes)-maxContextSummaryInputRunes:])
+	}
+	var prompt strings.Builder
+	if previous != nil {
+		fmt.Fprintf(&prompt, "已有摘要：\n%s\n\n", previous.Content)
+	}
+	fmt.Fprintf(&prompt, "新的对话记录：\n%s", input)
+
+	resp, err := newOpenAIClient(provider.BaseURL, provider.APIKey).CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: provider.Model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: contextSummarySystemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: prompt.String()},
+		},
+		MaxTokens:   maxTokens,
+		Temperature: 0,
+	})
+	if err != nil {
+		return nil, providerError(err)
+	}
+	if len(resp.Choices) == 0 {
+		return nil, ErrAIProviderEmptyResponse
+	}
+	content := strings.TrimSpace(resp.Choices[0].Message.Content)
+	if content == "" {
+		return nil, ErrAIProviderEmptyResponse
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.AIChatMessage.Delete().
+		Where(aichatmessage.SessionIDEQ(sessionID), aichatmessage.RoleEQ(aichatmessage.RoleSummary)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	summary, err := tx.AIChatMessage.Create().
+		SetSessionID(sessionID).
+		SetRole(aichatmessage.RoleSummary).
+		SetContent(content).
+		SetModel(provider.Model).
+		SetPromptTokens(resp.Usage.PromptTokens).
+		SetCompletionTokens(resp.Usage.CompletionTokens).
+		SetSummaryUntil(turns[len(turns)-1].ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
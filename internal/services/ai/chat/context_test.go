@@ -0,0 +1,143 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/aichatmessage"
+	"github.com/shuTwT/hoshikuzu/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestContextBudget(t *testing.T) {
+	tests := []struct {
+		name                             string
+		budget, contextWindow, maxTokens int
+		want                             int
+	}{
+		{"unknown window", 8000, 0, 1000, 8000},
+		{"unlimited", 0, 0, 1000, 0},
+		{"window is larger", 8000, 128000, 4000, 8000},
+		{"window caps budget", 8000, 8192, 2048, 6144},
+		{"window only", 0, 8192, 2048, 6144},
+		{"max_tokens exceeds window", 8000, 4096, 8192, 2048},
+	}
+	for _, tt := range tests {
+		if got := contextBudget(tt.budget, tt.contextWindow, tt.maxTokens); got != tt.want {
+			t.Errorf("%s: contextBudget() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWindowStart(t *testing.T) {
+	// Every turn is 100 ASCII characters: 25 tokens plus the overhead.
+	turn := strings.Repeat("a", 100)
+	perTurn := messageTokens(turn)
+	history := []*ent.AIChatMessage{
+		{Role: aichatmessage.RoleUser, Content: turn},
+		{Role: aichatmessage.RoleAssistant, Content: turn},
+		{Role: aichatmessage.RoleUser, Content: turn},
+		{Role: aichatmessage.RoleAssistant, Content: turn},
+		{Role: aichatmessage.RoleUser, Content: turn},
+	}
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{"everything fits", 5 * perTurn, 0},
+		{"drops the first turn", 4 * perTurn, 2},
+		{"does not start with a reply", 4*perTurn - 1, 2},
+		{"two messages fit", 2 * perTurn, 4},
+		{"keeps the newest turn", 0, 4},
+	}
+	for _, tt := range tests {
+		if got := windowStart(history, tt.limit); got != tt.want {
+			t.Errorf("%s: windowStart() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCalibrateTokens(t *testing.T) {
+	s := &AIServiceImpl{}
+	if got := s.tokenRatio("m"); got != 1 {
+		t.Fatalf("tokenRatio() without samples = %v, want 1", got)
+	}
+	s.calibrateTokens("m", 100, 150)
+	if got := s.tokenRatio("m"); got != 1.5 {
+		t.Errorf("tokenRatio() after first sample = %v, want 1.5", got)
+	}
+	s.calibrateTokens("m", 100, 1000)
+	if got := s.tokenRatio("m"); got != 0.8*1.5+0.2*2 {
+		t.Errorf("tokenRatio() = %v, want the clamped sample blended in", got)
+	}
+	s.calibrateTokens("other", 0, 100)
+	if got := s.tokenRatio("other"); got != 1 {
+		t.Errorf("tokenRatio() after an invalid sample = %v, want 1", got)
+	}
+}
+
+func TestRegenerationBranch(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "chat.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	s := &AIServiceImpl{client: client}
+
+	u := client.User.Create().SetEmail("user@example.com").SetName("user").SetPassword("x").SaveX(ctx)
+	session := client.AIChatSession.Create().SetUserID(u.ID).SaveX(ctx)
+	turn := func(role aichatmessage.Role, content string) *ent.AIChatMessage {
+		return client.AIChatMessage.Create().SetSessionID(session.ID).SetRole(role).SetContent(content).SaveX(ctx)
+	}
+	q1 := turn(aichatmessage.RoleUser, "q1")
+	a1 := turn(aichatmessage.RoleAssistant, "a1")
+	q2 := turn(aichatmessage.RoleUser, "q2")
+	turn(aichatmessage.RoleAssistant, "a2")
+	turn(aichatmessage.RoleUser, "q3")
+	turn(aichatmessage.RoleAssistant, "a3")
+	client.AIChatMessage.Create().SetSessionID(session.ID).SetRole(aichatmessage.RoleSummary).SetContent("s").SetSummaryUntil(a1.ID).SaveX(ctx)
+
+	history, summary, err := s.chatHistory(ctx, session.ID, q2.ID)
+	if err != nil {
+		t.Fatalf("chatHistory() error = %v", err)
+	}
+	if summary == nil || len(history) != 1 || history[0].ID != q2.ID {
+		t.Errorf("chatHistory(until q2) = %d turns, summary %v, want [q2] after the summary", len(history), summary)
+	}
+	history, summary, _ = s.chatHistory(ctx, session.ID, q1.ID)
+	if summary != nil || len(history) != 1 || history[0].ID != q1.ID {
+		t.Errorf("chatHistory(until q1) = %d turns, summary %v, want [q1] without the summary", len(history), summary)
+	}
+
+	regen := &regeneration{messageID: q2.ID, content: "q2 edited"}
+	answer := func(client *ent.AIChatMessageClient) (*ent.AIChatMessage, error) {
+		return client.Create().SetSessionID(session.ID).SetRole(aichatmessage.RoleAssistant).SetContent("a2 new").Save(ctx)
+	}
+
+	// A failed answer keeps the old branch.
+	failed := errors.New("save failed")
+	if _, err := s.replaceBranch(ctx, session.ID, regen, func(*ent.AIChatMessageClient) (*ent.AIChatMessage, error) { return nil, failed }); !errors.Is(err, failed) {
+		t.Fatalf("replaceBranch() error = %v, want %v", err, failed)
+	}
+	if n := client.AIChatMessage.Query().CountX(ctx); n != 7 {
+		t.Errorf("messages after a failed regeneration = %d, want 7", n)
+	}
+	if got := client.AIChatMessage.GetX(ctx, q2.ID).Content; got != "q2" {
+		t.Errorf("edited message after a failed regeneration = %q, want q2", got)
+	}
+
+	if _, err := s.replaceBranch(ctx, session.ID, regen, answer); err != nil {
+		t.Fatalf("replaceBranch() error = %v", err)
+	}
+	var contents []string
+	for _, m := range client.AIChatMessage.Query().Order(ent.Asc(aichatmessage.FieldID)).AllX(ctx) {
+		contents = append(contents, m.Content)
+	}
+	if got, want := strings.Join(contents, ","), "q1,a1,q2 edited,s,a2 new"; got != want {
+		t.Errorf("messages = %s, want %s", got, want)
+	}
+}
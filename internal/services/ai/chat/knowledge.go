@@ -0,0 +1,211 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/aiprovider"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const (
+	// embeddingBatchSize keeps each embeddings request well below the input
+	// limits of common OpenAI-compatible providers.
+	embeddingBatchSize = 32
+
+	knowledgeSystemPrompt = "你是本站的站点知识助手。请优先依据下面提供的站点内容片段回答用户问题，" +
+		"引用某个来源的内容时在句末用 [编号] 标注，编号与来源列表一致，不要编造不存在的编号。" +
+		"如果片段中没有相关信息，请明确说明站点内容中没有找到相关内容，再视情况给出一般性回答。"
+	knowledgeEmptyPrompt = "本次未检索到与问题相关的站点内容。"
+	// publicAnswerRules narrows knowledge mode for anonymous visitors, who
+	// should not be able to use the site as a general-purpose assistant.
+	publicAnswerRules = "你正在回答本站访客的提问。只能依据上面的站点内容片段回答，不要回答与本站内容无关的问题，" +
+		"也不要执行翻译、写代码等与站点内容无关的任务；没有相关内容时请礼貌说明并建议访客浏览站点的其他内容。回答请简洁。"
+)
+
+func (s *AIServiceImpl) AnswerQuestion(ctx context.Context, question string, knowledge *model.AIKnowledgeContext, maxTokens int, onDelta func(string) error) (*model.AIAnswerResult, error) {
+	if err := s.ensureCipher(); err != nil {
+		return nil, err
+	}
+	question = strings.TrimSpace(question)
+	if question == "" || utf8.RuneCountInString(question) > maxMessageRunes {
+		return nil, ErrInvalidAIChatContent
+	}
+	provider, err := s.providerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var chunks []model.AIKnowledgeChunk
+	if knowledge != nil {
+		chunks = knowledge.Chunks
+	}
+	prompt, citations := knowledgePrompt(chunks)
+	prompt += "\n\n" + publicAnswerRules
+	if maxTokens <= 0 || (provider.MaxTokens > 0 && provider.MaxTokens < maxTokens) {
+		maxTokens = provider.MaxTokens
+	}
+
+	output, usage, err := streamCompletion(ctx, newOpenAIClient(provider.BaseURL, provider.APIKey), openai.ChatCompletionRequest{
+		Model: provider.Model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: prompt},
+			{Role: openai.ChatMessageRoleUser, Content: question},
+		},
+		MaxTokens:        maxTokens,
+		Temperature:      float32(provider.Temperature),
+		TopP:             float32(provider.TopP),
+		FrequencyPenalty: float32(provider.FrequencyPenalty),
+		PresencePenalty:  float32(provider.PresencePenalty),
+		StreamOptions:    &openai.StreamOptions{IncludeUsage: true},
+	}, onDelta)
+
+	result := &model.AIAnswerResult{
+		Content:   output,
+		Model:     provider.Model,
+		Citations: citations,
+	}
+	if usage != nil {
+		result.PromptTokens = usage.PromptTokens
+		result.CompletionTokens = usage.CompletionTokens
+	} else {
+		result.PromptTokens = estimateTokens(prompt) + estimateTokens(question)
+		result.CompletionTokens = estimateTokens(output)
+	}
+	return result, err
+}
+
+// estimateTokens approximates the token count of s for providers that do not
+// report usage: one token per CJK character and one per four other bytes.
+func estimateTokens(s string) int {
+	var cjk, other int
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) || unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			cjk++
+		} else {
+			other += utf8.RuneLen(r)
+		}
+	}
+	return cjk + (other+3)/4
+}
+
+func (s *AIServiceImpl) Embed(ctx context.Context, inputs []string) (*model.AIEmbeddingResult, error) {
+	if err := s.ensureCipher(); err != nil {
+		return nil, err
+	}
+	provider, err := s.embeddingConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vectors, err := createEmbeddings(ctx, newOpenAIClient(provider.BaseURL, provider.APIKey), provider.Model, inputs)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AIEmbeddingResult{Model: provider.Model, Vectors: vectors}, nil
+}
+
+// embeddingConfig resolves the preferred enabled provider that has an
+// embedding model, using the same ordering as chat.
+func (s *AIServiceImpl) embeddingConfig(ctx context.Context) (*providerConfig, error) {
+	provider, err := s.client.AIProvider.Query().
+		Where(aiprovider.IsEnabledEQ(true), aiprovider.EmbeddingModelNEQ("")).
+		Order(ent.Desc(aiprovider.FieldIsDefault), ent.Asc(aiprovider.FieldSort), ent.Asc(aiprovider.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAIEmbeddingNotFound
+		}
+		return nil, err
+	}
+	cipher, err := s.cipher()
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := decryptProviderKey(cipher, provider.APIKeyCiphertext)
+	if err != nil {
+		return nil, err
+	}
+	return &providerConfig{
+		BaseURL: provider.BaseURL,
+		APIKey:  apiKey,
+		Model:   provider.EmbeddingModel,
+	}, nil
+}
+
+// createEmbeddings embeds inputs in batches and returns the vectors in input
+// order, whatever order the provider lists them in.
+func createEmbeddings(ctx context.Context, client *openai.Client, modelName string, inputs []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(inputs))
+	for start := 0; start < len(inputs); start += embeddingBatchSize {
+		end := min(start+embeddingBatchSize, len(inputs))
+		batch := inputs[start:end]
+		resp, err := client.CreateEmbeddings(ctx, openai.EmbeddingRequestStrings{
+			Input: batch,
+			Model: openai.EmbeddingModel(modelName),
+		})
+		if err != nil {
+			return nil, providerError(err)
+		}
+		if len(resp.Data) != len(batch) {
+			return nil, fmt.Errorf("%w: expected %d embeddings, got %d", ErrAIProviderEmptyResponse, len(batch), len(resp.Data))
+		}
+		sort.Slice(resp.Data, func(i, j int) bool { return resp.Data[i].Index < resp.Data[j].Index })
+		for _, item := range resp.Data {
+			if len(item.Embedding) == 0 {
+				return nil, ErrAIProviderEmptyResponse
+			}
+			vectors = append(vectors, item.Embedding)
+		}
+	}
+	return vectors, nil
+}
+
+// knowledgePrompt builds the system message for a knowledge-mode turn.
+// Chunks are grouped by source so that [n] in the answer maps to the n-th
+// returned citation.
+func knowledgePrompt(chunks []model.AIKnowledgeChunk) (string, []model.AIKnowledgeCitation) {
+	if len(chunks) == 0 {
+		return knowledgeSystemPrompt + "\n\n" + knowledgeEmptyPrompt, nil
+	}
+
+	var citations []model.AIKnowledgeCitation
+	index := map[string]int{}
+	grouped := map[int][]string{}
+	for _, chunk := range chunks {
+		key := fmt.Sprintf("%s:%d", chunk.Kind, chunk.SourceID)
+		n, ok := index[key]
+		if !ok {
+			citations = append(citations, model.AIKnowledgeCitation{
+				Kind:     chunk.Kind,
+				SourceID: chunk.SourceID,
+				Title:    chunk.Title,
+				Slug:     chunk.Slug,
+			})
+			n = len(citations)
+			index[key] = n
+		}
+		grouped[n] = append(grouped[n], strings.TrimSpace(chunk.Content))
+	}
+
+	var b strings.Builder
+	b.WriteString(knowledgeSystemPrompt)
+	b.WriteString("\n\n站点内容片段：")
+	for i, c := range citations {
+		fmt.Fprintf(&b, "\n\n[%d] 《%s》", i+1, c.Title)
+		if c.Slug != "" {
+			fmt.Fprintf(&b, "（slug: %s）", c.Slug)
+		}
+		for _, content := range grouped[i+1] {
+			b.WriteString("\n")
+			b.WriteString(content)
+		}
+	}
+	return b.String(), citations
+}
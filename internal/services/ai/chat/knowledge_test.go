@@ -0,0 +1,183 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// newFakeProvider starts an OpenAI-compatible server. /embeddings answers
+// with vector [len(input), position] in reverse order to exercise the index
+// sort; /chat/completions streams the given deltas as SSE, followed by a
+// usage chunk when the request asks for it.
+func newFakeProvider(t *testing.T, deltas []string) (*openai.Client, *[]int) {
+	t.Helper()
+	var mu sync.Mutex
+	var batches []int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/embeddings", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input []string `json:"input"`
+			Model string   `json:"model"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		batches = append(batches, len(req.Input))
+		mu.Unlock()
+		data := make([]map[string]any, 0, len(req.Input))
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			data = append(data, map[string]any{
+				"object":    "embedding",
+				"index":     i,
+				"embedding": []float32{float32(len(req.Input[i])), float32(i)},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"object": "list", "model": req.Model, "data": data})
+	})
+	mux.HandleFunc("/chat/completions", func(w http.ResponseWriter, r *http.Request) {
+		var req openai.ChatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, delta := range deltas {
+			chunk, _ := json.Marshal(map[string]any{
+				"id":      "chatcmpl-test",
+				"object":  "chat.completion.chunk",
+				"choices": []map[string]any{{"index": 0, "delta": map[string]string{"content": delta}}},
+			})
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+		}
+		if req.StreamOptions != nil && req.StreamOptions.IncludeUsage {
+			chunk, _ := json.Marshal(map[string]any{
+				"id":      "chatcmpl-test",
+				"object":  "chat.completion.chunk",
+				"choices": []any{},
+				"usage":   map[string]int{"prompt_tokens": 12, "completion_tokens": 3, "total_tokens": 15},
+			})
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return newOpenAIClient(server.URL, "test-key"), &batches
+}
+
+func TestCreateEmbeddings(t *testing.T) {
+	client, batches := newFakeProvider(t, nil)
+	inputs := make([]string, embeddingBatchSize+3)
+	for i := range inputs {
+		inputs[i] = strings.Repeat("a", i+1)
+	}
+
+	vectors, err := createEmbeddings(context.Background(), client, "embed-test", inputs)
+	if err != nil {
+		t.Fatalf("createEmbeddings() error = %v", err)
+	}
+	if len(vectors) != len(inputs) {
+		t.Fatalf("len(vectors) = %d, want %d", len(vectors), len(inputs))
+	}
+	for i, v := range vectors {
+		if int(v[0]) != len(inputs[i]) {
+			t.Errorf("vectors[%d] = %v, want first component %d", i, v, len(inputs[i]))
+		}
+	}
+	if got := *batches; len(got) != 2 || got[0] != embeddingBatchSize || got[1] != 3 {
+		t.Errorf("batches = %v, want [%d 3]", got, embeddingBatchSize)
+	}
+}
+
+func TestStreamCompletion(t *testing.T) {
+	client, _ := newFakeProvider(t, []string{"站点", "知识", " [1]"})
+	for _, includeUsage := range []bool{false, true} {
+		req := openai.ChatCompletionRequest{
+			Model:    "chat-test",
+			Messages: []openai.ChatCompletionMessage{{Role: openai.ChatMessageRoleUser, Content: "hi"}},
+		}
+		if includeUsage {
+			req.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+		}
+		var received []string
+		output, usage, err := streamCompletion(context.Background(), client, req, func(delta string) error {
+			received = append(received, delta)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("streamCompletion(includeUsage=%v) error = %v", includeUsage, err)
+		}
+		if output != "站点知识 [1]" || len(received) != 3 {
+			t.Errorf("streamCompletion(includeUsage=%v) = %q in %d deltas", includeUsage, output, len(received))
+		}
+		if includeUsage && (usage == nil || usage.PromptTokens != 12 || usage.CompletionTokens != 3) {
+			t.Errorf("usage = %+v, want 12 prompt and 3 completion tokens", usage)
+		}
+		if !includeUsage && usage != nil {
+			t.Errorf("usage = %+v, want nil", usage)
+		}
+	}
+
+	// A failing consumer stops the stream and keeps the partial output.
+	stop := errors.New("client gone")
+	output, _, err := streamCompletion(context.Background(), client, openai.ChatCompletionRequest{Model: "chat-test"}, func(string) error {
+		return stop
+	})
+	if !errors.Is(err, stop) || output != "站点" {
+		t.Errorf("streamCompletion() = %q, %v, want partial output and %v", output, err, stop)
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"", 0},
+		{"abcd", 1},
+		{"abcde", 2},
+		{"你好世界", 4},
+		{"Go 语言", 3},
+	}
+	for _, tt := range tests {
+		if got := estimateTokens(tt.input); got != tt.want {
+			t.Errorf("estimateTokens(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestKnowledgePrompt(t *testing.T) {
+	chunks := []model.AIKnowledgeChunk{
+		{Kind: model.KnowledgeKindPost, SourceID: 1, Title: "Go 入门", Slug: "go-intro", Content: "片段一"},
+		{Kind: model.KnowledgeKindPost, SourceID: 2, Title: "Rust 入门", Content: "片段二"},
+		{Kind: model.KnowledgeKindPost, SourceID: 1, Title: "Go 入门", Slug: "go-intro", Content: "片段三"},
+	}
+	prompt, citations := knowledgePrompt(chunks)
+	if len(citations) != 2 || citations[0].Slug != "go-intro" || citations[1].SourceID != 2 {
+		t.Fatalf("citations = %+v", citations)
+	}
+	for _, want := range []string{"[1] 《Go 入门》（slug: go-intro）\n片段一\n片段三", "[2] 《Rust 入门》\n片段二"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt missing %q:\n%s", want, prompt)
+		}
+	}
+
+	prompt, citations = knowledgePrompt(nil)
+	if citations != nil || !strings.Contains(prompt, knowledgeEmptyPrompt) {
+		t.Errorf("empty knowledgePrompt() = %q, %v", prompt, citations)
+	}
+}
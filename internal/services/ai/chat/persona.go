@@ -0,0 +1,316 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
+	"github.com/shuTwT/hoshikuzu/ent/aimodel"
+	"github.com/shuTwT/hoshikuzu/ent/aipersona"
+	"github.com/shuTwT/hoshikuzu/ent/aiprovider"
+	"github.com/shuTwT/hoshikuzu/internal/infra/logger"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+const (
+	maxPersonaNameRunes        = 64
+	maxPersonaDescriptionRunes = 255
+	maxChatMaxTokens           = 8192
+)
+
+func (s *AIServiceImpl) ListChatModels(ctx context.Context) ([]model.AIChatModelOption, error) {
+	providers, err := s.client.AIProvider.Query().
+		Where(aiprovider.IsEnabledEQ(true)).
+		Order(ent.Desc(aiprovider.FieldIsDefault), ent.Asc(aiprovider.FieldSort), ent.Asc(aiprovider.FieldID)).
+		WithModels(func(q *ent.AIModelQuery) {
+			q.Where(aimodel.IsEnabledEQ(true)).Order(ent.Asc(aimodel.FieldSort), ent.Asc(aimodel.FieldID))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	options := make([]model.AIChatModelOption, 0)
+	for i, provider := range providers {
+		for j, m := range provider.Edges.Models {
+			options = append(options, model.AIChatModelOption{
+				ID:           m.ID,
+				ProviderID:   provider.ID,
+				ProviderName: provider.Name,
+				ModelName:    m.ModelName,
+				DisplayName:  m.DisplayName,
+				// Mirrors providerConfig: the first model of the first provider.
+				IsDefault: i == 0 && j == 0,
+			})
+		}
+	}
+	return options, nil
+}
+
+func (s *AIServiceImpl) UpdateSessionSettings(ctx context.Context, userID, sessionID int, req model.AIChatSessionSettingsReq) (*model.AIChatSessionResp, error) {
+	if _, err := s.sessionForUser(ctx, userID, sessionID); err != nil {
+		return nil, err
+	}
+	if err := s.validateSessionSettings(ctx, userID, &req); err != nil {
+		return nil, err
+	}
+	update := s.client.AIChatSession.UpdateOneID(sessionID).SetSystemPrompt(req.SystemPrompt)
+	// Nil means "not overridden", so stale values must be cleared.
+	if req.ModelID != nil {
+		update.SetModelID(*req.ModelID)
+	} else {
+		update.ClearModelID()
+	}
+	if req.PersonaID != nil {
+		update.SetPersonaID(*req.PersonaID)
+	} else {
+		update.ClearPersonaID()
+	}
+	if req.Temperature != nil {
+		update.SetTemperature(*req.Temperature)
+	} else {
+		update.ClearTemperature()
+	}
+	if req.MaxTokens != nil {
+		update.SetMaxTokens(*req.MaxTokens)
+	} else {
+		update.ClearMaxTokens()
+	}
+	session, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := sessionResponse(session)
+	return &resp, nil
+}
+
+// validateSessionSettings checks that the model is enabled and the persona
+// belongs to the user, and trims the system prompt.
+func (s *AIServiceImpl) validateSessionSettings(ctx context.Context, userID int, req *model.AIChatSessionSettingsReq) error {
+	req.SystemPrompt = strings.TrimSpace(req.SystemPrompt)
+	if utf8.RuneCountInString(req.SystemPrompt) > maxMessageRunes {
+		return fmt.Errorf("%w: system_prompt is too long", ErrInvalidAIChatSettings)
+	}
+	if err := validateOverrides(req.Temperature, req.MaxTokens); err != nil {
+		return err
+	}
+	if req.ModelID != nil {
+		exists, err := s.client.AIModel.Query().
+			Where(aimodel.IDEQ(*req.ModelID), aimodel.IsEnabledEQ(true), aimodel.HasProviderWith(aiprovider.IsEnabledEQ(true))).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("%w: model is not available", ErrInvalidAIChatSettings)
+		}
+	}
+	if req.PersonaID != nil {
+		if _, err := s.personaForUser(ctx, userID, *req.PersonaID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateOverrides(temperature *float64, maxTokens *int) error {
+	if temperature != nil && (*temperature < 0 || *temperature > 2) {
+		return fmt.Errorf("%w: temperature must be between 0 and 2", ErrInvalidAIChatSettings)
+	}
+	if maxTokens != nil && (*maxTokens < 1 || *maxTokens > maxChatMaxTokens) {
+		return fmt.Errorf("%w: max_tokens must be between 1 and %d", ErrInvalidAIChatSettings, maxChatMaxTokens)
+	}
+	return nil
+}
+
+func (s *AIServiceImpl) ListPersonas(ctx context.Context, userID int) ([]model.AIPersonaResp, error) {
+	personas, err := s.client.AIPersona.Query().
+		Where(aipersona.UserIDEQ(userID)).
+		Order(ent.Asc(aipersona.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]model.AIPersonaResp, 0, len(personas))
+	for _, persona := range personas {
+		result = append(result, personaResponse(persona))
+	}
+	return result, nil
+}
+
+func (s *AIServiceImpl) CreatePersona(ctx context.Context, userID int, req model.AIPersonaReq) (*model.AIPersonaResp, error) {
+	if err := validatePersona(&req); err != nil {
+		return nil, err
+	}
+	persona, err := s.client.AIPersona.Create().
+		SetUserID(userID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetSystemPrompt(req.SystemPrompt).
+		SetNillableTemperature(req.Temperature).
+		SetNillableMaxTokens(req.MaxTokens).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := personaResponse(persona)
+	return &resp, nil
+}
+
+func (s *AIServiceImpl) UpdatePersona(ctx context.Context, userID, personaID int, req model.AIPersonaReq) (*model.AIPersonaResp, error) {
+	if err := validatePersona(&req); err != nil {
+		return nil, err
+	}
+	if _, err := s.personaForUser(ctx, userID, personaID); err != nil {
+		return nil, err
+	}
+	update := s.client.AIPersona.UpdateOneID(personaID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetSystemPrompt(req.SystemPrompt)
+	if req.Temperature != nil {
+		update.SetTemperature(*req.Temperature)
+	} else {
+		update.ClearTemperature()
+	}
+	if req.MaxTokens != nil {
+		update.SetMaxTokens(*req.MaxTokens)
+	} else {
+		update.ClearMaxTokens()
+	}
+	persona, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := personaResponse(persona)
+	return &resp, nil
+}
+
+// DeletePersona removes the persona and unbinds it from the user's sessions.
+func (s *AIServiceImpl) DeletePersona(ctx context.Context, userID, personaID int) error {
+	if _, err := s.personaForUser(ctx, userID, personaID); err != nil {
+		return err
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.AIChatSession.Update().
+		Where(aichatsession.UserIDEQ(userID), aichatsession.PersonaIDEQ(personaID)).
+		ClearPersonaID().
+		Save(ctx); err != nil {
+		return err
+	}
+	if err = tx.AIPersona.DeleteOneID(personaID).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *AIServiceImpl) personaForUser(ctx context.Context, userID, personaID int) (*ent.AIPersona, error) {
+	persona, err := s.client.AIPersona.Query().
+		Where(aipersona.IDEQ(personaID), aipersona.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAIPersonaNotFound
+		}
+		return nil, err
+	}
+	return persona, nil
+}
+
+func validatePersona(req *model.AIPersonaReq) error {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Description = strings.TrimSpace(req.Description)
+	req.SystemPrompt = strings.TrimSpace(req.SystemPrompt)
+	if req.Name == "" || utf8.RuneCountInString(req.Name) > maxPersonaNameRunes {
+		return fmt.Errorf("%w: name must be 1 to %d characters", ErrInvalidAIChatSettings, maxPersonaNameRunes)
+	}
+	if utf8.RuneCountInString(req.Description) > maxPersonaDescriptionRunes {
+		return fmt.Errorf("%w: description is too long", ErrInvalidAIChatSettings)
+	}
+	if req.SystemPrompt == "" || utf8.RuneCountInString(req.SystemPrompt) > maxMessageRunes {
+		return fmt.Errorf("%w: system_prompt is required and must not be too long", ErrInvalidAIChatSettings)
+	}
+	return validateOverrides(req.Temperature, req.MaxTokens)
+}
+
+// sessionConfig resolves the provider, model and system prompt of a chat
+// turn. Session overrides win over the persona, which wins over the provider
+// defaults. A selected model that has since been disabled or deleted falls
+// back to the default, and the stored message records the model actually
+// used.
+func (s *AIServiceImpl) sessionConfig(ctx context.Context, session *ent.AIChatSession) (*providerConfig, string, error) {
+	var config *providerConfig
+	if session.ModelID != nil {
+		m, err := s.client.AIModel.Query().
+			Where(aimodel.IDEQ(*session.ModelID), aimodel.IsEnabledEQ(true), aimodel.HasProviderWith(aiprovider.IsEnabledEQ(true))).
+			WithProvider().
+			Only(ctx)
+		switch {
+		case err == nil:
+			if config, err = s.configFor(m.Edges.Provider, m); err != nil {
+				return nil, "", err
+			}
+		case ent.IsNotFound(err):
+			logger.Info("会话选用的模型不可用，改用默认模型", "session_id", session.ID, "model_id", *session.ModelID)
+		default:
+			return nil, "", err
+		}
+	}
+	if config == nil {
+		var err error
+		if config, err = s.providerConfig(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+
+	systemPrompt := session.SystemPrompt
+	if session.PersonaID != nil {
+		persona, err := s.client.AIPersona.Query().
+			Where(aipersona.IDEQ(*session.PersonaID), aipersona.UserIDEQ(session.UserID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, "", err
+		}
+		if persona != nil {
+			if systemPrompt == "" {
+				systemPrompt = persona.SystemPrompt
+			}
+			if persona.Temperature != nil {
+				config.Temperature = *persona.Temperature
+			}
+			if persona.MaxTokens != nil {
+				config.MaxTokens = *persona.MaxTokens
+			}
+		}
+	}
+	if session.Temperature != nil {
+		config.Temperature = *session.Temperature
+	}
+	if session.MaxTokens != nil {
+		config.MaxTokens = *session.MaxTokens
+	}
+	return config, systemPrompt, nil
+}
+
+func personaResponse(persona *ent.AIPersona) model.AIPersonaResp {
+	return model.AIPersonaResp{
+		ID:           persona.ID,
+		Name:         persona.Name,
+		Description:  persona.Description,
+		SystemPrompt: persona.SystemPrompt,
+		Temperature:  persona.Temperature,
+		MaxTokens:    persona.MaxTokens,
+		CreatedAt:    model.LocalTime(persona.CreatedAt),
+		UpdatedAt:    model.LocalTime(persona.UpdatedAt),
+	}
+}
@@ -0,0 +1,59 @@
+package ai
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+func TestValidateOverrides(t *testing.T) {
+	float := func(v float64) *float64 { return &v }
+	integer := func(v int) *int { return &v }
+	tests := []struct {
+		name        string
+		temperature *float64
+		maxTokens   *int
+		valid       bool
+	}{
+		{"not overridden", nil, nil, true},
+		{"bounds", float(0), integer(maxChatMaxTokens), true},
+		{"temperature too high", float(2.5), nil, false},
+		{"negative temperature", float(-0.1), nil, false},
+		{"zero max_tokens", nil, integer(0), false},
+		{"max_tokens too large", nil, integer(maxChatMaxTokens + 1), false},
+	}
+	for _, tt := range tests {
+		err := validateOverrides(tt.temperature, tt.maxTokens)
+		if (err == nil) != tt.valid {
+			t.Errorf("%s: validateOverrides() error = %v, want valid %v", tt.name, err, tt.valid)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidAIChatSettings) {
+			t.Errorf("%s: error %v does not wrap ErrInvalidAIChatSettings", tt.name, err)
+		}
+	}
+}
+
+func TestValidatePersona(t *testing.T) {
+	req := model.AIPersonaReq{Name: "  翻译助手 ", Description: " 中英互译 ", SystemPrompt: "\n你是一名译者。\n"}
+	if err := validatePersona(&req); err != nil {
+		t.Fatalf("validatePersona() error = %v", err)
+	}
+	if req.Name != "翻译助手" || req.Description != "中英互译" || req.SystemPrompt != "你是一名译者。" {
+		t.Errorf("validatePersona() did not trim fields: %+v", req)
+	}
+
+	invalid := []model.AIPersonaReq{
+		{Name: " ", SystemPrompt: "prompt"},
+		{Name: strings.Repeat("名", maxPersonaNameRunes+1), SystemPrompt: "prompt"},
+		{Name: "name", Description: strings.Repeat("d", maxPersonaDescriptionRunes+1), SystemPrompt: "prompt"},
+		{Name: "name", SystemPrompt: "  "},
+		{Name: "name", SystemPrompt: "prompt", MaxTokens: new(int)},
+	}
+	for i, req := range invalid {
+		if err := validatePersona(&req); !errors.Is(err, ErrInvalidAIChatSettings) {
+			t.Errorf("case %d: validatePersona() error = %v, want ErrInvalidAIChatSettings", i, err)
+		}
+	}
+}
@@ -3,12 +3,15 @@ package ai
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
@@ -17,6 +20,7 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/aichatsession"
 	"github.com/shuTwT/hoshikuzu/ent/aimodel"
 	"github.com/shuTwT/hoshikuzu/ent/aiprovider"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 	"github.com/shuTwT/hoshikuzu/ent/setting"
 	infra_ai "github.com/shuTwT/hoshikuzu/internal/infra/ai"
 	"github.com/shuTwT/hoshikuzu/internal/infra/logger"
@@ -38,6 +42,10 @@ var (
 	ErrAIChatSessionNotFound   = errors.New("AI chat session not found")
 	ErrInvalidAIChatContent    = errors.New("invalid AI chat content")
 	ErrAIProviderEmptyResponse = errors.New("AI provider returned an empty response")
+	ErrAIEmbeddingNotFound     = errors.New("no enabled AI provider has an embedding model configured")
+	ErrAIPersonaNotFound       = errors.New("AI persona not found")
+	ErrInvalidAIChatSettings   = errors.New("invalid AI chat settings")
+	ErrAIChatMessageNotFound   = errors.New("AI chat message not found")
 )
 
 // AIService is the server-side boundary for provider configuration and chat
@@ -54,18 +62,50 @@ type AIService interface {
 	CreateProviderModel(ctx context.Context, providerID int, req model.AIModelReq) error
 	UpdateProviderModel(ctx context.Context, providerID, modelID int, req model.AIModelReq) error
 	DeleteProviderModel(ctx context.Context, providerID, modelID int) error
-	CreateSession(ctx context.Context, userID int) (*model.AIChatSessionResp, error)
+	// CreateSession creates an empty session, optionally with its settings.
+	CreateSession(ctx context.Context, userID int, settings *model.AIChatSessionSettingsReq) (*model.AIChatSessionResp, error)
+	// UpdateSessionSettings replaces the model, persona, system prompt and
+	// sampling overrides of a session.
+	UpdateSessionSettings(ctx context.Context, userID, sessionID int, req model.AIChatSessionSettingsReq) (*model.AIChatSessionResp, error)
+	// ListChatModels lists the enabled models a session can select.
+	ListChatModels(ctx context.Context) ([]model.AIChatModelOption, error)
+	ListPersonas(ctx context.Context, userID int) ([]model.AIPersonaResp, error)
+	CreatePersona(ctx context.Context, userID int, req model.AIPersonaReq) (*model.AIPersonaResp, error)
+	UpdatePersona(ctx context.Context, userID, personaID int, req model.AIPersonaReq) (*model.AIPersonaResp, error)
+	DeletePersona(ctx context.Context, userID, personaID int) error
 	ListSessions(ctx context.Context, userID int) ([]model.AIChatSessionResp, error)
 	ListMessages(ctx context.Context, userID, sessionID int) ([]model.AIChatMessageResp, error)
 	DeleteSession(ctx context.Context, userID, sessionID int) error
 	ClearSession(ctx context.Context, userID, sessionID int) error
-	StreamChat(ctx context.Context, userID, sessionID int, content string, onDelta func(string) error) (*model.AIChatMessageResp, error)
+	// StreamChat answers the next user turn. A non-nil knowledge context
+	// switches the turn into site knowledge mode.
+	StreamChat(ctx context.Context, userID, sessionID int, content string, knowledge *model.AIKnowledgeContext, onDelta func(string) error) (*model.AIChatMessageResp, error)
+	// RegenerateChat branches the session at an earlier user message: the
+	// turns after it are discarded, its content is replaced and a new reply
+	// is streamed. Passing the unchanged content regenerates the reply.
+	RegenerateChat(ctx context.Context, userID, sessionID, messageID int, content string, knowledge *model.AIKnowledgeContext, onDelta func(string) error) (*model.AIChatMessageResp, error)
 	GenerateSummary(ctx context.Context, title, content string) (string, error)
+	ClassifyComment(ctx context.Context, content string) (*model.CommentClassification, error)
+	// Embed returns one vector per input using the embedding model of the
+	// preferred enabled provider.
+	Embed(ctx context.Context, inputs []string) (*model.AIEmbeddingResult, error)
+	// AnswerQuestion streams a stateless answer to a site visitor using only
+	// the given knowledge. maxTokens > 0 caps the answer length. When the
+	// provider was reached, the result is returned even on error so that the
+	// spent tokens can be accounted for.
+	AnswerQuestion(ctx context.Context, question string, knowledge *model.AIKnowledgeContext, maxTokens int, onDelta func(string) error) (*model.AIAnswerResult, error)
+	// StreamPrompt streams a one-off completion of a system and user prompt
+	// outside any chat session. Like AnswerQuestion, the result is returned
+	// even on error once the provider was reached.
+	StreamPrompt(ctx context.Context, systemPrompt, userPrompt string, onDelta func(string) error) (*model.AIAnswerResult, error)
 }
 
 type AIServiceImpl struct {
 	client *ent.Client
 	cipher func() (infra_ai.SecretCipher, error)
+	// tokenRatios maps a model name to its calibrated token ratio, see
+	// tokenRatio.
+	tokenRatios sync.Map
 }
 
 func NewAIServiceImpl(client *ent.Client) AIService {
@@ -237,6 +277,7 @@ func (s *AIServiceImpl) CreateProvider(ctx context.Context, req model.AIProvider
 		SetProviderType(strings.TrimSpace(req.ProviderType)).
 		SetBaseURL(strings.TrimRight(strings.TrimSpace(req.BaseURL), "/")).
 		SetAPIKeyCiphertext(apiKeyCiphertext).
+		SetEmbeddingModel(strings.TrimSpace(req.EmbeddingModel)).
 		SetTemperature(req.Temperature).
 		SetMaxTokens(req.MaxTokens).
 		SetTopP(req.TopP).
@@ -283,6 +324,7 @@ func (s *AIServiceImpl) UpdateProvider(ctx context.Context, id int, req model.AI
 		SetName(strings.TrimSpace(req.Name)).
 		SetProviderType(strings.TrimSpace(req.ProviderType)).
 		SetBaseURL(strings.TrimRight(strings.TrimSpace(req.BaseURL), "/")).
+		SetEmbeddingModel(strings.TrimSpace(req.EmbeddingModel)).
 		SetTemperature(req.Temperature).
 		SetMaxTokens(req.MaxTokens).
 		SetTopP(req.TopP).
@@ -412,6 +454,7 @@ func (s *AIServiceImpl) CreateProviderModel(ctx context.Context, providerID int,
 		SetDisplayName(strings.TrimSpace(req.DisplayName)).
 		SetIsEnabled(req.IsEnabled).
 		SetSort(req.Sort).
+		SetContextWindow(req.ContextWindow).
 		Save(ctx)
 	return err
 }
@@ -437,6 +480,7 @@ func (s *AIServiceImpl) UpdateProviderModel(ctx context.Context, providerID, mod
 		SetDisplayName(strings.TrimSpace(req.DisplayName)).
 		SetIsEnabled(req.IsEnabled).
 		SetSort(req.Sort).
+		SetContextWindow(req.ContextWindow).
 		Save(ctx)
 	return err
 }
@@ -454,14 +498,24 @@ func (s *AIServiceImpl) DeleteProviderModel(ctx context.Context, providerID, mod
 	return s.client.AIModel.DeleteOneID(m.ID).Exec(ctx)
 }
 
-func (s *AIServiceImpl) CreateSession(ctx context.Context, userID int) (*model.AIChatSessionResp, error) {
+func (s *AIServiceImpl) CreateSession(ctx context.Context, userID int, settings *model.AIChatSessionSettingsReq) (*model.AIChatSessionResp, error) {
 	if err := s.ensureCipher(); err != nil {
 		return nil, err
 	}
-	session, err := s.client.AIChatSession.Create().
+	create := s.client.AIChatSession.Create().
 		SetUserID(userID).
-		SetTitle(newSessionTitle).
-		Save(ctx)
+		SetTitle(newSessionTitle)
+	if settings != nil {
+		if err := s.validateSessionSettings(ctx, userID, settings); err != nil {
+			return nil, err
+		}
+		create.SetNillableModelID(settings.ModelID).
+			SetNillablePersonaID(settings.PersonaID).
+			SetSystemPrompt(settings.SystemPrompt).
+			SetNillableTemperature(settings.Temperature).
+			SetNillableMaxTokens(settings.MaxTokens)
+	}
+	session, err := create.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -501,9 +555,23 @@ func (s *AIServiceImpl) ListMessages(ctx context.Context, userID, sessionID int)
 	if err != nil {
 		return nil, err
 	}
+	// A summary is created after the turns it covers, so it is moved to
+	// right after the last of them.
+	summaries := make(map[int]*ent.AIChatMessage)
+	for _, message := range messages {
+		if message.Role == aichatmessage.RoleSummary && message.SummaryUntil != nil {
+			summaries[*message.SummaryUntil] = message
+		}
+	}
 	result := make([]model.AIChatMessageResp, 0, len(messages))
 	for _, message := range messages {
+		if message.Role == aichatmessage.RoleSummary {
+			continue
+		}
 		result = append(result, messageResponse(message))
+		if summary, ok := summaries[message.ID]; ok {
+			result = append(result, messageResponse(summary))
+		}
 	}
 	return result, nil
 }
@@ -547,7 +615,7 @@ func (s *AIServiceImpl) ClearSession(ctx context.Context, userID, sessionID int)
 	return s.client.AIChatSession.UpdateOneID(session.ID).SetTitle(newSessionTitle).Exec(ctx)
 }
 
-func (s *AIServiceImpl) StreamChat(ctx context.Context, userID, sessionID int, content string, onDelta func(string) error) (*model.AIChatMessageResp, error) {
+func (s *AIServiceImpl) StreamChat(ctx context.Context, userID, sessionID int, content string, knowledge *model.AIKnowledgeContext, onDelta func(string) error) (*model.AIChatMessageResp, error) {
 	if err := s.ensureCipher(); err != nil {
 		return nil, err
 	}
@@ -574,26 +642,136 @@ func (s *AIServiceImpl) StreamChat(ctx context.Context, userID, sessionID int, c
 		return nil, err
 	}
 
-	provider, err := s.providerConfig(ctx)
+	return s.answer(ctx, session, knowledge, nil, onDelta)
+}
+
+func (s *AIServiceImpl) RegenerateChat(ctx context.Context, userID, sessionID, messageID int, content string, knowledge *model.AIKnowledgeContext, onDelta func(string) error) (*model.AIChatMessageResp, error) {
+	if err := s.ensureCipher(); err != nil {
+		return nil, err
+	}
+	content = strings.TrimSpace(content)
+	if content == "" || utf8.RuneCountInString(content) > maxMessageRunes {
+		return nil, ErrInvalidAIChatContent
+	}
+	session, err := s.sessionForUser(ctx, userID, sessionID)
 	if err != nil {
 		return nil, err
 	}
-	messages, err := s.client.AIChatMessage.Query().
-		Where(aichatmessage.SessionIDEQ(sessionID)).
-		Order(ent.Asc(aichatmessage.FieldCreatedAt), ent.Asc(aichatmessage.FieldID)).
-		All(ctx)
+	message, err := s.client.AIChatMessage.Query().
+		Where(aichatmessage.IDEQ(messageID), aichatmessage.SessionIDEQ(sessionID), aichatmessage.RoleEQ(aichatmessage.RoleUser)).
+		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAIChatMessageNotFound
+		}
 		return nil, err
 	}
-	requestMessages := make([]openai.ChatCompletionMessage, 0, len(messages))
-	for _, message := range messages {
+
+	if content != message.Content && session.Title == makeSessionTitle(message.Content) {
+		session.Title = makeSessionTitle(content)
+	}
+	// The old branch is kept until the new answer is saved, so a failed
+	// regeneration leaves the conversation as it was.
+	return s.answer(ctx, session, knowledge, &regeneration{messageID: messageID, content: content}, onDelta)
+}
+
+// regeneration is an edited user message whose later turns are replaced by
+// the new answer.
+type regeneration struct {
+	messageID int
+	content   string
+}
+
+// replaceBranch saves the new answer of a regeneration together with the
+// edited message, deleting the turns of the replaced branch in the same
+// transaction.
+func (s *AIServiceImpl) replaceBranch(ctx context.Context, sessionID int, regen *regeneration, save func(*ent.AIChatMessageClient) (*ent.AIChatMessage, error)) (assistant *ent.AIChatMessage, err error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	// Later turns belong to the replaced branch, and so does a summary that
+	// covers the edited message.
+	if _, err = tx.AIChatMessage.Delete().Where(
+		aichatmessage.SessionIDEQ(sessionID),
+		aichatmessage.Or(
+			aichatmessage.And(aichatmessage.RoleNEQ(aichatmessage.RoleSummary), aichatmessage.IDGT(regen.messageID)),
+			aichatmessage.And(aichatmessage.RoleEQ(aichatmessage.RoleSummary), aichatmessage.SummaryUntilGTE(regen.messageID)),
+		),
+	).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err = tx.AIChatMessage.UpdateOneID(regen.messageID).SetContent(regen.content).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if assistant, err = save(tx.AIChatMessage); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return assistant, nil
+}
+
+// answer streams the assistant reply to the last user turn of the session,
+// or to the edited message of a regeneration. The history is trimmed to the
+// context budget, see fitContext.
+func (s *AIServiceImpl) answer(ctx context.Context, session *ent.AIChatSession, knowledge *model.AIKnowledgeContext, regen *regeneration, onDelta func(string) error) (*model.AIChatMessageResp, error) {
+	provider, systemPrompt, err := s.sessionConfig(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.loadContextSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastID := 0
+	if regen != nil {
+		lastID = regen.messageID
+	}
+	history, summary, err := s.chatHistory(ctx, session.ID, lastID)
+	if err != nil {
+		return nil, err
+	}
+	if regen != nil && len(history) > 0 && history[len(history)-1].ID == regen.messageID {
+		history[len(history)-1].Content = regen.content
+	}
+	requestMessages := make([]openai.ChatCompletionMessage, 0, len(history)+3)
+	if systemPrompt != "" {
+		requestMessages = append(requestMessages, openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: systemPrompt,
+		})
+	}
+	var citations []model.AIKnowledgeCitation
+	if knowledge != nil {
+		var prompt string
+		prompt, citations = knowledgePrompt(knowledge.Chunks)
+		requestMessages = append(requestMessages, openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: prompt,
+		})
+	}
+	history, summary = s.fitContext(ctx, session, provider, settings, requestTokens(requestMessages), history, summary)
+	if summary != nil {
+		requestMessages = append(requestMessages, openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: contextSummaryPrompt + summary.Content,
+		})
+	}
+	for _, message := range history {
 		requestMessages = append(requestMessages, openai.ChatCompletionMessage{
 			Role:    string(message.Role),
 			Content: message.Content,
 		})
 	}
 
-	stream, err := newOpenAIClient(provider.BaseURL, provider.APIKey).CreateChatCompletionStream(ctx, openai.ChatCompletionRequest{
+	output, usage, err := streamCompletion(ctx, newOpenAIClient(provider.BaseURL, provider.APIKey), openai.ChatCompletionRequest{
 		Model:            provider.Model,
 		Messages:         requestMessages,
 		MaxTokens:        provider.MaxTokens,
@@ -601,20 +779,74 @@ func (s *AIServiceImpl) StreamChat(ctx context.Context, userID, sessionID int, c
 		TopP:             float32(provider.TopP),
 		FrequencyPenalty: float32(provider.FrequencyPenalty),
 		PresencePenalty:  float32(provider.PresencePenalty),
-	})
+		StreamOptions:    &openai.StreamOptions{IncludeUsage: true},
+	}, onDelta)
 	if err != nil {
-		return nil, providerError(err)
+		return nil, err
+	}
+	estimated := requestTokens(requestMessages)
+	promptTokens, completionTokens := estimated, estimateTokens(output)
+	if usage != nil {
+		s.calibrateTokens(provider.Model, estimated, usage.PromptTokens)
+		promptTokens, completionTokens = usage.PromptTokens, usage.CompletionTokens
+	}
+
+	save := func(client *ent.AIChatMessageClient) (*ent.AIChatMessage, error) {
+		create := client.Create().
+			SetSessionID(session.ID).
+			SetRole(aichatmessage.RoleAssistant).
+			SetContent(output).
+			SetModel(provider.Model).
+			SetPromptTokens(promptTokens).
+			SetCompletionTokens(completionTokens)
+		if len(citations) > 0 {
+			stored := make([]schema.AIChatCitation, 0, len(citations))
+			for _, c := range citations {
+				stored = append(stored, schema.AIChatCitation{Kind: c.Kind, SourceID: c.SourceID, Title: c.Title, Slug: c.Slug})
+			}
+			create = create.SetCitations(stored)
+		}
+		return create.Save(ctx)
+	}
+	var assistant *ent.AIChatMessage
+	if regen != nil {
+		assistant, err = s.replaceBranch(ctx, session.ID, regen, save)
+	} else {
+		assistant, err = save(s.client.AIChatMessage)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = s.touchSession(ctx, session); err != nil {
+		return nil, err
+	}
+	resp := messageResponse(assistant)
+	return &resp, nil
+}
+
+// streamCompletion streams one chat completion, forwarding every non-empty
+// delta to onDelta, and returns the full output together with the token usage
+// when the provider reports it. On error the output streamed so far is still
+// returned.
+func streamCompletion(ctx context.Context, client *openai.Client, req openai.ChatCompletionRequest, onDelta func(string) error) (string, *openai.Usage, error) {
+	stream, err := client.CreateChatCompletionStream(ctx, req)
+	if err != nil {
+		return "", nil, providerError(err)
 	}
 	defer stream.Close()
 
 	var output strings.Builder
+	var usage *openai.Usage
 	for {
 		response, recvErr := stream.Recv()
 		if errors.Is(recvErr, io.EOF) {
 			break
 		}
 		if recvErr != nil {
-			return nil, providerError(recvErr)
+			return output.String(), usage, providerError(recvErr)
+		}
+		if response.Usage != nil {
+			usage = response.Usage
 		}
 		if len(response.Choices) == 0 {
 			continue
@@ -625,27 +857,53 @@ func (s *AIServiceImpl) StreamChat(ctx context.Context, userID, sessionID int, c
 		}
 		output.WriteString(delta)
 		if err := onDelta(delta); err != nil {
-			return nil, err
+			return output.String(), usage, err
 		}
 	}
 
 	if output.Len() == 0 {
-		return nil, ErrAIProviderEmptyResponse
+		return "", usage, ErrAIProviderEmptyResponse
 	}
-	assistant, err := s.client.AIChatMessage.Create().
-		SetSessionID(sessionID).
-		SetRole(aichatmessage.RoleAssistant).
-		SetContent(output.String()).
-		SetModel(provider.Model).
-		Save(ctx)
-	if err != nil {
+	return output.String(), usage, nil
+}
+
+func (s *AIServiceImpl) StreamPrompt(ctx context.Context, systemPrompt, userPrompt string, onDelta func(string) error) (*model.AIAnswerResult, error) {
+	if err := s.ensureCipher(); err != nil {
 		return nil, err
 	}
-	if err = s.touchSession(ctx, session); err != nil {
+	if strings.TrimSpace(userPrompt) == "" {
+		return nil, ErrInvalidAIChatContent
+	}
+	provider, err := s.providerConfig(ctx)
+	if err != nil {
 		return nil, err
 	}
-	resp := messageResponse(assistant)
-	return &resp, nil
+
+	messages := make([]openai.ChatCompletionMessage, 0, 2)
+	if strings.TrimSpace(systemPrompt) != "" {
+		messages = append(messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleSystem, Content: systemPrompt})
+	}
+	messages = append(messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleUser, Content: userPrompt})
+	output, usage, err := streamCompletion(ctx, newOpenAIClient(provider.BaseURL, provider.APIKey), openai.ChatCompletionRequest{
+		Model:            provider.Model,
+		Messages:         messages,
+		MaxTokens:        provider.MaxTokens,
+		Temperature:      float32(provider.Temperature),
+		TopP:             float32(provider.TopP),
+		FrequencyPenalty: float32(provider.FrequencyPenalty),
+		PresencePenalty:  float32(provider.PresencePenalty),
+		StreamOptions:    &openai.StreamOptions{IncludeUsage: true},
+	}, onDelta)
+
+	result := &model.AIAnswerResult{Content: output, Model: provider.Model}
+	if usage != nil {
+		result.PromptTokens = usage.PromptTokens
+		result.CompletionTokens = usage.CompletionTokens
+	} else {
+		result.PromptTokens = estimateTokens(systemPrompt) + estimateTokens(userPrompt)
+		result.CompletionTokens = estimateTokens(output)
+	}
+	return result, err
 }
 
 const (
@@ -707,6 +965,71 @@ func (s *AIServiceImpl) GenerateSummary(ctx context.Context, title, content stri
 	return summary, nil
 }
 
+const (
+	classifySystemPrompt = "你是一名博客评论审核助手。判断用户给出的评论是否为垃圾评论（广告、推广链接、钓鱼、辱骂、无意义灌水等）。" +
+		"只输出一个 JSON 对象，格式为 {\"score\": 0 到 1 之间的小数, \"reason\": \"简短的中文理由\"}，score 越高越可能是垃圾评论，不要输出其他内容。"
+	maxClassifyInputRunes  = 2000
+	maxClassifyReasonRunes = 200
+)
+
+// ClassifyComment 使用当前 AI 提供商为评论打垃圾评分
+func (s *AIServiceImpl) ClassifyComment(ctx context.Context, content string) (*model.CommentClassification, error) {
+	if err := s.ensureCipher(); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.providerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := content
+	if utf8.RuneCountInString(input) > maxClassifyInputRunes {
+		runes := []rune(input)
+		input = string(runes[:maxClassifyInputRunes])
+	}
+
+	resp, err := newOpenAIClient(provider.BaseURL, provider.APIKey).CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: provider.Model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: classifySystemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: input},
+		},
+		MaxTokens:   provider.MaxTokens,
+		Temperature: 0,
+	})
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, ErrAIProviderEmptyResponse
+	}
+
+	return parseClassification(resp.Choices[0].Message.Content)
+}
+
+// parseClassification 解析模型返回的评分 JSON，容忍代码块包裹和前后多余文字
+func parseClassification(output string) (*model.CommentClassification, error) {
+	start := strings.Index(output, "{")
+	end := strings.LastIndex(output, "}")
+	if start < 0 || end <= start {
+		return nil, ErrAIProviderEmptyResponse
+	}
+
+	var result model.CommentClassification
+	if err := json.Unmarshal([]byte(output[start:end+1]), &result); err != nil {
+		return nil, fmt.Errorf("parse AI classification: %w", err)
+	}
+	result.Score = math.Min(math.Max(result.Score, 0), 1)
+	result.Reason = strings.TrimSpace(result.Reason)
+	if utf8.RuneCountInString(result.Reason) > maxClassifyReasonRunes {
+		runes := []rune(result.Reason)
+		result.Reason = string(runes[:maxClassifyReasonRunes])
+	}
+	return &result, nil
+}
+
 type providerConfig struct {
 	BaseURL          string
 	APIKey           string
@@ -716,6 +1039,7 @@ type providerConfig struct {
 	TopP             float64
 	FrequencyPenalty float64
 	PresencePenalty  float64
+	ContextWindow    int
 }
 
 func (s *AIServiceImpl) ensureCipher() error {
@@ -744,6 +1068,10 @@ func (s *AIServiceImpl) providerConfig(ctx context.Context) (*providerConfig, er
 		}
 		return nil, err
 	}
+	return s.configFor(provider, m)
+}
+
+func (s *AIServiceImpl) configFor(provider *ent.AIProvider, m *ent.AIModel) (*providerConfig, error) {
 	cipher, err := s.cipher()
 	if err != nil {
 		return nil, err
@@ -761,6 +1089,7 @@ func (s *AIServiceImpl) providerConfig(ctx context.Context) (*providerConfig, er
 		TopP:             provider.TopP,
 		FrequencyPenalty: provider.FrequencyPenalty,
 		PresencePenalty:  provider.PresencePenalty,
+		ContextWindow:    m.ContextWindow,
 	}, nil
 }
 
@@ -788,6 +1117,7 @@ func providerResponse(provider *ent.AIProvider) model.AIProviderResp {
 		ProviderType:     provider.ProviderType,
 		BaseURL:          provider.BaseURL,
 		APIKeyConfigured: provider.APIKeyCiphertext != "",
+		EmbeddingModel:   provider.EmbeddingModel,
 		Temperature:      provider.Temperature,
 		MaxTokens:        provider.MaxTokens,
 		TopP:             provider.TopP,
@@ -803,11 +1133,12 @@ func providerResponse(provider *ent.AIProvider) model.AIProviderResp {
 	}
 	for _, m := range provider.Edges.Models {
 		resp.Models = append(resp.Models, model.AIModelResp{
-			ID:          m.ID,
-			ModelName:   m.ModelName,
-			DisplayName: m.DisplayName,
-			IsEnabled:   m.IsEnabled,
-			Sort:        m.Sort,
+			ID:            m.ID,
+			ModelName:     m.ModelName,
+			DisplayName:   m.DisplayName,
+			IsEnabled:     m.IsEnabled,
+			Sort:          m.Sort,
+			ContextWindow: m.ContextWindow,
 		})
 	}
 	return resp
@@ -853,21 +1184,33 @@ func defaultProviderName(rawURL string) string {
 
 func sessionResponse(session *ent.AIChatSession) model.AIChatSessionResp {
 	return model.AIChatSessionResp{
-		ID:        session.ID,
-		Title:     session.Title,
-		CreatedAt: model.LocalTime(session.CreatedAt),
-		UpdatedAt: model.LocalTime(session.UpdatedAt),
+		ID:           session.ID,
+		Title:        session.Title,
+		ModelID:      session.ModelID,
+		PersonaID:    session.PersonaID,
+		SystemPrompt: session.SystemPrompt,
+		Temperature:  session.Temperature,
+		MaxTokens:    session.MaxTokens,
+		CreatedAt:    model.LocalTime(session.CreatedAt),
+		UpdatedAt:    model.LocalTime(session.UpdatedAt),
 	}
 }
 
 func messageResponse(message *ent.AIChatMessage) model.AIChatMessageResp {
-	return model.AIChatMessageResp{
-		ID:        message.ID,
-		Role:      string(message.Role),
-		Content:   message.Content,
-		Model:     message.Model,
-		CreatedAt: model.LocalTime(message.CreatedAt),
+	resp := model.AIChatMessageResp{
+		ID:               message.ID,
+		Role:             string(message.Role),
+		Content:          message.Content,
+		Model:            message.Model,
+		PromptTokens:     message.PromptTokens,
+		CompletionTokens: message.CompletionTokens,
+		SummaryUntil:     message.SummaryUntil,
+		CreatedAt:        model.LocalTime(message.CreatedAt),
+	}
+	for _, c := range message.Citations {
+		resp.Citations = append(resp.Citations, model.AIKnowledgeCitation{Kind: c.Kind, SourceID: c.SourceID, Title: c.Title, Slug: c.Slug})
 	}
+	return resp
 }
 
 func validateProvider(req model.AIProviderReq) error {
@@ -902,6 +1245,9 @@ func validateModel(req model.AIModelReq) error {
 	if strings.TrimSpace(req.ModelName) == "" {
 		return fmt.Errorf("%w: model_name is required", ErrInvalidAIConfig)
 	}
+	if req.ContextWindow < 0 {
+		return fmt.Errorf("%w: context_window must not be negative", ErrInvalidAIConfig)
+	}
 	return nil
 }
 
@@ -0,0 +1,601 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/essay"
+	"github.com/shuTwT/hoshikuzu/ent/post"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/internal/infra/database"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	"github.com/shuTwT/hoshikuzu/internal/infra/markdown"
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/philippgille/chromem-go"
+)
+
+const (
+	chunkSize    = 800
+	chunkOverlap = 100
+	defaultTopK  = 5
+	maxTopK      = 20
+	// indexTimeout bounds the background indexing of one source.
+	indexTimeout = 2 * time.Minute
+)
+
+// Metadata keys stored with every chunk.
+const (
+	metaKind       = "kind"
+	metaSource     = "source"
+	metaSourceID   = "source_id"
+	metaTitle      = "title"
+	metaSlug       = "slug"
+	metaModel      = "model"
+	metaRestricted = "restricted"
+)
+
+var (
+	ErrKnowledgeRebuilding  = errors.New("knowledge base rebuild is already running")
+	ErrInvalidKnowledgeKind = errors.New("invalid knowledge source kind")
+)
+
+var blankLines = regexp.MustCompile(`\n[ \t]*\n+`)
+
+// Embedder turns texts into vectors. AIService implements it through the
+// configured provider's embeddings endpoint.
+type Embedder interface {
+	Embed(ctx context.Context, inputs []string) (*model.AIEmbeddingResult, error)
+}
+
+// KnowledgeService keeps site content in the chromem knowledge base and
+// retrieves it for knowledge-mode chat.
+type KnowledgeService interface {
+	// Search returns the chunks most similar to query. topK <= 0 uses the
+	// configured default.
+	Search(ctx context.Context, query string, topK int) ([]model.AIKnowledgeChunk, error)
+	// SearchPublic is Search restricted to posts every visitor can read: no
+	// essays or products, and no pay- or comment-gated posts.
+	SearchPublic(ctx context.Context, query string, topK int) ([]model.AIKnowledgeChunk, error)
+	// SyncSource re-indexes one source, or removes it when it is no longer
+	// published, visible or enabled.
+	SyncSource(ctx context.Context, kind string, id int) error
+	// Rebuild starts a background re-index of all enabled sources.
+	Rebuild(ctx context.Context) error
+	Status(ctx context.Context) (*model.AIKnowledgeStatusResp, error)
+	// HandleEvent keeps the knowledge base in sync with content events.
+	HandleEvent(ctx context.Context, e event.Event) error
+}
+
+type KnowledgeServiceImpl struct {
+	client         *ent.Client
+	settingService setting_service.SettingService
+	embedder       Embedder
+	collection     func() (*chromem.Collection, error)
+
+	// mu serialises writes so concurrent syncs of one source cannot
+	// interleave their delete and add.
+	mu sync.Mutex
+
+	statusMu   sync.Mutex
+	rebuilding bool
+	lastSource int
+	lastAt     *time.Time
+	lastErr    string
+}
+
+func NewKnowledgeServiceImpl(client *ent.Client, settingService setting_service.SettingService, embedder Embedder) *KnowledgeServiceImpl {
+	return &KnowledgeServiceImpl{
+		client:         client,
+		settingService: settingService,
+		embedder:       embedder,
+		collection: func() (*chromem.Collection, error) {
+			return database.GetCollection(database.KnowledgeBaseCollection)
+		},
+	}
+}
+
+// knowledgeSettings mirrors the JSON stored under key 'ai_knowledge'. Posts
+// are always indexed; essays and products are opt-in.
+type knowledgeSettings struct {
+	Essays   bool `json:"essays"`
+	Products bool `json:"products"`
+	TopK     int  `json:"topK"`
+}
+
+// source is one piece of site content prepared for indexing.
+type source struct {
+	Kind       string
+	ID         int
+	Title      string
+	Slug       string
+	Text       string
+	Restricted bool
+}
+
+func (s source) key() string {
+	return s.Kind + ":" + strconv.Itoa(s.ID)
+}
+
+func (s *KnowledgeServiceImpl) Search(ctx context.Context, query string, topK int) ([]model.AIKnowledgeChunk, error) {
+	return s.search(ctx, query, topK, nil)
+}
+
+func (s *KnowledgeServiceImpl) SearchPublic(ctx context.Context, query string, topK int) ([]model.AIKnowledgeChunk, error) {
+	chunks, err := s.search(ctx, query, topK, map[string]string{
+		metaKind:       model.KnowledgeKindPost,
+		metaRestricted: "false",
+	})
+	if err != nil || len(chunks) == 0 {
+		return chunks, err
+	}
+	// The index is synced asynchronously, so re-check visibility in case a
+	// post was unpublished moments ago.
+	ids := make([]int, 0, len(chunks))
+	for _, chunk := range chunks {
+		ids = append(ids, chunk.SourceID)
+	}
+	visible, err := s.client.Post.Query().
+		Where(post.IDIn(ids...), post.StatusEQ(post.StatusPublished), post.IsVisibleEQ(true),
+			post.IsVisibleAfterPayEQ(false), post.IsVisibleAfterCommentEQ(false)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[int]bool, len(visible))
+	for _, id := range visible {
+		allowed[id] = true
+	}
+	filtered := chunks[:0]
+	for _, chunk := range chunks {
+		if allowed[chunk.SourceID] {
+			filtered = append(filtered, chunk)
+		}
+	}
+	return filtered, nil
+}
+
+// search embeds query and returns the topK nearest chunks whose metadata
+// matches where, always restricted to the current embedding model.
+func (s *KnowledgeServiceImpl) search(ctx context.Context, query string, topK int, where map[string]string) ([]model.AIKnowledgeChunk, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+	if topK <= 0 {
+		ks, err := s.loadSettings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		topK = ks.TopK
+	}
+	topK = max(1, min(topK, maxTopK))
+
+	col, err := s.collection()
+	if err != nil {
+		return nil, err
+	}
+	n := min(topK, col.Count())
+	if n == 0 {
+		return []model.AIKnowledgeChunk{}, nil
+	}
+	embedded, err := s.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, err
+	}
+	// Only chunks produced by the current embedding model are comparable.
+	filter := map[string]string{metaModel: embedded.Model}
+	for k, v := range where {
+		filter[k] = v
+	}
+	results, err := col.QueryEmbedding(ctx, embedded.Vectors[0], n, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	chunks := make([]model.AIKnowledgeChunk, 0, len(results))
+	for _, r := range results {
+		id, _ := strconv.Atoi(r.Metadata[metaSourceID])
+		chunks = append(chunks, model.AIKnowledgeChunk{
+			Kind:       r.Metadata[metaKind],
+			SourceID:   id,
+			Title:      r.Metadata[metaTitle],
+			Slug:       r.Metadata[metaSlug],
+			Content:    r.Content,
+			Similarity: r.Similarity,
+		})
+	}
+	return chunks, nil
+}
+
+func (s *KnowledgeServiceImpl) SyncSource(ctx context.Context, kind string, id int) error {
+	ks, err := s.loadSettings(ctx)
+	if err != nil {
+		return err
+	}
+	src, err := s.loadSource(ctx, ks, kind, id)
+	if err != nil {
+		return err
+	}
+	col, err := s.collection()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if src == nil {
+		return removeSource(ctx, col, kind+":"+strconv.Itoa(id))
+	}
+	return s.indexSource(ctx, col, src)
+}
+
+func (s *KnowledgeServiceImpl) Rebuild(ctx context.Context) error {
+	s.statusMu.Lock()
+	if s.rebuilding {
+		s.statusMu.Unlock()
+		return ErrKnowledgeRebuilding
+	}
+	s.rebuilding = true
+	s.statusMu.Unlock()
+
+	go func() {
+		count, err := s.rebuild(context.WithoutCancel(ctx))
+		now := time.Now()
+		s.statusMu.Lock()
+		defer s.statusMu.Unlock()
+		s.rebuilding = false
+		s.lastSource = count
+		s.lastAt = &now
+		s.lastErr = ""
+		if err != nil {
+			s.lastErr = err.Error()
+			slog.Error("重建站点知识库失败", "error", err.Error())
+		}
+	}()
+	return nil
+}
+
+func (s *KnowledgeServiceImpl) rebuild(ctx context.Context) (int, error) {
+	ks, err := s.loadSettings(ctx)
+	if err != nil {
+		return 0, err
+	}
+	col, err := s.collection()
+	if err != nil {
+		return 0, err
+	}
+	sources, err := s.loadAllSources(ctx, ks)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, kind := range []string{model.KnowledgeKindPost, model.KnowledgeKindEssay, model.KnowledgeKindProduct} {
+		if err := col.Delete(ctx, map[string]string{metaKind: kind}, nil); err != nil {
+			return 0, err
+		}
+	}
+	for i := range sources {
+		if err := s.indexSource(ctx, col, &sources[i]); err != nil {
+			return i, fmt.Errorf("索引 %s 失败: %w", sources[i].key(), err)
+		}
+	}
+	return len(sources), nil
+}
+
+func (s *KnowledgeServiceImpl) Status(ctx context.Context) (*model.AIKnowledgeStatusResp, error) {
+	col, err := s.collection()
+	if err != nil {
+		return nil, err
+	}
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+	resp := &model.AIKnowledgeStatusResp{
+		Chunks:             col.Count(),
+		Rebuilding:         s.rebuilding,
+		LastRebuildSources: s.lastSource,
+		LastRebuildError:   s.lastErr,
+	}
+	if s.lastAt != nil {
+		at := model.LocalTime(*s.lastAt)
+		resp.LastRebuildAt = &at
+	}
+	return resp, nil
+}
+
+func (s *KnowledgeServiceImpl) HandleEvent(ctx context.Context, e event.Event) error {
+	kind, id, ok := eventSource(e)
+	if !ok {
+		return nil
+	}
+	// Embedding calls the provider, so never hold up the publishing request.
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), indexTimeout)
+		defer cancel()
+		err := s.SyncSource(ctx, kind, id)
+		// Sites without an embedding model simply have no knowledge base.
+		if err != nil && !errors.Is(err, ai_service.ErrAIEmbeddingNotFound) {
+			slog.Warn("同步站点知识库失败", "kind", kind, "id", id, "event", e.Name, "error", err.Error())
+		}
+	}()
+	return nil
+}
+
+// indexSource replaces the chunks of src. New vectors are computed before the
+// old chunks are removed so a provider failure keeps the previous index.
+func (s *KnowledgeServiceImpl) indexSource(ctx context.Context, col *chromem.Collection, src *source) error {
+	chunks := chunkText(src.Text, chunkSize, chunkOverlap)
+	if len(chunks) == 0 {
+		return removeSource(ctx, col, src.key())
+	}
+	inputs := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		inputs[i] = src.Title + "\n" + chunk
+	}
+	embedded, err := s.embedder.Embed(ctx, inputs)
+	if err != nil {
+		return err
+	}
+	if len(embedded.Vectors) != len(chunks) {
+		return fmt.Errorf("向量数量 %d 与片段数量 %d 不一致", len(embedded.Vectors), len(chunks))
+	}
+
+	docs := make([]chromem.Document, len(chunks))
+	for i, chunk := range chunks {
+		docs[i] = chromem.Document{
+			ID: fmt.Sprintf("%s#%d", src.key(), i),
+			Metadata: map[string]string{
+				metaKind:       src.Kind,
+				metaSource:     src.key(),
+				metaSourceID:   strconv.Itoa(src.ID),
+				metaTitle:      src.Title,
+				metaSlug:       src.Slug,
+				metaModel:      embedded.Model,
+				metaRestricted: strconv.FormatBool(src.Restricted),
+			},
+			Embedding: embedded.Vectors[i],
+			Content:   chunk,
+		}
+	}
+	if err := removeSource(ctx, col, src.key()); err != nil {
+		return err
+	}
+	return col.AddDocuments(ctx, docs, 4)
+}
+
+func removeSource(ctx context.Context, col *chromem.Collection, key string) error {
+	return col.Delete(ctx, map[string]string{metaSource: key}, nil)
+}
+
+// loadSource returns nil when the source should not be in the knowledge base.
+func (s *KnowledgeServiceImpl) loadSource(ctx context.Context, ks *knowledgeSettings, kind string, id int) (*source, error) {
+	switch kind {
+	case model.KnowledgeKindPost:
+		entity, err := s.client.Post.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if entity.Status != post.StatusPublished || !entity.IsVisible {
+			return nil, nil
+		}
+		src := postSource(entity)
+		return &src, nil
+	case model.KnowledgeKindEssay:
+		if !ks.Essays {
+			return nil, nil
+		}
+		entity, err := s.client.Essay.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if entity.Draft || !entity.Public {
+			return nil, nil
+		}
+		src := essaySource(entity)
+		return &src, nil
+	case model.KnowledgeKindProduct:
+		if !ks.Products {
+			return nil, nil
+		}
+		entity, err := s.client.Product.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if !entity.Active {
+			return nil, nil
+		}
+		src := productSource(entity)
+		return &src, nil
+	default:
+		return nil, ErrInvalidKnowledgeKind
+	}
+}
+
+func (s *KnowledgeServiceImpl) loadAllSources(ctx context.Context, ks *knowledgeSettings) ([]source, error) {
+	posts, err := s.client.Post.Query().
+		Where(post.StatusEQ(post.StatusPublished), post.IsVisibleEQ(true)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]source, 0, len(posts))
+	for _, entity := range posts {
+		sources = append(sources, postSource(entity))
+	}
+	if ks.Essays {
+		essays, err := s.client.Essay.Query().
+			Where(essay.DraftEQ(false), essay.PublicEQ(true)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range essays {
+			sources = append(sources, essaySource(entity))
+		}
+	}
+	if ks.Products {
+		products, err := s.client.Product.Query().
+			Where(product.ActiveEQ(true)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range products {
+			sources = append(sources, productSource(entity))
+		}
+	}
+	return sources, nil
+}
+
+func (s *KnowledgeServiceImpl) loadSettings(ctx context.Context) (*knowledgeSettings, error) {
+	ks := &knowledgeSettings{TopK: defaultTopK}
+	setting, err := s.settingService.GetSettingByKey(ctx, model.SettingKeyAIKnowledge)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ks, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(setting.Value), ks); err != nil {
+		return nil, fmt.Errorf("解析站点知识库配置失败: %w", err)
+	}
+	if ks.TopK <= 0 {
+		ks.TopK = defaultTopK
+	}
+	return ks, nil
+}
+
+// postSource indexes only what every reader can see: hidden blocks are
+// stripped, and pay- or comment-gated posts are marked restricted.
+func postSource(entity *ent.Post) source {
+	content := entity.Content
+	if entity.MdContent != nil && *entity.MdContent != "" {
+		content = *entity.MdContent
+	}
+	text := plainText(markdown.StripHidden(content, ""))
+	if entity.Summary != "" {
+		text = entity.Summary + "\n\n" + text
+	}
+	src := source{
+		Kind:       model.KnowledgeKindPost,
+		ID:         entity.ID,
+		Title:      entity.Title,
+		Text:       text,
+		Restricted: entity.IsVisibleAfterPay || entity.IsVisibleAfterComment,
+	}
+	if entity.Slug != nil {
+		src.Slug = *entity.Slug
+	}
+	return src
+}
+
+func essaySource(entity *ent.Essay) source {
+	text := plainText(entity.Content)
+	title := text
+	if utf8.RuneCountInString(title) > 30 {
+		title = string([]rune(title)[:30]) + "..."
+	}
+	return source{
+		Kind:  model.KnowledgeKindEssay,
+		ID:    entity.ID,
+		Title: title,
+		Text:  text,
+	}
+}
+
+func productSource(entity *ent.Product) source {
+	parts := []string{entity.ShortDescription, plainText(entity.Description)}
+	return source{
+		Kind:  model.KnowledgeKindProduct,
+		ID:    entity.ID,
+		Title: entity.Name,
+		Text:  strings.TrimSpace(strings.Join(parts, "\n\n")),
+	}
+}
+
+// eventSource maps a content event to the source it affects.
+func eventSource(e event.Event) (string, int, bool) {
+	switch data := e.Data.(type) {
+	case model.PostEventData:
+		return model.KnowledgeKindPost, data.ID, true
+	case model.EssayEventData:
+		return model.KnowledgeKindEssay, data.ID, true
+	case model.ProductEventData:
+		return model.KnowledgeKindProduct, data.ID, true
+	default:
+		return "", 0, false
+	}
+}
+
+var textPolicy = bluemonday.StrictPolicy()
+
+// plainText strips HTML tags and collapses blank lines.
+func plainText(s string) string {
+	s = html.UnescapeString(textPolicy.Sanitize(s))
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.TrimSpace(blankLines.ReplaceAllString(s, "\n\n"))
+}
+
+// chunkText splits text into chunks of at most size runes. Each chunk after
+// the first repeats the last overlap runes of the previous one, and cuts
+// prefer a paragraph or sentence boundary in the second half of the window.
+func chunkText(text string, size, overlap int) []string {
+	runes := []rune(strings.TrimSpace(text))
+	var chunks []string
+	for start := 0; start < len(runes); {
+		end := min(start+size, len(runes))
+		if end < len(runes) {
+			half := start + size/2
+			for i := end - 1; i >= half; i-- {
+				if isBoundary(runes[i]) {
+					end = i + 1
+					break
+				}
+			}
+		}
+		if chunk := strings.TrimSpace(string(runes[start:end])); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		if end == len(runes) {
+			break
+		}
+		next := end - overlap
+		if next <= start {
+			next = end
+		}
+		start = next
+	}
+	return chunks
+}
+
+func isBoundary(r rune) bool {
+	switch r {
+	case '\n', '。', '！', '？', '.', '!', '?':
+		return true
+	}
+	return false
+}
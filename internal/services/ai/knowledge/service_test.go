@@ -0,0 +1,222 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/enttest"
+	"github.com/shuTwT/hoshikuzu/ent/post"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/philippgille/chromem-go"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func TestChunkText(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		size       int
+		overlap    int
+		wantChunks int
+	}{
+		{"空文本", "   ", 10, 2, 0},
+		{"短文本", "hello", 10, 2, 1},
+		{"按长度切分", strings.Repeat("a", 25), 10, 2, 3},
+		{"重叠不小于长度", strings.Repeat("a", 25), 10, 10, 3},
+	}
+	for _, tt := range tests {
+		chunks := chunkText(tt.text, tt.size, tt.overlap)
+		if len(chunks) != tt.wantChunks {
+			t.Errorf("%s: got %d chunks %q, want %d", tt.name, len(chunks), chunks, tt.wantChunks)
+		}
+		for _, chunk := range chunks {
+			if n := utf8.RuneCountInString(chunk); n > tt.size {
+				t.Errorf("%s: chunk %q has %d runes, want <= %d", tt.name, chunk, n, tt.size)
+			}
+		}
+	}
+
+	chunks := chunkText("第一句话。第二句话很长很长。第三句", 16, 2)
+	if len(chunks) < 2 || !strings.HasSuffix(chunks[0], "。") {
+		t.Errorf("sentence boundary not preferred: %q", chunks)
+	}
+}
+
+var testVocabulary = []string{"go", "rust", "咖啡"}
+
+// newFakeEmbedder embeds through an OpenAI-compatible test server whose
+// vectors count vocabulary words, so related texts are similar.
+func newFakeEmbedder(t *testing.T, modelName string) Embedder {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input []string `json:"input"`
+			Model string   `json:"model"`
+		}
+		if r.URL.Path != "/embeddings" || json.NewDecoder(r.Body).Decode(&req) != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		data := make([]map[string]any, len(req.Input))
+		for i, input := range req.Input {
+			vector := []float32{0.01}
+			for _, word := range testVocabulary {
+				vector = append(vector, float32(strings.Count(strings.ToLower(input), word)))
+			}
+			data[i] = map[string]any{"object": "embedding", "index": i, "embedding": vector}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"object": "list", "model": req.Model, "data": data})
+	}))
+	t.Cleanup(server.Close)
+	config := openai.DefaultConfig("test-key")
+	config.BaseURL = server.URL
+	return &openAIEmbedder{client: openai.NewClientWithConfig(config), model: modelName}
+}
+
+type openAIEmbedder struct {
+	client *openai.Client
+	model  string
+}
+
+func (e *openAIEmbedder) Embed(ctx context.Context, inputs []string) (*model.AIEmbeddingResult, error) {
+	resp, err := e.client.CreateEmbeddings(ctx, openai.EmbeddingRequestStrings{Input: inputs, Model: openai.EmbeddingModel(e.model)})
+	if err != nil {
+		return nil, err
+	}
+	result := &model.AIEmbeddingResult{Model: e.model}
+	for _, item := range resp.Data {
+		result.Vectors = append(result.Vectors, item.Embedding)
+	}
+	return result, nil
+}
+
+func newTestService(t *testing.T, embedder Embedder) (*KnowledgeServiceImpl, *chromem.Collection) {
+	t.Helper()
+	col, err := chromem.NewDB().CreateCollection("test", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &KnowledgeServiceImpl{
+		embedder:   embedder,
+		collection: func() (*chromem.Collection, error) { return col, nil },
+	}, col
+}
+
+func TestIndexAndSearch(t *testing.T) {
+	ctx := context.Background()
+	s, col := newTestService(t, newFakeEmbedder(t, "embed-a"))
+
+	sources := []source{
+		{Kind: model.KnowledgeKindPost, ID: 1, Title: "Go 并发", Slug: "go-concurrency", Text: "go go go 协程与通道"},
+		{Kind: model.KnowledgeKindPost, ID: 2, Title: "Rust 所有权", Slug: "rust-ownership", Text: "rust rust 借用检查"},
+		{Kind: model.KnowledgeKindProduct, ID: 3, Title: "咖啡豆", Text: "咖啡 咖啡 手冲"},
+	}
+	for i := range sources {
+		if err := s.indexSource(ctx, col, &sources[i]); err != nil {
+			t.Fatalf("indexSource(%s) error = %v", sources[i].key(), err)
+		}
+	}
+
+	chunks, err := s.Search(ctx, "rust 的借用", 1)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(chunks) != 1 || chunks[0].SourceID != 2 || chunks[0].Slug != "rust-ownership" {
+		t.Fatalf("Search() = %+v, want rust-ownership", chunks)
+	}
+
+	// topK larger than the collection must not fail.
+	if chunks, err = s.Search(ctx, "go", 10); err != nil || len(chunks) != 3 {
+		t.Fatalf("Search(topK=10) = %d chunks, %v", len(chunks), err)
+	}
+
+	// Re-indexing replaces the old chunks of the same source.
+	sources[1].Text = "咖啡 咖啡 咖啡"
+	if err := s.indexSource(ctx, col, &sources[1]); err != nil {
+		t.Fatal(err)
+	}
+	if col.Count() != 3 {
+		t.Fatalf("Count() = %d after re-index, want 3", col.Count())
+	}
+	chunks, _ = s.Search(ctx, "rust", 3)
+	for _, chunk := range chunks {
+		if strings.Contains(chunk.Content, "借用") {
+			t.Errorf("stale chunk still indexed: %+v", chunk)
+		}
+	}
+
+	if err := removeSource(ctx, col, sources[0].key()); err != nil {
+		t.Fatal(err)
+	}
+	if col.Count() != 2 {
+		t.Errorf("Count() = %d after remove, want 2", col.Count())
+	}
+}
+
+func TestSearchIgnoresOtherModels(t *testing.T) {
+	ctx := context.Background()
+	s, col := newTestService(t, newFakeEmbedder(t, "embed-a"))
+	src := source{Kind: model.KnowledgeKindPost, ID: 1, Title: "Go", Text: "go"}
+	if err := s.indexSource(ctx, col, &src); err != nil {
+		t.Fatal(err)
+	}
+
+	s.embedder = newFakeEmbedder(t, "embed-b")
+	chunks, err := s.Search(ctx, "go", 5)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(chunks) != 0 {
+		t.Errorf("Search() = %+v, want no chunks from another model", chunks)
+	}
+}
+
+func TestSearchPublic(t *testing.T) {
+	ctx := context.Background()
+	s, col := newTestService(t, newFakeEmbedder(t, "embed-a"))
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "knowledge.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	s.client = client
+
+	newPost := func(title string) *ent.PostCreate {
+		return client.Post.Create().SetTitle(title).SetContent("go").SetStatus(post.StatusPublished)
+	}
+	public := newPost("公开").SaveX(ctx)
+	draft := newPost("草稿").SetStatus(post.StatusDraft).SaveX(ctx)
+	hidden := newPost("隐藏").SetIsVisible(false).SaveX(ctx)
+	paid := newPost("付费").SetIsVisibleAfterPay(true).SetPrice(100).SaveX(ctx)
+	// Switched to comment-only after it was indexed, before the index synced.
+	commented := newPost("评论后可见").SetIsVisibleAfterComment(true).SaveX(ctx)
+
+	sources := []source{
+		{Kind: model.KnowledgeKindPost, ID: public.ID, Title: public.Title, Text: "go"},
+		{Kind: model.KnowledgeKindPost, ID: draft.ID, Title: draft.Title, Text: "go"},
+		{Kind: model.KnowledgeKindPost, ID: hidden.ID, Title: hidden.Title, Text: "go"},
+		{Kind: model.KnowledgeKindPost, ID: paid.ID, Title: paid.Title, Text: "go", Restricted: true},
+		{Kind: model.KnowledgeKindPost, ID: commented.ID, Title: commented.Title, Text: "go"},
+		{Kind: model.KnowledgeKindProduct, ID: 1, Title: "商品", Text: "go"},
+	}
+	for i := range sources {
+		if err := s.indexSource(ctx, col, &sources[i]); err != nil {
+			t.Fatalf("indexSource(%s) error = %v", sources[i].key(), err)
+		}
+	}
+
+	chunks, err := s.SearchPublic(ctx, "go", maxTopK)
+	if err != nil {
+		t.Fatalf("SearchPublic() error = %v", err)
+	}
+	if len(chunks) != 1 || chunks[0].Kind != model.KnowledgeKindPost || chunks[0].SourceID != public.ID {
+		t.Errorf("SearchPublic() = %+v, want only post %d", chunks, public.ID)
+	}
+}
@@ -0,0 +1,226 @@
+package writing
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+const (
+	maxTitles      = 10
+	maxKeywords    = 10
+	maxSlugLength  = 60
+	maxTags        = 5
+	maxCategories  = 3
+	maxDiffCells   = 1 << 22
+	diffOpEqual    = "equal"
+	diffOpInsert   = "insert"
+	diffOpDelete   = "delete"
+	codeFenceToken = "```"
+)
+
+var (
+	listMarker  = regexp.MustCompile(`^(\d+[.、)）]|[-*•])\s*`)
+	slugInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// parseTitles reads one title per line, dropping list markers, quotes and
+// duplicates.
+func parseTitles(output string) []string {
+	var titles []string
+	seen := map[string]bool{}
+	for _, line := range strings.Split(output, "\n") {
+		title := strings.TrimSpace(listMarker.ReplaceAllString(strings.TrimSpace(line), ""))
+		title = strings.Trim(title, "\"'“”‘’《》「」")
+		title = strings.TrimSpace(title)
+		if title == "" || seen[title] {
+			continue
+		}
+		seen[title] = true
+		titles = append(titles, title)
+		if len(titles) == maxTitles {
+			break
+		}
+	}
+	return titles
+}
+
+// parseJSONObject decodes the first JSON object in output, tolerating code
+// fences and text around it.
+func parseJSONObject(output string, v any) error {
+	start := strings.Index(output, "{")
+	end := strings.LastIndex(output, "}")
+	if start < 0 || end <= start {
+		return ai_service.ErrAIProviderEmptyResponse
+	}
+	return json.Unmarshal([]byte(output[start:end+1]), v)
+}
+
+func parseSEO(output string) ([]string, string, error) {
+	var resp struct {
+		Keywords []string `json:"keywords"`
+		Slug     string   `json:"slug"`
+	}
+	if err := parseJSONObject(output, &resp); err != nil {
+		return nil, "", err
+	}
+	var keywords []string
+	seen := map[string]bool{}
+	for _, k := range resp.Keywords {
+		k = strings.TrimSpace(k)
+		if k == "" || seen[strings.ToLower(k)] {
+			continue
+		}
+		seen[strings.ToLower(k)] = true
+		keywords = append(keywords, k)
+		if len(keywords) == maxKeywords {
+			break
+		}
+	}
+	return keywords, normalizeSlug(resp.Slug), nil
+}
+
+// normalizeSlug keeps lowercase ASCII letters and digits joined by single
+// hyphens.
+func normalizeSlug(s string) string {
+	s = strings.Trim(slugInvalid.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	if len(s) > maxSlugLength {
+		s = strings.TrimRight(s[:maxSlugLength], "-")
+	}
+	return s
+}
+
+// parseTaxonomy maps the suggested names onto existing terms. Names that do
+// not exist are dropped, so the model cannot invent new tags.
+func parseTaxonomy(output string, tags, categories []model.AIWritingTerm) ([]model.AIWritingTerm, []model.AIWritingTerm, error) {
+	var resp struct {
+		Tags       []string `json:"tags"`
+		Categories []string `json:"categories"`
+	}
+	if err := parseJSONObject(output, &resp); err != nil {
+		return nil, nil, err
+	}
+	return matchTerms(resp.Tags, tags, maxTags), matchTerms(resp.Categories, categories, maxCategories), nil
+}
+
+func matchTerms(names []string, terms []model.AIWritingTerm, limit int) []model.AIWritingTerm {
+	byName := make(map[string]model.AIWritingTerm, len(terms))
+	for _, t := range terms {
+		byName[strings.ToLower(strings.TrimSpace(t.Name))] = t
+	}
+	var matched []model.AIWritingTerm
+	seen := map[int]bool{}
+	for _, name := range names {
+		t, ok := byName[strings.ToLower(strings.TrimSpace(name))]
+		if !ok || seen[t.ID] {
+			continue
+		}
+		seen[t.ID] = true
+		matched = append(matched, t)
+		if len(matched) == limit {
+			break
+		}
+	}
+	return matched
+}
+
+// unwrapFence removes a code fence the model put around the whole output,
+// unless the original text itself starts with one.
+func unwrapFence(output, original string) string {
+	output = strings.TrimSpace(output)
+	if strings.HasPrefix(strings.TrimSpace(original), codeFenceToken) ||
+		!strings.HasPrefix(output, codeFenceToken) || !strings.HasSuffix(output, codeFenceToken) || len(output) < 2*len(codeFenceToken) {
+		return output
+	}
+	body := strings.TrimSuffix(output, codeFenceToken)
+	if i := strings.Index(body, "\n"); i >= 0 {
+		return strings.TrimSpace(body[i+1:])
+	}
+	return output
+}
+
+// diffLines returns a line diff of a and b based on the longest common
+// subsequence. Inputs too large for the table fall back to replacing
+// everything.
+func diffLines(a, b string) []model.AIWritingDiffLine {
+	x := splitLines(a)
+	y := splitLines(b)
+	if len(x)*len(y) > maxDiffCells {
+		diff := make([]model.AIWritingDiffLine, 0, len(x)+len(y))
+		for _, line := range x {
+			diff = append(diff, model.AIWritingDiffLine{Op: diffOpDelete, Text: line})
+		}
+		for _, line := range y {
+			diff = append(diff, model.AIWritingDiffLine{Op: diffOpInsert, Text: line})
+		}
+		return diff
+	}
+
+	// lcs[i][j] is the LCS length of x[i:] and y[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	diff := make([]model.AIWritingDiffLine, 0, max(len(x), len(y)))
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			diff = append(diff, model.AIWritingDiffLine{Op: diffOpEqual, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, model.AIWritingDiffLine{Op: diffOpDelete, Text: x[i]})
+			i++
+		default:
+			diff = append(diff, model.AIWritingDiffLine{Op: diffOpInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		diff = append(diff, model.AIWritingDiffLine{Op: diffOpDelete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		diff = append(diff, model.AIWritingDiffLine{Op: diffOpInsert, Text: y[j]})
+	}
+	return diff
+}
+
+func splitLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// tail returns the last n runes of s.
+func tail(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[len(runes)-n:])
+}
+
+// head returns the first n runes of s.
+func head(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
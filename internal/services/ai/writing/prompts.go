@@ -0,0 +1,131 @@
+package writing
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// Post authoring actions, also used as the keys of the prompt settings.
+const (
+	ActionTitles    = "titles"
+	ActionSEO       = "seo"
+	ActionTaxonomy  = "taxonomy"
+	ActionTranslate = "translate"
+	ActionProofread = "proofread"
+	ActionContinue  = "continue"
+)
+
+// Actions lists the actions in display order.
+var Actions = []string{ActionTitles, ActionSEO, ActionTaxonomy, ActionTranslate, ActionProofread, ActionContinue}
+
+var actionNames = map[string]string{
+	ActionTitles:    "标题建议",
+	ActionSEO:       "SEO 关键词与别名",
+	ActionTaxonomy:  "标签与分类",
+	ActionTranslate: "翻译",
+	ActionProofread: "校对",
+	ActionContinue:  "续写",
+}
+
+// defaultPrompts are used for every action that has no customised template.
+var defaultPrompts = map[string]model.AIWritingPrompt{
+	ActionTitles: {
+		System: "你是一名资深的博客编辑，擅长为文章拟定准确、吸引人的标题。",
+		User: "请为下面的文章拟定 {{.Count}} 个备选标题，每行一个，不要编号，不要输出其他内容。\n\n" +
+			"当前标题：{{.Title}}\n\n正文：\n{{.Content}}",
+	},
+	ActionSEO: {
+		System: "你是一名搜索引擎优化专家。",
+		User: "请为下面的文章生成 SEO 关键词和 URL 别名。只输出一个 JSON 对象，格式为 " +
+			"{\"keywords\": [\"关键词\"], \"slug\": \"english-words-joined-by-hyphens\"}，" +
+			"关键词 3 到 8 个，slug 使用小写英文单词和连字符，不超过 60 个字符，不要输出其他内容。\n\n" +
+			"标题：{{.Title}}\n\n正文：\n{{.Content}}",
+	},
+	ActionTaxonomy: {
+		System: "你是一名博客编辑，负责为文章归类。",
+		User: "请从已有的标签和分类中为下面的文章挑选合适的项，只能使用列表中已有的名称。只输出一个 JSON 对象，格式为 " +
+			"{\"tags\": [\"标签名\"], \"categories\": [\"分类名\"]}，标签最多 5 个，分类最多 2 个，没有合适的项时返回空数组，不要输出其他内容。\n\n" +
+			"已有标签：{{join .Tags \"、\"}}\n已有分类：{{join .Categories \"、\"}}\n\n" +
+			"标题：{{.Title}}\n\n正文：\n{{.Content}}",
+	},
+	ActionTranslate: {
+		System: "你是一名专业译者，译文准确、通顺、符合目标语言的表达习惯。",
+		User: "请将下面的文章翻译成{{.Language}}，保留原有的 Markdown 或 HTML 格式、代码块和链接，只输出译文，不要添加说明。\n\n" +
+			"{{.Content}}",
+	},
+	ActionProofread: {
+		System: "你是一名严谨的校对编辑。",
+		User: "请校对下面的文章，修正错别字、标点、语法错误和不通顺的句子，不要改变原意、段落结构和格式（包括 Markdown 或 HTML 标记与代码块）。" +
+			"直接输出校对后的全文，不要添加说明。\n\n{{.Content}}",
+	},
+	ActionContinue: {
+		System: "你是一名博客写作助手，擅长延续作者的语气和风格。",
+		User: "下面是一篇正在写作的文章，请从光标处续写一到两段，与上文的语气、风格和格式保持一致，并能自然衔接光标后的内容。" +
+			"只输出续写的内容，不要重复已有内容。\n\n标题：{{.Title}}\n\n光标前的内容：\n{{.Before}}\n\n光标后的内容：\n{{.After}}",
+	},
+}
+
+// promptData is the data available to prompt templates.
+type promptData struct {
+	Title      string
+	Content    string
+	Language   string
+	Tags       []string
+	Categories []string
+	Before     string
+	After      string
+	Count      int
+}
+
+// samplePromptData exercises every field when a template is validated.
+var samplePromptData = promptData{
+	Title:      "标题",
+	Content:    "正文",
+	Language:   "English",
+	Tags:       []string{"Go"},
+	Categories: []string{"技术"},
+	Before:     "光标前",
+	After:      "光标后",
+	Count:      defaultTitleCount,
+}
+
+var templateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+func parsePrompt(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+}
+
+func renderPrompt(name, text string, data promptData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := parsePrompt(name, text)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// validatePrompt parses and executes both templates against sample data, so
+// unknown fields are caught when saving rather than when the action runs.
+func validatePrompt(action string, p model.AIWritingPrompt) error {
+	if strings.TrimSpace(p.User) == "" {
+		return fmt.Errorf("%w: %s 的用户提示词不能为空", ErrInvalidPromptTemplate, actionNames[action])
+	}
+	for _, text := range []string{p.System, p.User} {
+		if _, err := renderPrompt(action, text, samplePromptData); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidPromptTemplate, actionNames[action], err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package writing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/category"
+	"github.com/shuTwT/hoshikuzu/ent/tag"
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+const (
+	defaultTitleCount = 5
+	// maxAnalyzeRunes truncates the post for actions that only read it.
+	maxAnalyzeRunes = 12000
+	// maxRewriteRunes rejects longer input for actions that rewrite the whole
+	// text, since the answer must fit in the model's output limit.
+	maxRewriteRunes  = 6000
+	maxContextBefore = 4000
+	maxContextAfter  = 1000
+	maxLanguageRunes = 32
+	// maxTerms bounds the tag and category lists sent to the model.
+	maxTerms = 200
+)
+
+var (
+	ErrInvalidWritingAction  = errors.New("不支持的写作操作")
+	ErrInvalidWritingInput   = errors.New("写作参数无效")
+	ErrWritingContentEmpty   = errors.New("文章内容为空")
+	ErrWritingContentTooLong = fmt.Errorf("文章过长，请选中不超过 %d 字的内容后再试", maxRewriteRunes)
+	ErrInvalidPromptTemplate = errors.New("提示词模板无效")
+)
+
+// Task is an authoring action whose post is loaded and prompts rendered,
+// ready to run.
+type Task struct {
+	Action     string
+	PostID     int
+	original   string
+	system     string
+	user       string
+	tags       []model.AIWritingTerm
+	categories []model.AIWritingTerm
+}
+
+// WritingService runs the AI authoring actions of the post editor.
+type WritingService interface {
+	// Prepare validates the request, loads the post and renders the prompt
+	// templates, so that errors can be reported before streaming starts.
+	Prepare(ctx context.Context, action string, postID int, req model.AIWritingReq) (*Task, error)
+	// Run streams the model output and parses it into the action's result.
+	Run(ctx context.Context, task *Task, onDelta func(string) error) (*model.AIWritingResult, error)
+	ListPrompts(ctx context.Context) ([]model.AIWritingPromptResp, error)
+	// SavePrompts replaces the customised templates. Actions that are omitted
+	// or equal to the default use the built-in prompts.
+	SavePrompts(ctx context.Context, prompts map[string]model.AIWritingPrompt) error
+}
+
+type WritingServiceImpl struct {
+	client         *ent.Client
+	settingService setting_service.SettingService
+	aiService      ai_service.AIService
+}
+
+func NewWritingServiceImpl(client *ent.Client, settingService setting_service.SettingService, aiService ai_service.AIService) *WritingServiceImpl {
+	return &WritingServiceImpl{
+		client:         client,
+		settingService: settingService,
+		aiService:      aiService,
+	}
+}
+
+func (s *WritingServiceImpl) Prepare(ctx context.Context, action string, postID int, req model.AIWritingReq) (*Task, error) {
+	if _, ok := defaultPrompts[action]; !ok {
+		return nil, ErrInvalidWritingAction
+	}
+	entity, err := s.client.Post.Get(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	title := entity.Title
+	if req.Title != nil {
+		title = strings.TrimSpace(*req.Title)
+	}
+	content := entity.Content
+	if entity.MdContent != nil && *entity.MdContent != "" {
+		content = *entity.MdContent
+	}
+	if req.Content != nil {
+		content = *req.Content
+	}
+	if strings.TrimSpace(content) == "" && action != ActionContinue {
+		return nil, ErrWritingContentEmpty
+	}
+
+	task := &Task{Action: action, PostID: postID}
+	data := promptData{Title: title, Count: defaultTitleCount}
+	switch action {
+	case ActionTitles, ActionSEO, ActionTaxonomy:
+		data.Content = head(plainText(content), maxAnalyzeRunes)
+	case ActionTranslate, ActionProofread:
+		if utf8.RuneCountInString(content) > maxRewriteRunes {
+			return nil, ErrWritingContentTooLong
+		}
+		data.Content = content
+		task.original = content
+	case ActionContinue:
+		runes := []rune(content)
+		cursor := len(runes)
+		if req.Cursor != nil {
+			cursor = *req.Cursor
+		}
+		if cursor < 0 || cursor > len(runes) {
+			return nil, fmt.Errorf("%w: 光标位置超出文章范围", ErrInvalidWritingInput)
+		}
+		data.Before = tail(string(runes[:cursor]), maxContextBefore)
+		data.After = head(string(runes[cursor:]), maxContextAfter)
+		if strings.TrimSpace(data.Before) == "" && title == "" {
+			return nil, ErrWritingContentEmpty
+		}
+	}
+	if action == ActionTranslate {
+		data.Language = strings.TrimSpace(req.Language)
+		if data.Language == "" || utf8.RuneCountInString(data.Language) > maxLanguageRunes {
+			return nil, fmt.Errorf("%w: 请指定目标语言", ErrInvalidWritingInput)
+		}
+	}
+	if action == ActionTaxonomy {
+		if task.tags, task.categories, err = s.terms(ctx); err != nil {
+			return nil, err
+		}
+		if len(task.tags) == 0 && len(task.categories) == 0 {
+			return nil, fmt.Errorf("%w: 尚未创建任何标签或分类", ErrInvalidWritingInput)
+		}
+		data.Tags = termNames(task.tags)
+		data.Categories = termNames(task.categories)
+	}
+
+	prompts, err := s.loadPrompts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prompt := prompts[action]
+	if task.system, err = renderPrompt(action, prompt.System, data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPromptTemplate, err)
+	}
+	if task.user, err = renderPrompt(action, prompt.User, data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPromptTemplate, err)
+	}
+	return task, nil
+}
+
+func (s *WritingServiceImpl) Run(ctx context.Context, task *Task, onDelta func(string) error) (*model.AIWritingResult, error) {
+	answer, err := s.aiService.StreamPrompt(ctx, task.system, task.user, onDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.AIWritingResult{
+		Action:  task.Action,
+		Model:   answer.Model,
+		Content: strings.TrimSpace(answer.Content),
+	}
+	switch task.Action {
+	case ActionTitles:
+		result.Titles = parseTitles(result.Content)
+		if len(result.Titles) == 0 {
+			return nil, ai_service.ErrAIProviderEmptyResponse
+		}
+	case ActionSEO:
+		if result.Keywords, result.Slug, err = parseSEO(result.Content); err != nil {
+			return nil, fmt.Errorf("parse AI SEO suggestion: %w", err)
+		}
+	case ActionTaxonomy:
+		if result.Tags, result.Categories, err = parseTaxonomy(result.Content, task.tags, task.categories); err != nil {
+			return nil, fmt.Errorf("parse AI taxonomy suggestion: %w", err)
+		}
+	case ActionTranslate:
+		result.Content = unwrapFence(result.Content, task.original)
+	case ActionProofread:
+		result.Content = unwrapFence(result.Content, task.original)
+		result.Diff = diffLines(strings.TrimSpace(task.original), result.Content)
+	}
+	return result, nil
+}
+
+func (s *WritingServiceImpl) ListPrompts(ctx context.Context) ([]model.AIWritingPromptResp, error) {
+	prompts, err := s.loadPrompts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]model.AIWritingPromptResp, 0, len(Actions))
+	for _, action := range Actions {
+		def := defaultPrompts[action]
+		p := prompts[action]
+		resp = append(resp, model.AIWritingPromptResp{
+			Action:        action,
+			Name:          actionNames[action],
+			System:        p.System,
+			User:          p.User,
+			DefaultSystem: def.System,
+			DefaultUser:   def.User,
+			Customized:    p != def,
+		})
+	}
+	return resp, nil
+}
+
+func (s *WritingServiceImpl) SavePrompts(ctx context.Context, prompts map[string]model.AIWritingPrompt) error {
+	custom := map[string]model.AIWritingPrompt{}
+	for action, p := range prompts {
+		def, ok := defaultPrompts[action]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrInvalidWritingAction, action)
+		}
+		if p == def {
+			continue
+		}
+		if err := validatePrompt(action, p); err != nil {
+			return err
+		}
+		custom[action] = p
+	}
+	value, err := json.Marshal(custom)
+	if err != nil {
+		return err
+	}
+	exists, err := s.settingService.ExistSettingByKey(ctx, model.SettingKeyAIWritingPrompts)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return s.settingService.CreateSettingIfNotExist(ctx, model.SettingKeyAIWritingPrompts, string(value))
+	}
+	return s.settingService.UpdateSettingByKey(ctx, model.SettingKeyAIWritingPrompts, string(value))
+}
+
+// loadPrompts returns the effective prompts of every action. A stored
+// template that no longer validates is ignored in favour of the default.
+func (s *WritingServiceImpl) loadPrompts(ctx context.Context) (map[string]model.AIWritingPrompt, error) {
+	prompts := make(map[string]model.AIWritingPrompt, len(defaultPrompts))
+	for action, p := range defaultPrompts {
+		prompts[action] = p
+	}
+	setting, err := s.settingService.GetSettingByKey(ctx, model.SettingKeyAIWritingPrompts)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return prompts, nil
+		}
+		return nil, err
+	}
+	var custom map[string]model.AIWritingPrompt
+	if err := json.Unmarshal([]byte(setting.Value), &custom); err != nil {
+		return nil, fmt.Errorf("解析写作提示词配置失败: %w", err)
+	}
+	for action, p := range custom {
+		if _, ok := defaultPrompts[action]; !ok {
+			continue
+		}
+		if err := validatePrompt(action, p); err != nil {
+			slog.Warn("忽略无效的写作提示词模板", "action", action, "error", err.Error())
+			continue
+		}
+		prompts[action] = p
+	}
+	return prompts, nil
+}
+
+func (s *WritingServiceImpl) terms(ctx context.Context) ([]model.AIWritingTerm, []model.AIWritingTerm, error) {
+	tags, err := s.client.Tag.Query().
+		Where(tag.ActiveEQ(true)).
+		Order(ent.Asc(tag.FieldSortOrder), ent.Asc(tag.FieldID)).
+		Limit(maxTerms).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	categories, err := s.client.Category.Query().
+		Where(category.ActiveEQ(true)).
+		Order(ent.Asc(category.FieldSortOrder), ent.Asc(category.FieldID)).
+		Limit(maxTerms).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	tagTerms := make([]model.AIWritingTerm, 0, len(tags))
+	for _, t := range tags {
+		tagTerms = append(tagTerms, model.AIWritingTerm{ID: t.ID, Name: t.Name})
+	}
+	categoryTerms := make([]model.AIWritingTerm, 0, len(categories))
+	for _, c := range categories {
+		categoryTerms = append(categoryTerms, model.AIWritingTerm{ID: c.ID, Name: c.Name})
+	}
+	return tagTerms, categoryTerms, nil
+}
+
+func termNames(terms []model.AIWritingTerm) []string {
+	names := make([]string, 0, len(terms))
+	for _, t := range terms {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+var textPolicy = bluemonday.StrictPolicy()
+
+// plainText strips HTML tags, which only cost tokens when the post is merely
+// being read.
+func plainText(s string) string {
+	return strings.TrimSpace(html.UnescapeString(textPolicy.Sanitize(s)))
+}
@@ -0,0 +1,134 @@
+package writing
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+func TestDefaultPromptsValid(t *testing.T) {
+	for _, action := range Actions {
+		p, ok := defaultPrompts[action]
+		if !ok {
+			t.Fatalf("no default prompt for %s", action)
+		}
+		if err := validatePrompt(action, p); err != nil {
+			t.Errorf("default prompt of %s: %v", action, err)
+		}
+	}
+}
+
+func TestValidatePrompt(t *testing.T) {
+	tests := []struct {
+		name   string
+		prompt model.AIWritingPrompt
+		valid  bool
+	}{
+		{"合法", model.AIWritingPrompt{User: "翻译成{{.Language}}：{{.Content}}"}, true},
+		{"使用 join", model.AIWritingPrompt{System: "标签：{{join .Tags \",\"}}", User: "{{.Title}}"}, true},
+		{"用户提示词为空", model.AIWritingPrompt{System: "系统", User: "  "}, false},
+		{"语法错误", model.AIWritingPrompt{User: "{{.Title"}, false},
+		{"未知字段", model.AIWritingPrompt{User: "{{.Author}}"}, false},
+	}
+	for _, tt := range tests {
+		err := validatePrompt(ActionTranslate, tt.prompt)
+		if (err == nil) != tt.valid {
+			t.Errorf("%s: validatePrompt() error = %v, want valid %v", tt.name, err, tt.valid)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidPromptTemplate) {
+			t.Errorf("%s: error %v does not wrap ErrInvalidPromptTemplate", tt.name, err)
+		}
+	}
+}
+
+func TestParseTitles(t *testing.T) {
+	output := "1. 《Go 并发入门》\n\n2、“通道与协程”\n- Go 并发入门\n* 从零理解 select"
+	want := []string{"Go 并发入门", "通道与协程", "从零理解 select"}
+	if got := parseTitles(output); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTitles() = %q, want %q", got, want)
+	}
+}
+
+func TestParseSEO(t *testing.T) {
+	output := "```json\n{\"keywords\": [\"Go\", \" 并发 \", \"go\", \"\"], \"slug\": \"Go Concurrency -- 101!\"}\n```"
+	keywords, slug, err := parseSEO(output)
+	if err != nil {
+		t.Fatalf("parseSEO() error = %v", err)
+	}
+	if !reflect.DeepEqual(keywords, []string{"Go", "并发"}) {
+		t.Errorf("keywords = %q", keywords)
+	}
+	if slug != "go-concurrency-101" {
+		t.Errorf("slug = %q", slug)
+	}
+
+	if _, _, err := parseSEO("没有 JSON"); err == nil {
+		t.Error("parseSEO() without JSON should fail")
+	}
+	if got := normalizeSlug(strings.Repeat("ab-", 30)); len(got) > maxSlugLength || strings.HasSuffix(got, "-") {
+		t.Errorf("normalizeSlug() = %q", got)
+	}
+}
+
+func TestParseTaxonomy(t *testing.T) {
+	tags := []model.AIWritingTerm{{ID: 1, Name: "Go"}, {ID: 2, Name: "Rust"}}
+	categories := []model.AIWritingTerm{{ID: 7, Name: "技术"}}
+	gotTags, gotCategories, err := parseTaxonomy(`{"tags": ["go", "Python", "GO"], "categories": ["技术", "生活"]}`, tags, categories)
+	if err != nil {
+		t.Fatalf("parseTaxonomy() error = %v", err)
+	}
+	if !reflect.DeepEqual(gotTags, []model.AIWritingTerm{{ID: 1, Name: "Go"}}) {
+		t.Errorf("tags = %+v, want only the existing Go tag", gotTags)
+	}
+	if !reflect.DeepEqual(gotCategories, []model.AIWritingTerm{{ID: 7, Name: "技术"}}) {
+		t.Errorf("categories = %+v", gotCategories)
+	}
+}
+
+func TestUnwrapFence(t *testing.T) {
+	tests := []struct {
+		output, original, want string
+	}{
+		{"```markdown\n# Title\n\nBody\n```", "# 标题\n\n正文", "# Title\n\nBody"},
+		{"# Title", "# 标题", "# Title"},
+		{"```go\nfmt.Println()\n```", "```go\nfmt.Println(1)\n```", "```go\nfmt.Println()\n```"},
+	}
+	for _, tt := range tests {
+		if got := unwrapFence(tt.output, tt.original); got != tt.want {
+			t.Errorf("unwrapFence(%q) = %q, want %q", tt.output, got, tt.want)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	diff := diffLines("第一行\n错别子\n第三行", "第一行\n错别字\n第三行\n第四行")
+	want := []model.AIWritingDiffLine{
+		{Op: diffOpEqual, Text: "第一行"},
+		{Op: diffOpDelete, Text: "错别子"},
+		{Op: diffOpInsert, Text: "错别字"},
+		{Op: diffOpEqual, Text: "第三行"},
+		{Op: diffOpInsert, Text: "第四行"},
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("diffLines() = %+v, want %+v", diff, want)
+	}
+
+	if diff := diffLines("", "新内容"); len(diff) != 1 || diff[0].Op != diffOpInsert {
+		t.Errorf("diffLines from empty = %+v", diff)
+	}
+}
+
+func TestHeadTail(t *testing.T) {
+	if got := head("你好世界", 2); got != "你好" {
+		t.Errorf("head() = %q", got)
+	}
+	if got := tail("你好世界", 2); got != "世界" {
+		t.Errorf("tail() = %q", got)
+	}
+	if got := tail("hi", 5); got != "hi" {
+		t.Errorf("tail() = %q", got)
+	}
+}
@@ -2,9 +2,12 @@ package comment
 
 import (
 	"context"
+	"unicode/utf8"
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/ent/comment"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	"github.com/shuTwT/hoshikuzu/internal/services/content/moderation"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 
 	"github.com/medama-io/go-useragent"
@@ -16,21 +19,26 @@ type CommentService interface {
 	ListComment(c context.Context, url string) ([]*ent.Comment, error)
 	GetComment(c context.Context, id int) (*ent.Comment, error)
 	CountComment(c context.Context, includeReply bool, urls []string) (int64, error)
-	CreateComment(c context.Context, comment string, href string, link string, mail string, nick string, ua string, url string, ipAddress string) (*int, error)
+	CreateComment(c context.Context, comment string, href string, link string, mail string, nick string, ua string, url string, ipAddress string, userID *int) (*int, error)
 	GetRecentComment(c context.Context, pageSize int) ([]*ent.Comment, error)
 	ParseUserAgent(ua string) (browser string, os string)
-	// SetCommentStatus 更新评论审核状态（1未审核,2已审核）
+	// SetCommentStatus 更新评论审核状态（1待审核,2已通过,3已拒绝）
 	SetCommentStatus(c context.Context, id int, status int) (*ent.Comment, error)
+	// QueryModerationPage 分页查询审核队列中的评论
+	QueryModerationPage(c context.Context, query model.CommentModerationPageQuery) (*model.PageResult[*ent.Comment], error)
+	// BatchSetCommentStatus 批量更新评论审核状态，返回实际更新的数量
+	BatchSetCommentStatus(c context.Context, ids []int, status int) (int, error)
 	// DeleteComment 删除评论
 	DeleteComment(c context.Context, id int) error
 }
 
 type CommentServiceImpl struct {
-	client *ent.Client
+	client            *ent.Client
+	moderationService moderation.ModerationService
 }
 
-func NewCommentServiceImpl(client *ent.Client) *CommentServiceImpl {
-	return &CommentServiceImpl{client: client}
+func NewCommentServiceImpl(client *ent.Client, moderationService moderation.ModerationService) *CommentServiceImpl {
+	return &CommentServiceImpl{client: client, moderationService: moderationService}
 }
 
 func (s *CommentServiceImpl) ListCommentPage(c context.Context, pageQuery model.PageQuery) (*model.PageResult[*ent.Comment], error) {
@@ -58,7 +66,7 @@ func (s *CommentServiceImpl) ListCommentPage(c context.Context, pageQuery model.
 func (s *CommentServiceImpl) ListComment(c context.Context, url string) ([]*ent.Comment, error) {
 	comments, err := s.client.Comment.Query().
 		Order(ent.Desc(comment.FieldID)).
-		Where(comment.URLEQ(url)).
+		Where(comment.URLEQ(url), comment.StatusEQ(model.CommentStatusApproved)).
 		All(c)
 	if err != nil {
 		return nil, err
@@ -79,7 +87,7 @@ func (s *CommentServiceImpl) GetComment(c context.Context, id int) (*ent.Comment
 func (s *CommentServiceImpl) CountComment(c context.Context, includeReply bool, urls []string) (int64, error) {
 	if includeReply {
 		count, err := s.client.Comment.Query().
-			Where(comment.URLIn(urls...)).
+			Where(comment.URLIn(urls...), comment.StatusEQ(model.CommentStatusApproved)).
 			Where(comment.Or(comment.ParentIDIsNil(), comment.ParentIDNotIn(0))).
 			Count(c)
 		if err != nil {
@@ -88,7 +96,7 @@ func (s *CommentServiceImpl) CountComment(c context.Context, includeReply bool,
 		return int64(count), nil
 	}
 	count, err := s.client.Comment.Query().
-		Where(comment.URLIn(urls...)).
+		Where(comment.URLIn(urls...), comment.StatusEQ(model.CommentStatusApproved)).
 		Where(comment.ParentIDIsNil()).
 		Count(c)
 	if err != nil {
@@ -97,16 +105,39 @@ func (s *CommentServiceImpl) CountComment(c context.Context, includeReply bool,
 	return int64(count), nil
 }
 
-func (s *CommentServiceImpl) CreateComment(c context.Context, comment string, href string, link string, mail string, nick string, ua string, url string, ipAddress string) (*int, error) {
+func (s *CommentServiceImpl) CreateComment(c context.Context, comment string, href string, link string, mail string, nick string, ua string, url string, ipAddress string, userID *int) (*int, error) {
+	verdict, err := s.moderationService.Moderate(c, moderation.Submission{
+		Content: comment,
+		Email:   mail,
+		IP:      ipAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
 	entity, err := s.client.Comment.Create().
 		SetContent(comment).
+		SetNick(truncateRunes(nick, 64)).
+		SetEmail(truncateRunes(mail, 255)).
 		SetUserAgent(ua).
 		SetURL(url).
 		SetIPAddress(ipAddress).
+		SetNillableUserID(userID).
+		SetStatus(verdict.Status).
+		SetModerationReason(truncateRunes(verdict.Reason(), 1024)).
+		SetNillableSpamScore(verdict.SpamScore).
 		Save(c)
 	if err != nil {
 		return nil, err
 	}
+	event.Publish(c, event.CommentCreated, model.CommentEventData{
+		ID:       entity.ID,
+		PostID:   entity.PostID,
+		ParentID: entity.ParentID,
+		UserID:   entity.UserID,
+		URL:      entity.URL,
+		Content:  entity.Content,
+		Status:   entity.Status,
+	})
 	return &entity.ID, nil
 }
 
@@ -119,6 +150,7 @@ func (s *CommentServiceImpl) ParseUserAgent(ua string) (browser string, os strin
 
 func (s *CommentServiceImpl) GetRecentComment(c context.Context, pageSize int) ([]*ent.Comment, error) {
 	comments, err := s.client.Comment.Query().
+		Where(comment.StatusEQ(model.CommentStatusApproved)).
 		Order(ent.Desc(comment.FieldCreatedAt)).
 		Limit(pageSize).
 		All(c)
@@ -146,6 +178,38 @@ func (s *CommentServiceImpl) SetCommentStatus(c context.Context, id int, status
 	return entity, nil
 }
 
+func (s *CommentServiceImpl) QueryModerationPage(c context.Context, query model.CommentModerationPageQuery) (*model.PageResult[*ent.Comment], error) {
+	status := query.Status
+	if status == 0 {
+		status = model.CommentStatusPending
+	}
+	q := s.client.Comment.Query().Where(comment.StatusEQ(status))
+
+	count, err := q.Clone().Count(c)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := q.
+		Order(ent.Desc(comment.FieldID)).
+		Offset((query.Page - 1) * query.Size).
+		Limit(query.Size).
+		All(c)
+	if err != nil {
+		return nil, err
+	}
+	return &model.PageResult[*ent.Comment]{
+		Total:   int64(count),
+		Records: comments,
+	}, nil
+}
+
+func (s *CommentServiceImpl) BatchSetCommentStatus(c context.Context, ids []int, status int) (int, error) {
+	return s.client.Comment.Update().
+		Where(comment.IDIn(ids...), comment.StatusNEQ(status)).
+		SetStatus(status).
+		Save(c)
+}
+
 func (s *CommentServiceImpl) DeleteComment(c context.Context, id int) error {
 	err := s.client.Comment.DeleteOneID(id).Exec(c)
 	if err != nil {
@@ -153,3 +217,11 @@ func (s *CommentServiceImpl) DeleteComment(c context.Context, id int) error {
 	}
 	return nil
 }
+
+// truncateRunes 按字符截断字符串，避免超出字段长度限制
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/ent/essay"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 )
 
@@ -32,15 +33,21 @@ func (s *EssayServiceImpl) CreateEssay(ctx context.Context, userId int, req *mod
 		SetImages(req.Images).
 		SetUserID(userId).
 		SaveX(ctx)
+	event.Publish(ctx, event.EssayCreated, essayEventData(essay))
 	return essay, nil
 }
 
 func (s *EssayServiceImpl) UpdateEssay(ctx context.Context, id int, req *model.EssayUpdateReq) error {
-	return s.client.Essay.UpdateOneID(id).
+	entity, err := s.client.Essay.UpdateOneID(id).
 		SetContent(req.Content).
 		SetDraft(req.Draft).
 		SetImages(req.Images).
-		Exec(ctx)
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	event.Publish(ctx, event.EssayUpdated, essayEventData(entity))
+	return nil
 }
 
 func (s *EssayServiceImpl) GetEssay(ctx context.Context, id int) (*ent.Essay, error) {
@@ -84,5 +91,19 @@ func (s *EssayServiceImpl) GetEssayList(ctx context.Context, limit int) ([]*ent.
 }
 
 func (s *EssayServiceImpl) DeleteEssay(ctx context.Context, id int) error {
-	return s.client.Essay.DeleteOneID(id).Exec(ctx)
+	if err := s.client.Essay.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	event.Publish(ctx, event.EssayDeleted, model.EssayEventData{ID: id})
+	return nil
+}
+
+func essayEventData(entity *ent.Essay) model.EssayEventData {
+	return model.EssayEventData{
+		ID:      entity.ID,
+		UserID:  entity.UserID,
+		Content: entity.Content,
+		Draft:   entity.Draft,
+		Public:  entity.Public,
+	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/flink"
 	"github.com/shuTwT/hoshikuzu/ent/flinkapplication"
 	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 )
 
@@ -28,7 +29,7 @@ func NewFlinkApplicationServiceImpl(client *ent.Client) *FlinkApplicationService
 }
 
 func (s *FlinkApplicationServiceImpl) CreateFlinkApplication(c context.Context, createReq *model.FlinkApplicationCreateReq) (*ent.FLinkApplication, error) {
-	return s.client.FLinkApplication.Create().
+	application, err := s.client.FLinkApplication.Create().
 		SetWebsiteURL(createReq.WebsiteURL).
 		SetApplicationType(createReq.ApplicationType).
 		SetWebsiteName(createReq.WebsiteName).
@@ -40,6 +41,19 @@ func (s *FlinkApplicationServiceImpl) CreateFlinkApplication(c context.Context,
 		SetNillableModificationReason(&createReq.ModificationReason).
 		SetStatus(0).
 		Save(c)
+	if err != nil {
+		return nil, err
+	}
+	event.Publish(c, event.FlinkApplicationCreated, model.FlinkApplicationEventData{
+		ID:                 application.ID,
+		ApplicationType:    application.ApplicationType,
+		WebsiteName:        application.WebsiteName,
+		WebsiteURL:         application.WebsiteURL,
+		WebsiteLogo:        application.WebsiteLogo,
+		WebsiteDescription: application.WebsiteDescription,
+		ContactEmail:       application.ContactEmail,
+	})
+	return application, nil
 }
 
 func (s *FlinkApplicationServiceImpl) ListFlinkApplicationPage(c context.Context, pageQuery model.FlinkApplicationPageReq) ([]*ent.FLinkApplication, int, error) {
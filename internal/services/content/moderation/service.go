@@ -0,0 +1,306 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/comment"
+	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// 规则命中后的处理方式
+const (
+	ActionApprove = "approve"
+	ActionHold    = "hold"
+	ActionReject  = "reject"
+)
+
+// AI 评分超时时间，超时后忽略 AI 结果，避免拖慢评论提交
+const classifyTimeout = 15 * time.Second
+
+var linkPattern = regexp.MustCompile(`(?i)(?:https?://)?www\.|https?://`)
+
+// Submission 待审核的评论内容
+type Submission struct {
+	Content string
+	Email   string
+	IP      string
+}
+
+// Verdict 审核结果
+type Verdict struct {
+	// 评论状态 1待审核 2已通过 3已拒绝
+	Status int
+	// 命中的规则说明
+	Reasons []string
+	// AI 垃圾评分，未启用 AI 时为空
+	SpamScore *float64
+}
+
+// Reason 以分号连接的审核原因
+func (v *Verdict) Reason() string {
+	return strings.Join(v.Reasons, "; ")
+}
+
+type keywordRule struct {
+	Pattern string `json:"pattern"`
+	// 为 true 时 pattern 按正则表达式匹配（忽略大小写），否则按关键词包含匹配
+	Regex  bool   `json:"regex"`
+	Action string `json:"action"`
+}
+
+type listRule struct {
+	// IP 规则支持单个地址或 CIDR，邮箱规则支持完整地址或以 @ 开头的域名
+	Value  string `json:"value"`
+	Action string `json:"action"`
+}
+
+type aiSettings struct {
+	Enabled bool `json:"enabled"`
+	// 评分达到阈值时转入待审核或直接拒绝，0 表示不启用该阈值
+	HoldThreshold   float64 `json:"holdThreshold"`
+	RejectThreshold float64 `json:"rejectThreshold"`
+}
+
+// moderationSettings 对应系统设置中 key='comment_moderation' 的 JSON 配置
+type moderationSettings struct {
+	Enabled bool `json:"enabled"`
+	// 未命中任何规则时的处理方式 approve、hold
+	DefaultAction string        `json:"defaultAction"`
+	Keywords      []keywordRule `json:"keywords"`
+	BlockedIPs    []listRule    `json:"blockedIps"`
+	BlockedEmails []listRule    `json:"blockedEmails"`
+	// 评论中链接数超过 MaxLinks 时执行 LinkAction，0 表示不限制
+	MaxLinks   int    `json:"maxLinks"`
+	LinkAction string `json:"linkAction"`
+	// 同一 IP 在 RateWindow 分钟内评论数达到 RateLimit 时执行 RateAction，0 表示不限制
+	RateLimit  int        `json:"rateLimit"`
+	RateWindow int        `json:"rateWindow"`
+	RateAction string     `json:"rateAction"`
+	AI         aiSettings `json:"ai"`
+}
+
+type ModerationService interface {
+	// Moderate 按评论审核设置对提交的评论执行规则，返回应保存的状态与原因
+	Moderate(ctx context.Context, sub Submission) (*Verdict, error)
+}
+
+type ModerationServiceImpl struct {
+	client         *ent.Client
+	settingService setting_service.SettingService
+	aiService      ai_service.AIService
+}
+
+func NewModerationServiceImpl(client *ent.Client, settingService setting_service.SettingService, aiService ai_service.AIService) *ModerationServiceImpl {
+	return &ModerationServiceImpl{client: client, settingService: settingService, aiService: aiService}
+}
+
+func (s *ModerationServiceImpl) Moderate(ctx context.Context, sub Submission) (*Verdict, error) {
+	ms, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ms.Enabled {
+		return &Verdict{Status: model.CommentStatusApproved}, nil
+	}
+
+	var j judgement
+	for _, rule := range ms.Keywords {
+		matched, err := matchKeyword(rule, sub.Content)
+		if err != nil {
+			slog.Warn("评论审核关键词规则无效", "pattern", rule.Pattern, "error", err.Error())
+			continue
+		}
+		if matched {
+			j.add(rule.Action, fmt.Sprintf("命中关键词 %q", rule.Pattern))
+		}
+	}
+	for _, rule := range ms.BlockedIPs {
+		if matchIP(rule.Value, sub.IP) {
+			j.add(rule.Action, fmt.Sprintf("命中 IP 规则 %s", rule.Value))
+		}
+	}
+	for _, rule := range ms.BlockedEmails {
+		if matchEmail(rule.Value, sub.Email) {
+			j.add(rule.Action, fmt.Sprintf("命中邮箱规则 %s", rule.Value))
+		}
+	}
+	if ms.MaxLinks > 0 {
+		if n := countLinks(sub.Content); n > ms.MaxLinks {
+			j.add(ms.LinkAction, fmt.Sprintf("包含 %d 个链接，超过上限 %d", n, ms.MaxLinks))
+		}
+	}
+	if ms.RateLimit > 0 && ms.RateWindow > 0 && sub.IP != "" {
+		n, err := s.client.Comment.Query().
+			Where(
+				comment.IPAddressEQ(sub.IP),
+				comment.CreatedAtGTE(time.Now().Add(-time.Duration(ms.RateWindow)*time.Minute)),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n >= ms.RateLimit {
+			j.add(ms.RateAction, fmt.Sprintf("%d 分钟内已评论 %d 次", ms.RateWindow, n))
+		}
+	}
+
+	verdict := &Verdict{}
+	// 规则已判定拒绝时不再调用 AI
+	if ms.AI.Enabled && j.action != ActionReject {
+		score, ok := s.classify(ctx, sub.Content)
+		if ok {
+			verdict.SpamScore = &score.Score
+			reason := fmt.Sprintf("AI 评分 %.2f", score.Score)
+			if score.Reason != "" {
+				reason += "：" + score.Reason
+			}
+			switch {
+			case ms.AI.RejectThreshold > 0 && score.Score >= ms.AI.RejectThreshold:
+				j.add(ActionReject, reason)
+			case ms.AI.HoldThreshold > 0 && score.Score >= ms.AI.HoldThreshold:
+				j.add(ActionHold, reason)
+			}
+		}
+	}
+
+	action := j.action
+	if action == "" {
+		action = ms.DefaultAction
+	}
+	verdict.Status = statusOf(action)
+	verdict.Reasons = j.reasons
+	return verdict, nil
+}
+
+// classify 调用 AI 评分，失败时仅记录日志，不影响评论提交
+func (s *ModerationServiceImpl) classify(ctx context.Context, content string) (*model.CommentClassification, bool) {
+	if s.aiService == nil {
+		return nil, false
+	}
+	ctx, cancel := context.WithTimeout(ctx, classifyTimeout)
+	defer cancel()
+	result, err := s.aiService.ClassifyComment(ctx, content)
+	if err != nil {
+		slog.Warn("AI 评论评分失败", "error", err.Error())
+		return nil, false
+	}
+	return result, true
+}
+
+// loadSettings 读取评论审核设置，未配置时返回未启用的设置
+func (s *ModerationServiceImpl) loadSettings(ctx context.Context) (*moderationSettings, error) {
+	setting, err := s.settingService.GetSettingByKey(ctx, model.SettingKeyCommentModeration)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &moderationSettings{}, nil
+		}
+		return nil, err
+	}
+	var ms moderationSettings
+	if err := json.Unmarshal([]byte(setting.Value), &ms); err != nil {
+		return nil, fmt.Errorf("解析评论审核配置失败: %w", err)
+	}
+	return &ms, nil
+}
+
+// judgement 汇总命中的规则，处理方式按 reject > hold > approve 取最严格的一项
+type judgement struct {
+	action  string
+	reasons []string
+}
+
+func (j *judgement) add(action, reason string) {
+	action = normalizeAction(action)
+	if severity(action) > severity(j.action) {
+		j.action = action
+	}
+	j.reasons = append(j.reasons, reason)
+}
+
+// normalizeAction 未设置或无法识别的处理方式按待审核处理
+func normalizeAction(action string) string {
+	switch action {
+	case ActionApprove, ActionHold, ActionReject:
+		return action
+	default:
+		return ActionHold
+	}
+}
+
+func severity(action string) int {
+	switch action {
+	case ActionApprove:
+		return 1
+	case ActionHold:
+		return 2
+	case ActionReject:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func statusOf(action string) int {
+	switch action {
+	case ActionHold:
+		return model.CommentStatusPending
+	case ActionReject:
+		return model.CommentStatusRejected
+	default:
+		return model.CommentStatusApproved
+	}
+}
+
+func matchKeyword(rule keywordRule, content string) (bool, error) {
+	if rule.Pattern == "" {
+		return false, nil
+	}
+	if rule.Regex {
+		re, err := regexp.Compile("(?i)" + rule.Pattern)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(content), nil
+	}
+	return strings.Contains(strings.ToLower(content), strings.ToLower(rule.Pattern)), nil
+}
+
+func matchIP(rule, ip string) bool {
+	rule = strings.TrimSpace(rule)
+	addr := net.ParseIP(ip)
+	if rule == "" || addr == nil {
+		return false
+	}
+	if strings.Contains(rule, "/") {
+		_, network, err := net.ParseCIDR(rule)
+		return err == nil && network.Contains(addr)
+	}
+	ruleAddr := net.ParseIP(rule)
+	return ruleAddr != nil && ruleAddr.Equal(addr)
+}
+
+func matchEmail(rule, email string) bool {
+	rule = strings.ToLower(strings.TrimSpace(rule))
+	email = strings.ToLower(strings.TrimSpace(email))
+	if rule == "" || email == "" {
+		return false
+	}
+	if strings.HasPrefix(rule, "@") {
+		return strings.HasSuffix(email, rule)
+	}
+	return email == rule
+}
+
+func countLinks(content string) int {
+	return len(linkPattern.FindAllStringIndex(content, -1))
+}
@@ -0,0 +1,108 @@
+package moderation
+
+import (
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+func TestMatchKeyword(t *testing.T) {
+	tests := []struct {
+		rule    keywordRule
+		content string
+		want    bool
+		wantErr bool
+	}{
+		{keywordRule{Pattern: "Casino"}, "best casino online", true, false},
+		{keywordRule{Pattern: "casino"}, "hello world", false, false},
+		{keywordRule{Pattern: ""}, "anything", false, false},
+		{keywordRule{Pattern: `v[i1]agra`, Regex: true}, "cheap V1AGRA here", true, false},
+		{keywordRule{Pattern: `^\d+$`, Regex: true}, "12a", false, false},
+		{keywordRule{Pattern: `(`, Regex: true}, "(", false, true},
+	}
+	for _, tt := range tests {
+		got, err := matchKeyword(tt.rule, tt.content)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("matchKeyword(%+v, %q) = %v, %v, want %v, err %v", tt.rule, tt.content, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestMatchIP(t *testing.T) {
+	tests := []struct {
+		rule, ip string
+		want     bool
+	}{
+		{"1.2.3.4", "1.2.3.4", true},
+		{"1.2.3.4", "1.2.3.5", false},
+		{"10.0.0.0/8", "10.20.30.40", true},
+		{"10.0.0.0/8", "11.0.0.1", false},
+		{"2001:db8::/32", "2001:db8::1", true},
+		{"bad/cidr", "1.2.3.4", false},
+		{"1.2.3.4", "", false},
+	}
+	for _, tt := range tests {
+		if got := matchIP(tt.rule, tt.ip); got != tt.want {
+			t.Errorf("matchIP(%q, %q) = %v, want %v", tt.rule, tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestMatchEmail(t *testing.T) {
+	tests := []struct {
+		rule, email string
+		want        bool
+	}{
+		{"spam@example.com", "Spam@Example.com", true},
+		{"spam@example.com", "ham@example.com", false},
+		{"@spam.io", "anyone@spam.io", true},
+		{"@spam.io", "anyone@notspam.io", false},
+		{"@spam.io", "", false},
+	}
+	for _, tt := range tests {
+		if got := matchEmail(tt.rule, tt.email); got != tt.want {
+			t.Errorf("matchEmail(%q, %q) = %v, want %v", tt.rule, tt.email, got, tt.want)
+		}
+	}
+}
+
+func TestCountLinks(t *testing.T) {
+	tests := []struct {
+		content string
+		want    int
+	}{
+		{"no links here", 0},
+		{"see https://a.com and http://b.com", 2},
+		{"visit https://www.a.com or www.b.com", 2},
+	}
+	for _, tt := range tests {
+		if got := countLinks(tt.content); got != tt.want {
+			t.Errorf("countLinks(%q) = %d, want %d", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestJudgement(t *testing.T) {
+	tests := []struct {
+		name    string
+		actions []string
+		want    int
+	}{
+		{"放行", []string{ActionApprove}, model.CommentStatusApproved},
+		{"待审核优先于放行", []string{ActionApprove, ActionHold}, model.CommentStatusPending},
+		{"拒绝优先", []string{ActionReject, ActionHold, ActionApprove}, model.CommentStatusRejected},
+		{"未知处理方式按待审核", []string{"unknown"}, model.CommentStatusPending},
+	}
+	for _, tt := range tests {
+		var j judgement
+		for _, a := range tt.actions {
+			j.add(a, a)
+		}
+		if got := statusOf(j.action); got != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, got, tt.want)
+		}
+		if len(j.reasons) != len(tt.actions) {
+			t.Errorf("%s: reasons = %v", tt.name, j.reasons)
+		}
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent/category"
 	"github.com/shuTwT/hoshikuzu/ent/post"
 	"github.com/shuTwT/hoshikuzu/ent/tag"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	"github.com/shuTwT/hoshikuzu/internal/infra/markdown"
 	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
 	"github.com/shuTwT/hoshikuzu/pkg/cache"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
@@ -125,6 +127,10 @@ func (s *PostServiceImpl) QueryPostPage(c context.Context, req model.PostPageReq
 		query.Where(post.TitleContains(req.Title))
 	}
 
+	if req.Author != "" {
+		query.Where(post.Author(req.Author))
+	}
+
 	// 创建时间区间过滤，前端日期范围结束值为所选最后一天的零点，加一天转为左闭右开区间
 	if req.StartDate != nil {
 		query.Where(post.CreatedAtGTE(req.StartDate.Time()))
@@ -213,12 +219,43 @@ func (s *PostServiceImpl) QueryPostById(c context.Context, id int) (*ent.Post, e
 }
 
 func (s *PostServiceImpl) UpdatePostContent(c context.Context, id int, content string, htmlContent *string, mdContent *string) (*ent.Post, error) {
+	htmlContent, err := normalizeHiddenContent(content, htmlContent, mdContent)
+	if err != nil {
+		return nil, err
+	}
 	newPost, err := s.client.Post.UpdateOneID(id).
 		SetContent(content).
 		SetNillableHTMLContent(htmlContent).
 		SetNillableMdContent(mdContent).
 		Save(c)
-	return newPost, err
+	if err != nil {
+		return nil, err
+	}
+	event.Publish(c, event.PostUpdated, postEventData(newPost))
+	return newPost, nil
+}
+
+// normalizeHiddenContent 校验隐藏内容标记，markdown 中的隐藏块在 HTML 中丢失时由服务端重新渲染 HTML
+func normalizeHiddenContent(content string, htmlContent *string, mdContent *string) (*string, error) {
+	for _, src := range []*string{&content, htmlContent, mdContent} {
+		if src == nil {
+			continue
+		}
+		if err := markdown.ValidateHidden(*src); err != nil {
+			return nil, err
+		}
+	}
+	if mdContent == nil || !markdown.HasHidden(*mdContent) {
+		return htmlContent, nil
+	}
+	if htmlContent != nil && markdown.HasHidden(*htmlContent) {
+		return htmlContent, nil
+	}
+	rendered, err := markdown.Render([]byte(*mdContent))
+	if err != nil {
+		return nil, fmt.Errorf("渲染文章内容失败: %w", err)
+	}
+	return &rendered, nil
 }
 
 func (s *PostServiceImpl) UpdatePostSetting(c context.Context, id int, updateReq model.PostUpdateReq) (*ent.Post, error) {
@@ -291,14 +328,21 @@ func (s *PostServiceImpl) UpdatePostSetting(c context.Context, id int, updateReq
 				Save(context.Background()); updateErr != nil {
 				slog.Error("保存 AI 生成摘要失败", "post_id", postID, "error", updateErr.Error())
 			}
-		}(id, updateReq.Title, updateReq.Content)
+		}(id, updateReq.Title, markdown.StripHidden(updateReq.Content, ""))
 	}
 
+	if err == nil {
+		event.Publish(c, event.PostUpdated, postEventData(newPost))
+	}
 	return newPost, err
 }
 
 func (s *PostServiceImpl) DeletePost(c context.Context, id int) error {
-	return s.client.Post.DeleteOneID(id).Exec(c)
+	if err := s.client.Post.DeleteOneID(id).Exec(c); err != nil {
+		return err
+	}
+	event.Publish(c, event.PostDeleted, model.PostEventData{ID: id})
+	return nil
 }
 
 func (s *PostServiceImpl) GetPostCount(c context.Context) (int, error) {
@@ -440,7 +484,7 @@ func (s *PostServiceImpl) GetRelatedPosts(c context.Context, id int, limit int)
 		score, hasTagMatch := calcRelatedScore(cand, currentTagIDs, currentCategoryIDs, currentTitleTokens)
 		scored = append(scored, scoredCandidate{
 			resp: &model.PostRelatedResp{
-				PostResp: toPostResp(cand),
+				PostResp: ToPostResp(cand),
 				Score:    score,
 			},
 			hasTagMatch: hasTagMatch,
@@ -592,8 +636,8 @@ func postCategoryIDSet(p *ent.Post) map[int]struct{} {
 	return set
 }
 
-// toPostResp 将 ent.Post 转换为响应模型
-func toPostResp(p *ent.Post) model.PostResp {
+// ToPostResp 将 ent.Post 转换为响应模型
+func ToPostResp(p *ent.Post) model.PostResp {
 	return model.PostResp{
 		ID:                    p.ID,
 		Title:                 p.Title,
@@ -716,11 +760,20 @@ func (s *PostServiceImpl) SearchPosts(c context.Context, req model.PostSearchReq
 	return pagedResults, total, nil
 }
 
+// searchableContent 参与搜索匹配的正文。整篇付费或评论可见的文章只匹配标题、摘要和关键词，
+// 其余文章去掉隐藏内容块后再匹配，避免通过搜索结果探测未解锁的内容
+func searchableContent(p *ent.Post) string {
+	if p.IsVisibleAfterPay || p.IsVisibleAfterComment {
+		return ""
+	}
+	return markdown.StripHidden(p.Content, "")
+}
+
 func (s *PostServiceImpl) calculateRelevance(p *ent.Post, keyword string) float64 {
 	var relevance float64 = 0
 
 	title := strings.ToLower(p.Title)
-	content := strings.ToLower(p.Content)
+	content := strings.ToLower(searchableContent(p))
 	summary := strings.ToLower(p.Summary)
 	keywords := strings.ToLower(p.Keywords)
 
@@ -758,6 +811,7 @@ func (s *PostServiceImpl) PublishPost(c context.Context, id int) (*ent.Post, err
 	if err != nil {
 		return nil, err
 	}
+	event.Publish(c, event.PostPublished, postEventData(post))
 	return post, nil
 }
 
@@ -768,9 +822,24 @@ func (s *PostServiceImpl) UnpublishPost(c context.Context, id int) (*ent.Post, e
 	if err != nil {
 		return nil, err
 	}
+	event.Publish(c, event.PostUnpublished, postEventData(post))
 	return post, nil
 }
 
+func postEventData(entity *ent.Post) model.PostEventData {
+	data := model.PostEventData{
+		ID:          entity.ID,
+		Title:       entity.Title,
+		Author:      entity.Author,
+		Summary:     entity.Summary,
+		PublishedAt: entity.PublishedAt,
+	}
+	if entity.Slug != nil {
+		data.Slug = *entity.Slug
+	}
+	return data
+}
+
 func (s *PostServiceImpl) PostCountByCategory(c context.Context, categoryID int) (int, error) {
 	count, err := s.client.Post.Query().
 		Where(post.HasCategoriesWith(category.IDEQ(categoryID))).
@@ -141,3 +141,23 @@ func TestSortRelatedCandidates(t *testing.T) {
 		t.Errorf("non-tag-matched post should rank last, got second ID=%d", scored[1].resp.ID)
 	}
 }
+
+func TestCalculateRelevanceSkipsLockedContent(t *testing.T) {
+	s := &PostServiceImpl{}
+	tests := []struct {
+		name string
+		post *ent.Post
+		want float64
+	}{
+		{"正文匹配", &ent.Post{Title: "标题", Content: "secret 正文"}, 0.5},
+		{"付费文章不匹配正文", &ent.Post{Title: "标题", Content: "secret 正文", IsVisibleAfterPay: true}, 0},
+		{"评论可见文章不匹配正文", &ent.Post{Title: "标题", Content: "secret 正文", IsVisibleAfterComment: true}, 0},
+		{"付费文章仍匹配标题", &ent.Post{Title: "secret", Content: "secret", IsVisibleAfterPay: true}, 10},
+		{"隐藏内容块不参与匹配", &ent.Post{Title: "标题", Content: "公开\n<!-- paywall -->\nsecret\n<!-- /paywall -->"}, 0},
+	}
+	for _, tt := range tests {
+		if got := s.calculateRelevance(tt.post, "secret"); got != tt.want {
+			t.Errorf("%s: calculateRelevance() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
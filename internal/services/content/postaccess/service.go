@@ -0,0 +1,338 @@
+package postaccess
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/comment"
+	"github.com/shuTwT/hoshikuzu/ent/post"
+	"github.com/shuTwT/hoshikuzu/ent/postpurchase"
+	"github.com/shuTwT/hoshikuzu/internal/infra/markdown"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// previewLength 锁定文章的预览字数
+const previewLength = 200
+
+// approvedCommentStatus 评论已审核状态
+const approvedCommentStatus = 2
+
+// PostAccessService 付费可见、评论可见文章及文章内隐藏内容块的访问控制
+type PostAccessService interface {
+	// GuardPosts 校验当前用户对文章的访问权限，移除无权查看的隐藏内容块，整篇锁定时只保留预览
+	GuardPosts(c context.Context, userID *int, posts ...*model.PostResp) error
+	// GuardSearchResults 对搜索结果做同样的处理，返回新的切片，不修改传入的结果
+	GuardSearchResults(c context.Context, userID *int, results []*model.PostSearchResp) ([]*model.PostSearchResp, error)
+	// GuardPost 对单篇文章实体做同样的处理，返回整篇锁定信息，nil 表示可以查看全文
+	GuardPost(c context.Context, userID *int, p *ent.Post) (*model.PostLock, error)
+}
+
+type PostAccessServiceImpl struct {
+	client *ent.Client
+}
+
+func NewPostAccessServiceImpl(client *ent.Client) *PostAccessServiceImpl {
+	return &PostAccessServiceImpl{client: client}
+}
+
+// access 当前用户对文章的解锁状态
+type access struct {
+	loggedIn  bool
+	purchased bool
+	commented bool
+}
+
+func (s *PostAccessServiceImpl) GuardPosts(c context.Context, userID *int, posts ...*model.PostResp) error {
+	states, err := s.resolveAccess(c, userID, posts)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		st := states[p.ID]
+		if lock := resolveHiddenLock(p, st); lock != nil {
+			p.HiddenLock = lock
+			p.Content = markdown.StripHidden(p.Content, hiddenPlaceholder(lock, p.ContentType == "html"))
+			if p.MdContent != nil {
+				md := markdown.StripHidden(*p.MdContent, hiddenPlaceholder(lock, false))
+				p.MdContent = &md
+			}
+			if p.HtmlContent != nil {
+				html := markdown.StripHidden(*p.HtmlContent, hiddenPlaceholder(lock, true))
+				p.HtmlContent = &html
+			}
+		}
+		if lock := resolveLock(p, st); lock != nil {
+			p.Lock = lock
+			p.Content = previewContent(p.Content, p.ContentType == "html", previewLength)
+			p.MdContent = nil
+			p.HtmlContent = nil
+		}
+	}
+	return nil
+}
+
+func (s *PostAccessServiceImpl) GuardSearchResults(c context.Context, userID *int, results []*model.PostSearchResp) ([]*model.PostSearchResp, error) {
+	if len(results) == 0 {
+		return results, nil
+	}
+	ids := make([]int, 0, len(results))
+	for _, r := range results {
+		ids = append(ids, r.ID)
+	}
+	entities, err := s.client.Post.Query().
+		Where(post.IDIn(ids...)).
+		Select(post.FieldID, post.FieldSlug, post.FieldContentType, post.FieldIsVisibleAfterPay, post.FieldIsVisibleAfterComment, post.FieldPrice).
+		All(c)
+	if err != nil {
+		return nil, err
+	}
+	gates := make(map[int]*model.PostResp, len(entities))
+	for _, p := range entities {
+		gates[p.ID] = gateResp(p)
+	}
+
+	guardedPosts := make([]*model.PostResp, 0, len(results))
+	for _, r := range results {
+		if g, ok := gates[r.ID]; ok {
+			g.Content = r.Content
+			guardedPosts = append(guardedPosts, g)
+		}
+	}
+	if err := s.GuardPosts(c, userID, guardedPosts...); err != nil {
+		return nil, err
+	}
+
+	// 搜索结果可能来自缓存，复制后再修改
+	guarded := make([]*model.PostSearchResp, 0, len(results))
+	for _, r := range results {
+		g, ok := gates[r.ID]
+		if !ok {
+			continue
+		}
+		locked := *r
+		locked.Content = g.Content
+		locked.Lock = g.Lock
+		guarded = append(guarded, &locked)
+	}
+	return guarded, nil
+}
+
+func (s *PostAccessServiceImpl) GuardPost(c context.Context, userID *int, p *ent.Post) (*model.PostLock, error) {
+	g := gateResp(p)
+	g.Content = p.Content
+	g.MdContent = p.MdContent
+	g.HtmlContent = p.HTMLContent
+	if err := s.GuardPosts(c, userID, g); err != nil {
+		return nil, err
+	}
+	p.Content = g.Content
+	p.MdContent = g.MdContent
+	p.HTMLContent = g.HtmlContent
+	return g.Lock, nil
+}
+
+// resolveAccess 批量查询购买记录和已审核评论
+func (s *PostAccessServiceImpl) resolveAccess(c context.Context, userID *int, posts []*model.PostResp) (map[int]access, error) {
+	states := make(map[int]access, len(posts))
+	if userID == nil {
+		return states, nil
+	}
+
+	var payGated, commentGated []*model.PostResp
+	for _, p := range posts {
+		needPay, needComment := requirements(p)
+		if needPay {
+			payGated = append(payGated, p)
+		}
+		if needComment {
+			commentGated = append(commentGated, p)
+		}
+	}
+
+	purchased, err := s.purchasedPosts(c, *userID, payGated)
+	if err != nil {
+		return nil, err
+	}
+	commented, err := s.commentedPosts(c, *userID, commentGated)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		states[p.ID] = access{loggedIn: true, purchased: purchased[p.ID], commented: commented[p.ID]}
+	}
+	return states, nil
+}
+
+// requirements 文章整篇锁定或隐藏内容块需要检查的条件，隐藏块在文章定价时需要购买，否则需要评论
+func requirements(p *model.PostResp) (needPay bool, needComment bool) {
+	hidden := hasHidden(p)
+	needPay = p.IsVisibleAfterPay || (hidden && p.Price > 0)
+	needComment = p.IsVisibleAfterComment || (hidden && p.Price <= 0)
+	return needPay, needComment
+}
+
+func hasHidden(p *model.PostResp) bool {
+	if markdown.HasHidden(p.Content) {
+		return true
+	}
+	if p.MdContent != nil && markdown.HasHidden(*p.MdContent) {
+		return true
+	}
+	return p.HtmlContent != nil && markdown.HasHidden(*p.HtmlContent)
+}
+
+// purchasedPosts 已购买的付费文章
+func (s *PostAccessServiceImpl) purchasedPosts(c context.Context, userID int, posts []*model.PostResp) (map[int]bool, error) {
+	ids := make([]int, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.ID)
+	}
+	result := make(map[int]bool)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	purchases, err := s.client.PostPurchase.Query().
+		Where(postpurchase.UserID(userID), postpurchase.PostIDIn(ids...)).
+		All(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, purchase := range purchases {
+		result[purchase.PostID] = true
+	}
+	return result, nil
+}
+
+// commentedPosts 当前用户有已审核评论的文章，评论按文章ID或文章页面路径关联
+func (s *PostAccessServiceImpl) commentedPosts(c context.Context, userID int, posts []*model.PostResp) (map[int]bool, error) {
+	ids := make([]int, 0, len(posts))
+	paths := make(map[string]int)
+	for _, p := range posts {
+		ids = append(ids, p.ID)
+		for _, path := range postPaths(p.Slug) {
+			paths[path] = p.ID
+		}
+	}
+	result := make(map[int]bool)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	urls := make([]string, 0, len(paths))
+	for path := range paths {
+		urls = append(urls, path)
+	}
+	comments, err := s.client.Comment.Query().
+		Where(
+			comment.UserID(userID),
+			comment.Status(approvedCommentStatus),
+			comment.Or(comment.PostIDIn(ids...), comment.URLIn(urls...)),
+		).
+		All(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, cm := range comments {
+		if cm.PostID != nil {
+			result[*cm.PostID] = true
+		}
+		if id, ok := paths[cm.URL]; ok {
+			result[id] = true
+		}
+	}
+	return result, nil
+}
+
+// resolveLock 计算整篇文章的锁定信息，返回 nil 表示可以查看全文
+func resolveLock(p *model.PostResp, st access) *model.PostLock {
+	return buildLock(p, st, p.IsVisibleAfterPay, p.IsVisibleAfterComment)
+}
+
+// resolveHiddenLock 计算隐藏内容块的锁定信息，返回 nil 表示可以查看隐藏内容
+func resolveHiddenLock(p *model.PostResp, st access) *model.PostLock {
+	if !hasHidden(p) {
+		return nil
+	}
+	return buildLock(p, st, p.Price > 0, p.Price <= 0)
+}
+
+func buildLock(p *model.PostResp, st access, payRequired, commentRequired bool) *model.PostLock {
+	needPay := payRequired && !st.purchased
+	needComment := commentRequired && !st.commented
+	if !needPay && !needComment {
+		return nil
+	}
+	lock := &model.PostLock{
+		NeedPay:     needPay,
+		NeedComment: needComment,
+	}
+	if needPay {
+		lock.Price = p.Price
+	}
+	switch {
+	case !st.loggedIn:
+		lock.RequiredAction = model.PostLockActionLogin
+	case needPay:
+		lock.RequiredAction = model.PostLockActionPay
+	default:
+		lock.RequiredAction = model.PostLockActionComment
+	}
+	return lock
+}
+
+// hiddenPlaceholder 隐藏内容块的占位内容，说明解锁方式
+func hiddenPlaceholder(lock *model.PostLock, isHTML bool) string {
+	var tip string
+	switch {
+	case lock.NeedPay && lock.RequiredAction == model.PostLockActionLogin:
+		tip = fmt.Sprintf("此处内容需登录并付费 ¥%.2f 后查看", lock.Price)
+	case lock.NeedPay:
+		tip = fmt.Sprintf("此处内容需付费 ¥%.2f 后查看", lock.Price)
+	case lock.RequiredAction == model.PostLockActionLogin:
+		tip = "此处内容需登录并评论通过审核后查看"
+	default:
+		tip = "此处内容需评论并通过审核后查看"
+	}
+	if isHTML {
+		return `<div class="post-hidden">` + tip + `</div>`
+	}
+	return "\n\n> " + tip + "\n\n"
+}
+
+// previewContent 截取纯文本预览，最多 limit 个字符且不超过全文的一半，避免短文章被完整展示。
+// 先转为纯文本再截取，不会从标签或 markdown 语法中间截断
+func previewContent(content string, isHTML bool, limit int) string {
+	runes := []rune(markdown.PlainText(content, isHTML))
+	if half := len(runes) / 2; limit > half {
+		limit = half
+	}
+	if limit <= 0 {
+		return ""
+	}
+	return strings.TrimSpace(string(runes[:limit])) + "..."
+}
+
+// postPaths 文章页面可能的评论地址
+func postPaths(slug *string) []string {
+	if slug == nil || *slug == "" {
+		return nil
+	}
+	paths := []string{"/post/" + *slug, "/post/" + *slug + "/"}
+	if escaped := url.PathEscape(*slug); escaped != *slug {
+		paths = append(paths, "/post/"+escaped, "/post/"+escaped+"/")
+	}
+	return paths
+}
+
+func gateResp(p *ent.Post) *model.PostResp {
+	return &model.PostResp{
+		ID:                    p.ID,
+		Slug:                  p.Slug,
+		ContentType:           string(p.ContentType),
+		IsVisibleAfterPay:     p.IsVisibleAfterPay,
+		IsVisibleAfterComment: p.IsVisibleAfterComment,
+		Price:                 float32(p.Price) / 100,
+	}
+}
@@ -0,0 +1,70 @@
+package postaccess
+
+import (
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+func TestResolveLock(t *testing.T) {
+	paid := &model.PostResp{ID: 1, IsVisibleAfterPay: true, Price: 9.9}
+	commentOnly := &model.PostResp{ID: 2, IsVisibleAfterComment: true}
+	both := &model.PostResp{ID: 3, IsVisibleAfterPay: true, IsVisibleAfterComment: true, Price: 1}
+
+	tests := []struct {
+		name       string
+		post       *model.PostResp
+		loggedIn   bool
+		purchased  bool
+		commented  bool
+		wantAction string
+	}{
+		{"普通文章", &model.PostResp{ID: 4}, false, false, false, ""},
+		{"付费文章未登录", paid, false, false, false, model.PostLockActionLogin},
+		{"付费文章未购买", paid, true, false, false, model.PostLockActionPay},
+		{"付费文章已购买", paid, true, true, false, ""},
+		{"评论可见未评论", commentOnly, true, false, false, model.PostLockActionComment},
+		{"评论可见已评论", commentOnly, true, false, true, ""},
+		{"付费且评论可见已购买未评论", both, true, true, false, model.PostLockActionComment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lock := resolveLock(tt.post, access{loggedIn: tt.loggedIn, purchased: tt.purchased, commented: tt.commented})
+			if tt.wantAction == "" {
+				if lock != nil {
+					t.Fatalf("resolveLock() = %+v, want nil", lock)
+				}
+				return
+			}
+			if lock == nil || lock.RequiredAction != tt.wantAction {
+				t.Fatalf("resolveLock() = %+v, want action %q", lock, tt.wantAction)
+			}
+			if lock.NeedPay && lock.Price != tt.post.Price {
+				t.Errorf("Price = %v, want %v", lock.Price, tt.post.Price)
+			}
+		})
+	}
+}
+
+func TestPreviewContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		isHTML  bool
+		limit   int
+		want    string
+	}{
+		{"按字数截取", "一二三四五六七八九十", false, 3, "一二三..."},
+		{"不超过全文一半", "一二三四", false, 10, "一二..."},
+		{"过短时不返回内容", "一", false, 10, ""},
+		{"不计入 markdown 语法", "## 一二三\n\n**四五六**七八九十", false, 3, "一二三..."},
+		{"不截断 HTML 标签", `<p><a href="https://example.com/very/long">一二三四</a>五六七八</p>`, true, 3, "一二三..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := previewContent(tt.content, tt.isHTML, tt.limit); got != tt.want {
+				t.Errorf("previewContent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
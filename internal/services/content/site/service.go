@@ -0,0 +1,411 @@
+package site
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shuTwT/hoshikuzu/ent"
+	category_service "github.com/shuTwT/hoshikuzu/internal/services/content/category"
+	menu_service "github.com/shuTwT/hoshikuzu/internal/services/content/menu"
+	post_service "github.com/shuTwT/hoshikuzu/internal/services/content/post"
+	postaccess_service "github.com/shuTwT/hoshikuzu/internal/services/content/postaccess"
+	tag_service "github.com/shuTwT/hoshikuzu/internal/services/content/tag"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	user_service "github.com/shuTwT/hoshikuzu/internal/services/system/user"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+const (
+	defaultPageSize   = 10
+	relatedPostsLimit = 5
+)
+
+// SiteService 为主题模板组装各页面的数据模型
+type SiteService interface {
+	GetSiteMeta(c *fiber.Ctx) model.SiteMeta
+	BuildIndexPage(c *fiber.Ctx, base model.SitePage, page int) (*model.IndexPage, error)
+	BuildPostPage(c *fiber.Ctx, base model.SitePage, slug string) (*model.PostPage, error)
+	BuildArchivesPage(c *fiber.Ctx, base model.SitePage) (*model.ArchivesPage, error)
+	BuildCategoriesPage(c *fiber.Ctx, base model.SitePage) (*model.CategoriesPage, error)
+	BuildCategoryPage(c *fiber.Ctx, base model.SitePage, name string, page int) (*model.CategoryPage, error)
+	BuildTagsPage(c *fiber.Ctx, base model.SitePage) (*model.TagsPage, error)
+	BuildTagPage(c *fiber.Ctx, base model.SitePage, name string, page int) (*model.TagPage, error)
+	BuildAuthorPage(c *fiber.Ctx, base model.SitePage, userId int, page int) (*model.AuthorPage, error)
+	BuildBasePage(c *fiber.Ctx, base model.SitePage) model.SitePage
+}
+
+type SiteServiceImpl struct {
+	postService       post_service.PostService
+	postAccessService postaccess_service.PostAccessService
+	categoryService   category_service.CategoryService
+	tagService        tag_service.TagService
+	menuService       menu_service.MenuService
+	settingService    setting_service.SettingService
+	userService       user_service.UserService
+}
+
+func NewSiteServiceImpl(
+	postService post_service.PostService,
+	postAccessService postaccess_service.PostAccessService,
+	categoryService category_service.CategoryService,
+	tagService tag_service.TagService,
+	menuService menu_service.MenuService,
+	settingService setting_service.SettingService,
+	userService user_service.UserService,
+) *SiteServiceImpl {
+	return &SiteServiceImpl{
+		postService:       postService,
+		postAccessService: postAccessService,
+		categoryService:   categoryService,
+		tagService:        tagService,
+		menuService:       menuService,
+		settingService:    settingService,
+		userService:       userService,
+	}
+}
+
+// GetSiteMeta 读取 basic 设置作为站点信息，读取失败时返回空值以保证页面可渲染
+func (s *SiteServiceImpl) GetSiteMeta(c *fiber.Ctx) model.SiteMeta {
+	var meta model.SiteMeta
+	setting, err := s.settingService.GetSettingByKey(c.Context(), "basic")
+	if err != nil {
+		return meta
+	}
+	_ = json.Unmarshal([]byte(setting.Value), &meta)
+	return meta
+}
+
+// BuildBasePage 填充站点信息与可见菜单
+func (s *SiteServiceImpl) BuildBasePage(c *fiber.Ctx, base model.SitePage) model.SitePage {
+	base.Site = s.GetSiteMeta(c)
+	if base.Site.Name != "" {
+		base.Title = pageTitle(base.Title, base.Site.Name)
+	}
+
+	menus, err := s.menuService.QueryMenuList(c)
+	if err == nil {
+		for _, m := range menus {
+			if m.Visible {
+				base.Menus = append(base.Menus, m)
+			}
+		}
+	}
+	return base
+}
+
+func (s *SiteServiceImpl) BuildIndexPage(c *fiber.Ctx, base model.SitePage, page int) (*model.IndexPage, error) {
+	posts, pagination, err := s.queryPublishedPage(c, base, model.PostPageReq{}, page, "/")
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryService.QueryCategoryList(c.Context())
+	if err != nil {
+		return nil, fmt.Errorf("查询分类失败: %w", err)
+	}
+
+	tags, err := s.tagService.QueryTagList(c)
+	if err != nil {
+		return nil, fmt.Errorf("查询标签失败: %w", err)
+	}
+
+	return &model.IndexPage{
+		SitePage:   s.BuildBasePage(c, base),
+		Posts:      posts,
+		Pagination: pagination,
+		Categories: categories,
+		Tags:       tags,
+	}, nil
+}
+
+// BuildPostPage 文章详情页，未发布或不可见的文章视为不存在
+func (s *SiteServiceImpl) BuildPostPage(c *fiber.Ctx, base model.SitePage, slug string) (*model.PostPage, error) {
+	p, err := s.postService.QueryPostBySlug(c.Context(), slug)
+	if err != nil {
+		return nil, err
+	}
+	if string(p.Status) != "published" || !p.IsVisible {
+		return nil, &ent.NotFoundError{}
+	}
+
+	related, err := s.postService.GetRelatedPosts(c.Context(), p.ID, relatedPostsLimit)
+	if err != nil {
+		related = nil
+	}
+
+	postResp := post_service.ToPostResp(p)
+	guarded := []*model.PostResp{&postResp}
+	for _, r := range related {
+		guarded = append(guarded, &r.PostResp)
+	}
+	if err := s.postAccessService.GuardPosts(c.Context(), currentUserID(base), guarded...); err != nil {
+		return nil, fmt.Errorf("校验文章访问权限失败: %w", err)
+	}
+
+	base.Title = p.Title
+	return &model.PostPage{
+		SitePage: s.BuildBasePage(c, base),
+		Post:     postResp,
+		Related:  related,
+	}, nil
+}
+
+// BuildArchivesPage 归档页，按发布时间的年月倒序分组
+func (s *SiteServiceImpl) BuildArchivesPage(c *fiber.Ctx, base model.SitePage) (*model.ArchivesPage, error) {
+	status := "published"
+	visible := true
+	posts, err := s.postService.QueryPostList(c.Context(), model.PostListReq{
+		Status:    &status,
+		IsVisible: &visible,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("查询文章失败: %w", err)
+	}
+
+	groups := groupArchives(posts)
+	for _, g := range groups {
+		if err := s.guardPosts(c, base, g.Posts); err != nil {
+			return nil, err
+		}
+	}
+
+	return &model.ArchivesPage{
+		SitePage: s.BuildBasePage(c, base),
+		Groups:   groups,
+		Total:    len(posts),
+	}, nil
+}
+
+func (s *SiteServiceImpl) BuildCategoriesPage(c *fiber.Ctx, base model.SitePage) (*model.CategoriesPage, error) {
+	categories, err := s.categoryService.QueryCategoryList(c.Context())
+	if err != nil {
+		return nil, fmt.Errorf("查询分类失败: %w", err)
+	}
+
+	resp := make([]model.CategoryResp, 0, len(categories))
+	for _, cat := range categories {
+		count, err := s.postService.PostCountByCategory(c.Context(), cat.ID)
+		if err != nil {
+			return nil, fmt.Errorf("统计分类文章失败: %w", err)
+		}
+		resp = append(resp, toCategoryResp(cat, count))
+	}
+
+	return &model.CategoriesPage{
+		SitePage:   s.BuildBasePage(c, base),
+		Categories: resp,
+	}, nil
+}
+
+// BuildCategoryPage 分类文章页，name 可以是分类名称或别名
+func (s *SiteServiceImpl) BuildCategoryPage(c *fiber.Ctx, base model.SitePage, name string, page int) (*model.CategoryPage, error) {
+	categories, err := s.categoryService.QueryCategoryList(c.Context())
+	if err != nil {
+		return nil, fmt.Errorf("查询分类失败: %w", err)
+	}
+
+	var current *ent.Category
+	for _, cat := range categories {
+		if cat.Name == name || cat.Slug == name {
+			current = cat
+			break
+		}
+	}
+	if current == nil {
+		return nil, &ent.NotFoundError{}
+	}
+
+	posts, pagination, err := s.queryPublishedPage(c, base, model.PostPageReq{CategoryID: &current.ID}, page, "/category/"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	base.Title = current.Name
+	return &model.CategoryPage{
+		SitePage:   s.BuildBasePage(c, base),
+		Category:   toCategoryResp(current, pagination.Total),
+		Posts:      posts,
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *SiteServiceImpl) BuildTagsPage(c *fiber.Ctx, base model.SitePage) (*model.TagsPage, error) {
+	tags, err := s.tagService.QueryTagList(c)
+	if err != nil {
+		return nil, fmt.Errorf("查询标签失败: %w", err)
+	}
+
+	return &model.TagsPage{
+		SitePage: s.BuildBasePage(c, base),
+		Tags:     tags,
+	}, nil
+}
+
+// BuildTagPage 标签文章页，name 可以是标签名称或别名
+func (s *SiteServiceImpl) BuildTagPage(c *fiber.Ctx, base model.SitePage, name string, page int) (*model.TagPage, error) {
+	tags, err := s.tagService.QueryTagList(c)
+	if err != nil {
+		return nil, fmt.Errorf("查询标签失败: %w", err)
+	}
+
+	var current *model.TagResp
+	for i := range tags {
+		if tags[i].Name == name || tags[i].Slug == name {
+			current = &tags[i]
+			break
+		}
+	}
+	if current == nil {
+		return nil, &ent.NotFoundError{}
+	}
+
+	posts, pagination, err := s.queryPublishedPage(c, base, model.PostPageReq{TagID: &current.ID}, page, "/tag/"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	base.Title = current.Name
+	return &model.TagPage{
+		SitePage:   s.BuildBasePage(c, base),
+		Tag:        *current,
+		Posts:      posts,
+		Pagination: pagination,
+	}, nil
+}
+
+// BuildAuthorPage 作者文章页，文章按作者昵称（未设置时为用户名）匹配
+func (s *SiteServiceImpl) BuildAuthorPage(c *fiber.Ctx, base model.SitePage, userId int, page int) (*model.AuthorPage, error) {
+	u, err := s.userService.QueryUserById(c.Context(), userId)
+	if err != nil {
+		return nil, err
+	}
+
+	author := model.SiteAuthor{
+		ID:       u.ID,
+		Name:     u.Name,
+		Nickname: u.Nickname,
+		Bio:      u.Bio,
+	}
+	authorName := u.Nickname
+	if authorName == "" {
+		authorName = u.Name
+	}
+
+	posts, pagination, err := s.queryPublishedPage(c, base, model.PostPageReq{Author: authorName}, page, fmt.Sprintf("/author/%d", userId))
+	if err != nil {
+		return nil, err
+	}
+
+	base.Title = authorName
+	return &model.AuthorPage{
+		SitePage:   s.BuildBasePage(c, base),
+		Author:     author,
+		Posts:      posts,
+		Pagination: pagination,
+	}, nil
+}
+
+// queryPublishedPage 分页查询已发布且可见的文章
+func (s *SiteServiceImpl) queryPublishedPage(c *fiber.Ctx, base model.SitePage, req model.PostPageReq, page int, basePath string) ([]model.PostResp, model.Pagination, error) {
+	if page < 1 {
+		page = 1
+	}
+	status := "published"
+	visible := true
+	req.Page = page
+	req.Size = defaultPageSize
+	req.Status = &status
+	req.IsVisible = &visible
+
+	posts, count, err := s.postService.QueryPostPage(c.Context(), req)
+	if err != nil {
+		return nil, model.Pagination{}, fmt.Errorf("查询文章失败: %w", err)
+	}
+
+	resp := make([]model.PostResp, 0, len(posts))
+	for _, p := range posts {
+		resp = append(resp, post_service.ToPostResp(p))
+	}
+	if err := s.guardPosts(c, base, resp); err != nil {
+		return nil, model.Pagination{}, err
+	}
+	return resp, model.NewPagination(page, defaultPageSize, count, basePath), nil
+}
+
+// guardPosts 对列表中付费可见、评论可见的文章只保留预览
+func (s *SiteServiceImpl) guardPosts(c *fiber.Ctx, base model.SitePage, posts []model.PostResp) error {
+	guarded := make([]*model.PostResp, 0, len(posts))
+	for i := range posts {
+		guarded = append(guarded, &posts[i])
+	}
+	if err := s.postAccessService.GuardPosts(c.Context(), currentUserID(base), guarded...); err != nil {
+		return fmt.Errorf("校验文章访问权限失败: %w", err)
+	}
+	return nil
+}
+
+// currentUserID 页面访问者的用户ID，匿名访问时返回 nil
+func currentUserID(base model.SitePage) *int {
+	if base.CurrentUser == nil {
+		return nil
+	}
+	return &base.CurrentUser.ID
+}
+
+// groupArchives 将文章按年月分组，文章已按 ID 倒序排列
+func groupArchives(posts []*ent.Post) []model.ArchiveGroup {
+	var groups []model.ArchiveGroup
+	for _, p := range posts {
+		t := p.CreatedAt
+		if p.PublishedAt != nil {
+			t = *p.PublishedAt
+		}
+		year, month := t.Year(), int(t.Month())
+
+		idx := -1
+		for i := range groups {
+			if groups[i].Year == year && groups[i].Month == month {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			groups = append(groups, model.ArchiveGroup{Year: year, Month: month})
+			idx = len(groups) - 1
+		}
+		groups[idx].Posts = append(groups[idx].Posts, post_service.ToPostResp(p))
+	}
+
+	sortArchiveGroups(groups)
+	return groups
+}
+
+func sortArchiveGroups(groups []model.ArchiveGroup) {
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Year != groups[j].Year {
+			return groups[i].Year > groups[j].Year
+		}
+		return groups[i].Month > groups[j].Month
+	})
+}
+
+func toCategoryResp(cat *ent.Category, postCount int) model.CategoryResp {
+	return model.CategoryResp{
+		ID:          cat.ID,
+		Name:        cat.Name,
+		Description: cat.Description,
+		Slug:        cat.Slug,
+		SortOrder:   cat.SortOrder,
+		Active:      cat.Active,
+		PostCount:   postCount,
+	}
+}
+
+func pageTitle(title, siteName string) string {
+	if title == "" {
+		return siteName
+	}
+	return title + " - " + siteName
+}
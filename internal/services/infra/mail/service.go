@@ -0,0 +1,93 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	mail_infra "github.com/shuTwT/hoshikuzu/internal/infra/mail"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+type MailService interface {
+	Send(ctx context.Context, msg *mail_infra.Message) error
+}
+
+type MailServiceImpl struct {
+	settingService setting_service.SettingService
+}
+
+func NewMailServiceImpl(settingService setting_service.SettingService) *MailServiceImpl {
+	return &MailServiceImpl{settingService: settingService}
+}
+
+// emailSettings 系统设置中的邮件配置
+type emailSettings struct {
+	SMTPHost       string  `json:"smtpHost"`
+	SMTPPort       flexInt `json:"smtpPort"`
+	SMTPUsername   string  `json:"smtpUsername"`
+	SMTPPassword   string  `json:"smtpPassword"`
+	SMTPEncryption string  `json:"smtpEncryption"`
+	SenderEmail    string  `json:"senderEmail"`
+	SenderName     string  `json:"senderName"`
+}
+
+// flexInt 兼容以字符串保存的端口
+type flexInt int
+
+func (i *flexInt) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*i = flexInt(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*i = 0
+		return nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*i = flexInt(n)
+	return nil
+}
+
+// Send 按邮件设置发送邮件，每次发送时读取设置以保证修改即时生效
+func (s *MailServiceImpl) Send(ctx context.Context, msg *mail_infra.Message) error {
+	sender, err := s.sender(ctx)
+	if err != nil {
+		return err
+	}
+	return sender.Send(ctx, msg)
+}
+
+func (s *MailServiceImpl) sender(ctx context.Context) (mail_infra.Sender, error) {
+	setting, err := s.settingService.GetSettingByKey(ctx, model.SettingKeyEmail)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, mail_infra.ErrNotConfigured
+		}
+		return nil, err
+	}
+	var es emailSettings
+	if err := json.Unmarshal([]byte(setting.Value), &es); err != nil {
+		return nil, fmt.Errorf("解析邮件配置失败: %w", err)
+	}
+	return mail_infra.NewSMTPSender(mail_infra.SMTPConfig{
+		Host:       es.SMTPHost,
+		Port:       int(es.SMTPPort),
+		Username:   es.SMTPUsername,
+		Password:   es.SMTPPassword,
+		Encryption: es.SMTPEncryption,
+		From:       es.SenderEmail,
+		FromName:   es.SenderName,
+	}), nil
+}
@@ -1,23 +1,17 @@
 package migration
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"path/filepath"
-	"regexp"
 	"strings"
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/ent/post"
+	"github.com/shuTwT/hoshikuzu/internal/infra/markdown"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
-
-	"github.com/microcosm-cc/bluemonday"
-	"github.com/yuin/goldmark"
-	"github.com/yuin/goldmark/extension"
-	"github.com/yuin/goldmark/renderer/html"
 )
 
 type MigrationService interface {
@@ -26,40 +20,12 @@ type MigrationService interface {
 }
 
 type MigrationServiceImpl struct {
-	client        *ent.Client
-	markdown      goldmark.Markdown
-	htmlSanitizer *bluemonday.Policy
+	client *ent.Client
 }
 
 func NewMigrationServiceImpl(client *ent.Client) *MigrationServiceImpl {
-	md := goldmark.New(
-		goldmark.WithExtensions(
-			extension.GFM,
-			extension.Table,
-			extension.Strikethrough,
-			extension.TaskList,
-		),
-		goldmark.WithRendererOptions(
-			html.WithHardWraps(),
-			html.WithXHTML(),
-		),
-	)
-
-	policy := bluemonday.UGCPolicy()
-	policy.AllowElements("a", "abbr", "b", "blockquote", "br", "caption", "cite", "code", "col", "colgroup",
-		"dd", "del", "details", "div", "dl", "dt", "em", "figcaption", "figure", "h1", "h2", "h3", "h4", "h5", "h6",
-		"hr", "i", "img", "ins", "kbd", "li", "ol", "p", "pre", "q", "rp", "rt", "ruby", "s", "samp",
-		"section", "small", "span", "strong", "sub", "sup", "table", "tbody", "td", "tfoot", "th", "thead",
-		"tr", "u", "ul", "var", "wbr")
-	policy.AllowAttrs("class").Matching(regexp.MustCompile(`[\w\s\-_]+`)).OnElements("div", "span", "p", "td", "th", "li")
-	policy.AllowAttrs("href").OnElements("a")
-	policy.AllowAttrs("src", "alt", "title", "width", "height").OnElements("img")
-	policy.AllowAttrs("colspan", "rowspan").OnElements("td", "th")
-
 	return &MigrationServiceImpl{
-		client:        client,
-		markdown:      md,
-		htmlSanitizer: policy,
+		client: client,
 	}
 }
 
@@ -89,15 +55,13 @@ func (s *MigrationServiceImpl) ImportMarkdownFiles(ctx context.Context, files []
 
 		title := extractTitleFromMarkdown(fileHeader.Filename, string(content))
 
-		var buf bytes.Buffer
-		if err = s.markdown.Convert(content, &buf); err != nil {
+		htmlContent, err := markdown.Render(content)
+		if err != nil {
 			result.Failed++
 			result.Errors = append(result.Errors, fmt.Sprintf("%s: Markdown转HTML失败 - %v", fileHeader.Filename, err))
 			continue
 		}
 
-		htmlContent := s.htmlSanitizer.Sanitize(buf.String())
-
 		existingPost, _ := s.client.Post.Query().
 			Where(post.Title(title)).
 			First(ctx)
@@ -1,21 +1,30 @@
 package permission
 
 import (
-	"embed"
+	"context"
 	"fmt"
 	"io/fs"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/role"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 
 	"github.com/pelletier/go-toml/v2"
 )
 
-var permissions []string
+// modules 按模块分组的权限定义
+var modules []model.ModuleDef
+
+// permissions 全部已定义的权限范围
+var permissions = map[string]struct{}{}
 
 type PermissionService interface {
-	LoadPermissionsFromDef(fileFs embed.FS)
+	LoadPermissionsFromDef(fileFs fs.FS)
+	ListPermissionGroups() []model.PermissionGroupResp
+	InitRolePermissions(ctx context.Context) error
 }
 
 type PermissionServiceImpl struct {
@@ -26,7 +35,7 @@ func NewPermissionServiceImpl(client *ent.Client) *PermissionServiceImpl {
 	return &PermissionServiceImpl{client: client}
 }
 
-func (s *PermissionServiceImpl) LoadPermissionsFromDef(fileFs embed.FS) {
+func (s *PermissionServiceImpl) LoadPermissionsFromDef(fileFs fs.FS) {
 	dir, _ := fs.ReadDir(fileFs, "assets/moduleDefs")
 	for _, entry := range dir {
 		if entry.IsDir() {
@@ -34,7 +43,7 @@ func (s *PermissionServiceImpl) LoadPermissionsFromDef(fileFs embed.FS) {
 		}
 		filePath := fmt.Sprintf("assets/moduleDefs/%s", entry.Name())
 
-		content, err := fileFs.ReadFile(filePath)
+		content, err := fs.ReadFile(fileFs, filePath)
 		if err != nil {
 			log.Printf("读取文件 %s 失败: %v", filePath, err)
 			continue
@@ -46,13 +55,115 @@ func (s *PermissionServiceImpl) LoadPermissionsFromDef(fileFs embed.FS) {
 			log.Printf("解析文件 %s 失败: %v", filePath, err)
 			continue
 		}
-		var scopes []string
-		// 将 permission.scope复制到 scopes
+		modules = append(modules, moduleDef)
 		for _, permission := range moduleDef.Meta.Permissions {
-			scopes = append(scopes, permission.Scope)
+			permissions[permission.Scope] = struct{}{}
+		}
+	}
+}
+
+// ListPermissionGroups 按模块列出全部已定义的权限
+func (s *PermissionServiceImpl) ListPermissionGroups() []model.PermissionGroupResp {
+	groups := make([]model.PermissionGroupResp, 0, len(modules))
+	for _, m := range modules {
+		items := make([]model.PermissionResp, 0, len(m.Meta.Permissions))
+		for _, p := range m.Meta.Permissions {
+			items = append(items, model.PermissionResp{Scope: p.Scope, Title: p.Title})
 		}
+		groups = append(groups, model.PermissionGroupResp{
+			Module:      m.Name,
+			Description: m.Description,
+			Permissions: items,
+		})
+	}
+	return groups
+}
 
-		permissions = append(permissions, scopes...)
+// InitRolePermissions 为尚未配置权限的内置角色写入默认权限，兼容升级前创建的角色
+func (s *PermissionServiceImpl) InitRolePermissions(ctx context.Context) error {
+	roles, err := s.client.Role.Query().
+		Where(role.PermissionsIsNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		scopes, ok := model.DefaultRoleScopes[r.Code]
+		if !ok {
+			continue
+		}
+		if err := s.client.Role.UpdateOne(r).SetPermissions(scopes).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateScopes 校验权限范围均已定义，支持 * 与 hoshikuzu:post:* 形式的通配
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if scope == model.ScopeAll {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(scope, "*"); ok {
+			if !hasScopePrefix(prefix) {
+				return fmt.Errorf("未定义的权限: %s", scope)
+			}
+			continue
+		}
+		if _, ok := permissions[scope]; !ok {
+			return fmt.Errorf("未定义的权限: %s", scope)
+		}
+	}
+	return nil
+}
+
+// HasScope 已授予的权限是否包含所需权限，支持 * 与 hoshikuzu:post:* 形式的通配
+func HasScope(granted []string, required string) bool {
+	for _, scope := range granted {
+		if scope == required || scope == model.ScopeAll {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(scope, "*"); ok && strings.HasPrefix(required, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDefined 权限范围是否已在模块定义中声明
+func IsDefined(scope string) bool {
+	_, ok := permissions[scope]
+	return ok
+}
+
+// DefinedScopes 返回全部已定义的权限范围
+func DefinedScopes() []string {
+	scopes := make([]string, 0, len(permissions))
+	for scope := range permissions {
+		scopes = append(scopes, scope)
+	}
+	slices.Sort(scopes)
+	return scopes
+}
+
+// ScopeTitle 权限范围的名称，未定义时返回权限范围本身
+func ScopeTitle(scope string) string {
+	for _, m := range modules {
+		for _, p := range m.Meta.Permissions {
+			if p.Scope == scope {
+				return p.Title
+			}
+		}
+	}
+	return scope
+}
+
+func hasScopePrefix(prefix string) bool {
+	for scope := range permissions {
+		if strings.HasPrefix(scope, prefix) {
+			return true
+		}
 	}
-	// log.Printf("%v", permissions)
+	return false
 }
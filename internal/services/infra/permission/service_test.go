@@ -0,0 +1,26 @@
+package permission
+
+import "testing"
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required string
+		want     bool
+	}{
+		{"精确匹配", []string{"hoshikuzu:post:view"}, "hoshikuzu:post:view", true},
+		{"全部权限", []string{"*"}, "hoshikuzu:user:delete", true},
+		{"模块通配", []string{"hoshikuzu:post:*"}, "hoshikuzu:post:delete", true},
+		{"通配不跨模块", []string{"hoshikuzu:post:*"}, "hoshikuzu:post-tag:view", false},
+		{"无权限", nil, "hoshikuzu:post:view", false},
+		{"其他权限", []string{"hoshikuzu:post:view"}, "hoshikuzu:post:delete", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasScope(tt.granted, tt.required); got != tt.want {
+				t.Fatalf("HasScope(%v, %q) = %v, want %v", tt.granted, tt.required, got, tt.want)
+			}
+		})
+	}
+}
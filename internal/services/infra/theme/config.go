@@ -0,0 +1,456 @@
+package theme
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	theme_infra "github.com/shuTwT/hoshikuzu/internal/infra/theme"
+	"github.com/shuTwT/hoshikuzu/pkg/cache"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultSettingName   = "config.yaml"
+	configMapKeyPrefix   = "theme_config_"
+	schemaCacheKeyPrefix = "theme_schema:"
+	schemaCacheTTL       = 10 * time.Minute
+)
+
+var (
+	colorPattern   = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	settingTypeSet = map[string]struct{}{
+		"text": {}, "textarea": {}, "number": {}, "switch": {}, "select": {},
+		"color": {}, "image": {}, "url": {}, "array": {},
+	}
+)
+
+// settingFileName 配置表单声明文件名，未声明 setting-name 时使用 config.yaml
+func settingFileName(settingName string) string {
+	if settingName != "" {
+		return filepath.Base(settingName)
+	}
+	return defaultSettingName
+}
+
+// configMapKey 主题配置值在系统设置中的存储键。config-map-name 由主题包声明，
+// 同样加上前缀，避免覆盖 basic、payment 等系统设置
+func configMapKey(t *ent.Theme) string {
+	if t.ConfigMapName != "" {
+		return configMapKeyPrefix + t.ConfigMapName
+	}
+	return configMapKeyPrefix + t.Name
+}
+
+// loadSettingSchema 读取并校验主题目录下的配置表单声明，文件不存在时返回空表单
+func loadSettingSchema(themeDir, fileName string) (*model.ThemeSettingSchema, error) {
+	content, err := os.ReadFile(filepath.Join(themeDir, fileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &model.ThemeSettingSchema{Type: "setting", Forms: []model.ThemeSettingGroup{}}, nil
+		}
+		return nil, fmt.Errorf("读取%s失败: %w", fileName, err)
+	}
+
+	var schema model.ThemeSettingSchema
+	if err := yaml.Unmarshal(content, &schema); err != nil {
+		return nil, fmt.Errorf("解析%s失败: %w", fileName, err)
+	}
+	if err := validateSettingSchema(&schema); err != nil {
+		return nil, fmt.Errorf("%s: %w", fileName, err)
+	}
+	return &schema, nil
+}
+
+func validateSettingSchema(schema *model.ThemeSettingSchema) error {
+	if schema.Type != "setting" {
+		return errors.New("类型必须为 setting")
+	}
+	groups := make(map[string]struct{}, len(schema.Forms))
+	for _, g := range schema.Forms {
+		if g.Group == "" {
+			return errors.New("分组标识不能为空")
+		}
+		if _, ok := groups[g.Group]; ok {
+			return fmt.Errorf("分组 '%s' 重复", g.Group)
+		}
+		groups[g.Group] = struct{}{}
+		if err := validateSettingFields(g.Group, g.Fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSettingFields(scope string, fields []model.ThemeSettingField) error {
+	names := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		if f.Name == "" {
+			return fmt.Errorf("'%s' 中存在未命名字段", scope)
+		}
+		if _, ok := names[f.Name]; ok {
+			return fmt.Errorf("'%s' 中字段 '%s' 重复", scope, f.Name)
+		}
+		names[f.Name] = struct{}{}
+		if _, ok := settingTypeSet[f.Type]; !ok {
+			return fmt.Errorf("字段 '%s.%s' 类型 '%s' 不支持", scope, f.Name, f.Type)
+		}
+		if f.Type == "select" && len(f.Options) == 0 {
+			return fmt.Errorf("字段 '%s.%s' 缺少选项", scope, f.Name)
+		}
+		if f.Type == "array" {
+			if err := validateSettingFields(scope+"."+f.Name, f.Fields); err != nil {
+				return err
+			}
+		}
+		if f.Validation != nil && f.Validation.Pattern != "" {
+			if _, err := regexp.Compile(f.Validation.Pattern); err != nil {
+				return fmt.Errorf("字段 '%s.%s' 正则无效: %w", scope, f.Name, err)
+			}
+		}
+		if f.Default != nil {
+			if _, err := normalizeSettingValue(f, f.Default); err != nil {
+				return fmt.Errorf("字段 '%s.%s' 默认值无效: %w", scope, f.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeConfigValues 按表单声明校验配置值，未声明的字段会被丢弃，缺失的字段使用默认值
+func normalizeConfigValues(schema *model.ThemeSettingSchema, values model.ThemeConfigValues) (model.ThemeConfigValues, error) {
+	result := make(model.ThemeConfigValues, len(schema.Forms))
+	for _, g := range schema.Forms {
+		groupValues := values[g.Group]
+		normalized, err := normalizeFieldValues(g.Fields, groupValues)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", groupLabel(g), err)
+		}
+		result[g.Group] = normalized
+	}
+	return result, nil
+}
+
+func normalizeFieldValues(fields []model.ThemeSettingField, values map[string]any) (map[string]any, error) {
+	result := make(map[string]any, len(fields))
+	for _, f := range fields {
+		raw, ok := values[f.Name]
+		if !ok || raw == nil {
+			raw = f.Default
+		}
+		v, err := normalizeSettingValue(f, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s%w", fieldLabel(f), err)
+		}
+		result[f.Name] = v
+	}
+	return result, nil
+}
+
+// normalizeSettingValue 校验单个字段的值并转换为统一类型
+func normalizeSettingValue(f model.ThemeSettingField, raw any) (any, error) {
+	rule := f.Validation
+	if rule == nil {
+		rule = &model.ThemeSettingValidation{}
+	}
+
+	switch f.Type {
+	case "number":
+		if raw == nil {
+			if rule.Required {
+				return nil, errors.New("不能为空")
+			}
+			return float64(0), nil
+		}
+		n, ok := toFloat(raw)
+		if !ok {
+			return nil, errors.New("必须为数字")
+		}
+		if rule.Min != nil && n < *rule.Min {
+			return nil, fmt.Errorf("不能小于 %v", *rule.Min)
+		}
+		if rule.Max != nil && n > *rule.Max {
+			return nil, fmt.Errorf("不能大于 %v", *rule.Max)
+		}
+		return n, nil
+	case "switch":
+		if raw == nil {
+			return false, nil
+		}
+		b, ok := raw.(bool)
+		if !ok {
+			return nil, errors.New("必须为布尔值")
+		}
+		return b, nil
+	case "array":
+		if raw == nil {
+			raw = []any{}
+		}
+		items, ok := raw.([]any)
+		if !ok {
+			return nil, errors.New("必须为列表")
+		}
+		if rule.Required && len(items) == 0 {
+			return nil, errors.New("不能为空")
+		}
+		if rule.Min != nil && float64(len(items)) < *rule.Min {
+			return nil, fmt.Errorf("至少需要 %v 项", *rule.Min)
+		}
+		if rule.Max != nil && float64(len(items)) > *rule.Max {
+			return nil, fmt.Errorf("最多 %v 项", *rule.Max)
+		}
+		result := make([]any, 0, len(items))
+		for i, item := range items {
+			obj, ok := toStringMap(item)
+			if !ok {
+				return nil, fmt.Errorf("第 %d 项格式错误", i+1)
+			}
+			normalized, err := normalizeFieldValues(f.Fields, obj)
+			if err != nil {
+				return nil, fmt.Errorf("第 %d 项%w", i+1, err)
+			}
+			result = append(result, normalized)
+		}
+		return result, nil
+	}
+
+	// 其余类型均为字符串
+	if raw == nil {
+		raw = ""
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return nil, errors.New("必须为字符串")
+	}
+	if s == "" {
+		if rule.Required {
+			return nil, errors.New("不能为空")
+		}
+		return s, nil
+	}
+	length := utf8.RuneCountInString(s)
+	if rule.MinLength != nil && length < *rule.MinLength {
+		return nil, fmt.Errorf("长度不能少于 %d", *rule.MinLength)
+	}
+	if rule.MaxLength != nil && length > *rule.MaxLength {
+		return nil, fmt.Errorf("长度不能超过 %d", *rule.MaxLength)
+	}
+	if rule.Pattern != "" && !regexp.MustCompile(rule.Pattern).MatchString(s) {
+		return nil, errors.New("格式不正确")
+	}
+
+	switch f.Type {
+	case "color":
+		if !colorPattern.MatchString(s) {
+			return nil, errors.New("必须为 #RGB 或 #RRGGBB 格式的颜色")
+		}
+	case "url", "image":
+		if !isSafeURL(s) {
+			return nil, errors.New("必须为 http(s) 链接或站内路径")
+		}
+	case "select":
+		found := false
+		for _, opt := range f.Options {
+			if opt.Value == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("不在可选范围内")
+		}
+	}
+	return s, nil
+}
+
+func isSafeURL(s string) bool {
+	if strings.HasPrefix(s, "/") && !strings.HasPrefix(s, "//") {
+		return true
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// toStringMap yaml 解析出的默认值为 map[string]any，JSON 请求体同样如此
+func toStringMap(v any) (map[string]any, bool) {
+	switch m := v.(type) {
+	case map[string]any:
+		return m, true
+	case map[any]any:
+		result := make(map[string]any, len(m))
+		for k, val := range m {
+			key, ok := k.(string)
+			if !ok {
+				return nil, false
+			}
+			result[key] = val
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+func groupLabel(g model.ThemeSettingGroup) string {
+	if g.Label != "" {
+		return g.Label
+	}
+	return g.Group
+}
+
+func fieldLabel(f model.ThemeSettingField) string {
+	if f.Label != "" {
+		return f.Label
+	}
+	return f.Name
+}
+
+// getSettingSchema 读取主题配置表单，按主题名称和版本缓存
+func (s *ThemeServiceImpl) getSettingSchema(t *ent.Theme) (*model.ThemeSettingSchema, error) {
+	key := schemaCacheKeyPrefix + t.Name + "@" + t.Version
+	if v, ok := cache.GetCache().Get(key); ok {
+		return v.(*model.ThemeSettingSchema), nil
+	}
+	schema, err := loadSettingSchema(t.Path, settingFileName(t.SettingName))
+	if err != nil {
+		return nil, err
+	}
+	cache.GetCache().Set(key, schema, schemaCacheTTL)
+	return schema, nil
+}
+
+// invalidateThemeCache 清除主题的模板和配置表单缓存
+func invalidateThemeCache(name string) {
+	theme_infra.GetLoader().Invalidate(name)
+	cache.GetCache().DeletePrefix(schemaCacheKeyPrefix + name + "@")
+}
+
+// GetThemeConfig 获取主题配置表单及当前值
+func (s *ThemeServiceImpl) GetThemeConfig(ctx context.Context, id int) (*model.ThemeConfigResp, error) {
+	t, err := s.QueryTheme(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Type == "external" {
+		return nil, errors.New("外部主题不支持配置")
+	}
+
+	schema, err := s.getSettingSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	values, err := s.loadConfigValues(ctx, t, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ThemeConfigResp{
+		ThemeID: t.ID,
+		Schema:  schema,
+		Values:  values,
+	}, nil
+}
+
+// SaveThemeConfig 按表单声明校验并保存主题配置
+func (s *ThemeServiceImpl) SaveThemeConfig(ctx context.Context, id int, values model.ThemeConfigValues) (model.ThemeConfigValues, error) {
+	t, err := s.QueryTheme(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Type == "external" {
+		return nil, errors.New("外部主题不支持配置")
+	}
+
+	schema, err := s.getSettingSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeConfigValues(schema, values)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("序列化主题配置失败: %w", err)
+	}
+
+	key := configMapKey(t)
+	exists, err := s.settingService.ExistSettingByKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("查询主题配置失败: %w", err)
+	}
+	if exists {
+		err = s.settingService.UpdateSettingByKey(ctx, key, string(data))
+	} else {
+		err = s.settingService.CreateSettingIfNotExist(ctx, key, string(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("保存主题配置失败: %w", err)
+	}
+	return normalized, nil
+}
+
+// GetThemeConfigValues 获取主题配置值供模板使用，出错时退回表单默认值
+func (s *ThemeServiceImpl) GetThemeConfigValues(ctx context.Context, t *ent.Theme) model.ThemeConfigValues {
+	schema, err := s.getSettingSchema(t)
+	if err != nil {
+		return model.ThemeConfigValues{}
+	}
+	values, err := s.loadConfigValues(ctx, t, schema)
+	if err != nil {
+		values, _ = normalizeConfigValues(schema, nil)
+	}
+	return values
+}
+
+// loadConfigValues 读取已保存的配置并与表单默认值合并
+func (s *ThemeServiceImpl) loadConfigValues(ctx context.Context, t *ent.Theme, schema *model.ThemeSettingSchema) (model.ThemeConfigValues, error) {
+	stored := model.ThemeConfigValues{}
+	setting, err := s.settingService.GetSettingByKey(ctx, configMapKey(t))
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("查询主题配置失败: %w", err)
+	}
+	if setting != nil && setting.Value != "" {
+		if err := json.Unmarshal([]byte(setting.Value), &stored); err != nil {
+			return nil, fmt.Errorf("解析主题配置失败: %w", err)
+		}
+	}
+
+	values, err := normalizeConfigValues(schema, stored)
+	if err != nil {
+		// 主题升级后旧值可能不再满足新的声明，此时使用默认值
+		return normalizeConfigValues(schema, nil)
+	}
+	return values, nil
+}
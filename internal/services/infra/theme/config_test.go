@@ -0,0 +1,142 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+func TestLoadDefaultThemeSchema(t *testing.T) {
+	schema, err := loadSettingSchema("../../../../assets/themes/hoshikuzu-theme-ace", defaultSettingName)
+	if err != nil {
+		t.Fatalf("loadSettingSchema() error = %v", err)
+	}
+	values, err := normalizeConfigValues(schema, nil)
+	if err != nil {
+		t.Fatalf("normalizeConfigValues() error = %v", err)
+	}
+	if got := values["style"]["primaryColor"]; got != "#165DFF" {
+		t.Errorf("primaryColor 默认值 = %v", got)
+	}
+	if got := values["sidebar"]["showTags"]; got != true {
+		t.Errorf("showTags 默认值 = %v", got)
+	}
+}
+
+func TestLoadSettingSchemaMissingFile(t *testing.T) {
+	schema, err := loadSettingSchema(t.TempDir(), defaultSettingName)
+	if err != nil {
+		t.Fatalf("loadSettingSchema() error = %v", err)
+	}
+	if len(schema.Forms) != 0 {
+		t.Errorf("缺少声明文件时应返回空表单")
+	}
+}
+
+func TestValidateSettingSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  model.ThemeSettingSchema
+		wantErr string
+	}{
+		{
+			name:    "类型错误",
+			schema:  model.ThemeSettingSchema{Type: "theme"},
+			wantErr: "类型必须为 setting",
+		},
+		{
+			name: "字段类型不支持",
+			schema: model.ThemeSettingSchema{Type: "setting", Forms: []model.ThemeSettingGroup{
+				{Group: "base", Fields: []model.ThemeSettingField{{Name: "a", Type: "date"}}},
+			}},
+			wantErr: "不支持",
+		},
+		{
+			name: "select 缺少选项",
+			schema: model.ThemeSettingSchema{Type: "setting", Forms: []model.ThemeSettingGroup{
+				{Group: "base", Fields: []model.ThemeSettingField{{Name: "a", Type: "select"}}},
+			}},
+			wantErr: "缺少选项",
+		},
+		{
+			name: "默认值不满足校验",
+			schema: model.ThemeSettingSchema{Type: "setting", Forms: []model.ThemeSettingGroup{
+				{Group: "base", Fields: []model.ThemeSettingField{{Name: "c", Type: "color", Default: "red"}}},
+			}},
+			wantErr: "默认值无效",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSettingSchema(&tt.schema)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateSettingSchema() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalizeConfigValues(t *testing.T) {
+	maxLen := 5
+	minNum := 1.0
+	schema := &model.ThemeSettingSchema{Type: "setting", Forms: []model.ThemeSettingGroup{
+		{Group: "base", Label: "基础", Fields: []model.ThemeSettingField{
+			{Name: "title", Label: "标题", Type: "text", Validation: &model.ThemeSettingValidation{Required: true, MaxLength: &maxLen}},
+			{Name: "size", Label: "数量", Type: "number", Default: 3, Validation: &model.ThemeSettingValidation{Min: &minNum}},
+			{Name: "mode", Label: "模式", Type: "select", Default: "a", Options: []model.ThemeSettingOption{{Value: "a"}, {Value: "b"}}},
+			{Name: "links", Label: "链接", Type: "array", Fields: []model.ThemeSettingField{
+				{Name: "url", Label: "地址", Type: "url", Validation: &model.ThemeSettingValidation{Required: true}},
+			}},
+		}},
+	}}
+
+	tests := []struct {
+		name    string
+		values  model.ThemeConfigValues
+		wantErr string
+	}{
+		{"合法值", model.ThemeConfigValues{"base": {"title": "你好", "links": []any{map[string]any{"url": "https://example.com"}}}}, ""},
+		{"必填为空", model.ThemeConfigValues{"base": {"title": ""}}, "标题不能为空"},
+		{"超出长度", model.ThemeConfigValues{"base": {"title": "超过五个字符了"}}, "长度不能超过 5"},
+		{"数字类型错误", model.ThemeConfigValues{"base": {"title": "a", "size": "3"}}, "数量必须为数字"},
+		{"数字低于最小值", model.ThemeConfigValues{"base": {"title": "a", "size": 0.0}}, "不能小于 1"},
+		{"选项不存在", model.ThemeConfigValues{"base": {"title": "a", "mode": "c"}}, "不在可选范围内"},
+		{"列表项链接非法", model.ThemeConfigValues{"base": {"title": "a", "links": []any{map[string]any{"url": "javascript:alert(1)"}}}}, "第 1 项地址"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeConfigValues(schema, tt.values)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("normalizeConfigValues() error = %v", err)
+				}
+				if got["base"]["size"] != float64(3) || got["base"]["mode"] != "a" {
+					t.Errorf("默认值未填充: %v", got)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("normalizeConfigValues() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigMapKeyReservedName(t *testing.T) {
+	reserved := []string{
+		model.SettingKeyBasic, model.SettingKeySite, model.SettingKeyEmail, model.SettingKeySocialLogin,
+		model.SettingKeyAIAsk, model.SettingKeyAIKnowledge, model.SettingKeyAIChatContext,
+		model.SettingKeyAIWritingPrompts, model.SettingKeyCommentModeration, "payment",
+	}
+	for _, name := range reserved {
+		key := configMapKey(&ent.Theme{Name: "ace", ConfigMapName: name})
+		if key == name || !strings.HasPrefix(key, configMapKeyPrefix) {
+			t.Errorf("config-map-name %q 使用了存储键 %q，会覆盖系统设置", name, key)
+		}
+	}
+	if got := configMapKey(&ent.Theme{Name: "ace"}); got != configMapKeyPrefix+"ace" {
+		t.Errorf("未声明 config-map-name 时存储键 = %q", got)
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	theme_ent "github.com/shuTwT/hoshikuzu/ent/theme"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 
 	"gopkg.in/yaml.v3"
@@ -30,15 +31,20 @@ type ThemeService interface {
 	DisableTheme(ctx context.Context, id int) error
 	RegisterDefaultTheme(ctx context.Context) error
 	GetEnabledTheme(ctx context.Context) (*ent.Theme, error)
+	GetThemeConfig(ctx context.Context, id int) (*model.ThemeConfigResp, error)
+	SaveThemeConfig(ctx context.Context, id int, values model.ThemeConfigValues) (model.ThemeConfigValues, error)
+	GetThemeConfigValues(ctx context.Context, t *ent.Theme) model.ThemeConfigValues
 }
 
 type ThemeServiceImpl struct {
-	client *ent.Client
+	client         *ent.Client
+	settingService setting_service.SettingService
 }
 
-func NewThemeServiceImpl(client *ent.Client) *ThemeServiceImpl {
+func NewThemeServiceImpl(client *ent.Client, settingService setting_service.SettingService) *ThemeServiceImpl {
 	return &ThemeServiceImpl{
-		client: client,
+		client:         client,
+		settingService: settingService,
 	}
 }
 
@@ -239,6 +245,12 @@ func (s *ThemeServiceImpl) createInternalTheme(ctx context.Context, req *model.C
 		}
 	}
 
+	// 主题包携带的配置表单声明需在入库前校验通过
+	if _, err := loadSettingSchema(targetDir, settingFileName(themeConfig.SettingName)); err != nil {
+		os.RemoveAll(targetDir)
+		return nil, err
+	}
+
 	os.Remove(req.FilePath)
 
 	builder := s.client.Theme.Create().
@@ -288,6 +300,9 @@ func (s *ThemeServiceImpl) createInternalTheme(ctx context.Context, req *model.C
 		return nil, fmt.Errorf("保存主题信息失败: %w", err)
 	}
 
+	// 同名主题重新上传后丢弃旧的模板缓存
+	invalidateThemeCache(themeEntity.Name)
+
 	return themeEntity, nil
 }
 
@@ -353,6 +368,8 @@ func (s *ThemeServiceImpl) DeleteTheme(ctx context.Context, id int) error {
 		return err
 	}
 
+	invalidateThemeCache(themeEntity.Name)
+
 	return nil
 }
 
@@ -515,6 +532,9 @@ func (s *ThemeServiceImpl) createStaticTheme(ctx context.Context, req *model.Cre
 		return nil, fmt.Errorf("保存主题信息失败: %w", err)
 	}
 
+	// 同名主题重新上传后丢弃旧的模板缓存
+	invalidateThemeCache(themeEntity.Name)
+
 	return themeEntity, nil
 }
 
@@ -543,6 +563,8 @@ func (s *ThemeServiceImpl) EnableTheme(ctx context.Context, id int) error {
 		return err
 	}
 
+	invalidateThemeCache(themeEntity.Name)
+
 	return nil
 }
 
@@ -0,0 +1,176 @@
+package cart
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/cartitem"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// maxCartItems 购物车最多商品种类数
+const maxCartItems = 100
+
+type CartService interface {
+	GetCart(ctx context.Context, userID int) (*model.CartResp, error)
+	AddItem(ctx context.Context, userID int, req *model.CartItemAddReq) (*model.CartResp, error)
+	UpdateItem(ctx context.Context, userID, itemID int, req *model.CartItemUpdateReq) (*model.CartResp, error)
+	RemoveItem(ctx context.Context, userID, itemID int) error
+	ClearCart(ctx context.Context, userID int) error
+}
+
+type CartServiceImpl struct {
+	client *ent.Client
+}
+
+func NewCartServiceImpl(client *ent.Client) *CartServiceImpl {
+	return &CartServiceImpl{client: client}
+}
+
+// GetCart 查询购物车，商品信息按当前价格与库存返回
+func (s *CartServiceImpl) GetCart(ctx context.Context, userID int) (*model.CartResp, error) {
+	items, err := s.client.CartItem.Query().
+		Where(cartitem.UserIDEQ(userID)).
+		WithProduct().
+		Order(ent.Desc(cartitem.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &model.CartResp{Items: make([]model.CartItemResp, 0, len(items))}
+	for _, item := range items {
+		p := item.Edges.Product
+		if p == nil {
+			continue
+		}
+		r := model.CartItemResp{
+			ID:        item.ID,
+			ProductId: p.ID,
+			Name:      p.Name,
+			Price:     p.Price,
+			Quantity:  item.Quantity,
+			Stock:     p.Stock,
+			Available: p.Active && p.Stock >= item.Quantity,
+		}
+		if len(p.Images) > 0 {
+			r.Image = p.Images[0]
+		}
+		if r.Available {
+			resp.TotalQuantity += item.Quantity
+			resp.TotalAmount += p.Price * item.Quantity
+		}
+		resp.Items = append(resp.Items, r)
+	}
+	return resp, nil
+}
+
+// AddItem 加入购物车，已存在时累加数量
+func (s *CartServiceImpl) AddItem(ctx context.Context, userID int, req *model.CartItemAddReq) (*model.CartResp, error) {
+	quantity := req.Quantity
+	if quantity <= 0 {
+		quantity = 1
+	}
+	p, err := s.availableProduct(ctx, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.client.CartItem.Query().
+		Where(cartitem.UserIDEQ(userID), cartitem.ProductIDEQ(p.ID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil {
+		quantity += existing.Quantity
+	}
+	if quantity > p.Stock {
+		return nil, fmt.Errorf("商品库存不足，当前库存 %d", p.Stock)
+	}
+
+	if existing != nil {
+		if err := s.client.CartItem.UpdateOneID(existing.ID).SetQuantity(quantity).Exec(ctx); err != nil {
+			return nil, err
+		}
+		return s.GetCart(ctx, userID)
+	}
+
+	count, err := s.client.CartItem.Query().Where(cartitem.UserIDEQ(userID)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxCartItems {
+		return nil, fmt.Errorf("购物车商品数量已达上限")
+	}
+	if err := s.client.CartItem.Create().
+		SetUserID(userID).
+		SetProductID(p.ID).
+		SetQuantity(quantity).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, userID)
+}
+
+// UpdateItem 修改购物车商品数量
+func (s *CartServiceImpl) UpdateItem(ctx context.Context, userID, itemID int, req *model.CartItemUpdateReq) (*model.CartResp, error) {
+	if req.Quantity <= 0 {
+		return nil, fmt.Errorf("数量必须大于0")
+	}
+	item, err := s.client.CartItem.Query().
+		Where(cartitem.IDEQ(itemID), cartitem.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.availableProduct(ctx, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Quantity > p.Stock {
+		return nil, fmt.Errorf("商品库存不足，当前库存 %d", p.Stock)
+	}
+	if err := s.client.CartItem.UpdateOneID(item.ID).SetQuantity(req.Quantity).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, userID)
+}
+
+// RemoveItem 从购物车移除商品
+func (s *CartServiceImpl) RemoveItem(ctx context.Context, userID, itemID int) error {
+	n, err := s.client.CartItem.Delete().
+		Where(cartitem.IDEQ(itemID), cartitem.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("购物车商品不存在")
+	}
+	return nil
+}
+
+// ClearCart 清空购物车
+func (s *CartServiceImpl) ClearCart(ctx context.Context, userID int) error {
+	_, err := s.client.CartItem.Delete().
+		Where(cartitem.UserIDEQ(userID)).
+		Exec(ctx)
+	return err
+}
+
+func (s *CartServiceImpl) availableProduct(ctx context.Context, productID int) (*ent.Product, error) {
+	p, err := s.client.Product.Query().Where(product.IDEQ(productID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("商品不存在")
+		}
+		return nil, err
+	}
+	if !p.Active {
+		return nil, fmt.Errorf("商品已下架")
+	}
+	return p, nil
+}
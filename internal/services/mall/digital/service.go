@@ -0,0 +1,487 @@
+package digital
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/file"
+	"github.com/shuTwT/hoshikuzu/ent/orderdelivery"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/productdeliverable"
+	"github.com/shuTwT/hoshikuzu/ent/productkey"
+	"github.com/shuTwT/hoshikuzu/ent/storagestrategy"
+	"github.com/shuTwT/hoshikuzu/internal/infra/storage"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// DigitalService 数字商品交付：管理交付内容与卡密库，支付成功后交付，退款时撤销
+type DigitalService interface {
+	ListDeliverables(ctx context.Context, productID int) ([]*ent.ProductDeliverable, error)
+	CreateDeliverable(ctx context.Context, productID int, req *model.ProductDeliverableCreateReq) (*ent.ProductDeliverable, error)
+	DeleteDeliverable(ctx context.Context, id int) error
+	ImportKeys(ctx context.Context, productID int, req *model.ProductKeyImportReq) (*model.ProductKeyImportResp, error)
+	ListKeysPage(ctx context.Context, productID int, req *model.ProductKeyPageQuery) ([]*ent.ProductKey, int, error)
+	DeleteKey(ctx context.Context, id int) error
+
+	// DeliverOrder 支付成功后为订单中的数字商品生成交付记录，在支付成功事务内调用，幂等
+	DeliverOrder(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error
+	// RevokeOrder 退款时撤销订单的交付记录，已分配的卡密作废不再回收，在退款事务内调用
+	RevokeOrder(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error
+	// ListOrderDeliveries 查询用户订单的交付内容，文件交付返回限时下载链接
+	ListOrderDeliveries(ctx context.Context, userID int, orderIDs ...int) (map[int][]model.OrderDeliveryResp, error)
+	// ResolveDownload 校验下载链接签名与交付状态，返回要下载的文件
+	ResolveDownload(ctx context.Context, deliveryID int, expires int64, sign string) (*ent.File, error)
+	// ObjectDownloadURL 对象存储文件的预签名下载地址，与下载链接同样限时有效
+	ObjectDownloadURL(f *ent.File) (string, error)
+}
+
+type DigitalServiceImpl struct {
+	client *ent.Client
+}
+
+func NewDigitalServiceImpl(client *ent.Client) *DigitalServiceImpl {
+	return &DigitalServiceImpl{client: client}
+}
+
+func (s *DigitalServiceImpl) ListDeliverables(ctx context.Context, productID int) ([]*ent.ProductDeliverable, error) {
+	return s.client.ProductDeliverable.Query().
+		Where(productdeliverable.ProductIDEQ(productID)).
+		WithFile().
+		Order(ent.Asc(productdeliverable.FieldSortOrder), ent.Asc(productdeliverable.FieldID)).
+		All(ctx)
+}
+
+func (s *DigitalServiceImpl) CreateDeliverable(ctx context.Context, productID int, req *model.ProductDeliverableCreateReq) (*ent.ProductDeliverable, error) {
+	p, err := s.client.Product.Get(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Digital {
+		return nil, fmt.Errorf("只有数字商品可以配置交付内容")
+	}
+
+	builder := s.client.ProductDeliverable.Create().
+		SetProductID(productID).
+		SetName(strings.TrimSpace(req.Name)).
+		SetSortOrder(req.SortOrder)
+	switch req.Type {
+	case model.DeliverableTypeFile:
+		if req.FileId <= 0 {
+			return nil, fmt.Errorf("请选择交付文件")
+		}
+		if _, err := s.client.File.Get(ctx, req.FileId); err != nil {
+			return nil, err
+		}
+		builder.SetType(productdeliverable.TypeFile).SetFileID(req.FileId)
+	case model.DeliverableTypeKey:
+		// 卡密交付从商品卡密库中分配，每件商品分配一个
+		builder.SetType(productdeliverable.TypeKey)
+	case model.DeliverableTypeText:
+		if strings.TrimSpace(req.Content) == "" {
+			return nil, fmt.Errorf("文本内容不能为空")
+		}
+		builder.SetType(productdeliverable.TypeText).SetContent(req.Content)
+	default:
+		return nil, fmt.Errorf("无效的交付类型")
+	}
+	return builder.Save(ctx)
+}
+
+func (s *DigitalServiceImpl) DeleteDeliverable(ctx context.Context, id int) error {
+	return s.client.ProductDeliverable.DeleteOneID(id).Exec(ctx)
+}
+
+// ImportKeys 导入卡密，导入后按下单顺序补发待补货的卡密交付
+func (s *DigitalServiceImpl) ImportKeys(ctx context.Context, productID int, req *model.ProductKeyImportReq) (*model.ProductKeyImportResp, error) {
+	if _, err := s.client.Product.Get(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(req.Codes))
+	codes := make([]string, 0, len(req.Codes))
+	for _, code := range req.Codes {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			continue
+		}
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		codes = append(codes, code)
+	}
+	resp := &model.ProductKeyImportResp{Skipped: len(req.Codes) - len(codes)}
+	if len(codes) == 0 {
+		return resp, nil
+	}
+
+	existing, err := s.client.ProductKey.Query().
+		Where(productkey.ProductIDEQ(productID), productkey.CodeIn(codes...)).
+		Select(productkey.FieldCode).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[string]struct{}, len(existing))
+	for _, code := range existing {
+		exists[code] = struct{}{}
+	}
+	builders := make([]*ent.ProductKeyCreate, 0, len(codes))
+	for _, code := range codes {
+		if _, ok := exists[code]; ok {
+			resp.Skipped++
+			continue
+		}
+		builders = append(builders, s.client.ProductKey.Create().
+			SetProductID(productID).
+			SetCode(code).
+			SetStatus(model.ProductKeyStatusAvailable))
+	}
+	if len(builders) > 0 {
+		if err := s.client.ProductKey.CreateBulk(builders...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	resp.Imported = len(builders)
+
+	fulfilled, err := s.fulfilPending(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	resp.Fulfilled = fulfilled
+	return resp, nil
+}
+
+func (s *DigitalServiceImpl) ListKeysPage(ctx context.Context, productID int, req *model.ProductKeyPageQuery) ([]*ent.ProductKey, int, error) {
+	query := s.client.ProductKey.Query().Where(productkey.ProductIDEQ(productID))
+	if req.Status != nil {
+		query.Where(productkey.StatusEQ(*req.Status))
+	}
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	keys, err := query.
+		Order(ent.Desc(productkey.FieldID)).
+		Offset((req.Page - 1) * req.Size).
+		Limit(req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return keys, count, nil
+}
+
+// DeleteKey 删除未分配的卡密，已分配的卡密需保留以便追溯
+func (s *DigitalServiceImpl) DeleteKey(ctx context.Context, id int) error {
+	n, err := s.client.ProductKey.Delete().
+		Where(productkey.IDEQ(id), productkey.StatusEQ(model.ProductKeyStatusAvailable)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("卡密不存在或已分配")
+	}
+	return nil
+}
+
+func (s *DigitalServiceImpl) DeliverOrder(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error {
+	if order.OrderType != model.PayOrderTypeProduct {
+		return nil
+	}
+	delivered, err := tx.OrderDelivery.Query().
+		Where(orderdelivery.OrderIDEQ(order.ID)).
+		Exist(ctx)
+	if err != nil || delivered {
+		return err
+	}
+
+	items, err := tx.PayOrderItem.Query().
+		Where(
+			payorderitem.OrderIDEQ(order.ID),
+			payorderitem.HasProductWith(product.DigitalEQ(true)),
+		).
+		All(ctx)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	productIDs := make([]int, 0, len(items))
+	for _, item := range items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	deliverables, err := tx.ProductDeliverable.Query().
+		Where(productdeliverable.ProductIDIn(productIDs...)).
+		Order(ent.Asc(productdeliverable.FieldSortOrder), ent.Asc(productdeliverable.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	byProduct := make(map[int][]*ent.ProductDeliverable)
+	for _, d := range deliverables {
+		byProduct[d.ProductID] = append(byProduct[d.ProductID], d)
+	}
+
+	shortage := make(map[string]int)
+	for _, item := range items {
+		for _, d := range byProduct[item.ProductID] {
+			builder := tx.OrderDelivery.Create().
+				SetOrderID(order.ID).
+				SetUserID(order.UserID).
+				SetProductID(item.ProductID).
+				SetDeliverableID(d.ID).
+				SetName(d.Name)
+			switch d.Type {
+			case productdeliverable.TypeFile:
+				if err := builder.SetType(orderdelivery.TypeFile).SetFileID(d.FileID).Exec(ctx); err != nil {
+					return err
+				}
+			case productdeliverable.TypeText:
+				if err := builder.SetType(orderdelivery.TypeText).SetContent(d.Content).Exec(ctx); err != nil {
+					return err
+				}
+			case productdeliverable.TypeKey:
+				// 每件商品分配一个卡密，卡密不足时记为待补货，导入卡密后补发
+				for i := 0; i < item.Quantity; i++ {
+					key, err := claimKey(ctx, tx, item.ProductID, order)
+					if err != nil {
+						return err
+					}
+					b := tx.OrderDelivery.Create().
+						SetOrderID(order.ID).
+						SetUserID(order.UserID).
+						SetProductID(item.ProductID).
+						SetDeliverableID(d.ID).
+						SetName(d.Name).
+						SetType(orderdelivery.TypeKey)
+					if key == nil {
+						b.SetStatus(model.DeliveryStatusPending)
+						shortage[item.ProductName]++
+					} else {
+						b.SetKeyID(key.ID).SetContent(key.Code)
+					}
+					if err := b.Exec(ctx); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	for name, count := range shortage {
+		if err := tx.Notification.Create().
+			SetTitle("卡密库存不足").
+			SetContent(fmt.Sprintf("商品「%s」卡密不足，订单 %d 有 %d 个卡密待补发，导入卡密后将自动补发。", name, order.ID, count)).
+			SetPublishTime(time.Now()).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DigitalServiceImpl) RevokeOrder(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error {
+	deliveries, err := tx.OrderDelivery.Query().
+		Where(
+			orderdelivery.OrderIDEQ(order.ID),
+			orderdelivery.StatusNEQ(model.DeliveryStatusRevoked),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	keyIDs := make([]int, 0, len(deliveries))
+	ids := make([]int, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.ID)
+		if d.KeyID > 0 {
+			keyIDs = append(keyIDs, d.KeyID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := tx.OrderDelivery.Update().
+		Where(orderdelivery.IDIn(ids...)).
+		SetStatus(model.DeliveryStatusRevoked).
+		SetRevokedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(keyIDs) == 0 {
+		return nil
+	}
+	// 卡密已经展示给买家，作废而不是放回卡密库
+	return tx.ProductKey.Update().
+		Where(productkey.IDIn(keyIDs...)).
+		SetStatus(model.ProductKeyStatusRevoked).
+		Exec(ctx)
+}
+
+func (s *DigitalServiceImpl) ListOrderDeliveries(ctx context.Context, userID int, orderIDs ...int) (map[int][]model.OrderDeliveryResp, error) {
+	result := make(map[int][]model.OrderDeliveryResp)
+	if len(orderIDs) == 0 {
+		return result, nil
+	}
+	deliveries, err := s.client.OrderDelivery.Query().
+		Where(
+			orderdelivery.UserIDEQ(userID),
+			orderdelivery.OrderIDIn(orderIDs...),
+		).
+		Order(ent.Asc(orderdelivery.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, d := range deliveries {
+		resp := model.OrderDeliveryResp{
+			ID:        d.ID,
+			OrderID:   d.OrderID,
+			ProductID: d.ProductID,
+			Type:      string(d.Type),
+			Name:      d.Name,
+			Status:    d.Status,
+			CreatedAt: model.LocalTime(d.CreatedAt),
+		}
+		if d.Status == model.DeliveryStatusDelivered {
+			switch d.Type {
+			case orderdelivery.TypeFile:
+				expires := now.Add(downloadLinkTTL)
+				resp.DownloadURL = downloadURL(d.ID, expires)
+				expireAt := model.LocalTime(expires)
+				resp.DownloadExpireAt = &expireAt
+			default:
+				resp.Content = d.Content
+			}
+		}
+		result[d.OrderID] = append(result[d.OrderID], resp)
+	}
+	return result, nil
+}
+
+func (s *DigitalServiceImpl) ResolveDownload(ctx context.Context, deliveryID int, expires int64, sign string) (*ent.File, error) {
+	if err := verifyDownload(deliveryID, expires, sign, time.Now()); err != nil {
+		return nil, err
+	}
+	d, err := s.client.OrderDelivery.Query().
+		Where(orderdelivery.IDEQ(deliveryID), orderdelivery.TypeEQ(orderdelivery.TypeFile)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status != model.DeliveryStatusDelivered {
+		return nil, fmt.Errorf("交付已撤销")
+	}
+	f, err := s.client.File.Query().
+		Where(file.IDEQ(d.FileID)).
+		WithStorageStrategy().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.OrderDelivery.UpdateOneID(d.ID).AddDownloadCount(1).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *DigitalServiceImpl) ObjectDownloadURL(f *ent.File) (string, error) {
+	strategy := f.Edges.StorageStrategy
+	// 只有 S3 能生成限时地址，文件的公开地址永久有效且无法在退款后撤销，不能交给买家
+	if strategy == nil || strategy.Type != storagestrategy.TypeS3 {
+		return "", fmt.Errorf("该文件的存储策略不支持限时下载")
+	}
+	uploader, err := storage.GetUploader(strategy)
+	if err != nil {
+		return "", err
+	}
+	return uploader.GetURL(f.Name, downloadLinkTTL)
+}
+
+// claimKey 从卡密库中分配一个卡密，卡密库为空时返回 nil
+func claimKey(ctx context.Context, tx *ent.Tx, productID int, order *ent.PayOrder) (*ent.ProductKey, error) {
+	for {
+		key, err := tx.ProductKey.Query().
+			Where(productkey.ProductIDEQ(productID), productkey.StatusEQ(model.ProductKeyStatusAvailable)).
+			Order(ent.Asc(productkey.FieldID)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		// 条件更新避免并发订单分配到同一个卡密，被抢占时重新选取
+		n, err := tx.ProductKey.Update().
+			Where(productkey.IDEQ(key.ID), productkey.StatusEQ(model.ProductKeyStatusAvailable)).
+			SetStatus(model.ProductKeyStatusAssigned).
+			SetOrderID(order.ID).
+			SetUserID(order.UserID).
+			SetAssignedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			return key, nil
+		}
+	}
+}
+
+// fulfilPending 按下单顺序补发商品待补货的卡密交付，返回补发数量
+func (s *DigitalServiceImpl) fulfilPending(ctx context.Context, productID int) (int, error) {
+	pending, err := s.client.OrderDelivery.Query().
+		Where(
+			orderdelivery.ProductIDEQ(productID),
+			orderdelivery.TypeEQ(orderdelivery.TypeKey),
+			orderdelivery.StatusEQ(model.DeliveryStatusPending),
+		).
+		Order(ent.Asc(orderdelivery.FieldID)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	fulfilled := 0
+	for _, d := range pending {
+		ok, err := s.fulfilOne(ctx, d)
+		if err != nil {
+			return fulfilled, err
+		}
+		if ok {
+			fulfilled++
+		}
+	}
+	return fulfilled, nil
+}
+
+// fulfilOne 为一条待补货交付分配卡密，卡密不足或交付已撤销时返回 false
+func (s *DigitalServiceImpl) fulfilOne(ctx context.Context, d *ent.OrderDelivery) (bool, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	key, err := claimKey(ctx, tx, d.ProductID, &ent.PayOrder{ID: d.OrderID, UserID: d.UserID})
+	if err != nil || key == nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	// 补发期间订单可能已退款，只更新仍处于待补货的交付
+	n, err := tx.OrderDelivery.Update().
+		Where(orderdelivery.IDEQ(d.ID), orderdelivery.StatusEQ(model.DeliveryStatusPending)).
+		SetKeyID(key.ID).
+		SetContent(key.Code).
+		SetStatus(model.DeliveryStatusDelivered).
+		Save(ctx)
+	if err != nil || n == 0 {
+		_ = tx.Rollback()
+		return false, err
+	}
+	return true, tx.Commit()
+}
@@ -0,0 +1,41 @@
+package digital
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/pkg/config"
+)
+
+// downloadLinkTTL 下载链接有效期，买家每次查看订单时重新生成
+const downloadLinkTTL = 30 * time.Minute
+
+// DownloadPath 数字商品下载接口路径
+const DownloadPath = "/api/v1/public/digital/download/"
+
+// downloadURL 生成带签名的限时下载链接
+func downloadURL(deliveryID int, expires time.Time) string {
+	exp := expires.Unix()
+	return fmt.Sprintf("%s%d?expires=%d&sign=%s", DownloadPath, deliveryID, exp, signDownload(deliveryID, exp))
+}
+
+func signDownload(deliveryID int, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(config.GetString(config.AUTH_TOKEN_SECRET)))
+	mac.Write([]byte("digital-download:" + strconv.Itoa(deliveryID) + ":" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyDownload 校验下载链接签名和有效期
+func verifyDownload(deliveryID int, expires int64, sign string, now time.Time) error {
+	if !hmac.Equal([]byte(sign), []byte(signDownload(deliveryID, expires))) {
+		return fmt.Errorf("下载链接无效")
+	}
+	if now.Unix() > expires {
+		return fmt.Errorf("下载链接已过期，请在订单中重新获取")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package digital
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVerifyDownload(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	exp := now.Add(downloadLinkTTL).Unix()
+	sign := signDownload(7, exp)
+
+	tests := []struct {
+		name    string
+		id      int
+		expires int64
+		sign    string
+		now     time.Time
+		wantErr bool
+	}{
+		{"有效", 7, exp, sign, now, false},
+		{"交付ID被篡改", 8, exp, sign, now, true},
+		{"过期时间被篡改", 7, exp + 3600, sign, now, true},
+		{"签名错误", 7, exp, "deadbeef", now, true},
+		{"已过期", 7, exp, sign, now.Add(downloadLinkTTL + time.Second), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyDownload(tt.id, tt.expires, tt.sign, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verifyDownload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package payorder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/coupon"
+	"github.com/shuTwT/hoshikuzu/ent/couponusage"
+	"github.com/shuTwT/hoshikuzu/ent/predicate"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// 优惠券在下单时占用（使用记录状态为未使用），支付成功后核销，订单关闭、失败或退款时释放。
+
+// reserveCoupon 下单时占用优惠券：累加使用数量并写入未使用的使用记录，在创建订单的事务内调用
+func reserveCoupon(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error {
+	if order.PriceDetail == nil || order.PriceDetail.CouponCode == "" {
+		return nil
+	}
+	code := order.PriceDetail.CouponCode
+
+	c, err := tx.Coupon.Query().Where(coupon.CodeEQ(code)).Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 条件更新保证并发下单时不超发，同时锁住优惠券行，使同一优惠券的占用串行执行
+	n, err := tx.Coupon.Update().
+		Where(
+			coupon.IDEQ(c.ID),
+			coupon.ActiveEQ(true),
+			coupon.Or(
+				coupon.TotalCountLTE(0),
+				predicate.Coupon(sql.FieldsLT(coupon.FieldUsedCount, coupon.FieldTotalCount)),
+			),
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("优惠券已被领完")
+	}
+
+	// 持有优惠券行锁后再统计用户的使用次数，并发提交的订单能看到先提交的使用记录
+	if c.PerUserLimit > 0 {
+		taken, err := tx.CouponUsage.Query().
+			Where(
+				couponusage.CouponCodeEQ(code),
+				couponusage.UserIDEQ(order.UserID),
+				couponusage.StatusIn(model.CouponUsageStatusUnused, model.CouponUsageStatusUsed),
+				lockForUpdate,
+			).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(taken) >= c.PerUserLimit {
+			return fmt.Errorf("优惠券使用次数已达上限")
+		}
+	}
+
+	return tx.CouponUsage.Create().
+		SetCouponCode(code).
+		SetUserID(order.UserID).
+		SetOrderID(order.ID).
+		SetStatus(model.CouponUsageStatusUnused).
+		SetDiscountAmount(order.PriceDetail.CouponDiscount).
+		SetExpireAt(c.EndTime).
+		Exec(ctx)
+}
+
+// lockForUpdate 以当前读加锁查询，MySQL 可重复读隔离级别下也能读到其他事务已提交的记录。
+// SQLite 不支持 FOR UPDATE，写事务本身已串行执行
+func lockForUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
+
+// confirmCoupon 支付成功后核销订单占用的优惠券，在支付成功事务内调用
+func confirmCoupon(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error {
+	if order.PriceDetail == nil || order.PriceDetail.CouponCode == "" {
+		return nil
+	}
+	n, err := tx.CouponUsage.Update().
+		Where(
+			couponusage.OrderIDEQ(order.ID),
+			couponusage.StatusEQ(model.CouponUsageStatusUnused),
+		).
+		SetStatus(model.CouponUsageStatusUsed).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+
+	// 订单已超时关闭释放了优惠券但随后仍支付成功，按已支付的事实补记使用
+	used, err := tx.CouponUsage.Query().
+		Where(
+			couponusage.OrderIDEQ(order.ID),
+			couponusage.StatusEQ(model.CouponUsageStatusUsed),
+		).
+		Exist(ctx)
+	if err != nil || used {
+		return err
+	}
+	if err := tx.Coupon.Update().
+		Where(coupon.CodeEQ(order.PriceDetail.CouponCode)).
+		AddUsedCount(1).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return tx.CouponUsage.Create().
+		SetCouponCode(order.PriceDetail.CouponCode).
+		SetUserID(order.UserID).
+		SetOrderID(order.ID).
+		SetStatus(model.CouponUsageStatusUsed).
+		SetUsedAt(time.Now()).
+		SetDiscountAmount(order.PriceDetail.CouponDiscount).
+		Exec(ctx)
+}
+
+// releaseCoupon 订单关闭、失败或退款时释放优惠券：使用记录置为已过期并回退使用数量，幂等
+func releaseCoupon(ctx context.Context, tx *ent.Tx, order *ent.PayOrder, reason string) error {
+	if order.PriceDetail == nil || order.PriceDetail.CouponCode == "" {
+		return nil
+	}
+	n, err := tx.CouponUsage.Update().
+		Where(
+			couponusage.OrderIDEQ(order.ID),
+			couponusage.StatusIn(model.CouponUsageStatusUnused, model.CouponUsageStatusUsed),
+		).
+		SetStatus(model.CouponUsageStatusExpired).
+		SetRemark(reason).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return err
+	}
+	return tx.Coupon.Update().
+		Where(coupon.CodeEQ(order.PriceDetail.CouponCode), coupon.UsedCountGT(0)).
+		AddUsedCount(-n).
+		Exec(ctx)
+}
@@ -0,0 +1,53 @@
+package payorder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent/couponusage"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
+)
+
+// TestReserveCouponPerUserLimitConcurrent 同一用户并发提交订单时不能超出每人限用次数
+func TestReserveCouponPerUserLimitConcurrent(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	c := client.Coupon.Create().
+		SetName("新人券").
+		SetCode("NEW").
+		SetValue(100).
+		SetPerUserLimit(1).
+		SetEndTime(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+
+	reserved := runConcurrently(5, func(int) error {
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		order, err := tx.PayOrder.Create().
+			SetUserID(user.ID).
+			SetPriceDetail(&schema.PayOrderPriceDetail{CouponCode: c.Code, CouponDiscount: 100}).
+			Save(ctx)
+		if err == nil {
+			err = reserveCoupon(ctx, tx, order)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	})
+	if reserved != 1 {
+		t.Fatalf("reserved %d times, want 1", reserved)
+	}
+	if got := client.CouponUsage.Query().Where(couponusage.CouponCodeEQ(c.Code)).CountX(ctx); got != 1 {
+		t.Errorf("coupon usages = %d, want 1", got)
+	}
+	if got := client.Coupon.GetX(ctx, c.ID).UsedCount; got != 1 {
+		t.Errorf("used count = %d, want 1", got)
+	}
+}
@@ -0,0 +1,108 @@
+package payorder
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay/epay"
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay/stripe"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// epayChannels 易支付支持的支付渠道
+var epayChannels = []string{model.PayChannelAlipay, model.PayChannelWechat, model.PayChannelQQ}
+
+// loadGateways 按支付设置构建支付网关注册表，每次调用按需读取以保证设置修改即时生效。
+// 开启模拟支付时所有渠道都由模拟网关处理。
+func (s *PayOrderServiceImpl) loadGateways(ctx context.Context) (*pay.Registry, error) {
+	ps, err := s.loadPaymentSettings(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("支付配置未初始化，请先在系统设置-支付设置中配置")
+		}
+		return nil, err
+	}
+	return newGatewayRegistry(ps), nil
+}
+
+func newGatewayRegistry(ps *paymentSettings) *pay.Registry {
+	registry := pay.NewRegistry()
+	if ps.EnableMockPay {
+		registry.Register(pay.NewMockGateway(), append([]string{model.PayChannelStripe}, epayChannels...)...)
+		return registry
+	}
+	if ps.EnableEpay {
+		registry.Register(epay.NewGateway(epay.Config{
+			MchID:     ps.EpayMerchantId,
+			Key:       ps.EpayMerchantKey,
+			APIURL:    ps.EpayApiUrl,
+			NotifyURL: ps.EpayNotifyUrl,
+			ReturnURL: ps.EpayReturnUrl,
+		}, ps.EpayVersion), epayChannels...)
+	}
+	if ps.EnableStripe {
+		registry.Register(stripe.NewClient(stripe.Config{
+			APIURL:        ps.StripeApiUrl,
+			SecretKey:     ps.StripeSecretKey,
+			WebhookSecret: ps.StripeWebhookSecret,
+			Currency:      ps.StripeCurrency,
+			ReturnURL:     ps.StripeReturnUrl,
+		}), model.PayChannelStripe)
+	}
+	return registry
+}
+
+// orderGateway 获取订单支付渠道对应的网关，余额支付订单不经过网关返回 nil
+func orderGateway(registry *pay.Registry, order *ent.PayOrder) (pay.PaymentGateway, error) {
+	if order.ChannelType == nil || *order.ChannelType == model.PayChannelBalance {
+		return nil, nil
+	}
+	return registry.Get(*order.ChannelType)
+}
+
+// submitToGateway 在支付网关下单并落库支付链接与网关订单号，下单失败时订单置为失败，金额单位为分。
+func (s *PayOrderServiceImpl) submitToGateway(ctx context.Context, gateway pay.PaymentGateway, order *ent.PayOrder, returnURL, name string) (string, string, error) {
+	resp, err := gateway.CreateOrder(ctx, &pay.CreateOrderReq{
+		OrderID:     order.ID,
+		ChannelType: *order.ChannelType,
+		OutTradeNo:  *order.OutTradeNo,
+		Subject:     name,
+		Amount:      order.Price,
+		ReturnURL:   returnURL,
+	})
+	if err != nil {
+		// 下单失败，记录错误信息
+		s.failOrder(ctx, order, err.Error())
+		return "", "", err
+	}
+
+	if _, err := s.db.PayOrder.UpdateOneID(order.ID).
+		SetPayURL(resp.PayURL).
+		SetOrderID(resp.TradeNo).
+		SetReturnURL(returnURL).
+		Save(ctx); err != nil {
+		return "", "", err
+	}
+	return resp.PayURL, resp.TradeNo, nil
+}
+
+// closeGatewayOrder 关闭网关侧订单，失败只记录日志不影响本地关单
+func closeGatewayOrder(ctx context.Context, registry *pay.Registry, order *ent.PayOrder) {
+	gateway, err := orderGateway(registry, order)
+	if err != nil || gateway == nil {
+		return
+	}
+	req := &pay.QueryOrderReq{}
+	if order.OutTradeNo != nil {
+		req.OutTradeNo = *order.OutTradeNo
+	}
+	if order.OrderID != nil {
+		req.TradeNo = *order.OrderID
+	}
+	if err := gateway.CloseOrder(ctx, req); err != nil {
+		slog.Warn("关闭网关订单失败", "order_id", order.ID, "gateway", gateway.Name(), "error", err)
+	}
+}
@@ -0,0 +1,350 @@
+package payorder
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/coupon"
+	"github.com/shuTwT/hoshikuzu/ent/couponusage"
+	"github.com/shuTwT/hoshikuzu/ent/member"
+	"github.com/shuTwT/hoshikuzu/ent/postpurchase"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+// minPayAmount 最低支付金额，支付网关不接受 0 元订单
+const minPayAmount = 1
+
+// maxOrderItemQuantity 单个商品单次购买的最大数量
+const maxOrderItemQuantity = 999
+
+// orderQuote 服务端计算出的订单信息
+type orderQuote struct {
+	subject string
+	detail  schema.PayOrderPriceDetail
+	// 商品订单明细，文章订单为空
+	lines []orderLine
+}
+
+// orderLine 商品订单的一行明细，价格取下单时的商品价格
+type orderLine struct {
+	productID  int
+	categoryID int
+	name       string
+	sku        string
+	quantity   int
+	unitPrice  int
+}
+
+func (l orderLine) amount() int {
+	return l.unitPrice * l.quantity
+}
+
+// productID 单商品订单关联的商品，多商品订单返回 0
+func (q *orderQuote) productID() int {
+	if len(q.lines) == 1 {
+		return q.lines[0].productID
+	}
+	return 0
+}
+
+// QuoteOrder 按文章或商品当前价格、会员折扣和优惠券计算应付金额
+func (s *PayOrderServiceImpl) QuoteOrder(ctx context.Context, userID int, req *model.PayOrderQuoteReq) (*schema.PayOrderPriceDetail, error) {
+	items, err := normalizeItems(req.ProductId, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := s.quoteOrder(ctx, userID, req.OrderType, req.PostId, items, req.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+	return &quote.detail, nil
+}
+
+// normalizeItems 合并商品明细中的重复商品，只传 product_id 时视为购买一件
+func normalizeItems(productID int, items []model.PayOrderItemReq) ([]model.PayOrderItemReq, error) {
+	if len(items) == 0 {
+		if productID <= 0 {
+			return nil, nil
+		}
+		return []model.PayOrderItemReq{{ProductId: productID, Quantity: 1}}, nil
+	}
+	merged := make([]model.PayOrderItemReq, 0, len(items))
+	index := make(map[int]int, len(items))
+	for _, item := range items {
+		if item.ProductId <= 0 || item.Quantity <= 0 {
+			return nil, fmt.Errorf("商品明细无效")
+		}
+		if i, ok := index[item.ProductId]; ok {
+			merged[i].Quantity += item.Quantity
+		} else {
+			index[item.ProductId] = len(merged)
+			merged = append(merged, item)
+		}
+	}
+	for _, item := range merged {
+		if item.Quantity > maxOrderItemQuantity {
+			return nil, fmt.Errorf("单个商品最多购买 %d 件", maxOrderItemQuantity)
+		}
+	}
+	return merged, nil
+}
+
+// quoteOrder 价格以服务端数据为准，客户端只能指定购买对象、数量和优惠券
+func (s *PayOrderServiceImpl) quoteOrder(ctx context.Context, userID int, orderType string, postID int, items []model.PayOrderItemReq, couponCode string) (*orderQuote, error) {
+	var (
+		subject  string
+		original int
+		lines    []orderLine
+	)
+	switch orderType {
+	case model.PayOrderTypePost:
+		p, err := s.db.Post.Get(ctx, postID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("文章不存在")
+			}
+			return nil, err
+		}
+		if p.Price <= 0 {
+			return nil, fmt.Errorf("该文章无需付费")
+		}
+		purchased, err := s.db.PostPurchase.Query().
+			Where(postpurchase.UserIDEQ(userID), postpurchase.PostIDEQ(postID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if purchased {
+			return nil, fmt.Errorf("已购买该文章")
+		}
+		subject, original = p.Title, p.Price
+	case model.PayOrderTypeProduct:
+		var err error
+		lines, err = s.productLines(ctx, items)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range lines {
+			original += l.amount()
+		}
+		subject = lines[0].name
+		if len(lines) > 1 {
+			subject = fmt.Sprintf("%s 等%d件商品", lines[0].name, len(lines))
+		}
+	default:
+		return nil, fmt.Errorf("无效的订单类型")
+	}
+
+	level, err := s.memberLevelOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var c *ent.Coupon
+	eligible := original
+	if code := strings.TrimSpace(couponCode); code != "" {
+		c, err = s.checkCoupon(ctx, userID, code)
+		if err != nil {
+			return nil, err
+		}
+		eligible = couponEligibleAmount(c, original, lines)
+		if eligible <= 0 {
+			return nil, fmt.Errorf("优惠券不适用于该订单")
+		}
+	}
+
+	detail, err := calcPrice(original, eligible, level, c)
+	if err != nil {
+		return nil, err
+	}
+	return &orderQuote{subject: subject, detail: detail, lines: lines}, nil
+}
+
+// productLines 按商品当前价格生成订单明细，库存在下单事务内扣减，这里只做提前校验
+func (s *PayOrderServiceImpl) productLines(ctx context.Context, items []model.PayOrderItemReq) ([]orderLine, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("请选择要购买的商品")
+	}
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductId)
+	}
+	products, err := s.db.Product.Query().Where(product.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*ent.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+
+	lines := make([]orderLine, 0, len(items))
+	for _, item := range items {
+		p, ok := byID[item.ProductId]
+		if !ok {
+			return nil, fmt.Errorf("商品不存在")
+		}
+		if !p.Active {
+			return nil, fmt.Errorf("商品「%s」已下架", p.Name)
+		}
+		if p.Price <= 0 {
+			return nil, fmt.Errorf("商品「%s」价格无效", p.Name)
+		}
+		if p.Stock < item.Quantity {
+			return nil, fmt.Errorf("商品「%s」库存不足，当前库存 %d", p.Name, p.Stock)
+		}
+		lines = append(lines, orderLine{
+			productID:  p.ID,
+			categoryID: p.CategoryID,
+			name:       p.Name,
+			sku:        p.Sku,
+			quantity:   item.Quantity,
+			unitPrice:  p.Price,
+		})
+	}
+	return lines, nil
+}
+
+// memberLevelOf 当前用户有效的会员等级，非会员或会员已过期时返回 nil
+func (s *PayOrderServiceImpl) memberLevelOf(ctx context.Context, userID int) (*ent.MemberLevel, error) {
+	m, err := s.db.Member.Query().Where(member.UserIDEQ(userID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !m.Active || (!m.ExpireTime.IsZero() && m.ExpireTime.Before(time.Now())) {
+		return nil, nil
+	}
+	level, err := s.db.MemberLevel.Get(ctx, m.MemberLevel)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !level.Active {
+		return nil, nil
+	}
+	return level, nil
+}
+
+// checkCoupon 校验优惠券状态、有效期、发放数量和每人限用次数
+func (s *PayOrderServiceImpl) checkCoupon(ctx context.Context, userID int, code string) (*ent.Coupon, error) {
+	c, err := s.db.Coupon.Query().Where(coupon.CodeEQ(code)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("优惠券不存在")
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if !c.Active {
+		return nil, fmt.Errorf("优惠券已停用")
+	}
+	if now.Before(c.StartTime) || now.After(c.EndTime) {
+		return nil, fmt.Errorf("优惠券不在有效期内")
+	}
+	if c.TotalCount > 0 && c.UsedCount >= c.TotalCount {
+		return nil, fmt.Errorf("优惠券已被领完")
+	}
+	if c.PerUserLimit > 0 {
+		used, err := s.db.CouponUsage.Query().
+			Where(
+				couponusage.CouponCodeEQ(code),
+				couponusage.UserIDEQ(userID),
+				couponusage.StatusIn(model.CouponUsageStatusUnused, model.CouponUsageStatusUsed),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if used >= c.PerUserLimit {
+			return nil, fmt.Errorf("优惠券使用次数已达上限")
+		}
+	}
+	return c, nil
+}
+
+// couponEligibleAmount 优惠券适用部分的原价，限定商品或分类的优惠券只计算对应商品，文章订单不适用
+func couponEligibleAmount(c *ent.Coupon, original int, lines []orderLine) int {
+	if len(c.ProductIds) == 0 && len(c.CategoryIds) == 0 {
+		return original
+	}
+	eligible := 0
+	for _, l := range lines {
+		if slices.Contains(c.ProductIds, l.productID) || (l.categoryID > 0 && slices.Contains(c.CategoryIds, l.categoryID)) {
+			eligible += l.amount()
+		}
+	}
+	return eligible
+}
+
+// calcPrice 按 原价 -> 会员折扣 -> 优惠券 的顺序计算应付金额，eligible 为优惠券适用部分的原价，金额单位为分
+func calcPrice(original, eligible int, level *ent.MemberLevel, c *ent.Coupon) (schema.PayOrderPriceDetail, error) {
+	detail := schema.PayOrderPriceDetail{
+		OriginalAmount:     original,
+		MemberDiscountRate: 100,
+	}
+	amount := original
+	base := eligible
+
+	if level != nil && level.DiscountRate > 0 && level.DiscountRate < 100 {
+		detail.MemberLevelID = level.ID
+		detail.MemberLevelName = level.Name
+		detail.MemberDiscountRate = level.DiscountRate
+		// 低价订单折扣后可能为 0，同样保留最低支付金额
+		detail.MemberDiscount = max(min(original-original*level.DiscountRate/100, original-minPayAmount), 0)
+		amount -= detail.MemberDiscount
+		base = eligible * level.DiscountRate / 100
+	}
+
+	if c != nil {
+		discount := 0
+		switch c.Type {
+		case model.CouponTypeFullReduction:
+			if base < c.MinAmount {
+				return detail, fmt.Errorf("订单金额未满 %.2f 元，无法使用该优惠券", float64(c.MinAmount)/100)
+			}
+			discount = c.Value
+		case model.CouponTypeDiscount:
+			if base < c.MinAmount {
+				return detail, fmt.Errorf("订单金额未满 %.2f 元，无法使用该优惠券", float64(c.MinAmount)/100)
+			}
+			if c.Value <= 0 || c.Value >= 100 {
+				return detail, fmt.Errorf("优惠券折扣配置无效")
+			}
+			discount = base - base*c.Value/100
+			if c.MaxDiscount > 0 && discount > c.MaxDiscount {
+				discount = c.MaxDiscount
+			}
+		case model.CouponTypeNoThreshold:
+			discount = c.Value
+		default:
+			return detail, fmt.Errorf("不支持的优惠券类型")
+		}
+		// 优惠不超过适用部分金额，且订单至少支付最低金额
+		if discount > base {
+			discount = base
+		}
+		if discount > amount-minPayAmount {
+			discount = amount - minPayAmount
+		}
+		if discount < 0 {
+			discount = 0
+		}
+		detail.CouponCode = c.Code
+		detail.CouponDiscount = discount
+		amount -= discount
+	}
+
+	detail.PayAmount = amount
+	return detail, nil
+}
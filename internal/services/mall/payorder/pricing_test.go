@@ -0,0 +1,117 @@
+package payorder
+
+import (
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+)
+
+func TestCalcPrice(t *testing.T) {
+	vip := &ent.MemberLevel{ID: 1, Name: "VIP", DiscountRate: 90}
+	fullReduction := &ent.Coupon{Code: "FR", Type: model.CouponTypeFullReduction, Value: 500, MinAmount: 3000}
+	discount := &ent.Coupon{Code: "DC", Type: model.CouponTypeDiscount, Value: 80, MaxDiscount: 300}
+	noThreshold := &ent.Coupon{Code: "NT", Type: model.CouponTypeNoThreshold, Value: 2000}
+
+	tests := []struct {
+		name     string
+		original int
+		level    *ent.MemberLevel
+		coupon   *ent.Coupon
+		wantPay  int
+		wantErr  bool
+	}{
+		{"原价", 1000, nil, nil, 1000, false},
+		{"会员折扣", 1000, vip, nil, 900, false},
+		{"无折扣会员等级", 1000, &ent.MemberLevel{ID: 2, DiscountRate: 100}, nil, 1000, false},
+		{"满减", 5000, nil, fullReduction, 4500, false},
+		{"满减未达门槛", 2000, nil, fullReduction, 0, true},
+		{"会员折扣后未达满减门槛", 3000, vip, fullReduction, 0, true},
+		{"折扣券", 1000, nil, discount, 800, false},
+		{"折扣券封顶", 5000, nil, discount, 4700, false},
+		{"会员折扣叠加折扣券", 1000, vip, discount, 720, false},
+		{"无门槛券最低支付一分", 1000, nil, noThreshold, minPayAmount, false},
+		{"会员折扣后最低支付一分", 1, &ent.MemberLevel{ID: 3, DiscountRate: 50}, nil, minPayAmount, false},
+		{"会员折扣后叠加无门槛券", 1, vip, noThreshold, minPayAmount, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail, err := calcPrice(tt.original, tt.original, tt.level, tt.coupon)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("calcPrice() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("calcPrice() error = %v", err)
+			}
+			if detail.PayAmount != tt.wantPay {
+				t.Fatalf("calcPrice() pay = %d, want %d", detail.PayAmount, tt.wantPay)
+			}
+			if got := detail.OriginalAmount - detail.MemberDiscount - detail.CouponDiscount; got != detail.PayAmount {
+				t.Fatalf("calcPrice() breakdown %+v does not add up", detail)
+			}
+		})
+	}
+}
+
+func TestCouponEligibleAmount(t *testing.T) {
+	lines := []orderLine{
+		{productID: 1, categoryID: 10, quantity: 2, unitPrice: 500},
+		{productID: 2, categoryID: 20, quantity: 1, unitPrice: 3000},
+	}
+	tests := []struct {
+		name   string
+		coupon *ent.Coupon
+		lines  []orderLine
+		want   int
+	}{
+		{"不限范围", &ent.Coupon{}, lines, 4000},
+		{"限定商品", &ent.Coupon{ProductIds: []int{1}}, lines, 1000},
+		{"限定分类", &ent.Coupon{CategoryIds: []int{20}}, lines, 3000},
+		{"商品或分类", &ent.Coupon{ProductIds: []int{1}, CategoryIds: []int{20}}, lines, 4000},
+		{"不适用", &ent.Coupon{ProductIds: []int{3}}, lines, 0},
+		{"文章订单不适用限定券", &ent.Coupon{CategoryIds: []int{10}}, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := couponEligibleAmount(tt.coupon, 4000, tt.lines); got != tt.want {
+				t.Fatalf("couponEligibleAmount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	// 限定券的优惠只按适用部分计算
+	c := &ent.Coupon{Type: model.CouponTypeDiscount, Value: 50, ProductIds: []int{1}}
+	detail, err := calcPrice(4000, couponEligibleAmount(c, 4000, lines), nil, c)
+	if err != nil {
+		t.Fatalf("calcPrice() error = %v", err)
+	}
+	if detail.CouponDiscount != 500 || detail.PayAmount != 3500 {
+		t.Fatalf("calcPrice() = %+v, want discount 500 pay 3500", detail)
+	}
+}
+
+func TestNormalizeItems(t *testing.T) {
+	items, err := normalizeItems(0, []model.PayOrderItemReq{
+		{ProductId: 1, Quantity: 1},
+		{ProductId: 2, Quantity: 3},
+		{ProductId: 1, Quantity: 2},
+	})
+	if err != nil {
+		t.Fatalf("normalizeItems() error = %v", err)
+	}
+	if len(items) != 2 || items[0].Quantity != 3 || items[1].Quantity != 3 {
+		t.Fatalf("normalizeItems() = %+v", items)
+	}
+
+	items, err = normalizeItems(5, nil)
+	if err != nil || len(items) != 1 || items[0].ProductId != 5 || items[0].Quantity != 1 {
+		t.Fatalf("normalizeItems(product_id) = %+v, %v", items, err)
+	}
+
+	if _, err := normalizeItems(0, []model.PayOrderItemReq{{ProductId: 1, Quantity: 0}}); err == nil {
+		t.Fatalf("normalizeItems() with zero quantity error = nil")
+	}
+}
@@ -3,25 +3,33 @@ package payorder
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
 	"time"
 
 	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/cartitem"
 	"github.com/shuTwT/hoshikuzu/ent/member"
 	"github.com/shuTwT/hoshikuzu/ent/payorder"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
 	"github.com/shuTwT/hoshikuzu/ent/postpurchase"
+	"github.com/shuTwT/hoshikuzu/ent/schema"
 	"github.com/shuTwT/hoshikuzu/ent/wallet"
-	"github.com/shuTwT/hoshikuzu/internal/infra/pay/epay"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+	digital_service "github.com/shuTwT/hoshikuzu/internal/services/mall/digital"
 	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 )
 
 type PayOrderService interface {
 	ListPayOrderPage(ctx context.Context, req *model.PageQuery) ([]*ent.PayOrder, int, error)
+	ListMyOrders(ctx context.Context, userID int, req *model.PageQuery) ([]model.MyPayOrderResp, int, error)
 	SubmitPayOrder(ctx context.Context, userID int, req *model.PayOrderSubmitReq) (*model.PayOrderSubmitResp, error)
-	HandleNotify(ctx context.Context, params map[string]string) error
+	QuoteOrder(ctx context.Context, userID int, req *model.PayOrderQuoteReq) (*schema.PayOrderPriceDetail, error)
+	HandleNotify(ctx context.Context, gatewayName string, req *pay.NotifyReq) error
 	SyncOrderStatus(ctx context.Context, orderID int) (*model.PayOrderStatusResp, error)
 	GetTodayStats(ctx context.Context) (*model.PayOrderTodayStats, error)
 	CloseTimeoutOrders(ctx context.Context) error
@@ -35,10 +43,11 @@ type PayOrderService interface {
 type PayOrderServiceImpl struct {
 	db             *ent.Client
 	settingService setting_service.SettingService
+	digitalService digital_service.DigitalService
 }
 
-func NewPayOrderServiceImpl(db *ent.Client, settingService setting_service.SettingService) PayOrderService {
-	return &PayOrderServiceImpl{db: db, settingService: settingService}
+func NewPayOrderServiceImpl(db *ent.Client, settingService setting_service.SettingService, digitalService digital_service.DigitalService) PayOrderService {
+	return &PayOrderServiceImpl{db: db, settingService: settingService, digitalService: digitalService}
 }
 
 // paymentSettings 对应系统设置中 key='payment' 的 JSON 配置，仅声明后端消费的字段。
@@ -49,6 +58,15 @@ type paymentSettings struct {
 	EpayMerchantKey string `json:"epayMerchantKey"`
 	EpayNotifyUrl   string `json:"epayNotifyUrl"`
 	EpayReturnUrl   string `json:"epayReturnUrl"`
+	// 易支付接口版本 v1 或 v2，默认 v1
+	EpayVersion string `json:"epayVersion"`
+	// Stripe Checkout 支付
+	EnableStripe        bool   `json:"enableStripe"`
+	StripeApiUrl        string `json:"stripeApiUrl"`
+	StripeSecretKey     string `json:"stripeSecretKey"`
+	StripeWebhookSecret string `json:"stripeWebhookSecret"`
+	StripeCurrency      string `json:"stripeCurrency"`
+	StripeReturnUrl     string `json:"stripeReturnUrl"`
 	// 订单超时分钟数，超时未支付自动关单
 	OrderTimeout int `json:"orderTimeout"`
 	// 充值积分比例：每支付 1 分钱发放的积分数量
@@ -74,26 +92,6 @@ func (s *PayOrderServiceImpl) loadPaymentSettings(ctx context.Context) (*payment
 	return &ps, nil
 }
 
-// loadEpayConfig 从系统设置读取易支付配置，每次下单按需读取以保证设置修改即时生效。
-func (s *PayOrderServiceImpl) loadEpayConfig(ctx context.Context) (epay.Config, bool, error) {
-	ps, err := s.loadPaymentSettings(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return epay.Config{}, false, fmt.Errorf("支付配置未初始化，请先在系统设置-支付设置中配置")
-		}
-		return epay.Config{}, false, err
-	}
-
-	cfg := epay.Config{
-		MchID:     ps.EpayMerchantId,
-		Key:       ps.EpayMerchantKey,
-		APIURL:    ps.EpayApiUrl,
-		NotifyURL: ps.EpayNotifyUrl,
-		ReturnURL: ps.EpayReturnUrl,
-	}
-	return cfg, ps.EnableEpay, nil
-}
-
 // 查询支付订单列表
 func (s *PayOrderServiceImpl) ListPayOrderPage(ctx context.Context, req *model.PageQuery) ([]*ent.PayOrder, int, error) {
 	orders, err := s.db.PayOrder.Query().
@@ -112,23 +110,121 @@ func (s *PayOrderServiceImpl) ListPayOrderPage(ctx context.Context, req *model.P
 	return orders, count, nil
 }
 
-// SubmitPayOrder 提交文章付费/商品购买订单并跳转易支付下单。
+// ListMyOrders 查询当前用户的订单，包含商品明细与数字商品交付内容。
+func (s *PayOrderServiceImpl) ListMyOrders(ctx context.Context, userID int, req *model.PageQuery) ([]model.MyPayOrderResp, int, error) {
+	query := s.db.PayOrder.Query().Where(payorder.UserIDEQ(userID))
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	orders, err := query.
+		Order(ent.Desc(payorder.FieldID)).
+		Offset((req.Page - 1) * req.Size).
+		Limit(req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int, 0, len(orders))
+	for _, o := range orders {
+		ids = append(ids, o.ID)
+	}
+	items, err := s.db.PayOrderItem.Query().
+		Where(payorderitem.OrderIDIn(ids...)).
+		Order(ent.Asc(payorderitem.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	itemsByOrder := make(map[int][]model.PayOrderItemResp)
+	for _, item := range items {
+		itemsByOrder[item.OrderID] = append(itemsByOrder[item.OrderID], model.PayOrderItemResp{
+			ProductId:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			TotalPrice:  item.TotalPrice,
+		})
+	}
+	deliveries, err := s.digitalService.ListOrderDeliveries(ctx, userID, ids...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]model.MyPayOrderResp, 0, len(orders))
+	for _, o := range orders {
+		r := model.MyPayOrderResp{
+			ID:          o.ID,
+			OrderType:   o.OrderType,
+			Subject:     o.Subject,
+			State:       o.State,
+			OrderPrice:  o.OrderPrice,
+			Price:       o.Price,
+			PostId:      o.PostID,
+			PriceDetail: o.PriceDetail,
+			Items:       itemsByOrder[o.ID],
+			Deliveries:  deliveries[o.ID],
+			CreatedAt:   model.LocalTime(o.CreatedAt),
+		}
+		if o.OutTradeNo != nil {
+			r.OutTradeNo = *o.OutTradeNo
+		}
+		result = append(result, r)
+	}
+	return result, count, nil
+}
+
+// SubmitPayOrder 提交文章付费/商品购买订单并跳转支付网关下单。
 func (s *PayOrderServiceImpl) SubmitPayOrder(ctx context.Context, userID int, req *model.PayOrderSubmitReq) (*model.PayOrderSubmitResp, error) {
+	items, err := normalizeItems(req.ProductId, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := s.quoteOrder(ctx, userID, req.OrderType, req.PostId, items, req.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+	// 金额以服务端计算为准，客户端金额不一致说明价格已变动或被篡改
+	if req.Money != quote.detail.PayAmount {
+		return nil, fmt.Errorf("订单金额不一致，应付 ¥%s，请刷新后重试", strconv.FormatFloat(float64(quote.detail.PayAmount)/100, 'f', 2, 64))
+	}
+
 	// 余额支付：直接扣减钱包余额完成支付，不经过第三方网关
 	if req.ChannelType == model.PayChannelBalance {
-		return s.submitBalancePay(ctx, userID, req)
+		resp, err := s.submitBalancePay(ctx, userID, req, quote)
+		if err != nil {
+			return nil, err
+		}
+		if req.FromCart {
+			s.removeFromCart(ctx, userID, quote)
+		}
+		return resp, nil
 	}
 
-	order, err := s.createPayOrder(ctx, userID, req.OrderType, req.ChannelType, req.Name, req.Name, req.Money, req.PostId, req.ProductId)
+	// 先确认支付渠道可用，避免创建无法支付的订单占用优惠券和库存
+	registry, err := s.loadGateways(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gateway, err := registry.Get(req.ChannelType)
 	if err != nil {
 		return nil, err
 	}
 
-	payURL, tradeNO, err := s.submitToEpay(ctx, order, req.ChannelType, req.ReturnUrl, req.Name)
+	order, err := s.createPayOrder(ctx, userID, req.OrderType, req.ChannelType, quote.subject, quote.subject, quote.detail.PayAmount, req.PostId, quote)
 	if err != nil {
 		return nil, err
 	}
 
+	payURL, tradeNO, err := s.submitToGateway(ctx, gateway, order, req.ReturnUrl, quote.subject)
+	if err != nil {
+		return nil, err
+	}
+	if req.FromCart {
+		s.removeFromCart(ctx, userID, quote)
+	}
+
 	return &model.PayOrderSubmitResp{
 		OrderID:    order.ID,
 		OutTradeNo: *order.OutTradeNo,
@@ -138,37 +234,52 @@ func (s *PayOrderServiceImpl) SubmitPayOrder(ctx context.Context, userID int, re
 }
 
 // submitBalancePay 余额支付：扣减钱包余额并将订单置为已支付（含履约），全部在一个事务内完成。
-func (s *PayOrderServiceImpl) submitBalancePay(ctx context.Context, userID int, req *model.PayOrderSubmitReq) (*model.PayOrderSubmitResp, error) {
-	order, err := s.createPayOrder(ctx, userID, req.OrderType, model.PayChannelBalance, req.Name, req.Name, req.Money, req.PostId, req.ProductId)
+func (s *PayOrderServiceImpl) submitBalancePay(ctx context.Context, userID int, req *model.PayOrderSubmitReq, quote *orderQuote) (*model.PayOrderSubmitResp, error) {
+	amount := quote.detail.PayAmount
+	order, err := s.createPayOrder(ctx, userID, req.OrderType, model.PayChannelBalance, quote.subject, quote.subject, amount, req.PostId, quote)
 	if err != nil {
 		return nil, err
 	}
 
+	resp, err := s.payWithBalance(ctx, userID, order, amount)
+	if err != nil {
+		// 余额支付失败时订单直接置为失败，释放占用的优惠券
+		s.failOrder(ctx, order, err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
+
+// payWithBalance 扣减钱包余额并完成订单履约，在一个事务内完成。
+func (s *PayOrderServiceImpl) payWithBalance(ctx context.Context, userID int, order *ent.PayOrder, amount int) (*model.PayOrderSubmitResp, error) {
 	tx, err := s.db.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// 校验并扣减钱包余额
-	walletEnt, err := tx.Wallet.Query().Where(wallet.UserIDEQ(userID)).Only(ctx)
+	// 以余额充足为条件扣减，并发的余额支付不会重复扣款或扣成负数
+	n, err := tx.Wallet.Update().
+		Where(wallet.UserIDEQ(userID), wallet.BalanceGTE(amount)).
+		AddBalance(-amount).
+		AddTotalExpense(amount).
+		Save(ctx)
 	if err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
-	if walletEnt.Balance < req.Money {
+	if n == 0 {
 		_ = tx.Rollback()
+		walletEnt, err := s.db.Wallet.Query().Where(wallet.UserIDEQ(userID)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
 		return nil, fmt.Errorf("余额不足，当前余额 ¥%s", strconv.FormatFloat(float64(walletEnt.Balance)/100, 'f', 2, 64))
 	}
-	if _, err := tx.Wallet.UpdateOneID(walletEnt.ID).
-		SetBalance(walletEnt.Balance - req.Money).
-		SetTotalExpense(walletEnt.TotalExpense + req.Money).
-		Save(ctx); err != nil {
-		_ = tx.Rollback()
-		return nil, err
-	}
 
-	// 订单置为已支付并落库交易号
-	order, err = tx.PayOrder.UpdateOneID(order.ID).
+	// 订单置为已支付并落库交易号，与支付成功回调一样只迁移待支付的订单，
+	// 已被超时关闭的订单库存和优惠券已释放，不能再按占用状态结算
+	n, err = tx.PayOrder.Update().
+		Where(payorder.IDEQ(order.ID), payorder.StateEQ("1")).
 		SetState("2").
 		SetOrderID(fmt.Sprintf("BAL%d", order.ID)).
 		SetPayURL("").
@@ -177,18 +288,40 @@ func (s *PayOrderServiceImpl) submitBalancePay(ctx context.Context, userID int,
 		_ = tx.Rollback()
 		return nil, err
 	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("订单已关闭，请重新下单")
+	}
+	order, err = tx.PayOrder.Get(ctx, order.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
 
 	// 履约（文章付费写购买记录）
 	if err := writePostPurchase(ctx, tx, order); err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
+	if err := confirmCoupon(ctx, tx, order); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := settleStock(ctx, tx, order, true); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := s.digitalService.DeliverOrder(ctx, tx, order); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	event.Publish(ctx, event.PayOrderPaid, toPayOrderEventData(order))
 
-	slog.Info("余额支付成功", "user_id", userID, "order_id", order.ID, "amount", req.Money)
+	slog.Info("余额支付成功", "user_id", userID, "order_id", order.ID, "amount", amount)
 	return &model.PayOrderSubmitResp{
 		OrderID:    order.ID,
 		OutTradeNo: *order.OutTradeNo,
@@ -197,20 +330,29 @@ func (s *PayOrderServiceImpl) submitBalancePay(ctx context.Context, userID int,
 	}, nil
 }
 
-// RechargePayOrder 提交余额充值订单并跳转易支付下单，支付成功后回调入账钱包并发放会员积分。
+// RechargePayOrder 提交余额充值订单并跳转支付网关下单，支付成功后回调入账钱包并发放会员积分。
 func (s *PayOrderServiceImpl) RechargePayOrder(ctx context.Context, userID int, req *model.PayOrderRechargeReq) (*model.PayOrderSubmitResp, error) {
 	// 充值不能使用余额支付
 	if req.ChannelType == model.PayChannelBalance {
 		return nil, fmt.Errorf("充值不支持余额支付")
 	}
 
+	registry, err := s.loadGateways(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gateway, err := registry.Get(req.ChannelType)
+	if err != nil {
+		return nil, err
+	}
+
 	subject := "余额充值"
-	order, err := s.createPayOrder(ctx, userID, model.PayOrderTypeRecharge, req.ChannelType, subject, subject, req.Amount, 0, 0)
+	order, err := s.createPayOrder(ctx, userID, model.PayOrderTypeRecharge, req.ChannelType, subject, subject, req.Amount, 0, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	payURL, tradeNO, err := s.submitToEpay(ctx, order, req.ChannelType, req.ReturnUrl, subject)
+	payURL, tradeNO, err := s.submitToGateway(ctx, gateway, order, req.ReturnUrl, subject)
 	if err != nil {
 		return nil, err
 	}
@@ -223,9 +365,14 @@ func (s *PayOrderServiceImpl) RechargePayOrder(ctx context.Context, userID int,
 	}, nil
 }
 
-// createPayOrder 创建支付订单并生成商户订单号，金额单位为分。
-func (s *PayOrderServiceImpl) createPayOrder(ctx context.Context, userID int, orderType, channelType, subject, body string, amount, postID, productID int) (*ent.PayOrder, error) {
-	builder := s.db.PayOrder.Create().
+// createPayOrder 创建支付订单并生成商户订单号，金额单位为分。quote 不为空时按金额明细落库，并占用优惠券和商品库存。
+func (s *PayOrderServiceImpl) createPayOrder(ctx context.Context, userID int, orderType, channelType, subject, body string, amount, postID int, quote *orderQuote) (*ent.PayOrder, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := tx.PayOrder.Create().
 		SetUserID(userID).
 		SetOrderType(orderType).
 		SetChannelType(channelType).
@@ -234,87 +381,103 @@ func (s *PayOrderServiceImpl) createPayOrder(ctx context.Context, userID int, or
 		SetOrderPrice(amount).
 		SetPrice(amount).
 		SetState("1")
+	if quote != nil {
+		builder = builder.
+			SetOrderPrice(quote.detail.OriginalAmount).
+			SetPrice(quote.detail.PayAmount).
+			SetPriceDetail(&quote.detail)
+		if productID := quote.productID(); productID > 0 {
+			builder = builder.SetProductID(productID)
+		}
+	}
 	if postID > 0 {
 		builder = builder.SetPostID(postID)
 	}
-	if productID > 0 {
-		builder = builder.SetProductID(productID)
-	}
 	order, err := builder.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
 		return nil, err
 	}
 
 	// 生成商户订单号
 	orderNo := time.Now().Format("20060102150405") + fmt.Sprintf("%09d", order.ID)
-	return s.db.PayOrder.UpdateOneID(order.ID).SetOutTradeNo(orderNo).Save(ctx)
-}
-
-// submitToEpay 下单并落库支付链接与易支付订单号。开启模拟支付时跳过真实网关，返回本地模拟支付页，金额单位为分。
-func (s *PayOrderServiceImpl) submitToEpay(ctx context.Context, order *ent.PayOrder, channelType, returnURL, name string) (string, string, error) {
-	moneyYuan := strconv.FormatFloat(float64(order.Price)/100, 'f', 2, 64)
-
-	ps, err := s.loadPaymentSettings(ctx)
+	order, err = tx.PayOrder.UpdateOneID(order.ID).SetOutTradeNo(orderNo).Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return "", "", fmt.Errorf("支付配置未初始化，请先在系统设置-支付设置中配置")
-		}
-		return "", "", err
+		_ = tx.Rollback()
+		return nil, err
 	}
 
-	// 模拟支付：不调用真实网关，返回本地 mock 支付页
-	if ps.EnableMockPay {
-		mockURL := fmt.Sprintf("/api/v1/pay-order/mock-pay/%d", order.ID)
-		if _, err := s.db.PayOrder.UpdateOneID(order.ID).
-			SetPayURL(mockURL).
-			SetOrderID(fmt.Sprintf("MOCK%d", order.ID)).
-			SetReturnURL(returnURL).
-			Save(ctx); err != nil {
-			return "", "", err
+	// 优惠券与库存和订单同一事务占用，占用失败则不创建订单
+	if err := reserveCoupon(ctx, tx, order); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	var lowStock []*ent.Product
+	if quote != nil && len(quote.lines) > 0 {
+		lowStock, err = reserveStock(ctx, tx, order, quote.lines)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
 		}
-		return mockURL, fmt.Sprintf("MOCK%d", order.ID), nil
 	}
 
-	if !ps.EnableEpay {
-		return "", "", fmt.Errorf("易支付未启用")
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
+	s.notifyLowStock(ctx, lowStock)
+	return order, nil
+}
 
-	cfg := epay.Config{
-		MchID:     ps.EpayMerchantId,
-		Key:       ps.EpayMerchantKey,
-		APIURL:    ps.EpayApiUrl,
-		NotifyURL: ps.EpayNotifyUrl,
-		ReturnURL: ps.EpayReturnUrl,
+// removeFromCart 购物车结算下单后移除已下单的商品，失败只记录日志
+func (s *PayOrderServiceImpl) removeFromCart(ctx context.Context, userID int, quote *orderQuote) {
+	if len(quote.lines) == 0 {
+		return
 	}
-	params := epay.V1PayRequestParams{
-		PID:        cfg.MchID,
-		Type:       channelType,
-		OutTradeNo: *order.OutTradeNo,
-		Name:       name,
-		Money:      moneyYuan,
-		ReturnURL:  &returnURL,
+	ids := make([]int, 0, len(quote.lines))
+	for _, l := range quote.lines {
+		ids = append(ids, l.productID)
 	}
-	resp, err := epay.NewV1Client(cfg).CreateOrder(params)
-	if err != nil {
-		// 下单失败，记录错误信息
-		_ = s.db.PayOrder.UpdateOneID(order.ID).SetState("3").SetErrorMsg(err.Error()).Exec(ctx)
-		return "", "", err
+	if _, err := s.db.CartItem.Delete().
+		Where(cartitem.UserIDEQ(userID), cartitem.ProductIDIn(ids...)).
+		Exec(ctx); err != nil {
+		slog.Error("移除购物车商品失败", "user_id", userID, "error", err)
 	}
+}
 
-	// 存储支付链接与易支付订单号
-	payURL := ""
-	if resp.Payurl != nil {
-		payURL = *resp.Payurl
-	}
-	if _, err := s.db.PayOrder.UpdateOneID(order.ID).
-		SetPayURL(payURL).
-		SetOrderID(resp.TradeNO).
-		SetReturnURL(returnURL).
-		Save(ctx); err != nil {
-		return "", "", err
+// failOrder 将待支付订单置为失败并释放占用的优惠券。
+func (s *PayOrderServiceImpl) failOrder(ctx context.Context, order *ent.PayOrder, msg string) {
+	if err := s.closeOrder(ctx, order, "3", msg); err != nil {
+		slog.Error("订单置为失败出错", "order_id", order.ID, "error", err)
 	}
+}
 
-	return payURL, resp.TradeNO, nil
+// closeOrder 待支付订单置为关闭或失败并释放占用的优惠券和库存，订单已不是待支付状态时跳过。
+func (s *PayOrderServiceImpl) closeOrder(ctx context.Context, order *ent.PayOrder, state, msg string) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	// 仅更新待支付订单，避免与支付成功回调并发时覆盖已支付状态
+	n, err := tx.PayOrder.Update().
+		Where(payorder.IDEQ(order.ID), payorder.StateEQ("1")).
+		SetState(state).
+		SetErrorMsg(msg).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if n > 0 {
+		if err := releaseCoupon(ctx, tx, order, msg); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if err := releaseStock(ctx, tx, order); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
 // MockPaySuccess 模拟支付成功：复用真实支付成功的履约逻辑（幂等，含文章购买记录/充值入账积分）。
@@ -329,16 +492,16 @@ func (s *PayOrderServiceImpl) MockPaySuccess(ctx context.Context, orderID int) e
 	return s.handlePaySuccess(ctx, order, fmt.Sprintf("MOCK%d", order.ID))
 }
 
-// MockPayFail 模拟支付失败：订单置为失败状态。
+// MockPayFail 模拟支付失败：订单置为失败状态并释放占用的优惠券。
 func (s *PayOrderServiceImpl) MockPayFail(ctx context.Context, orderID int) error {
-	_, err := s.db.PayOrder.UpdateOneID(orderID).
-		SetState("3").
-		SetErrorMsg("模拟支付失败").
-		Save(ctx)
-	return err
+	order, err := s.db.PayOrder.Get(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	return s.closeOrder(ctx, order, "3", "模拟支付失败")
 }
 
-// GetPayMethods 查询当前用户可用的支付方式：支付宝/微信取决于易支付或直连渠道启用，余额取决于钱包余额。
+// GetPayMethods 查询当前用户可用的支付方式：支付宝/微信/Stripe 取决于对应网关或直连渠道启用，余额取决于钱包余额。
 func (s *PayOrderServiceImpl) GetPayMethods(ctx context.Context, userID int) (*model.PayMethodResp, error) {
 	resp := &model.PayMethodResp{}
 
@@ -353,9 +516,10 @@ func (s *PayOrderServiceImpl) GetPayMethods(ctx context.Context, userID int) (*m
 	}
 
 	// 模拟支付模式下所有渠道均可测试
-	epayAvailable := ps.EnableEpay || ps.EnableMockPay
-	resp.Alipay = epayAvailable || ps.EnableAlipay
-	resp.Wechat = epayAvailable || ps.EnableWechatPay
+	registry := newGatewayRegistry(ps)
+	resp.Alipay = registry.Has(model.PayChannelAlipay) || ps.EnableAlipay
+	resp.Wechat = registry.Has(model.PayChannelWechat) || ps.EnableWechatPay
+	resp.Stripe = registry.Has(model.PayChannelStripe)
 
 	// 余额支付：钱包存在即可选（余额不足时由下单接口提示，前端始终展示该方式）
 	walletEnt, err := s.db.Wallet.Query().Where(wallet.UserIDEQ(userID)).Only(ctx)
@@ -373,7 +537,8 @@ func (s *PayOrderServiceImpl) GetPayMethods(ctx context.Context, userID int) (*m
 
 // handlePaySuccess 标记订单为已支付并执行业务履约（文章付费写购买记录、充值入账钱包与积分），幂等。
 func (s *PayOrderServiceImpl) handlePaySuccess(ctx context.Context, order *ent.PayOrder, tradeNo string) error {
-	if order.State == "2" {
+	// 已退款订单网关侧仍为已支付，重复通知或查单不能恢复为已支付
+	if order.State == "2" || order.State == "4" {
 		return nil
 	}
 
@@ -382,12 +547,36 @@ func (s *PayOrderServiceImpl) handlePaySuccess(ctx context.Context, order *ent.P
 		return err
 	}
 
-	updater := tx.PayOrder.UpdateOneID(order.ID).SetState("2")
+	// 以条件更新抢占状态迁移，并发的通知、查单或超时关闭只有一方能生效。
+	// 待支付订单的库存仍处于占用状态，已关闭或失败的订单库存已退回
+	stockReserved := true
+	n, err := tx.PayOrder.Update().
+		Where(payorder.IDEQ(order.ID), payorder.StateEQ("1")).
+		SetState("2").
+		Save(ctx)
+	if err == nil && n == 0 {
+		stockReserved = false
+		n, err = tx.PayOrder.Update().
+			Where(payorder.IDEQ(order.ID), payorder.StateIn("0", "3")).
+			SetState("2").
+			Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if n == 0 {
+		// 已被其他请求置为已支付或已退款
+		return tx.Rollback()
+	}
 	if tradeNo != "" {
-		// 落库易支付订单号，退款时需要使用
-		updater.SetOrderID(tradeNo)
+		// 落库网关订单号，退款时需要使用
+		if err := tx.PayOrder.UpdateOneID(order.ID).SetOrderID(tradeNo).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
 	}
-	order, err = updater.Save(ctx)
+	order, err = tx.PayOrder.Get(ctx, order.ID)
 	if err != nil {
 		_ = tx.Rollback()
 		return err
@@ -398,6 +587,21 @@ func (s *PayOrderServiceImpl) handlePaySuccess(ctx context.Context, order *ent.P
 		_ = tx.Rollback()
 		return err
 	}
+	// 使用了优惠券：核销下单时占用的优惠券
+	if err := confirmCoupon(ctx, tx, order); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	// 商品购买：累加销量
+	if err := settleStock(ctx, tx, order, stockReserved); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	// 数字商品：交付文件、卡密或文本
+	if err := s.digitalService.DeliverOrder(ctx, tx, order); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 
 	// 余额充值：钱包入账并发放会员积分
 	if order.OrderType == model.PayOrderTypeRecharge {
@@ -407,7 +611,11 @@ func (s *PayOrderServiceImpl) handlePaySuccess(ctx context.Context, order *ent.P
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	event.Publish(ctx, event.PayOrderPaid, toPayOrderEventData(order))
+	return nil
 }
 
 // writePostPurchase 文章付费履约：写入购买记录（唯一索引保证不重复），在事务内调用。
@@ -535,95 +743,91 @@ func (s *PayOrderServiceImpl) getRechargePointsRate(ctx context.Context) int {
 	return ps.RechargePointsRate
 }
 
-// HandleNotify 处理易支付异步回调，支付通知与退款通知共用回调地址，按参数区分。
-func (s *PayOrderServiceImpl) HandleNotify(ctx context.Context, params map[string]string) error {
-	// 退款通知带 refund_no 参数，支付通知带 trade_status
-	if params["refund_no"] != "" {
-		return s.handleRefundNotify(ctx, params)
-	}
-
-	cfg, _, err := s.loadEpayConfig(ctx)
+// HandleNotify 处理支付网关异步通知：按网关名称验签解析后分发到支付成功或退款处理，幂等。
+func (s *PayOrderServiceImpl) HandleNotify(ctx context.Context, gatewayName string, req *pay.NotifyReq) error {
+	registry, err := s.loadGateways(ctx)
 	if err != nil {
 		return err
 	}
-
-	sign, ok := params["sign"]
+	gateway, ok := registry.ByName(gatewayName)
 	if !ok {
-		return fmt.Errorf("缺少签名参数")
-	}
-	if !epay.VerifySign(params, cfg.Key, sign) {
-		return fmt.Errorf("签名校验失败")
-	}
-
-	// 仅处理支付成功的通知
-	if params["trade_status"] != "TRADE_SUCCESS" {
-		return nil
+		return fmt.Errorf("支付网关 %s 未启用", gatewayName)
 	}
 
-	outTradeNo := params["out_trade_no"]
-	order, err := s.db.PayOrder.Query().
-		Where(payorder.OutTradeNoEQ(outTradeNo)).
-		Only(ctx)
+	notify, err := gateway.VerifyNotify(ctx, req)
 	if err != nil {
 		return err
 	}
+	switch notify.Type {
+	case pay.NotifyPaid:
+		order, err := s.db.PayOrder.Query().
+			Where(payorder.OutTradeNoEQ(notify.OutTradeNo)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if err := checkPaidAmount(order, gateway.Name(), notify.Amount); err != nil {
+			return err
+		}
+		return s.handlePaySuccess(ctx, order, notify.TradeNo)
+	case pay.NotifyRefunded:
+		return s.handleRefundNotify(ctx, notify)
+	}
+	return nil
+}
 
-	return s.handlePaySuccess(ctx, order, params["trade_no"])
+// checkPaidAmount 网关返回的实付金额与订单金额不一致时拒绝履约，避免金额被篡改的订单发货。
+// 支付通知与主动查单都需要校验，网关未返回金额时跳过
+func checkPaidAmount(order *ent.PayOrder, gatewayName string, amount int) error {
+	if amount > 0 && amount != order.Price {
+		slog.Error("支付金额与订单不一致", "order_id", order.ID, "gateway", gatewayName, "paid_amount", amount, "price", order.Price)
+		return fmt.Errorf("支付金额与订单金额不一致")
+	}
+	return nil
 }
 
-// SyncOrderStatus 主动查询易支付订单状态并同步本地（用于补单/后台手动刷新）。
+// SyncOrderStatus 主动查询支付网关订单状态并同步本地（用于补单/后台手动刷新）。
 func (s *PayOrderServiceImpl) SyncOrderStatus(ctx context.Context, orderID int) (*model.PayOrderStatusResp, error) {
 	order, err := s.db.PayOrder.Get(ctx, orderID)
 	if err != nil {
 		return nil, err
 	}
 
-	// 已支付的订单无需同步
-	if order.State == "2" {
+	// 已支付或已退款的订单无需同步
+	if order.State == "2" || order.State == "4" {
 		return toPayOrderStatusResp(order), nil
 	}
 
-	// 模拟支付模式下不查外部网关，直接返回当前状态
-	ps, err := s.loadPaymentSettings(ctx)
+	registry, err := s.loadGateways(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if ps.EnableMockPay {
-		return toPayOrderStatusResp(order), nil
-	}
-	if !ps.EnableEpay {
-		return nil, fmt.Errorf("易支付未启用")
+	gateway, err := orderGateway(registry, order)
+	if err != nil {
+		return nil, err
 	}
-
-	cfg := epay.Config{
-		MchID:     ps.EpayMerchantId,
-		Key:       ps.EpayMerchantKey,
-		APIURL:    ps.EpayApiUrl,
-		NotifyURL: ps.EpayNotifyUrl,
-		ReturnURL: ps.EpayReturnUrl,
+	// 余额支付订单不经过网关，直接返回当前状态
+	if gateway == nil {
+		return toPayOrderStatusResp(order), nil
 	}
 
-	pid, err := strconv.Atoi(cfg.MchID)
-	if err != nil {
-		return nil, fmt.Errorf("商户ID无效: %w", err)
-	}
-	tradeNo := ""
+	req := &pay.QueryOrderReq{}
 	if order.OrderID != nil {
-		tradeNo = *order.OrderID
+		req.TradeNo = *order.OrderID
 	}
-	outTradeNo := ""
 	if order.OutTradeNo != nil {
-		outTradeNo = *order.OutTradeNo
+		req.OutTradeNo = *order.OutTradeNo
 	}
-
-	queryResp, err := epay.NewV1Client(cfg).QueryOrder(pid, cfg.Key, tradeNo, outTradeNo)
+	queryResp, err := gateway.QueryOrder(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	// 易支付 status=2 表示已支付
-	if queryResp.Status == 2 {
-		if err := s.handlePaySuccess(ctx, order, queryResp.TradeNO); err != nil {
+	if queryResp.Paid {
+		if err := checkPaidAmount(order, gateway.Name(), queryResp.Amount); err != nil {
+			return nil, err
+		}
+		if err := s.handlePaySuccess(ctx, order, queryResp.TradeNo); err != nil {
 			return nil, err
 		}
 		order, err = s.db.PayOrder.Get(ctx, orderID)
@@ -703,24 +907,38 @@ func (s *PayOrderServiceImpl) getOrderTimeoutMinutes(ctx context.Context) int {
 	return ps.OrderTimeout
 }
 
-// CloseTimeoutOrders 关闭超时未支付订单（state 1 -> 0），由定时任务周期调用。
+// CloseTimeoutOrders 关闭超时未支付订单（state 1 -> 0）并释放占用的优惠券和库存，由定时任务周期调用。
 func (s *PayOrderServiceImpl) CloseTimeoutOrders(ctx context.Context) error {
 	cutoff := time.Now().Add(-time.Duration(s.getOrderTimeoutMinutes(ctx)) * time.Minute)
 
-	updated, err := s.db.PayOrder.Update().
+	orders, err := s.db.PayOrder.Query().
 		Where(
 			payorder.StateEQ("1"),
 			payorder.CreatedAtLT(cutoff),
 		).
-		SetState("0").
-		SetErrorMsg("超时未支付，系统自动关单").
-		Save(ctx)
+		All(ctx)
 	if err != nil {
 		return err
 	}
 
-	if updated > 0 {
-		slog.Info("超时关单完成", "count", updated)
+	// 网关配置读取失败时仍然关闭本地订单
+	registry, err := s.loadGateways(ctx)
+	if err != nil {
+		registry = pay.NewRegistry()
+	}
+
+	closed := 0
+	for _, order := range orders {
+		closeGatewayOrder(ctx, registry, order)
+		if err := s.closeOrder(ctx, order, "0", "超时未支付，系统自动关单"); err != nil {
+			slog.Error("超时关单失败", "order_id", order.ID, "error", err)
+			continue
+		}
+		closed++
+	}
+
+	if closed > 0 {
+		slog.Info("超时关单完成", "count", closed)
 	}
 	return nil
 }
@@ -756,37 +974,26 @@ func (s *PayOrderServiceImpl) RefundOrder(ctx context.Context, orderID int, req
 		refundNo = fmt.Sprintf("R%d%s", order.ID, time.Now().Format("20060102150405"))
 	}
 
-	// 真实退款：调用易支付网关；余额支付订单与模拟支付模式下跳过网关调用
-	ps, err := s.loadPaymentSettings(ctx)
-	if err != nil {
-		return nil, err
-	}
+	// 真实退款：调用订单支付渠道对应的网关；余额支付订单退回钱包不经过网关
 	isBalanceOrder := order.ChannelType != nil && *order.ChannelType == model.PayChannelBalance
-	if !isBalanceOrder && !ps.EnableMockPay {
-		if !ps.EnableEpay {
-			return nil, fmt.Errorf("易支付未启用")
-		}
-
-		cfg := epay.Config{
-			MchID:     ps.EpayMerchantId,
-			Key:       ps.EpayMerchantKey,
-			APIURL:    ps.EpayApiUrl,
-			NotifyURL: ps.EpayNotifyUrl,
-			ReturnURL: ps.EpayReturnUrl,
-		}
-		// 易支付订单号本地缺失时先查单补齐（部分订单经回调支付成功但未落库 trade_no）
-		tradeNo, err := s.getEpayTradeNo(ctx, cfg, order)
+	if !isBalanceOrder {
+		registry, err := s.loadGateways(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		moneyYuan := strconv.FormatFloat(float64(amount)/100, 'f', 2, 64)
-		pid, err := strconv.Atoi(cfg.MchID)
+		gateway, err := orderGateway(registry, order)
 		if err != nil {
-			return nil, fmt.Errorf("商户ID无效: %w", err)
+			return nil, err
+		}
+		refundReq := &pay.RefundReq{RefundNo: refundNo, Amount: amount}
+		if order.OrderID != nil {
+			refundReq.TradeNo = *order.OrderID
 		}
-		if _, err := epay.NewV1Client(cfg).RefundOrder(pid, cfg.Key, tradeNo, refundNo, moneyYuan); err != nil {
-			return nil, fmt.Errorf("易支付退款失败: %w", err)
+		if order.OutTradeNo != nil {
+			refundReq.OutTradeNo = *order.OutTradeNo
+		}
+		if _, err := gateway.Refund(ctx, refundReq); err != nil {
+			return nil, fmt.Errorf("%s 退款失败: %w", gateway.Name(), err)
 		}
 	}
 
@@ -796,15 +1003,29 @@ func (s *PayOrderServiceImpl) RefundOrder(ctx context.Context, orderID int, req
 		return nil, err
 	}
 
-	if _, err := tx.PayOrder.UpdateOneID(order.ID).
+	// 只迁移已支付的订单，与同时到达的退款通知只有一方回滚权益
+	n, err := tx.PayOrder.Update().
+		Where(payorder.IDEQ(order.ID), payorder.StateEQ("2")).
 		SetState("4").
 		SetRefundNo(refundNo).
 		SetRefundAmount(amount).
 		SetRefundAt(time.Now()).
-		Save(ctx); err != nil {
+		Save(ctx)
+	if err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
+	if n == 0 {
+		_ = tx.Rollback()
+		order, err = s.db.PayOrder.Get(ctx, orderID)
+		if err != nil {
+			return nil, err
+		}
+		if order.State != "4" {
+			return nil, fmt.Errorf("订单状态 %s 不可退款", order.State)
+		}
+		return toPayOrderRefundResp(order), nil
+	}
 	// 余额支付订单：退款退回钱包余额
 	if isBalanceOrder {
 		if err := s.refundToWallet(ctx, tx, order, amount); err != nil {
@@ -817,6 +1038,21 @@ func (s *PayOrderServiceImpl) RefundOrder(ctx context.Context, orderID int, req
 		_ = tx.Rollback()
 		return nil, err
 	}
+	// 释放订单使用的优惠券
+	if err := releaseCoupon(ctx, tx, order, "订单退款"); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	// 商品购买：退回库存并扣减销量
+	if err := refundStock(ctx, tx, order); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	// 撤销数字商品交付
+	if err := s.digitalService.RevokeOrder(ctx, tx, order); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
 	// 余额充值：扣回钱包余额与会员积分
 	if order.OrderType == model.PayOrderTypeRecharge {
 		if err := s.revokeWalletAndPoints(ctx, tx, order, amount); err != nil {
@@ -833,29 +1069,19 @@ func (s *PayOrderServiceImpl) RefundOrder(ctx context.Context, orderID int, req
 	if err != nil {
 		return nil, err
 	}
+	event.Publish(ctx, event.PayOrderRefunded, toPayOrderEventData(order))
 	slog.Info("订单退款成功", "order_id", order.ID, "refund_no", refundNo, "amount", amount)
 	return toPayOrderRefundResp(order), nil
 }
 
-// handleRefundNotify 处理易支付退款异步通知：验签 -> 找订单 -> 标记退款并回滚权益，幂等。
-func (s *PayOrderServiceImpl) handleRefundNotify(ctx context.Context, params map[string]string) error {
-	cfg, _, err := s.loadEpayConfig(ctx)
-	if err != nil {
-		return err
-	}
-
-	sign, ok := params["sign"]
-	if !ok {
-		return fmt.Errorf("缺少签名参数")
-	}
-	if !epay.VerifySign(params, cfg.Key, sign) {
-		return fmt.Errorf("签名校验失败")
-	}
+// errRefundSkipped 订单已不是已支付状态，退款通知不再回滚权益
+var errRefundSkipped = errors.New("订单不是已支付状态")
 
-	refundNo := params["refund_no"]
-	outTradeNo := params["out_trade_no"]
+// handleRefundNotify 处理网关退款异步通知：找订单 -> 标记退款并回滚权益，幂等。
+func (s *PayOrderServiceImpl) handleRefundNotify(ctx context.Context, notify *pay.Notify) error {
+	refundNo := notify.RefundNo
 	order, err := s.db.PayOrder.Query().
-		Where(payorder.OutTradeNoEQ(outTradeNo)).
+		Where(payorder.OutTradeNoEQ(notify.OutTradeNo)).
 		Only(ctx)
 	if err != nil {
 		return err
@@ -866,12 +1092,7 @@ func (s *PayOrderServiceImpl) handleRefundNotify(ctx context.Context, params map
 		return nil
 	}
 
-	amount := 0
-	if money := params["money"]; money != "" {
-		if moneyF, parseErr := strconv.ParseFloat(money, 64); parseErr == nil {
-			amount = int(moneyF * 100)
-		}
-	}
+	amount := notify.Amount
 
 	err = func() error {
 		tx, err := s.db.Tx(ctx)
@@ -879,20 +1100,43 @@ func (s *PayOrderServiceImpl) handleRefundNotify(ctx context.Context, params map
 			return err
 		}
 
-		if _, err := tx.PayOrder.UpdateOneID(order.ID).
+		// 只迁移已支付的订单：待支付、已关闭的订单不接受退款通知，
+		// 与同时进行的后台退款只有一方回滚权益
+		n, err := tx.PayOrder.Update().
+			Where(payorder.IDEQ(order.ID), payorder.StateEQ("2")).
 			SetState("4").
 			SetRefundNo(refundNo).
 			SetRefundAmount(amount).
 			SetRefundAt(time.Now()).
-			Save(ctx); err != nil {
+			Save(ctx)
+		if err != nil {
 			_ = tx.Rollback()
 			return err
 		}
+		if n == 0 {
+			_ = tx.Rollback()
+			return errRefundSkipped
+		}
 		// 文章付费权益回滚
 		if err := deletePostPurchase(ctx, tx, order); err != nil {
 			_ = tx.Rollback()
 			return err
 		}
+		// 释放订单使用的优惠券
+		if err := releaseCoupon(ctx, tx, order, "订单退款"); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		// 商品购买：退回库存并扣减销量
+		if err := refundStock(ctx, tx, order); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		// 撤销数字商品交付
+		if err := s.digitalService.RevokeOrder(ctx, tx, order); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
 		// 余额充值：扣回钱包余额与会员积分
 		if order.OrderType == model.PayOrderTypeRecharge {
 			if err := s.revokeWalletAndPoints(ctx, tx, order, amount); err != nil {
@@ -903,9 +1147,16 @@ func (s *PayOrderServiceImpl) handleRefundNotify(ctx context.Context, params map
 
 		return tx.Commit()
 	}()
+	if errors.Is(err, errRefundSkipped) {
+		slog.Warn("退款通知对应的订单不是已支付状态，已忽略", "order_id", order.ID, "refund_no", refundNo)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
+	if refunded, err := s.db.PayOrder.Get(ctx, order.ID); err == nil {
+		event.Publish(ctx, event.PayOrderRefunded, toPayOrderEventData(refunded))
+	}
 
 	slog.Info("退款通知处理完成", "order_id", order.ID, "refund_no", refundNo, "amount", amount)
 	return nil
@@ -940,27 +1191,27 @@ func (s *PayOrderServiceImpl) refundToWallet(ctx context.Context, tx *ent.Tx, or
 	return nil
 }
 
-// getEpayTradeNo 获取易支付订单号，本地未落库时通过查询接口补齐。
-func (s *PayOrderServiceImpl) getEpayTradeNo(ctx context.Context, cfg epay.Config, order *ent.PayOrder) (string, error) {
-	if order.OrderID != nil && *order.OrderID != "" {
-		return *order.OrderID, nil
-	}
-	if order.OutTradeNo == nil || *order.OutTradeNo == "" {
-		return "", fmt.Errorf("订单缺少交易号")
+// toPayOrderEventData 订单支付与退款事件数据
+func toPayOrderEventData(o *ent.PayOrder) model.PayOrderEventData {
+	data := model.PayOrderEventData{
+		ID:           o.ID,
+		UserID:       o.UserID,
+		OrderType:    o.OrderType,
+		Subject:      o.Subject,
+		Price:        o.Price,
+		RefundAmount: o.RefundAmount,
+		RefundAt:     o.RefundAt,
 	}
-
-	pid, err := strconv.Atoi(cfg.MchID)
-	if err != nil {
-		return "", fmt.Errorf("商户ID无效: %w", err)
+	if o.ChannelType != nil {
+		data.ChannelType = *o.ChannelType
 	}
-	resp, err := epay.NewV1Client(cfg).QueryOrder(pid, cfg.Key, "", *order.OutTradeNo)
-	if err != nil {
-		return "", err
+	if o.OutTradeNo != nil {
+		data.OutTradeNo = *o.OutTradeNo
 	}
-	if resp.TradeNO == "" {
-		return "", fmt.Errorf("易支付未找到对应订单")
+	if o.RefundNo != nil {
+		data.RefundNo = *o.RefundNo
 	}
-	return resp.TradeNO, nil
+	return data
 }
 
 func toPayOrderRefundResp(o *ent.PayOrder) *model.PayOrderRefundResp {
@@ -0,0 +1,330 @@
+package payorder
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/enttest"
+	"github.com/shuTwT/hoshikuzu/internal/infra/pay"
+	digital_service "github.com/shuTwT/hoshikuzu/internal/services/mall/digital"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// openTestDB 打开临时的 SQLite 数据库，写事务立即加锁，并发写入时等待而不是报错
+func openTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?_fk=1&_busy_timeout=5000&_txlock=immediate", filepath.Join(t.TempDir(), "pay.db"))
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func newTestService(client *ent.Client) *PayOrderServiceImpl {
+	return &PayOrderServiceImpl{db: client, digitalService: digital_service.NewDigitalServiceImpl(client)}
+}
+
+// createProductOrder 创建待支付的商品订单，下单时占用的库存已从 stock 中扣除
+func createProductOrder(ctx context.Context, client *ent.Client, userID int, product *ent.Product, quantity int) *ent.PayOrder {
+	order := client.PayOrder.Create().
+		SetUserID(userID).
+		SetOrderType(model.PayOrderTypeProduct).
+		SetProductID(product.ID).
+		SetPrice(product.Price * quantity).
+		SaveX(ctx)
+	order = order.Update().SetOutTradeNo(fmt.Sprintf("T%d", order.ID)).SaveX(ctx)
+	client.PayOrderItem.Create().
+		SetOrderID(order.ID).
+		SetProductID(product.ID).
+		SetProductName(product.Name).
+		SetQuantity(quantity).
+		SetUnitPrice(product.Price).
+		SetTotalPrice(product.Price * quantity).
+		SaveX(ctx)
+	return order
+}
+
+// runConcurrently 并发执行 n 次 fn，返回成功的次数
+func runConcurrently(n int, fn func(i int) error) int {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if fn(i) == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return succeeded
+}
+
+// TestHandlePaySuccessConcurrent 模拟两个支付通知同时到达：只能有一方履约，
+// 充值只入账一次，商品只累加一次销量且不重复扣减库存。
+func TestHandlePaySuccessConcurrent(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	w := client.Wallet.Create().SetUserID(user.ID).SaveX(ctx)
+	product := client.Product.Create().SetName("商品").SetSku("SKU-1").SetPrice(100).SetStock(8).SaveX(ctx)
+
+	recharge := client.PayOrder.Create().
+		SetUserID(user.ID).
+		SetOrderType(model.PayOrderTypeRecharge).
+		SetPrice(1000).
+		SaveX(ctx)
+	productOrder := createProductOrder(ctx, client, user.ID, product, 2)
+
+	for _, order := range []*ent.PayOrder{recharge, productOrder} {
+		var mu sync.Mutex
+		var errs []error
+		runConcurrently(2, func(i int) error {
+			err := s.handlePaySuccess(ctx, order, fmt.Sprintf("TRADE%d-%d", order.ID, i))
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+			return err
+		})
+		for _, err := range errs {
+			if err != nil {
+				t.Fatalf("handlePaySuccess(%d) error = %v", order.ID, err)
+			}
+		}
+		if got := client.PayOrder.GetX(ctx, order.ID).State; got != "2" {
+			t.Errorf("order %d state = %q, want 2", order.ID, got)
+		}
+	}
+
+	if got := client.Wallet.GetX(ctx, w.ID).Balance; got != 1000 {
+		t.Errorf("wallet balance = %d, want 1000 credited once", got)
+	}
+	p := client.Product.GetX(ctx, product.ID)
+	if p.Stock != 8 || p.Sales != 2 {
+		t.Errorf("product stock = %d, sales = %d, want 8 and 2", p.Stock, p.Sales)
+	}
+}
+
+// TestHandlePaySuccessAfterClose 订单超时关闭已退回库存，之后仍支付成功时需重新扣减库存。
+func TestHandlePaySuccessAfterClose(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	product := client.Product.Create().SetName("商品").SetSku("SKU-1").SetPrice(100).SetStock(8).SaveX(ctx)
+	order := createProductOrder(ctx, client, user.ID, product, 2)
+
+	// 调用方持有的是关闭前读取的订单
+	if err := s.closeOrder(ctx, order, "3", "超时关闭"); err != nil {
+		t.Fatalf("closeOrder() error = %v", err)
+	}
+	if got := client.Product.GetX(ctx, product.ID).Stock; got != 10 {
+		t.Fatalf("stock after close = %d, want 10", got)
+	}
+	if err := s.handlePaySuccess(ctx, order, "TRADE"); err != nil {
+		t.Fatalf("handlePaySuccess() error = %v", err)
+	}
+	p := client.Product.GetX(ctx, product.ID)
+	if p.Stock != 8 || p.Sales != 2 {
+		t.Errorf("product stock = %d, sales = %d, want 8 and 2", p.Stock, p.Sales)
+	}
+}
+
+// TestHandlePaySuccessAfterCloseOversold 关闭后库存已被他人买走时支付成功不会把库存扣成负数，并发送超卖通知
+func TestHandlePaySuccessAfterCloseOversold(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	product := client.Product.Create().SetName("商品").SetSku("SKU-1").SetPrice(100).SetStock(0).SaveX(ctx)
+	order := createProductOrder(ctx, client, user.ID, product, 2)
+
+	if err := s.closeOrder(ctx, order, "3", "超时关闭"); err != nil {
+		t.Fatalf("closeOrder() error = %v", err)
+	}
+	// 退回的库存被其他订单买走了一件
+	client.Product.UpdateOneID(product.ID).AddStock(-1).ExecX(ctx)
+
+	if err := s.handlePaySuccess(ctx, order, "TRADE"); err != nil {
+		t.Fatalf("handlePaySuccess() error = %v", err)
+	}
+	p := client.Product.GetX(ctx, product.ID)
+	if p.Stock != 0 || p.Sales != 2 {
+		t.Errorf("product stock = %d, sales = %d, want 0 and 2", p.Stock, p.Sales)
+	}
+	if n := client.Notification.Query().CountX(ctx); n != 1 {
+		t.Errorf("notifications = %d, want 1 oversell notice", n)
+	}
+}
+
+// TestHandleRefundNotifyRestoresStock 商品订单退款后退回库存并扣减销量
+func TestHandleRefundNotifyRestoresStock(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	product := client.Product.Create().SetName("商品").SetSku("SKU-1").SetPrice(100).SetStock(8).SaveX(ctx)
+	order := createProductOrder(ctx, client, user.ID, product, 2)
+	if err := s.handlePaySuccess(ctx, order, "TRADE"); err != nil {
+		t.Fatalf("handlePaySuccess() error = %v", err)
+	}
+
+	if err := s.handleRefundNotify(ctx, &pay.Notify{OutTradeNo: *order.OutTradeNo, RefundNo: "RF", Amount: 200}); err != nil {
+		t.Fatalf("handleRefundNotify() error = %v", err)
+	}
+	p := client.Product.GetX(ctx, product.ID)
+	if p.Stock != 10 || p.Sales != 0 {
+		t.Errorf("product stock = %d, sales = %d, want 10 and 0", p.Stock, p.Sales)
+	}
+}
+
+// TestPayWithBalanceConcurrent 余额只够支付一笔时，并发的两笔余额支付只能成功一笔
+func TestPayWithBalanceConcurrent(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	w := client.Wallet.Create().SetUserID(user.ID).SetBalance(1000).SaveX(ctx)
+	product := client.Product.Create().SetName("商品").SetSku("SKU-1").SetPrice(800).SetStock(8).SaveX(ctx)
+	orders := []*ent.PayOrder{
+		createProductOrder(ctx, client, user.ID, product, 1),
+		createProductOrder(ctx, client, user.ID, product, 1),
+	}
+
+	paid := runConcurrently(len(orders), func(i int) error {
+		_, err := s.payWithBalance(ctx, user.ID, orders[i], 800)
+		return err
+	})
+	if paid != 1 {
+		t.Fatalf("paid %d orders, want 1", paid)
+	}
+	got := client.Wallet.GetX(ctx, w.ID)
+	if got.Balance != 200 || got.TotalExpense != 800 {
+		t.Errorf("wallet balance = %d, total expense = %d, want 200 and 800", got.Balance, got.TotalExpense)
+	}
+}
+
+// TestPayWithBalanceAfterClose 订单已被超时关闭时余额支付失败，不扣款也不重复结算库存
+func TestPayWithBalanceAfterClose(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	w := client.Wallet.Create().SetUserID(user.ID).SetBalance(1000).SaveX(ctx)
+	product := client.Product.Create().SetName("商品").SetSku("SKU-1").SetPrice(100).SetStock(8).SaveX(ctx)
+	order := createProductOrder(ctx, client, user.ID, product, 2)
+
+	if err := s.closeOrder(ctx, order, "3", "超时关闭"); err != nil {
+		t.Fatalf("closeOrder() error = %v", err)
+	}
+	if _, err := s.payWithBalance(ctx, user.ID, order, 200); err == nil {
+		t.Fatal("payWithBalance() on a closed order error = nil")
+	}
+	if got := client.Wallet.GetX(ctx, w.ID).Balance; got != 1000 {
+		t.Errorf("wallet balance = %d, want 1000", got)
+	}
+	p := client.Product.GetX(ctx, product.ID)
+	if p.Stock != 10 || p.Sales != 0 {
+		t.Errorf("product stock = %d, sales = %d, want 10 and 0", p.Stock, p.Sales)
+	}
+}
+
+// TestHandleRefundNotifyConcurrent 两个退款通知同时到达：充值只扣回一次
+func TestHandleRefundNotifyConcurrent(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	w := client.Wallet.Create().SetUserID(user.ID).SaveX(ctx)
+	recharge := client.PayOrder.Create().
+		SetUserID(user.ID).
+		SetOrderType(model.PayOrderTypeRecharge).
+		SetPrice(1000).
+		SetOutTradeNo("R1").
+		SaveX(ctx)
+	if err := s.handlePaySuccess(ctx, recharge, "TRADE"); err != nil {
+		t.Fatalf("handlePaySuccess() error = %v", err)
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	runConcurrently(2, func(i int) error {
+		err := s.handleRefundNotify(ctx, &pay.Notify{OutTradeNo: "R1", RefundNo: fmt.Sprintf("RF%d", i), Amount: 1000})
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+		return err
+	})
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("handleRefundNotify() error = %v", err)
+		}
+	}
+	if got := client.PayOrder.GetX(ctx, recharge.ID).State; got != "4" {
+		t.Errorf("order state = %q, want 4", got)
+	}
+	if got := client.Wallet.GetX(ctx, w.ID).Balance; got != 0 {
+		t.Errorf("wallet balance = %d, want 0 revoked once", got)
+	}
+}
+
+// TestHandleRefundNotifyNotPaid 待支付、已关闭的订单收到退款通知时保持原状态
+func TestHandleRefundNotifyNotPaid(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+	s := newTestService(client)
+
+	user := client.User.Create().SetEmail("buyer@example.com").SetPassword("x").SaveX(ctx)
+	product := client.Product.Create().SetName("商品").SetSku("SKU-1").SetPrice(100).SetStock(8).SaveX(ctx)
+	pending := createProductOrder(ctx, client, user.ID, product, 1)
+	closed := createProductOrder(ctx, client, user.ID, product, 1)
+	if err := s.closeOrder(ctx, closed, "3", "超时关闭"); err != nil {
+		t.Fatalf("closeOrder() error = %v", err)
+	}
+
+	for _, order := range []*ent.PayOrder{pending, closed} {
+		want := client.PayOrder.GetX(ctx, order.ID).State
+		if err := s.handleRefundNotify(ctx, &pay.Notify{OutTradeNo: *order.OutTradeNo, RefundNo: "RF", Amount: 100}); err != nil {
+			t.Fatalf("handleRefundNotify(%d) error = %v", order.ID, err)
+		}
+		if got := client.PayOrder.GetX(ctx, order.ID).State; got != want {
+			t.Errorf("order %d state = %q, want %q", order.ID, got, want)
+		}
+	}
+}
+
+func TestCheckPaidAmount(t *testing.T) {
+	order := &ent.PayOrder{ID: 1, Price: 1000}
+	tests := []struct {
+		name   string
+		amount int
+		ok     bool
+	}{
+		{"金额一致", 1000, true},
+		{"网关未返回金额", 0, true},
+		{"少付", 1, false},
+		{"多付", 2000, false},
+	}
+	for _, tt := range tests {
+		if err := checkPaidAmount(order, "epay", tt.amount); (err == nil) != tt.ok {
+			t.Errorf("%s: checkPaidAmount() error = %v, want ok %v", tt.name, err, tt.ok)
+		}
+	}
+}
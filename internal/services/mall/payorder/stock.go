@@ -0,0 +1,163 @@
+package payorder
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/payorderitem"
+	"github.com/shuTwT/hoshikuzu/ent/product"
+)
+
+// 商品库存在下单时扣减（占用），订单关闭或失败时退回，支付成功后累加销量。
+
+// reserveStock 写入订单明细并扣减库存，返回扣减后跌破预警值的商品，在创建订单的事务内调用
+func reserveStock(ctx context.Context, tx *ent.Tx, order *ent.PayOrder, lines []orderLine) ([]*ent.Product, error) {
+	var lowStock []*ent.Product
+	for _, l := range lines {
+		// 条件更新保证并发下单时不超卖
+		n, err := tx.Product.Update().
+			Where(product.IDEQ(l.productID), product.StockGTE(l.quantity)).
+			AddStock(-l.quantity).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, fmt.Errorf("商品「%s」库存不足", l.name)
+		}
+
+		if err := tx.PayOrderItem.Create().
+			SetOrderID(order.ID).
+			SetProductID(l.productID).
+			SetProductName(l.name).
+			SetSku(l.sku).
+			SetQuantity(l.quantity).
+			SetUnitPrice(l.unitPrice).
+			SetTotalPrice(l.amount()).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		p, err := tx.Product.Get(ctx, l.productID)
+		if err != nil {
+			return nil, err
+		}
+		// 只在本次扣减跌破预警值时提醒，避免每笔订单重复提醒
+		if crossedMinStock(p.Stock+l.quantity, p.Stock, p.MinStock) {
+			lowStock = append(lowStock, p)
+		}
+	}
+	return lowStock, nil
+}
+
+// releaseStock 订单关闭或失败时退回占用的库存，在事务内调用
+func releaseStock(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error {
+	items, err := tx.PayOrderItem.Query().
+		Where(payorderitem.OrderIDEQ(order.ID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := tx.Product.UpdateOneID(item.ProductID).
+			AddStock(item.Quantity).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settleStock 支付成功后累加销量。订单已超时关闭退回了库存但随后仍支付成功时重新扣减库存，在支付成功事务内调用
+func settleStock(ctx context.Context, tx *ent.Tx, order *ent.PayOrder, reserved bool) error {
+	items, err := tx.PayOrderItem.Query().
+		Where(payorderitem.OrderIDEQ(order.ID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := tx.Product.UpdateOneID(item.ProductID).
+			AddSales(item.Quantity).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if reserved {
+			continue
+		}
+		n, err := tx.Product.Update().
+			Where(product.IDEQ(item.ProductID), product.StockGTE(item.Quantity)).
+			AddStock(-item.Quantity).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			// 款项已收，不能回滚支付：库存清零并通知人工补货或退款
+			if err := markOversold(ctx, tx, order, item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// markOversold 关闭后支付的订单库存已被他人买走时清零库存并发送超卖通知，在支付成功事务内调用
+func markOversold(ctx context.Context, tx *ent.Tx, order *ent.PayOrder, item *ent.PayOrderItem) error {
+	if err := tx.Product.UpdateOneID(item.ProductID).SetStock(0).Exec(ctx); err != nil {
+		return err
+	}
+	slog.Error("订单支付时库存不足，已超卖", "order_id", order.ID, "product_id", item.ProductID, "quantity", item.Quantity)
+	return tx.Notification.Create().
+		SetTitle("超卖预警").
+		SetContent(fmt.Sprintf("订单 #%d 在关闭后支付成功，商品「%s」(SKU: %s) 库存不足 %d 件，请及时补货或为该订单退款。", order.ID, item.ProductName, item.Sku, item.Quantity)).
+		SetPublishTime(time.Now()).
+		Exec(ctx)
+}
+
+// refundStock 订单退款时退回库存并扣减销量，在退款事务内调用
+func refundStock(ctx context.Context, tx *ent.Tx, order *ent.PayOrder) error {
+	items, err := tx.PayOrderItem.Query().
+		Where(payorderitem.OrderIDEQ(order.ID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := tx.Product.UpdateOneID(item.ProductID).
+			AddStock(item.Quantity).
+			Exec(ctx); err != nil {
+			return err
+		}
+		// 销量可能已被后台手动调整，不扣成负数
+		if _, err := tx.Product.Update().
+			Where(product.IDEQ(item.ProductID), product.SalesGTE(item.Quantity)).
+			AddSales(-item.Quantity).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// crossedMinStock 库存是否从预警值及以上跌到预警值以下
+func crossedMinStock(before, after, minStock int) bool {
+	return minStock > 0 && before >= minStock && after < minStock
+}
+
+// notifyLowStock 发送库存预警通知，失败只记录日志不影响下单
+func (s *PayOrderServiceImpl) notifyLowStock(ctx context.Context, products []*ent.Product) {
+	for _, p := range products {
+		err := s.db.Notification.Create().
+			SetTitle("库存预警").
+			SetContent(fmt.Sprintf("商品「%s」(SKU: %s) 当前库存 %d，已低于预警值 %d，请及时补货。", p.Name, p.Sku, p.Stock, p.MinStock)).
+			SetPublishTime(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			slog.Error("库存预警通知发送失败", "product_id", p.ID, "error", err)
+		}
+	}
+}
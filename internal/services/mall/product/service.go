@@ -9,6 +9,7 @@ import (
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/ent/product"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
 	"github.com/shuTwT/hoshikuzu/pkg/cache"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 )
@@ -101,7 +102,12 @@ func (s *ProductServiceImpl) CreateProduct(ctx context.Context, req *model.Produ
 		createBuilder.SetSortOrder(*req.SortOrder)
 	}
 
-	return createBuilder.Save(ctx)
+	entity, err := createBuilder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	event.Publish(ctx, event.ProductCreated, productEventData(entity))
+	return entity, nil
 }
 
 func (s *ProductServiceImpl) UpdateProduct(ctx context.Context, id int, req *model.ProductUpdateReq) (*ent.Product, error) {
@@ -183,11 +189,20 @@ func (s *ProductServiceImpl) UpdateProduct(ctx context.Context, id int, req *mod
 		updateBuilder.SetSortOrder(*req.SortOrder)
 	}
 
-	return updateBuilder.Save(ctx)
+	entity, err := updateBuilder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	event.Publish(ctx, event.ProductUpdated, productEventData(entity))
+	return entity, nil
 }
 
 func (s *ProductServiceImpl) DeleteProduct(ctx context.Context, id int) error {
-	return s.client.Product.DeleteOneID(id).Exec(ctx)
+	if err := s.client.Product.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	event.Publish(ctx, event.ProductDeleted, model.ProductEventData{ID: id})
+	return nil
 }
 
 func (s *ProductServiceImpl) GetProduct(ctx context.Context, id int) (*ent.Product, error) {
@@ -236,8 +251,17 @@ func (s *ProductServiceImpl) BatchUpdateProducts(ctx context.Context, ids []int,
 		updateBuilder.SetActive(*req.Active)
 	}
 
-	_, err := updateBuilder.Save(ctx)
-	return err
+	if _, err := updateBuilder.Save(ctx); err != nil {
+		return err
+	}
+	products, err := s.client.Product.Query().Where(product.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entity := range products {
+		event.Publish(ctx, event.ProductUpdated, productEventData(entity))
+	}
+	return nil
 }
 
 func (s *ProductServiceImpl) BatchDeleteProducts(ctx context.Context, ids []int) error {
@@ -245,10 +269,19 @@ func (s *ProductServiceImpl) BatchDeleteProducts(ctx context.Context, ids []int)
 		return nil
 	}
 
-	_, err := s.client.Product.Delete().
-		Where(product.IDIn(ids...)).
-		Exec(ctx)
-	return err
+	products, err := s.client.Product.Query().Where(product.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.client.Product.Delete().
+		Where(product.IDIn(products...)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	for _, id := range products {
+		event.Publish(ctx, event.ProductDeleted, model.ProductEventData{ID: id})
+	}
+	return nil
 }
 
 func (s *ProductServiceImpl) SearchProducts(ctx context.Context, req model.ProductSearchReq) ([]*model.ProductSearchResp, int, error) {
@@ -368,3 +401,13 @@ func (s *ProductServiceImpl) calculateProductRelevance(p *ent.Product, keyword s
 
 	return relevance
 }
+
+func productEventData(entity *ent.Product) model.ProductEventData {
+	return model.ProductEventData{
+		ID:     entity.ID,
+		Name:   entity.Name,
+		SKU:    entity.Sku,
+		Price:  entity.Price,
+		Active: entity.Active,
+	}
+}
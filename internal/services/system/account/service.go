@@ -0,0 +1,487 @@
+package account
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/refreshtoken"
+	"github.com/shuTwT/hoshikuzu/ent/role"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	mail_infra "github.com/shuTwT/hoshikuzu/internal/infra/mail"
+	mail_service "github.com/shuTwT/hoshikuzu/internal/services/infra/mail"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	user_service "github.com/shuTwT/hoshikuzu/internal/services/system/user"
+	"github.com/shuTwT/hoshikuzu/pkg/config"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 令牌用途，不同用途使用不同的签名密钥，避免互相冒用
+const (
+	purposeVerifyEmail   = "verify-email"
+	purposeResetPassword = "reset-password"
+)
+
+const (
+	verifyTokenTTL = 24 * time.Hour
+	resetTokenTTL  = 30 * time.Minute
+	// 同一邮箱两次发信的最小间隔
+	sendCooldown      = time.Minute
+	minPasswordLength = 8
+	sendTimeout       = time.Minute
+)
+
+var (
+	ErrRegistrationClosed = errors.New("本站暂未开放注册")
+	// ErrSiteURLNotConfigured 邮件链接只使用站点设置中的地址，不信任请求的 Host 头
+	ErrSiteURLNotConfigured = errors.New("站点地址未配置，暂时无法发送账号邮件，请联系管理员")
+)
+
+type AccountService interface {
+	Register(ctx context.Context, req model.RegisterReq) (*model.RegisterResp, error)
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, email string) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, req model.ResetPasswordReq) error
+}
+
+type AccountServiceImpl struct {
+	client         *ent.Client
+	settingService setting_service.SettingService
+	userService    user_service.UserService
+	mailService    mail_service.MailService
+
+	mu sync.Mutex
+	// lastSent 按用途与邮箱记录最近一次发信时间
+	lastSent map[string]time.Time
+}
+
+func NewAccountServiceImpl(client *ent.Client, settingService setting_service.SettingService, userService user_service.UserService, mailService mail_service.MailService) *AccountServiceImpl {
+	return &AccountServiceImpl{
+		client:         client,
+		settingService: settingService,
+		userService:    userService,
+		mailService:    mailService,
+		lastSent:       map[string]time.Time{},
+	}
+}
+
+// siteSettings 站点设置中与注册相关的部分，未保存过设置时不允许注册、注册需要验证邮箱
+type siteSettings struct {
+	AllowRegistration *bool `json:"allowRegistration"`
+	EmailVerification *bool `json:"emailVerification"`
+}
+
+// tokenClaims 邮件链接中的令牌
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	UserID int    `json:"uid"`
+	Email  string `json:"email"`
+	// 签发时密码哈希的指纹，密码修改后重置令牌即失效，保证只能使用一次
+	Fingerprint string `json:"fp,omitempty"`
+}
+
+// Register 自助注册，需要在站点设置中开启，开启邮箱验证时验证后才能登录
+func (s *AccountServiceImpl) Register(ctx context.Context, req model.RegisterReq) (*model.RegisterResp, error) {
+	allow, verify, err := s.registrationSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !allow {
+		return nil, ErrRegistrationClosed
+	}
+	// 无法发送验证邮件时不创建账号，否则注册的账号无法登录
+	var site *mailSite
+	if verify {
+		if site, err = s.loadMailSite(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	email, err := normalizeEmail(req.Email)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > 255 {
+		return nil, errors.New("请填写 255 个字符以内的用户名")
+	}
+	if len(req.Password) < minPasswordLength {
+		return nil, fmt.Errorf("密码长度不能少于 %d 位", minPasswordLength)
+	}
+	existing, err := s.client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil {
+		// 需要邮箱验证时与 ResendVerification 一致，不暴露邮箱是否已注册；
+		// 无需验证时注册后即可登录，无法隐藏，由路由按 IP 限制频率
+		if !verify {
+			return nil, errors.New("该邮箱已注册")
+		}
+		if !existing.EmailVerified && s.checkCooldown(purposeVerifyEmail, email) == nil {
+			s.sendAsync(ctx, func(ctx context.Context) error {
+				return s.sendVerification(ctx, existing, site)
+			})
+		}
+		return &model.RegisterResp{EmailVerificationRequired: true}, nil
+	}
+	r, err := s.client.Role.Query().Where(role.CodeEQ(model.RoleCodeCommon)).Only(ctx)
+	if err != nil {
+		return nil, errors.New("默认角色不存在")
+	}
+
+	// 用户与待验证标记一起提交，避免留下未标记待验证、可直接登录的账号
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.userService.CreateUserTx(ctx, tx, model.UserCreateReq{
+		Email:    email,
+		Name:     name,
+		Password: req.Password,
+		RoleID:   r.ID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if verify {
+		if u, err = tx.User.UpdateOne(u).SetEmailVerifyRequired(true).Save(ctx); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	event.Publish(ctx, event.UserRegistered, model.UserEventData{
+		ID:       u.ID,
+		Email:    u.Email,
+		Name:     u.Name,
+		Nickname: u.Nickname,
+		Source:   "email",
+	})
+	if !verify {
+		return &model.RegisterResp{}, nil
+	}
+	s.markSent(purposeVerifyEmail, email)
+	s.sendAsync(ctx, func(ctx context.Context) error {
+		return s.sendVerification(ctx, u, site)
+	})
+	return &model.RegisterResp{EmailVerificationRequired: true}, nil
+}
+
+// VerifyEmail 使用邮件中的令牌完成邮箱验证，重复验证视为成功
+func (s *AccountServiceImpl) VerifyEmail(ctx context.Context, token string) error {
+	claims, err := parseToken(purposeVerifyEmail, token)
+	if err != nil {
+		return errors.New("验证链接无效或已过期，请重新发送验证邮件")
+	}
+	u, err := s.client.User.Get(ctx, claims.UserID)
+	if err != nil || u.Email != claims.Email {
+		return errors.New("验证链接无效或已过期，请重新发送验证邮件")
+	}
+	if u.EmailVerified {
+		return nil
+	}
+	return s.client.User.UpdateOne(u).SetEmailVerified(true).Exec(ctx)
+}
+
+// ResendVerification 重新发送验证邮件，邮箱未注册或已验证时同样返回成功，避免暴露注册情况
+func (s *AccountServiceImpl) ResendVerification(ctx context.Context, email string) error {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	site, err := s.loadMailSite(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.checkCooldown(purposeVerifyEmail, email); err != nil {
+		return err
+	}
+	u, err := s.client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if u.EmailVerified {
+		return nil
+	}
+	s.sendAsync(ctx, func(ctx context.Context) error {
+		return s.sendVerification(ctx, u, site)
+	})
+	return nil
+}
+
+// ForgotPassword 发送重置密码邮件，邮箱未注册时同样返回成功，避免暴露注册情况
+func (s *AccountServiceImpl) ForgotPassword(ctx context.Context, email string) error {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	site, err := s.loadMailSite(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.checkCooldown(purposeResetPassword, email); err != nil {
+		return err
+	}
+	u, err := s.client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	s.sendAsync(ctx, func(ctx context.Context) error {
+		return s.sendPasswordReset(ctx, u, site)
+	})
+	return nil
+}
+
+// ResetPassword 使用邮件中的令牌重置密码，成功后令牌失效并吊销该用户的全部登录会话
+func (s *AccountServiceImpl) ResetPassword(ctx context.Context, req model.ResetPasswordReq) error {
+	if len(req.Password) < minPasswordLength {
+		return fmt.Errorf("密码长度不能少于 %d 位", minPasswordLength)
+	}
+	claims, err := parseToken(purposeResetPassword, req.Token)
+	if err != nil {
+		return errors.New("重置链接无效或已过期，请重新申请")
+	}
+	u, err := s.client.User.Get(ctx, claims.UserID)
+	if err != nil || u.Email != claims.Email || claims.Fingerprint != passwordFingerprint(u.Password) {
+		return errors.New("重置链接无效或已过期，请重新申请")
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	// 以旧密码哈希为条件更新，并发使用同一令牌时只有一次能成功。
+	// 能收到重置邮件即证明拥有该邮箱
+	n, err := tx.User.Update().
+		Where(user.IDEQ(u.ID), user.PasswordEQ(u.Password)).
+		SetPassword(string(hashed)).
+		SetEmailVerified(true).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return errors.New("重置链接无效或已过期，请重新申请")
+	}
+	if _, err := tx.RefreshToken.Update().
+		Where(refreshtoken.UserID(u.ID), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// registrationSettings 读取是否允许注册与注册是否需要验证邮箱
+func (s *AccountServiceImpl) registrationSettings(ctx context.Context) (bool, bool, error) {
+	var ss siteSettings
+	if err := s.loadJSONSetting(ctx, model.SettingKeySite, &ss); err != nil {
+		return false, false, err
+	}
+	allow := ss.AllowRegistration != nil && *ss.AllowRegistration
+	verify := ss.EmailVerification == nil || *ss.EmailVerification
+	return allow, verify, nil
+}
+
+// loadJSONSetting 读取 JSON 设置，未保存过时保持零值
+func (s *AccountServiceImpl) loadJSONSetting(ctx context.Context, key string, v any) error {
+	setting, err := s.settingService.GetSettingByKey(ctx, key)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err := json.Unmarshal([]byte(setting.Value), v); err != nil {
+		return fmt.Errorf("解析设置 %s 失败: %w", key, err)
+	}
+	return nil
+}
+
+// mailSite 邮件中使用的站点名称与地址
+type mailSite struct {
+	URL  string
+	Name string
+}
+
+// loadMailSite 读取站点设置中的站点地址，未配置或不是 http(s) 地址时返回 ErrSiteURLNotConfigured。
+// 不回退到请求地址，否则伪造 Host 头即可让重置链接指向攻击者的站点
+func (s *AccountServiceImpl) loadMailSite(ctx context.Context) (*mailSite, error) {
+	var basic struct {
+		SiteName string `json:"siteName"`
+		SiteURL  string `json:"siteUrl"`
+	}
+	if err := s.loadJSONSetting(ctx, model.SettingKeyBasic, &basic); err != nil {
+		return nil, err
+	}
+	siteURL, ok := normalizeSiteURL(basic.SiteURL)
+	if !ok {
+		return nil, ErrSiteURLNotConfigured
+	}
+	siteName := basic.SiteName
+	if siteName == "" {
+		siteName = "Hoshikuzu"
+	}
+	return &mailSite{URL: siteURL, Name: siteName}, nil
+}
+
+// normalizeSiteURL 校验站点地址为带主机名的 http(s) 地址并去掉末尾的斜杠
+func normalizeSiteURL(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+	return strings.TrimRight(raw, "/"), true
+}
+
+func (s *AccountServiceImpl) sendVerification(ctx context.Context, u *ent.User, site *mailSite) error {
+	token, err := signToken(purposeVerifyEmail, &tokenClaims{UserID: u.ID, Email: u.Email}, verifyTokenTTL)
+	if err != nil {
+		return err
+	}
+	link := site.URL + "/console/verify-email?token=" + url.QueryEscape(token)
+	return s.mailService.Send(ctx, buildMessage(u.Email, fmt.Sprintf("[%s] 验证您的邮箱", site.Name),
+		fmt.Sprintf("%s，您好：", u.Name),
+		fmt.Sprintf("感谢注册 %s，请在 24 小时内打开以下链接完成邮箱验证：", site.Name),
+		link,
+		"如果这不是您本人的操作，请忽略本邮件。",
+	))
+}
+
+func (s *AccountServiceImpl) sendPasswordReset(ctx context.Context, u *ent.User, site *mailSite) error {
+	token, err := signToken(purposeResetPassword, &tokenClaims{
+		UserID:      u.ID,
+		Email:       u.Email,
+		Fingerprint: passwordFingerprint(u.Password),
+	}, resetTokenTTL)
+	if err != nil {
+		return err
+	}
+	link := site.URL + "/console/reset-password?token=" + url.QueryEscape(token)
+	return s.mailService.Send(ctx, buildMessage(u.Email, fmt.Sprintf("[%s] 重置密码", site.Name),
+		fmt.Sprintf("%s，您好：", u.Name),
+		"我们收到了重置您账号密码的申请，请在 30 分钟内打开以下链接设置新密码，链接只能使用一次：",
+		link,
+		"如果这不是您本人的操作，请忽略本邮件，您的密码不会被修改。",
+	))
+}
+
+// sendAsync 在后台发信，请求的响应时间不因用户是否存在而不同
+func (s *AccountServiceImpl) sendAsync(ctx context.Context, send func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sendTimeout)
+	go func() {
+		defer cancel()
+		if err := send(ctx); err != nil {
+			slog.Error("发送账号邮件失败", "error", err)
+		}
+	}()
+}
+
+// checkCooldown 限制同一邮箱的发信频率，通过后记录本次发信
+func (s *AccountServiceImpl) checkCooldown(purpose, email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for key, t := range s.lastSent {
+		if now.Sub(t) >= sendCooldown {
+			delete(s.lastSent, key)
+		}
+	}
+	key := purpose + ":" + email
+	if t, ok := s.lastSent[key]; ok {
+		return fmt.Errorf("发送过于频繁，请 %d 秒后再试", int((sendCooldown-now.Sub(t)).Seconds())+1)
+	}
+	s.lastSent[key] = now
+	return nil
+}
+
+func (s *AccountServiceImpl) markSent(purpose, email string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastSent[purpose+":"+email] = time.Now()
+}
+
+// buildMessage 生成纯文本与 HTML 正文相同的通知邮件，第三段为链接
+func buildMessage(to, subject, greeting, intro, link, footer string) *mail_infra.Message {
+	text := strings.Join([]string{greeting, "", intro, link, "", footer}, "\n")
+	body := fmt.Sprintf(`<p>%s</p><p>%s</p><p><a href="%s">%s</a></p><p style="color:#888">%s</p>`,
+		html.EscapeString(greeting), html.EscapeString(intro),
+		html.EscapeString(link), html.EscapeString(link), html.EscapeString(footer))
+	return &mail_infra.Message{To: []string{to}, Subject: subject, Text: text, HTML: body}
+}
+
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || len(email) > 255 {
+		return "", errors.New("邮箱格式不正确")
+	}
+	return email, nil
+}
+
+// tokenKey 令牌签名密钥，由登录令牌密钥按用途派生
+func tokenKey(purpose string) []byte {
+	sum := sha256.Sum256([]byte("account:" + purpose + ":" + config.GetString(config.AUTH_TOKEN_SECRET)))
+	return sum[:]
+}
+
+func signToken(purpose string, claims *tokenClaims, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Audience:  jwt.ClaimStrings{"hoshikuzu:" + purpose},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(tokenKey(purpose))
+}
+
+func parseToken(purpose, token string) (*tokenClaims, error) {
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		return tokenKey(purpose), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithAudience("hoshikuzu:"+purpose), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// passwordFingerprint 密码哈希的指纹，不在令牌中暴露完整哈希
+func passwordFingerprint(hash string) string {
+	sum := sha256.Sum256([]byte(hash))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,77 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/pkg/config"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseToken(t *testing.T) {
+	viper.Set(config.AUTH_TOKEN_SECRET, "secret")
+	valid, _ := signToken(purposeVerifyEmail, &tokenClaims{UserID: 1, Email: "a@example.com"}, time.Hour)
+	expired, _ := signToken(purposeVerifyEmail, &tokenClaims{UserID: 1, Email: "a@example.com"}, -time.Minute)
+
+	tests := []struct {
+		name    string
+		purpose string
+		token   string
+		ok      bool
+	}{
+		{"valid", purposeVerifyEmail, valid, true},
+		{"other purpose", purposeResetPassword, valid, false},
+		{"expired", purposeVerifyEmail, expired, false},
+		{"tampered", purposeVerifyEmail, valid + "x", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := parseToken(tt.purpose, tt.token)
+			if (err == nil) != tt.ok {
+				t.Fatalf("parseToken() error = %v, want ok %v", err, tt.ok)
+			}
+			if tt.ok && (claims.UserID != 1 || claims.Email != "a@example.com") {
+				t.Fatalf("unexpected claims: %+v", claims)
+			}
+		})
+	}
+}
+
+func TestCheckCooldown(t *testing.T) {
+	s := NewAccountServiceImpl(nil, nil, nil, nil)
+	if err := s.checkCooldown(purposeResetPassword, "a@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.checkCooldown(purposeResetPassword, "a@example.com"); err == nil {
+		t.Fatal("expected cooldown error")
+	}
+	if err := s.checkCooldown(purposeVerifyEmail, "a@example.com"); err != nil {
+		t.Fatalf("cooldown should be per purpose: %v", err)
+	}
+	s.lastSent[purposeResetPassword+":a@example.com"] = time.Now().Add(-sendCooldown)
+	if err := s.checkCooldown(purposeResetPassword, "a@example.com"); err != nil {
+		t.Fatalf("cooldown should expire: %v", err)
+	}
+}
+
+func TestNormalizeSiteURL(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+		ok   bool
+	}{
+		{"https://example.com/", "https://example.com", true},
+		{" http://example.com/blog ", "http://example.com/blog", true},
+		{"", "", false},
+		{"example.com", "", false},
+		{"//example.com", "", false},
+		{"javascript:alert(1)", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := normalizeSiteURL(tt.raw)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("normalizeSiteURL(%q) = %q, %v, want %q, %v", tt.raw, got, ok, tt.want, tt.ok)
+		}
+	}
+}
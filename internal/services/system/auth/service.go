@@ -6,11 +6,17 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"log/slog"
 	"time"
 
 	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/loginlog"
 	"github.com/shuTwT/hoshikuzu/ent/refreshtoken"
 	"github.com/shuTwT/hoshikuzu/ent/user"
+	loginguard_service "github.com/shuTwT/hoshikuzu/internal/services/system/loginguard"
+	loginlog_service "github.com/shuTwT/hoshikuzu/internal/services/system/loginlog"
+	session_service "github.com/shuTwT/hoshikuzu/internal/services/system/session"
+	twofactor_service "github.com/shuTwT/hoshikuzu/internal/services/system/twofactor"
 	"github.com/shuTwT/hoshikuzu/pkg/config"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 
@@ -22,51 +28,223 @@ const (
 	accessTokenTTL   = 24 * time.Hour
 	refreshTokenTTL  = 7 * 24 * time.Hour
 	refreshTokenSize = 32 // 字节数，生成 64 位十六进制字符串
+	challengeTTL     = 5 * time.Minute
+	challengeAud     = "hoshikuzu:2fa-challenge"
 )
 
+// ErrEmailNotVerified 自助注册的用户尚未验证邮箱
+var ErrEmailNotVerified = errors.New("邮箱尚未验证，请先打开验证邮件中的链接完成验证")
+
+// ErrInvalidCredentials 账号不存在与密码错误返回相同的错误，避免探测已注册的邮箱
+var ErrInvalidCredentials = errors.New("邮箱或密码错误")
+
 type AuthService interface {
 	Login(ctx context.Context, req *model.LoginRequest, userAgent, ip string) (*model.LoginResp, error)
+	LoginUser(ctx context.Context, u *ent.User, userAgent, ip string) (*model.LoginResp, error)
 	RefreshToken(ctx context.Context, req *model.RefreshTokenRequest, userAgent, ip string) (*model.RefreshTokenResp, error)
 	RevokeRefreshToken(ctx context.Context, rawToken string) error
+	TwoFactorSetup(ctx context.Context, challengeToken string) (*model.TwoFactorSetupResp, error)
+	LoginTwoFactor(ctx context.Context, req *model.TwoFactorLoginReq, userAgent, ip string) (*model.LoginResp, error)
 }
 
 type AuthServiceImpl struct {
-	client *ent.Client
+	client            *ent.Client
+	twoFactorService  twofactor_service.TwoFactorService
+	loginGuardService loginguard_service.LoginGuardService
+	loginLogService   loginlog_service.LoginLogService
+}
+
+func NewAuthServiceImpl(client *ent.Client, twoFactorService twofactor_service.TwoFactorService, loginGuardService loginguard_service.LoginGuardService, loginLogService loginlog_service.LoginLogService) *AuthServiceImpl {
+	return &AuthServiceImpl{
+		client:            client,
+		twoFactorService:  twoFactorService,
+		loginGuardService: loginGuardService,
+		loginLogService:   loginLogService,
+	}
 }
 
-func NewAuthServiceImpl(client *ent.Client) *AuthServiceImpl {
-	return &AuthServiceImpl{client: client}
+// challengeClaims 登录第二步的挑战令牌
+type challengeClaims struct {
+	jwt.RegisteredClaims
+	UserID int `json:"uid"`
+	// 签发时密码哈希的指纹，期间修改密码后挑战失效
+	Fingerprint string `json:"fp"`
+	// 是否需要先绑定验证器
+	Setup bool `json:"setup,omitempty"`
 }
 
+// Login 密码登录，账号或 IP 连续失败过多时返回 *loginguard.LockedError
 func (s *AuthServiceImpl) Login(ctx context.Context, req *model.LoginRequest, userAgent, ip string) (*model.LoginResp, error) {
+	attempt := loginlog_service.Attempt{
+		Email:     req.Email,
+		Method:    loginlog.MethodPassword,
+		IP:        ip,
+		UserAgent: userAgent,
+	}
+	if err := s.loginGuardService.Check(ctx, req.Email, ip); err != nil {
+		s.record(ctx, attempt, loginlog.OutcomeLocked, err.Error())
+		return nil, err
+	}
+
 	u, err := s.client.User.Query().
 		Where(user.EmailEQ(req.Email)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, errors.New("找不到该用户")
+			s.fail(ctx, attempt, "账号不存在")
+			return nil, ErrInvalidCredentials
 		}
 		return nil, err
 	}
+	attempt.UserID = &u.ID
 
 	if err = bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password)); err != nil {
-		return nil, errors.New("密码错误")
+		s.fail(ctx, attempt, "密码错误")
+		return nil, ErrInvalidCredentials
 	}
+	if u.EmailVerifyRequired && !u.EmailVerified {
+		s.record(ctx, attempt, loginlog.OutcomeFailure, ErrEmailNotVerified.Error())
+		return nil, ErrEmailNotVerified
+	}
+	return s.loginUser(ctx, u, attempt)
+}
+
+// LoginUser 为已通过第三方认证的用户签发登录令牌。
+// 已启用两步验证或角色要求两步验证时只返回挑战令牌，由 LoginTwoFactor 完成登录。
+func (s *AuthServiceImpl) LoginUser(ctx context.Context, u *ent.User, userAgent, ip string) (*model.LoginResp, error) {
+	return s.loginUser(ctx, u, loginlog_service.Attempt{
+		UserID:    &u.ID,
+		Email:     u.Email,
+		Method:    loginlog.MethodSocial,
+		IP:        ip,
+		UserAgent: userAgent,
+	})
+}
+
+// loginUser 第一步认证通过后签发登录令牌或两步验证挑战，供密码登录与第三方登录共用
+func (s *AuthServiceImpl) loginUser(ctx context.Context, u *ent.User, attempt loginlog_service.Attempt) (*model.LoginResp, error) {
 	role, err := u.QueryRole().Only(ctx)
 	if err != nil {
 		return nil, errors.New("用户角色不存在")
 	}
+	if u.TotpEnabled || role.RequireTwoFactor {
+		setup := !u.TotpEnabled
+		challenge, err := signChallenge(u, setup)
+		if err != nil {
+			return nil, err
+		}
+		s.record(ctx, attempt, loginlog.OutcomeChallenge, "")
+		return &model.LoginResp{
+			Username:               u.Name,
+			TwoFactorRequired:      true,
+			TwoFactorSetupRequired: setup,
+			ChallengeToken:         challenge,
+		}, nil
+	}
+	return s.succeed(ctx, u, role.Code, attempt)
+}
 
-	return s.issueTokens(ctx, u, role.Code, userAgent, ip)
+// TwoFactorSetup 角色要求两步验证但尚未启用时，凭挑战令牌获取待绑定的密钥
+func (s *AuthServiceImpl) TwoFactorSetup(ctx context.Context, challengeToken string) (*model.TwoFactorSetupResp, error) {
+	u, claims, err := s.parseChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.Setup {
+		return nil, errors.New("已启用两步验证，请输入验证码")
+	}
+	return s.twoFactorService.Setup(ctx, u.ID)
+}
+
+// LoginTwoFactor 使用挑战令牌与验证码完成登录，需要绑定时同时启用两步验证并返回恢复码，验证码错误计入登录失败次数
+func (s *AuthServiceImpl) LoginTwoFactor(ctx context.Context, req *model.TwoFactorLoginReq, userAgent, ip string) (*model.LoginResp, error) {
+	u, claims, err := s.parseChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	attempt := loginlog_service.Attempt{
+		UserID:    &u.ID,
+		Email:     u.Email,
+		Method:    loginlog.MethodTwoFactor,
+		IP:        ip,
+		UserAgent: userAgent,
+	}
+	if err := s.loginGuardService.Check(ctx, u.Email, ip); err != nil {
+		s.record(ctx, attempt, loginlog.OutcomeLocked, err.Error())
+		return nil, err
+	}
+	var recoveryCodes []string
+	if claims.Setup {
+		recoveryCodes, err = s.twoFactorService.Enable(ctx, u.ID, req.Code)
+	} else {
+		err = s.twoFactorService.Verify(ctx, u, req.Code)
+	}
+	if err != nil {
+		s.fail(ctx, attempt, err.Error())
+		return nil, err
+	}
+	role, err := u.QueryRole().Only(ctx)
+	if err != nil {
+		return nil, errors.New("用户角色不存在")
+	}
+	resp, err := s.succeed(ctx, u, role.Code, attempt)
+	if err != nil {
+		return nil, err
+	}
+	resp.RecoveryCodes = recoveryCodes
+	return resp, nil
+}
+
+// succeed 签发登录令牌，记录登录成功并清除账号的失败计数
+func (s *AuthServiceImpl) succeed(ctx context.Context, u *ent.User, roleCode string, attempt loginlog_service.Attempt) (*model.LoginResp, error) {
+	resp, err := s.issueTokens(ctx, u, roleCode, attempt.UserAgent, attempt.IP)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, attempt, loginlog.OutcomeSuccess, "")
+	if err := s.loginGuardService.Succeed(ctx, u.Email); err != nil {
+		slog.Error("清除登录失败计数失败", "email", u.Email, "error", err.Error())
+	}
+	return resp, nil
+}
+
+// fail 记录登录失败并累计账号与 IP 的失败次数
+func (s *AuthServiceImpl) fail(ctx context.Context, attempt loginlog_service.Attempt, reason string) {
+	s.record(ctx, attempt, loginlog.OutcomeFailure, reason)
+	if err := s.loginGuardService.Fail(ctx, attempt.Email, attempt.IP); err != nil {
+		slog.Error("记录登录失败次数失败", "email", attempt.Email, "error", err.Error())
+	}
+}
+
+func (s *AuthServiceImpl) record(ctx context.Context, attempt loginlog_service.Attempt, outcome loginlog.Outcome, reason string) {
+	attempt.Outcome = outcome
+	attempt.Reason = reason
+	s.loginLogService.Record(ctx, attempt)
+}
+
+// parseChallenge 校验挑战令牌，用户两步验证状态与签发时不一致时令牌失效
+func (s *AuthServiceImpl) parseChallenge(ctx context.Context, token string) (*ent.User, *challengeClaims, error) {
+	var claims challengeClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		return challengeKey(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithAudience(challengeAud), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, nil, errors.New("登录已超时，请重新登录")
+	}
+	u, err := s.client.User.Get(ctx, claims.UserID)
+	if err != nil || claims.Fingerprint != passwordFingerprint(u.Password) || claims.Setup == u.TotpEnabled {
+		return nil, nil, errors.New("登录已超时，请重新登录")
+	}
+	return u, &claims, nil
 }
 
 // RefreshToken 使用有效 refresh token 轮换签发新的 access/refresh token。
+// 已轮换或已吊销的 refresh token 再次使用时视为泄露，吊销整个会话。
 func (s *AuthServiceImpl) RefreshToken(ctx context.Context, req *model.RefreshTokenRequest, userAgent, ip string) (*model.RefreshTokenResp, error) {
 	hash := hashRefreshToken(req.RefreshToken)
 	rt, err := s.client.RefreshToken.Query().
 		Where(
 			refreshtoken.TokenHash(hash),
-			refreshtoken.Revoked(false),
 			refreshtoken.ExpiresAtGT(time.Now()),
 		).
 		Only(ctx)
@@ -76,6 +254,12 @@ func (s *AuthServiceImpl) RefreshToken(ctx context.Context, req *model.RefreshTo
 		}
 		return nil, err
 	}
+	if rt.Revoked {
+		if err := session_service.RevokeFamily(ctx, s.client, rt); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("refresh token 已失效，请重新登录")
+	}
 
 	u, err := s.client.User.Get(ctx, rt.UserID)
 	if err != nil {
@@ -86,7 +270,19 @@ func (s *AuthServiceImpl) RefreshToken(ctx context.Context, req *model.RefreshTo
 		return nil, errors.New("用户角色不存在")
 	}
 
-	accessToken, expires, err := s.generateAccessToken(u)
+	// 升级前签发的 refresh token 没有会话ID，轮换时补上
+	familyID := rt.FamilyID
+	if familyID == "" {
+		if familyID, err = generateFamilyID(); err != nil {
+			return nil, err
+		}
+	}
+	loginAt := rt.LoginAt
+	if loginAt.IsZero() {
+		loginAt = rt.CreatedAt
+	}
+
+	accessToken, expires, err := s.generateAccessToken(u, familyID)
 	if err != nil {
 		return nil, err
 	}
@@ -102,11 +298,22 @@ func (s *AuthServiceImpl) RefreshToken(ctx context.Context, req *model.RefreshTo
 		}
 	}()
 
-	// 吊销旧 refresh token
-	if err := tx.RefreshToken.UpdateOne(rt).SetRevoked(true).Exec(ctx); err != nil {
+	// 吊销旧 refresh token，并发轮换时只有一个请求能成功
+	n, err := tx.RefreshToken.Update().
+		Where(refreshtoken.ID(rt.ID), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx)
+	if err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
+	if n == 0 {
+		_ = tx.Rollback()
+		if err := session_service.RevokeFamily(ctx, s.client, rt); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("refresh token 已失效，请重新登录")
+	}
 
 	// 签发新 refresh token
 	raw, newHash, expiresAt, err := generateRefreshToken()
@@ -120,6 +327,8 @@ func (s *AuthServiceImpl) RefreshToken(ctx context.Context, req *model.RefreshTo
 		SetExpiresAt(expiresAt).
 		SetUserAgent(userAgent).
 		SetIP(ip).
+		SetFamilyID(familyID).
+		SetLoginAt(loginAt).
 		Save(ctx); err != nil {
 		_ = tx.Rollback()
 		return nil, err
@@ -156,7 +365,11 @@ func (s *AuthServiceImpl) RevokeRefreshToken(ctx context.Context, rawToken strin
 
 // issueTokens 生成 access token 与 refresh token 并持久化 refresh token。
 func (s *AuthServiceImpl) issueTokens(ctx context.Context, u *ent.User, roleCode, userAgent, ip string) (*model.LoginResp, error) {
-	accessToken, expires, err := s.generateAccessToken(u)
+	familyID, err := generateFamilyID()
+	if err != nil {
+		return nil, err
+	}
+	accessToken, expires, err := s.generateAccessToken(u, familyID)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +385,8 @@ func (s *AuthServiceImpl) issueTokens(ctx context.Context, u *ent.User, roleCode
 		SetExpiresAt(expiresAt).
 		SetUserAgent(userAgent).
 		SetIP(ip).
+		SetFamilyID(familyID).
+		SetLoginAt(time.Now()).
 		Save(ctx); err != nil {
 		return nil, err
 	}
@@ -186,12 +401,14 @@ func (s *AuthServiceImpl) issueTokens(ctx context.Context, u *ent.User, roleCode
 }
 
 // generateAccessToken 生成登录态 JWT，返回 token 字符串与毫秒级过期时间戳。
-func (s *AuthServiceImpl) generateAccessToken(u *ent.User) (string, int64, error) {
+// sid 为所属会话ID，会话被吊销后 access token 随之失效。
+func (s *AuthServiceImpl) generateAccessToken(u *ent.User, sid string) (string, int64, error) {
 	expiresAt := time.Now().Add(accessTokenTTL)
 	claims := jwt.MapClaims{
 		"id":    u.ID,
 		"email": u.Email,
 		"name":  u.Name,
+		"sid":   sid,
 		"exp":   expiresAt.Unix(), // JWT 规范：秒级时间戳
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -204,6 +421,33 @@ func (s *AuthServiceImpl) generateAccessToken(u *ent.User) (string, int64, error
 	return t, expiresAt.UnixMilli(), nil
 }
 
+func signChallenge(u *ent.User, setup bool) (string, error) {
+	now := time.Now()
+	claims := challengeClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{challengeAud},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(challengeTTL)),
+		},
+		UserID:      u.ID,
+		Fingerprint: passwordFingerprint(u.Password),
+		Setup:       setup,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(challengeKey())
+}
+
+// challengeKey 挑战令牌签名密钥，由登录令牌密钥派生，避免挑战令牌被当作登录令牌使用
+func challengeKey() []byte {
+	sum := sha256.Sum256([]byte("2fa-challenge:" + config.GetString(config.AUTH_TOKEN_SECRET)))
+	return sum[:]
+}
+
+// passwordFingerprint 密码哈希的指纹，不在令牌中暴露完整哈希
+func passwordFingerprint(hash string) string {
+	sum := sha256.Sum256([]byte(hash))
+	return hex.EncodeToString(sum[:8])
+}
+
 // generateRefreshToken 生成随机 refresh token 明文、SHA256 哈希与过期时间。
 func generateRefreshToken() (raw, hash string, expiresAt time.Time, err error) {
 	b := make([]byte, refreshTokenSize)
@@ -217,6 +461,15 @@ func generateRefreshToken() (raw, hash string, expiresAt time.Time, err error) {
 	return raw, hash, expiresAt, nil
 }
 
+// generateFamilyID 生成会话ID
+func generateFamilyID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 func hashRefreshToken(raw string) string {
 	sum := sha256.Sum256([]byte(raw))
 	return hex.EncodeToString(sum[:])
@@ -0,0 +1,136 @@
+package loginguard
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/pkg/cache"
+	"github.com/shuTwT/hoshikuzu/pkg/config"
+)
+
+const (
+	failAccountKeyPrefix = "login:fail:account:"
+	failIPKeyPrefix      = "login:fail:ip:"
+	lockAccountKeyPrefix = "login:lock:account:"
+	lockIPKeyPrefix      = "login:lock:ip:"
+	// delayAfter 连续失败超过该次数后每次登录前等待，等待时间逐次翻倍
+	delayAfter = 2
+	maxDelay   = 8 * time.Second
+)
+
+// LockedError 账号或 IP 因连续登录失败被暂时锁定
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("登录失败次数过多，请 %d 分钟后再试", max(int(math.Ceil(e.RetryAfter.Minutes())), 1))
+}
+
+type LoginGuardService interface {
+	Check(ctx context.Context, email, ip string) error
+	Fail(ctx context.Context, email, ip string) error
+	Succeed(ctx context.Context, email string) error
+	Unlock(ctx context.Context, userId int) error
+}
+
+type LoginGuardServiceImpl struct {
+	client  *ent.Client
+	counter cache.Counter
+}
+
+func NewLoginGuardServiceImpl(client *ent.Client, counter cache.Counter) *LoginGuardServiceImpl {
+	return &LoginGuardServiceImpl{client: client, counter: counter}
+}
+
+// Check 账号或 IP 已锁定时返回 *LockedError；账号连续失败多次时先等待一段时间，拖慢暴力破解
+func (s *LoginGuardServiceImpl) Check(ctx context.Context, email, ip string) error {
+	email = normalizeEmail(email)
+	for _, key := range []string{lockAccountKeyPrefix + email, lockIPKeyPrefix + ip} {
+		locked, ttl, err := s.counter.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if locked > 0 {
+			return &LockedError{RetryAfter: ttl}
+		}
+	}
+	failures, _, err := s.counter.Get(ctx, failAccountKeyPrefix+email)
+	if err != nil {
+		return err
+	}
+	if d := delayFor(failures); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Fail 记录一次登录失败，账号或 IP 失败次数达到上限时锁定
+func (s *LoginGuardServiceImpl) Fail(ctx context.Context, email, ip string) error {
+	email = normalizeEmail(email)
+	lockDuration := config.GetDuration(config.AUTH_LOGIN_LOCK_DURATION)
+	limits := []struct {
+		failKey, lockKey string
+		max              int
+	}{
+		{failAccountKeyPrefix + email, lockAccountKeyPrefix + email, config.GetInt(config.AUTH_LOGIN_MAX_FAILURES)},
+		{failIPKeyPrefix + ip, lockIPKeyPrefix + ip, config.GetInt(config.AUTH_LOGIN_IP_MAX_FAILURES)},
+	}
+	for _, l := range limits {
+		if l.max <= 0 {
+			continue
+		}
+		failures, err := s.counter.Incr(ctx, l.failKey, lockDuration)
+		if err != nil {
+			return err
+		}
+		if failures < int64(l.max) {
+			continue
+		}
+		if _, err := s.counter.Incr(ctx, l.lockKey, lockDuration); err != nil {
+			return err
+		}
+		if err := s.counter.Delete(ctx, l.failKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Succeed 登录成功后清除账号的失败计数，IP 的失败计数保留到过期
+func (s *LoginGuardServiceImpl) Succeed(ctx context.Context, email string) error {
+	return s.counter.Delete(ctx, failAccountKeyPrefix+normalizeEmail(email))
+}
+
+// Unlock 解除用户账号的锁定并清除失败计数
+func (s *LoginGuardServiceImpl) Unlock(ctx context.Context, userId int) error {
+	u, err := s.client.User.Get(ctx, userId)
+	if err != nil {
+		return err
+	}
+	email := normalizeEmail(u.Email)
+	return s.counter.Delete(ctx, failAccountKeyPrefix+email, lockAccountKeyPrefix+email)
+}
+
+// delayFor 连续失败次数对应的等待时间
+func delayFor(failures int64) time.Duration {
+	if failures <= delayAfter {
+		return 0
+	}
+	shift := min(failures-delayAfter-1, 3)
+	return min(time.Second<<shift, maxDelay)
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
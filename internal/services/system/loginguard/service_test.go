@@ -0,0 +1,33 @@
+package loginguard
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDelayFor(t *testing.T) {
+	tests := []struct {
+		failures int64
+		want     time.Duration
+	}{
+		{0, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{5, 4 * time.Second},
+		{6, 8 * time.Second},
+		{20, 8 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := delayFor(tt.failures); got != tt.want {
+			t.Errorf("delayFor(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestLockedErrorMessage(t *testing.T) {
+	err := &LockedError{RetryAfter: 90 * time.Second}
+	if got, want := err.Error(), "登录失败次数过多，请 2 分钟后再试"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
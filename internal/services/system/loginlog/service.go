@@ -0,0 +1,86 @@
+package loginlog
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/loginlog"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+)
+
+// Attempt 一次登录尝试
+type Attempt struct {
+	// 账号不存在时为空
+	UserID    *int
+	Email     string
+	Method    loginlog.Method
+	Outcome   loginlog.Outcome
+	Reason    string
+	IP        string
+	UserAgent string
+}
+
+type LoginLogService interface {
+	Record(ctx context.Context, attempt Attempt)
+	QueryLoginLogPage(ctx context.Context, req model.LoginLogPageQuery) ([]*ent.LoginLog, int, error)
+}
+
+type LoginLogServiceImpl struct {
+	client *ent.Client
+}
+
+func NewLoginLogServiceImpl(client *ent.Client) *LoginLogServiceImpl {
+	return &LoginLogServiceImpl{client: client}
+}
+
+// Record 写入登录审计日志，写入失败只记录错误，不影响登录
+func (s *LoginLogServiceImpl) Record(ctx context.Context, attempt Attempt) {
+	browser, os, _ := utils.ParseUserAgent(attempt.UserAgent)
+	err := s.client.LoginLog.Create().
+		SetNillableUserID(attempt.UserID).
+		SetEmail(attempt.Email).
+		SetMethod(attempt.Method).
+		SetOutcome(attempt.Outcome).
+		SetReason(attempt.Reason).
+		SetIP(attempt.IP).
+		SetUserAgent(attempt.UserAgent).
+		SetBrowser(browser).
+		SetOs(os).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("记录登录日志失败", "email", attempt.Email, "error", err.Error())
+	}
+}
+
+func (s *LoginLogServiceImpl) QueryLoginLogPage(ctx context.Context, req model.LoginLogPageQuery) ([]*ent.LoginLog, int, error) {
+	query := s.client.LoginLog.Query()
+
+	if req.UserID > 0 {
+		query.Where(loginlog.UserID(req.UserID))
+	}
+	if req.Email != "" {
+		query.Where(loginlog.EmailContains(req.Email))
+	}
+	if req.IP != "" {
+		query.Where(loginlog.IPContains(req.IP))
+	}
+	if req.Outcome != "" {
+		query.Where(loginlog.OutcomeEQ(loginlog.Outcome(req.Outcome)))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	logs, err := query.
+		Order(ent.Desc(loginlog.FieldID)).
+		Limit(req.Size).
+		Offset((req.Page - 1) * req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, count, nil
+}
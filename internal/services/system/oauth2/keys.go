@@ -0,0 +1,110 @@
+package oauth2
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/pkg/config"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	signingKeyOnce sync.Once
+	signingKey     *rsa.PrivateKey
+	signingKeyID   string
+	signingKeyErr  error
+)
+
+// loadSigningKey 解析配置中的 OIDC 签名私钥，kid 取公钥摘要，密钥不变时 kid 保持稳定
+func loadSigningKey() (*rsa.PrivateKey, string, error) {
+	signingKeyOnce.Do(func() {
+		block, _ := pem.Decode([]byte(config.GetString(config.OAUTH2_SIGNING_KEY)))
+		if block == nil {
+			signingKeyErr = errors.New("OIDC 签名私钥未配置")
+			return
+		}
+		var key any
+		if key, signingKeyErr = x509.ParsePKCS8PrivateKey(block.Bytes); signingKeyErr != nil {
+			if key, signingKeyErr = x509.ParsePKCS1PrivateKey(block.Bytes); signingKeyErr != nil {
+				return
+			}
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			signingKeyErr = errors.New("OIDC 签名私钥必须是 RSA 私钥")
+			return
+		}
+		der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+		if err != nil {
+			signingKeyErr = err
+			return
+		}
+		sum := sha256.Sum256(der)
+		signingKey = rsaKey
+		signingKeyID = base64.RawURLEncoding.EncodeToString(sum[:12])
+	})
+	return signingKey, signingKeyID, signingKeyErr
+}
+
+// JWKS 返回用于校验 id_token 的公钥
+func (s *OAuth2ServiceImpl) JWKS() (*model.JWKSResp, error) {
+	key, kid, err := loadSigningKey()
+	if err != nil {
+		return nil, err
+	}
+	return &model.JWKSResp{Keys: []model.JWK{{
+		Kty: "RSA",
+		Use: "sig",
+		Kid: kid,
+		Alg: jwt.SigningMethodRS256.Alg(),
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}, nil
+}
+
+// signIDToken 签发 RS256 id_token，用户声明与 userinfo 端点一致
+func signIDToken(issuer, clientID string, u *ent.User, scopes []string, authTime time.Time, nonce string) (string, error) {
+	key, kid, err := loadSigningKey()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":       issuer,
+		"sub":       strconv.Itoa(u.ID),
+		"aud":       clientID,
+		"iat":       now.Unix(),
+		"exp":       now.Add(accessTokenTTL).Unix(),
+		"auth_time": authTime.Unix(),
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	info := userInfo(u, scopes)
+	if slices.Contains(scopes, model.OIDCScopeProfile) {
+		claims["name"] = info.Name
+		claims["preferred_username"] = info.PreferredUsername
+		if info.Nickname != "" {
+			claims["nickname"] = info.Nickname
+		}
+	}
+	if slices.Contains(scopes, model.OIDCScopeEmail) {
+		claims["email"] = info.Email
+		claims["email_verified"] = *info.EmailVerified
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
@@ -0,0 +1,528 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2accesstoken"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2client"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2code"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2refreshtoken"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+)
+
+// OAuth2 协议错误码
+const (
+	ErrInvalidRequest          = "invalid_request"
+	ErrInvalidClient           = "invalid_client"
+	ErrInvalidGrant            = "invalid_grant"
+	ErrInvalidScope            = "invalid_scope"
+	ErrInvalidToken            = "invalid_token"
+	ErrInsufficientScope       = "insufficient_scope"
+	ErrAccessDenied            = "access_denied"
+	ErrUnsupportedGrantType    = "unsupported_grant_type"
+	ErrUnsupportedResponseType = "unsupported_response_type"
+)
+
+const codeChallengeMethodS256 = "S256"
+
+// Error OAuth2 协议错误，授权端点上可通过回调地址带回客户端
+type Error struct {
+	Code        string
+	Description string
+}
+
+func (e *Error) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func newError(code, description string) *Error {
+	return &Error{Code: code, Description: description}
+}
+
+// CheckAuthorizeRequest 校验授权请求，返回客户端与申请的授权范围。
+// 客户端或回调地址无效时返回普通错误，不能重定向；其余参数错误返回 *Error，可带回客户端。
+func (s *OAuth2ServiceImpl) CheckAuthorizeRequest(ctx context.Context, req *model.OAuth2AuthorizeReq) (*ent.Oauth2Client, []string, error) {
+	c, err := s.client.Oauth2Client.Query().
+		Where(oauth2client.ClientID(req.ClientID), oauth2client.Enabled(true)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("客户端不存在或已停用")
+		}
+		return nil, nil, err
+	}
+	if !slices.Contains(c.RedirectUris, req.RedirectURI) {
+		return nil, nil, fmt.Errorf("回调地址未在客户端中登记")
+	}
+
+	if req.ResponseType != "code" {
+		return nil, nil, newError(ErrUnsupportedResponseType, "仅支持授权码模式")
+	}
+	scopes := parseScopes(req.Scope)
+	if len(scopes) == 0 {
+		return nil, nil, newError(ErrInvalidScope, "缺少授权范围")
+	}
+	for _, scope := range scopes {
+		if _, ok := model.OIDCScopes[scope]; !ok && !permission.IsDefined(scope) {
+			return nil, nil, newError(ErrInvalidScope, "未定义的授权范围: "+scope)
+		}
+		if !permission.HasScope(c.Scopes, scope) {
+			return nil, nil, newError(ErrInvalidScope, "客户端无权申请授权范围: "+scope)
+		}
+	}
+	if len(req.Nonce) > 255 {
+		return nil, nil, newError(ErrInvalidRequest, "nonce 过长")
+	}
+	if req.CodeChallenge == "" {
+		if c.Public {
+			return nil, nil, newError(ErrInvalidRequest, "公开客户端必须使用 PKCE")
+		}
+	} else {
+		if req.CodeChallengeMethod != codeChallengeMethodS256 {
+			return nil, nil, newError(ErrInvalidRequest, "code_challenge_method 仅支持 S256")
+		}
+		if len(req.CodeChallenge) != 43 {
+			return nil, nil, newError(ErrInvalidRequest, "code_challenge 格式错误")
+		}
+	}
+	return c, scopes, nil
+}
+
+// GrantedScopes 按用户角色收窄授权范围，OIDC 标准范围总是保留，接口权限只保留角色拥有的部分
+func (s *OAuth2ServiceImpl) GrantedScopes(ctx context.Context, userId int, scopes []string) ([]string, error) {
+	u, err := s.client.User.Query().
+		Where(user.IDEQ(userId)).
+		WithRole().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var roleScopes []string
+	if u.Edges.Role != nil {
+		roleScopes = u.Edges.Role.Permissions
+	}
+	granted := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if _, ok := model.OIDCScopes[scope]; ok || permission.HasScope(roleScopes, scope) {
+			granted = append(granted, scope)
+		}
+	}
+	return granted, nil
+}
+
+// Authorize 用户同意授权后签发授权码，返回授权码明文
+func (s *OAuth2ServiceImpl) Authorize(ctx context.Context, userId int, req *model.OAuth2AuthorizeReq) (string, error) {
+	c, scopes, err := s.CheckAuthorizeRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	granted, err := s.GrantedScopes(ctx, userId, scopes)
+	if err != nil {
+		return "", err
+	}
+	if len(granted) == 0 {
+		return "", newError(ErrInvalidScope, "当前账号没有可授予的授权范围")
+	}
+
+	code, err := utils.GenerateToken("", tokenSize)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	create := s.client.Oauth2Code.Create().
+		SetUserID(userId).
+		SetCode(utils.HashToken(code)).
+		SetExpireAt(now.Add(codeTTL)).
+		SetClientID(c.ClientID).
+		SetRedirectURI(req.RedirectURI).
+		SetScope(strings.Join(granted, " ")).
+		SetNonce(req.Nonce).
+		SetAuthTime(now)
+	if req.CodeChallenge != "" {
+		create.SetCodeChallenge(req.CodeChallenge).SetCodeChallengeMethod(req.CodeChallengeMethod)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// Exchange 令牌端点，支持 authorization_code 与 refresh_token 两种授权方式
+func (s *OAuth2ServiceImpl) Exchange(ctx context.Context, req *model.OAuth2TokenReq, issuer string) (*model.OAuth2TokenResp, error) {
+	c, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	switch req.GrantType {
+	case "authorization_code":
+		return s.exchangeCode(ctx, c, req, issuer)
+	case "refresh_token":
+		return s.exchangeRefreshToken(ctx, c, req, issuer)
+	default:
+		return nil, newError(ErrUnsupportedGrantType, "不支持的授权方式: "+req.GrantType)
+	}
+}
+
+func (s *OAuth2ServiceImpl) exchangeCode(ctx context.Context, c *ent.Oauth2Client, req *model.OAuth2TokenReq, issuer string) (*model.OAuth2TokenResp, error) {
+	if req.Code == "" {
+		return nil, newError(ErrInvalidRequest, "缺少 code")
+	}
+	code, err := s.client.Oauth2Code.Query().
+		Where(
+			oauth2code.Code(utils.HashToken(req.Code)),
+			oauth2code.ClientID(c.ClientID),
+			oauth2code.ExpireAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidGrant, "授权码无效或已过期")
+		}
+		return nil, err
+	}
+	if code.RedirectURI != req.RedirectURI {
+		return nil, newError(ErrInvalidGrant, "redirect_uri 与授权请求不一致")
+	}
+	if code.CodeChallenge != "" {
+		if !verifyCodeChallenge(req.CodeVerifier, code.CodeChallenge) {
+			return nil, newError(ErrInvalidGrant, "code_verifier 校验失败")
+		}
+	} else if c.Public {
+		return nil, newError(ErrInvalidGrant, "公开客户端必须使用 PKCE")
+	}
+	u, err := s.client.User.Get(ctx, code.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidGrant, "用户不存在")
+		}
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 授权码只能使用一次，删除失败说明已被并发使用
+	if err := tx.Oauth2Code.DeleteOne(code).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidGrant, "授权码已被使用")
+		}
+		return nil, err
+	}
+	resp, err := s.issueTokens(ctx, tx, c, u, parseScopes(code.Scope), code.AuthTime, code.Nonce, issuer)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// exchangeRefreshToken 轮换刷新令牌，可申请不超出原授权的更小授权范围
+func (s *OAuth2ServiceImpl) exchangeRefreshToken(ctx context.Context, c *ent.Oauth2Client, req *model.OAuth2TokenReq, issuer string) (*model.OAuth2TokenResp, error) {
+	if req.RefreshToken == "" {
+		return nil, newError(ErrInvalidRequest, "缺少 refresh_token")
+	}
+	rt, err := s.client.Oauth2RefreshToken.Query().
+		Where(
+			oauth2refreshtoken.RefreshToken(utils.HashToken(req.RefreshToken)),
+			oauth2refreshtoken.ClientID(c.ClientID),
+			oauth2refreshtoken.ExpireAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidGrant, "refresh_token 无效或已过期")
+		}
+		return nil, err
+	}
+	scopes := parseScopes(rt.Scope)
+	if requested := parseScopes(req.Scope); len(requested) > 0 {
+		for _, scope := range requested {
+			if !slices.Contains(scopes, scope) {
+				return nil, newError(ErrInvalidScope, "授权范围超出原授权: "+scope)
+			}
+		}
+		scopes = requested
+	}
+	u, err := s.client.User.Get(ctx, rt.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidGrant, "用户不存在")
+		}
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Oauth2RefreshToken.DeleteOne(rt).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidGrant, "refresh_token 已被使用")
+		}
+		return nil, err
+	}
+	resp, err := s.issueTokens(ctx, tx, c, u, scopes, rt.AuthTime, "", issuer)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// issueTokens 签发访问令牌与刷新令牌，授权范围包含 openid 时同时签发 id_token
+func (s *OAuth2ServiceImpl) issueTokens(ctx context.Context, tx *ent.Tx, c *ent.Oauth2Client, u *ent.User, scopes []string, authTime time.Time, nonce, issuer string) (*model.OAuth2TokenResp, error) {
+	accessToken, err := utils.GenerateToken(model.OAuth2AccessTokenPrefix, tokenSize)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := utils.GenerateToken(model.OAuth2RefreshTokenPrefix, tokenSize)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	scope := strings.Join(scopes, " ")
+	if err := tx.Oauth2RefreshToken.Create().
+		SetUserID(u.ID).
+		SetRefreshToken(utils.HashToken(refreshToken)).
+		SetClientID(c.ClientID).
+		SetScope(scope).
+		SetExpireAt(now.Add(refreshTokenTTL)).
+		SetAuthTime(authTime).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.Oauth2AccessToken.Create().
+		SetUserID(u.ID).
+		SetAccessToken(utils.HashToken(accessToken)).
+		SetRefreshToken(utils.HashToken(refreshToken)).
+		SetClientID(c.ClientID).
+		SetScope(scope).
+		SetExpireAt(now.Add(accessTokenTTL)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	resp := &model.OAuth2TokenResp{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(accessTokenTTL.Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        scope,
+	}
+	if slices.Contains(scopes, model.OIDCScopeOpenID) {
+		if resp.IDToken, err = signIDToken(issuer, c.ClientID, u, scopes, authTime, nonce); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// Revoke 吊销访问令牌或刷新令牌（RFC 7009），吊销刷新令牌时一并吊销其签发的访问令牌。
+// 令牌不存在或不属于该客户端时同样视为成功。
+func (s *OAuth2ServiceImpl) Revoke(ctx context.Context, req *model.OAuth2TokenActionReq) error {
+	c, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return err
+	}
+	if req.Token == "" {
+		return newError(ErrInvalidRequest, "缺少 token")
+	}
+	hash := utils.HashToken(req.Token)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Oauth2RefreshToken.Delete().
+		Where(oauth2refreshtoken.RefreshToken(hash), oauth2refreshtoken.ClientID(c.ClientID)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.Oauth2AccessToken.Delete().
+		Where(
+			oauth2accesstoken.Or(oauth2accesstoken.AccessToken(hash), oauth2accesstoken.RefreshToken(hash)),
+			oauth2accesstoken.ClientID(c.ClientID),
+		).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Introspect 令牌内省（RFC 7662），仅机密客户端可调用，且只能查询签发给自身的令牌
+func (s *OAuth2ServiceImpl) Introspect(ctx context.Context, req *model.OAuth2TokenActionReq, issuer string) (*model.OAuth2IntrospectResp, error) {
+	c, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if c.Public {
+		return nil, newError(ErrInvalidClient, "公开客户端不能调用内省端点")
+	}
+	inactive := &model.OAuth2IntrospectResp{Active: false}
+	if req.Token == "" {
+		return inactive, nil
+	}
+	hash := utils.HashToken(req.Token)
+	now := time.Now()
+
+	var userId int
+	resp := &model.OAuth2IntrospectResp{Active: true, ClientID: c.ClientID, Iss: issuer}
+	at, err := s.client.Oauth2AccessToken.Query().
+		Where(
+			oauth2accesstoken.AccessToken(hash),
+			oauth2accesstoken.ClientID(c.ClientID),
+			oauth2accesstoken.ExpireAtGT(now),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		userId = at.UserID
+		resp.Scope, resp.TokenType, resp.Exp, resp.Iat = at.Scope, "Bearer", at.ExpireAt.Unix(), at.CreatedAt.Unix()
+	case ent.IsNotFound(err):
+		rt, err := s.client.Oauth2RefreshToken.Query().
+			Where(
+				oauth2refreshtoken.RefreshToken(hash),
+				oauth2refreshtoken.ClientID(c.ClientID),
+				oauth2refreshtoken.ExpireAtGT(now),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return inactive, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		userId = rt.UserID
+		resp.Scope, resp.TokenType, resp.Exp, resp.Iat = rt.Scope, "refresh_token", rt.ExpireAt.Unix(), rt.CreatedAt.Unix()
+	default:
+		return nil, err
+	}
+
+	u, err := s.client.User.Get(ctx, userId)
+	if ent.IsNotFound(err) {
+		return inactive, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Sub = strconv.Itoa(u.ID)
+	resp.Username = u.Name
+	return resp, nil
+}
+
+// UserInfo 按访问令牌的授权范围返回用户信息，访问令牌必须包含 openid
+func (s *OAuth2ServiceImpl) UserInfo(ctx context.Context, accessToken string) (*model.OIDCUserInfoResp, error) {
+	at, err := s.client.Oauth2AccessToken.Query().
+		Where(
+			oauth2accesstoken.AccessToken(utils.HashToken(accessToken)),
+			oauth2accesstoken.ExpireAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidToken, "访问令牌无效或已过期")
+		}
+		return nil, err
+	}
+	scopes := parseScopes(at.Scope)
+	if !slices.Contains(scopes, model.OIDCScopeOpenID) {
+		return nil, newError(ErrInsufficientScope, "访问令牌缺少 openid 授权范围")
+	}
+	u, err := s.client.User.Get(ctx, at.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidToken, "用户不存在")
+		}
+		return nil, err
+	}
+	return userInfo(u, scopes), nil
+}
+
+// authenticateClient 校验客户端身份，机密客户端必须提供正确的密钥
+func (s *OAuth2ServiceImpl) authenticateClient(ctx context.Context, clientID, secret string) (*ent.Oauth2Client, error) {
+	if clientID == "" {
+		return nil, newError(ErrInvalidClient, "缺少 client_id")
+	}
+	c, err := s.client.Oauth2Client.Query().
+		Where(oauth2client.ClientID(clientID), oauth2client.Enabled(true)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrInvalidClient, "客户端不存在或已停用")
+		}
+		return nil, err
+	}
+	if c.Public {
+		return c, nil
+	}
+	if secret == "" || subtle.ConstantTimeCompare([]byte(utils.HashToken(secret)), []byte(c.ClientSecretHash)) != 1 {
+		return nil, newError(ErrInvalidClient, "客户端认证失败")
+	}
+	return c, nil
+}
+
+// userInfo 按授权范围组装用户声明
+func userInfo(u *ent.User, scopes []string) *model.OIDCUserInfoResp {
+	info := &model.OIDCUserInfoResp{Sub: strconv.Itoa(u.ID)}
+	if slices.Contains(scopes, model.OIDCScopeProfile) {
+		info.Name = u.Name
+		info.PreferredUsername = u.Name
+		info.Nickname = u.Nickname
+	}
+	if slices.Contains(scopes, model.OIDCScopeEmail) {
+		info.Email = u.Email
+		info.EmailVerified = &u.EmailVerified
+	}
+	return info
+}
+
+// verifyCodeChallenge 按 S256 方式校验 PKCE code_verifier
+func verifyCodeChallenge(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// parseScopes 解析空格分隔的授权范围并去重
+func parseScopes(scope string) []string {
+	var scopes []string
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// IsError 错误是否为 OAuth2 协议错误
+func IsError(err error) (*Error, bool) {
+	var e *Error
+	ok := errors.As(err, &e)
+	return e, ok
+}
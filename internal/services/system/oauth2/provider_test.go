@@ -0,0 +1,28 @@
+package oauth2
+
+import "testing"
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	const verifier = "dBjftJeZ4CVP-mA3JTTGqN1iaDf9vFjNLLOa_eGPvjJjqZQzC3K-xqLb5Ilfc4lMOAWc"
+	const challenge = "LpgzRUfbR7Yl7lGRXqYOg_6VLi15jT57iasKSARn_yY"
+
+	tests := []struct {
+		name      string
+		verifier  string
+		challenge string
+		want      bool
+	}{
+		{"校验通过", verifier, challenge, true},
+		{"校验码错误", verifier[:len(verifier)-1] + "x", challenge, false},
+		{"挑战码错误", verifier, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", false},
+		{"校验码过短", "short", challenge, false},
+		{"缺少校验码", "", challenge, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyCodeChallenge(tt.verifier, tt.challenge); got != tt.want {
+				t.Fatalf("verifyCodeChallenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package oauth2
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2accesstoken"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2client"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2code"
+	"github.com/shuTwT/hoshikuzu/ent/oauth2refreshtoken"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+)
+
+const (
+	codeTTL          = 10 * time.Minute
+	accessTokenTTL   = time.Hour
+	refreshTokenTTL  = 30 * 24 * time.Hour
+	tokenSize        = 32
+	clientIDSize     = 12
+	clientSecretSize = 32
+)
+
+type OAuth2Service interface {
+	QueryClientPage(ctx context.Context, req model.PageQuery) ([]*ent.Oauth2Client, int, error)
+	QueryClient(ctx context.Context, id int) (*ent.Oauth2Client, error)
+	CreateClient(ctx context.Context, req model.Oauth2ClientCreateReq) (*ent.Oauth2Client, string, error)
+	UpdateClient(ctx context.Context, id int, req model.Oauth2ClientUpdateReq) (*ent.Oauth2Client, error)
+	DeleteClient(ctx context.Context, id int) error
+	ResetClientSecret(ctx context.Context, id int) (*ent.Oauth2Client, string, error)
+
+	CheckAuthorizeRequest(ctx context.Context, req *model.OAuth2AuthorizeReq) (*ent.Oauth2Client, []string, error)
+	GrantedScopes(ctx context.Context, userId int, scopes []string) ([]string, error)
+	Authorize(ctx context.Context, userId int, req *model.OAuth2AuthorizeReq) (string, error)
+	Exchange(ctx context.Context, req *model.OAuth2TokenReq, issuer string) (*model.OAuth2TokenResp, error)
+	Revoke(ctx context.Context, req *model.OAuth2TokenActionReq) error
+	Introspect(ctx context.Context, req *model.OAuth2TokenActionReq, issuer string) (*model.OAuth2IntrospectResp, error)
+	UserInfo(ctx context.Context, accessToken string) (*model.OIDCUserInfoResp, error)
+	JWKS() (*model.JWKSResp, error)
+}
+
+type OAuth2ServiceImpl struct {
+	client *ent.Client
+}
+
+func NewOAuth2ServiceImpl(client *ent.Client) *OAuth2ServiceImpl {
+	return &OAuth2ServiceImpl{client: client}
+}
+
+func (s *OAuth2ServiceImpl) QueryClientPage(ctx context.Context, req model.PageQuery) ([]*ent.Oauth2Client, int, error) {
+	count, err := s.client.Oauth2Client.Query().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	clients, err := s.client.Oauth2Client.Query().
+		Order(ent.Desc(oauth2client.FieldID)).
+		Limit(req.Size).
+		Offset((req.Page - 1) * req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return clients, count, nil
+}
+
+func (s *OAuth2ServiceImpl) QueryClient(ctx context.Context, id int) (*ent.Oauth2Client, error) {
+	return s.client.Oauth2Client.Get(ctx, id)
+}
+
+// CreateClient 注册客户端，返回的明文密钥只在创建时返回一次，公开客户端没有密钥
+func (s *OAuth2ServiceImpl) CreateClient(ctx context.Context, req model.Oauth2ClientCreateReq) (*ent.Oauth2Client, string, error) {
+	if err := validateRedirectURIs(req.RedirectURIs); err != nil {
+		return nil, "", err
+	}
+	if err := validateClientScopes(req.Scopes); err != nil {
+		return nil, "", err
+	}
+	clientID, err := utils.GenerateToken("", clientIDSize)
+	if err != nil {
+		return nil, "", err
+	}
+	create := s.client.Oauth2Client.Create().
+		SetClientID(clientID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetLogo(req.Logo).
+		SetRedirectUris(req.RedirectURIs).
+		SetScopes(req.Scopes).
+		SetPublic(req.Public)
+	var secret string
+	if !req.Public {
+		if secret, err = utils.GenerateToken("", clientSecretSize); err != nil {
+			return nil, "", err
+		}
+		create.SetClientSecretHash(utils.HashToken(secret))
+	}
+	c, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return c, secret, nil
+}
+
+// UpdateClient 更新客户端，停用时吊销该客户端的全部授权码与令牌
+func (s *OAuth2ServiceImpl) UpdateClient(ctx context.Context, id int, req model.Oauth2ClientUpdateReq) (*ent.Oauth2Client, error) {
+	if err := validateRedirectURIs(req.RedirectURIs); err != nil {
+		return nil, err
+	}
+	if err := validateClientScopes(req.Scopes); err != nil {
+		return nil, err
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := tx.Oauth2Client.UpdateOneID(id).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetLogo(req.Logo).
+		SetRedirectUris(req.RedirectURIs).
+		SetScopes(req.Scopes).
+		SetEnabled(req.Enabled).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if !c.Enabled {
+		if err := revokeClientGrants(ctx, tx, c.ClientID); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DeleteClient 删除客户端及其全部授权码与令牌
+func (s *OAuth2ServiceImpl) DeleteClient(ctx context.Context, id int) error {
+	c, err := s.client.Oauth2Client.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := revokeClientGrants(ctx, tx, c.ClientID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Oauth2Client.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ResetClientSecret 重置机密客户端的密钥，旧密钥立即失效
+func (s *OAuth2ServiceImpl) ResetClientSecret(ctx context.Context, id int) (*ent.Oauth2Client, string, error) {
+	c, err := s.client.Oauth2Client.Get(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if c.Public {
+		return nil, "", fmt.Errorf("公开客户端没有密钥")
+	}
+	secret, err := utils.GenerateToken("", clientSecretSize)
+	if err != nil {
+		return nil, "", err
+	}
+	c, err = s.client.Oauth2Client.UpdateOne(c).
+		SetClientSecretHash(utils.HashToken(secret)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return c, secret, nil
+}
+
+// revokeClientGrants 删除客户端的全部授权码与令牌
+func revokeClientGrants(ctx context.Context, tx *ent.Tx, clientID string) error {
+	if _, err := tx.Oauth2Code.Delete().Where(oauth2code.ClientID(clientID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Oauth2AccessToken.Delete().Where(oauth2accesstoken.ClientID(clientID)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Oauth2RefreshToken.Delete().Where(oauth2refreshtoken.ClientID(clientID)).Exec(ctx)
+	return err
+}
+
+// validateRedirectURIs 回调地址必须是不含片段的绝对地址
+func validateRedirectURIs(uris []string) error {
+	if len(uris) == 0 {
+		return fmt.Errorf("请至少填写一个回调地址")
+	}
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return fmt.Errorf("无效的回调地址: %s", uri)
+		}
+	}
+	return nil
+}
+
+// validateClientScopes 客户端授权范围只能是 OIDC 标准范围或已定义的接口权限
+func validateClientScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("请至少选择一个授权范围")
+	}
+	var apiScopes []string
+	for _, scope := range scopes {
+		if _, ok := model.OIDCScopes[scope]; !ok {
+			apiScopes = append(apiScopes, scope)
+		}
+	}
+	return permission.ValidateScopes(apiScopes)
+}
@@ -3,9 +3,11 @@ package role
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/ent/role"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
 )
 
@@ -64,10 +66,18 @@ func (s *RoleServiceImpl) CreateRole(c context.Context, req model.RoleCreateReq)
 	if exists {
 		return nil, fmt.Errorf("Role code already exists")
 	}
+	if err := permission.ValidateScopes(req.Permissions); err != nil {
+		return nil, err
+	}
+	if req.Permissions == nil {
+		req.Permissions = []string{}
+	}
 
 	role, err := s.client.Role.Create().
 		SetName(req.Name).
 		SetCode(req.Code).
+		SetPermissions(req.Permissions).
+		SetRequireTwoFactor(req.RequireTwoFactor).
 		Save(c)
 	if err != nil {
 		return nil, err
@@ -76,9 +86,9 @@ func (s *RoleServiceImpl) CreateRole(c context.Context, req model.RoleCreateReq)
 }
 
 func (s *RoleServiceImpl) UpdateRole(c context.Context, id int, req model.RoleUpdateReq) (*ent.Role, error) {
-	// 检查角色代码是否已存在
+	// 检查角色代码是否已被其他角色使用
 	exists, err := s.client.Role.Query().
-		Where(role.CodeEQ(req.Code)).
+		Where(role.CodeEQ(req.Code), role.IDNEQ(id)).
 		Exist(c)
 	if err != nil {
 		return nil, err
@@ -103,6 +113,25 @@ func (s *RoleServiceImpl) UpdateRole(c context.Context, id int, req model.RoleUp
 
 	update.SetDescription(req.Description)
 
+	if req.Permissions != nil {
+		if err := permission.ValidateScopes(req.Permissions); err != nil {
+			return nil, err
+		}
+		// 超级管理员必须保留全部权限，避免后台被锁死
+		old, err := s.client.Role.Get(c, id)
+		if err != nil {
+			return nil, err
+		}
+		if old.Code == model.RoleCodeSuperAdmin && !slices.Contains(req.Permissions, model.ScopeAll) {
+			return nil, fmt.Errorf("超级管理员角色必须拥有全部权限")
+		}
+		update.SetPermissions(req.Permissions)
+	}
+
+	if req.RequireTwoFactor != nil {
+		update.SetRequireTwoFactor(*req.RequireTwoFactor)
+	}
+
 	newRole, err := update.Save(c)
 	if err != nil {
 		return nil, err
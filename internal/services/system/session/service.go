@@ -0,0 +1,114 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/refreshtoken"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+)
+
+type SessionService interface {
+	ListSessions(ctx context.Context, userId int, currentSid string) ([]model.SessionResp, error)
+	RevokeSession(ctx context.Context, userId, id int) error
+	RevokeOtherSessions(ctx context.Context, userId int, currentSid string) (int, error)
+	RevokeUserSessions(ctx context.Context, userId int) (int, error)
+}
+
+type SessionServiceImpl struct {
+	client *ent.Client
+}
+
+func NewSessionServiceImpl(client *ent.Client) *SessionServiceImpl {
+	return &SessionServiceImpl{client: client}
+}
+
+// ListSessions 列出用户未吊销且未过期的会话，按最近活跃时间倒序
+func (s *SessionServiceImpl) ListSessions(ctx context.Context, userId int, currentSid string) ([]model.SessionResp, error) {
+	tokens, err := s.client.RefreshToken.Query().
+		Where(
+			refreshtoken.UserID(userId),
+			refreshtoken.Revoked(false),
+			refreshtoken.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(refreshtoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]model.SessionResp, 0, len(tokens))
+	for _, rt := range tokens {
+		browser, os, device := utils.ParseUserAgent(rt.UserAgent)
+		loginAt := rt.LoginAt
+		if loginAt.IsZero() {
+			loginAt = rt.CreatedAt
+		}
+		sessions = append(sessions, model.SessionResp{
+			ID:           rt.ID,
+			Browser:      browser,
+			OS:           os,
+			Device:       device,
+			UserAgent:    rt.UserAgent,
+			IP:           rt.IP,
+			LoginAt:      model.LocalTime(loginAt),
+			LastActiveAt: model.LocalTime(rt.CreatedAt),
+			ExpiresAt:    model.LocalTime(rt.ExpiresAt),
+			Current:      currentSid != "" && rt.FamilyID == currentSid,
+		})
+	}
+	return sessions, nil
+}
+
+// RevokeSession 吊销用户的指定会话
+func (s *SessionServiceImpl) RevokeSession(ctx context.Context, userId, id int) error {
+	rt, err := s.client.RefreshToken.Query().
+		Where(refreshtoken.ID(id), refreshtoken.UserID(userId)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return RevokeFamily(ctx, s.client, rt)
+}
+
+// RevokeOtherSessions 吊销用户除当前会话外的全部会话
+func (s *SessionServiceImpl) RevokeOtherSessions(ctx context.Context, userId int, currentSid string) (int, error) {
+	update := s.client.RefreshToken.Update().
+		Where(refreshtoken.UserID(userId), refreshtoken.Revoked(false))
+	if currentSid != "" {
+		update.Where(refreshtoken.Or(refreshtoken.FamilyIDIsNil(), refreshtoken.FamilyIDNEQ(currentSid)))
+	}
+	return update.SetRevoked(true).Save(ctx)
+}
+
+// RevokeUserSessions 吊销用户的全部会话，用于管理员强制下线
+func (s *SessionServiceImpl) RevokeUserSessions(ctx context.Context, userId int) (int, error) {
+	return s.client.RefreshToken.Update().
+		Where(refreshtoken.UserID(userId), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx)
+}
+
+// RevokeFamily 吊销 refresh token 所属会话的全部 refresh token，升级前签发的没有会话ID，只吊销其本身
+func RevokeFamily(ctx context.Context, client *ent.Client, rt *ent.RefreshToken) error {
+	update := client.RefreshToken.Update().Where(refreshtoken.Revoked(false))
+	if rt.FamilyID == "" {
+		update.Where(refreshtoken.ID(rt.ID))
+	} else {
+		update.Where(refreshtoken.FamilyID(rt.FamilyID))
+	}
+	_, err := update.SetRevoked(true).Save(ctx)
+	return err
+}
+
+// IsActive 会话是否仍有未吊销且未过期的 refresh token
+func IsActive(ctx context.Context, client *ent.Client, sid string) (bool, error) {
+	return client.RefreshToken.Query().
+		Where(
+			refreshtoken.FamilyID(sid),
+			refreshtoken.Revoked(false),
+			refreshtoken.ExpiresAtGT(time.Now()),
+		).
+		Exist(ctx)
+}
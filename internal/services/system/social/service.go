@@ -0,0 +1,486 @@
+package social
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/role"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/ent/useridentity"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	social_infra "github.com/shuTwT/hoshikuzu/internal/infra/social"
+	"github.com/shuTwT/hoshikuzu/internal/infra/social/github"
+	"github.com/shuTwT/hoshikuzu/internal/infra/social/oidc"
+	auth_service "github.com/shuTwT/hoshikuzu/internal/services/system/auth"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	user_service "github.com/shuTwT/hoshikuzu/internal/services/system/user"
+	"github.com/shuTwT/hoshikuzu/pkg/config"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	stateTTL      = 10 * time.Minute
+	stateSize     = 16
+	stateAudience = "hoshikuzu:social-state"
+	passwordSize  = 32
+)
+
+type SocialService interface {
+	ListProviders(ctx context.Context) ([]model.SocialProviderResp, error)
+	AuthCodeURL(ctx context.Context, name string, req model.SocialAuthorizeReq, userId int) (string, string, error)
+	Login(ctx context.Context, stateToken string, req model.SocialCallbackReq, userAgent, ip string) (*model.LoginResp, error)
+	Link(ctx context.Context, userId int, stateToken string, req model.SocialCallbackReq) (*ent.UserIdentity, error)
+	ListIdentities(ctx context.Context, userId int) ([]model.UserIdentityResp, error)
+	Unlink(ctx context.Context, userId, id int) error
+}
+
+type SocialServiceImpl struct {
+	client         *ent.Client
+	settingService setting_service.SettingService
+	authService    auth_service.AuthService
+	userService    user_service.UserService
+}
+
+func NewSocialServiceImpl(client *ent.Client, settingService setting_service.SettingService, authService auth_service.AuthService, userService user_service.UserService) *SocialServiceImpl {
+	return &SocialServiceImpl{client: client, settingService: settingService, authService: authService, userService: userService}
+}
+
+// providerConfig 社交登录设置中的单个登录方式
+type providerConfig struct {
+	// 登录方式标识，关联身份后不应修改
+	Name string `json:"name"`
+	// 提供方类型 github、oidc
+	Type         string `json:"type"`
+	DisplayName  string `json:"displayName"`
+	Enabled      bool   `json:"enabled"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	// OIDC 签发者地址
+	Issuer string `json:"issuer"`
+	// 空格分隔的权限，为空时使用提供方的默认权限
+	Scopes string `json:"scopes"`
+	// GitHub Enterprise 实例地址与接口地址，为空时使用 github.com
+	BaseURL string `json:"baseUrl"`
+	APIURL  string `json:"apiUrl"`
+	// 第三方账号首次登录且未关联用户时自动创建用户
+	AutoRegister bool `json:"autoRegister"`
+}
+
+// socialLoginSettings 对应系统设置中 key='social_login' 的 JSON 配置
+type socialLoginSettings struct {
+	Providers []providerConfig `json:"providers"`
+	// 自动创建用户使用的角色标识，为空时使用普通用户角色
+	DefaultRole string `json:"defaultRole"`
+}
+
+// stateClaims 授权请求上下文，签名后保存在 HttpOnly Cookie 中，回调时校验
+type stateClaims struct {
+	jwt.RegisteredClaims
+	Provider string `json:"provider"`
+	// 绑定第三方账号的用户，登录时为 0
+	UserID      int    `json:"uid,omitempty"`
+	State       string `json:"state"`
+	Nonce       string `json:"nonce"`
+	Verifier    string `json:"verifier"`
+	RedirectURI string `json:"redirect_uri"`
+}
+
+// loadSettings 读取社交登录设置，未配置时返回空设置
+func (s *SocialServiceImpl) loadSettings(ctx context.Context) (*socialLoginSettings, error) {
+	setting, err := s.settingService.GetSettingByKey(ctx, model.SettingKeySocialLogin)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &socialLoginSettings{}, nil
+		}
+		return nil, err
+	}
+	var ss socialLoginSettings
+	if err := json.Unmarshal([]byte(setting.Value), &ss); err != nil {
+		return nil, fmt.Errorf("解析社交登录配置失败: %w", err)
+	}
+	return &ss, nil
+}
+
+// loadProvider 按标识获取已启用的登录方式，每次调用按需读取以保证设置修改即时生效
+func (s *SocialServiceImpl) loadProvider(ctx context.Context, name string) (*socialLoginSettings, *providerConfig, social_infra.Provider, error) {
+	ss, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for i := range ss.Providers {
+		cfg := &ss.Providers[i]
+		if cfg.Name != name || !cfg.Enabled {
+			continue
+		}
+		provider, err := newProvider(cfg)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return ss, cfg, provider, nil
+	}
+	return nil, nil, nil, fmt.Errorf("登录方式 %s 未启用", name)
+}
+
+func newProvider(cfg *providerConfig) (social_infra.Provider, error) {
+	scopes := strings.Fields(cfg.Scopes)
+	switch cfg.Type {
+	case model.SocialProviderGitHub:
+		return github.NewClient(github.Config{
+			Name:         cfg.Name,
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			Scopes:       scopes,
+			BaseURL:      cfg.BaseURL,
+			APIURL:       cfg.APIURL,
+		}), nil
+	case model.SocialProviderOIDC:
+		return oidc.NewClient(oidc.Config{
+			Name:         cfg.Name,
+			Issuer:       cfg.Issuer,
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			Scopes:       scopes,
+		}), nil
+	}
+	return nil, fmt.Errorf("不支持的登录方式类型 %s", cfg.Type)
+}
+
+// ListProviders 列出已启用的登录方式，不包含客户端凭据
+func (s *SocialServiceImpl) ListProviders(ctx context.Context) ([]model.SocialProviderResp, error) {
+	ss, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	providers := make([]model.SocialProviderResp, 0, len(ss.Providers))
+	for _, cfg := range ss.Providers {
+		if !cfg.Enabled {
+			continue
+		}
+		providers = append(providers, model.SocialProviderResp{
+			Name:        cfg.Name,
+			DisplayName: displayName(&cfg),
+			Type:        cfg.Type,
+		})
+	}
+	return providers, nil
+}
+
+// AuthCodeURL 生成第三方授权页地址与签名的授权上下文，userId 不为 0 时为绑定第三方账号
+func (s *SocialServiceImpl) AuthCodeURL(ctx context.Context, name string, req model.SocialAuthorizeReq, userId int) (string, string, error) {
+	redirect, err := url.Parse(req.RedirectURI)
+	if err != nil || (redirect.Scheme != "https" && redirect.Scheme != "http") || redirect.Host == "" || redirect.Fragment != "" {
+		return "", "", fmt.Errorf("无效的回调地址")
+	}
+	_, _, provider, err := s.loadProvider(ctx, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	claims := stateClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{stateAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(stateTTL)),
+		},
+		Provider:    name,
+		UserID:      userId,
+		RedirectURI: req.RedirectURI,
+	}
+	for _, v := range []*string{&claims.State, &claims.Nonce, &claims.Verifier} {
+		if *v, err = utils.GenerateToken("", stateSize); err != nil {
+			return "", "", err
+		}
+	}
+	authURL, err := provider.AuthCodeURL(ctx, &social_infra.AuthCodeReq{
+		RedirectURI:   req.RedirectURI,
+		State:         claims.State,
+		Nonce:         claims.Nonce,
+		CodeChallenge: social_infra.CodeChallenge(claims.Verifier),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	stateToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(stateKey())
+	if err != nil {
+		return "", "", err
+	}
+	return authURL, stateToken, nil
+}
+
+// exchange 校验授权上下文后使用授权码换取第三方账号身份
+func (s *SocialServiceImpl) exchange(ctx context.Context, stateToken string, req model.SocialCallbackReq, userId int) (*socialLoginSettings, *providerConfig, *social_infra.Identity, error) {
+	var claims stateClaims
+	_, err := jwt.ParseWithClaims(stateToken, &claims, func(t *jwt.Token) (any, error) {
+		return stateKey(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithAudience(stateAudience), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, nil, nil, errors.New("授权已过期，请重新发起")
+	}
+	if req.State == "" || claims.State != req.State {
+		return nil, nil, nil, errors.New("授权 state 不匹配，请重新发起")
+	}
+	if claims.UserID != userId {
+		return nil, nil, nil, errors.New("授权请求与当前用户不匹配")
+	}
+	ss, cfg, provider, err := s.loadProvider(ctx, claims.Provider)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	identity, err := provider.Exchange(ctx, &social_infra.ExchangeReq{
+		Code:         req.Code,
+		RedirectURI:  claims.RedirectURI,
+		Nonce:        claims.Nonce,
+		CodeVerifier: claims.Verifier,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if identity.Subject == "" {
+		return nil, nil, nil, errors.New("第三方账号缺少唯一标识")
+	}
+	return ss, cfg, identity, nil
+}
+
+// Login 第三方登录，已关联的账号直接登录，未关联时按设置使用已验证的邮箱自动创建用户
+func (s *SocialServiceImpl) Login(ctx context.Context, stateToken string, req model.SocialCallbackReq, userAgent, ip string) (*model.LoginResp, error) {
+	ss, cfg, identity, err := s.exchange(ctx, stateToken, req, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ui, err := s.client.UserIdentity.Query().
+		Where(useridentity.Provider(cfg.Name), useridentity.Subject(identity.Subject)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if ui == nil {
+		if ui, err = s.provision(ctx, ss, cfg, identity); err != nil {
+			return nil, err
+		}
+	}
+	if err := updateIdentity(ctx, s.client.UserIdentity.UpdateOne(ui), identity); err != nil {
+		return nil, err
+	}
+
+	u, err := s.client.User.Get(ctx, ui.UserID)
+	if err != nil {
+		return nil, errors.New("用户不存在")
+	}
+	return s.authService.LoginUser(ctx, u, userAgent, ip)
+}
+
+// provision 为首次登录的第三方账号创建用户，邮箱已被注册时不自动关联，避免通过第三方账号接管本地账号
+func (s *SocialServiceImpl) provision(ctx context.Context, ss *socialLoginSettings, cfg *providerConfig, identity *social_infra.Identity) (*ent.UserIdentity, error) {
+	if !cfg.AutoRegister {
+		return nil, errors.New("该第三方账号未关联本站账号，请登录后在个人中心绑定")
+	}
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, errors.New("第三方账号未提供已验证的邮箱，无法自动注册")
+	}
+	exists, err := s.client.User.Query().Where(user.EmailEQ(identity.Email)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("邮箱 %s 已注册，请使用该账号登录后在个人中心绑定", identity.Email)
+	}
+
+	roleCode := ss.DefaultRole
+	if roleCode == "" {
+		roleCode = model.RoleCodeCommon
+	}
+	r, err := s.client.Role.Query().Where(role.CodeEQ(roleCode)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("默认角色 %s 不存在", roleCode)
+	}
+	// 第三方登录创建的用户没有可用密码，使用随机密码占位
+	password, err := utils.GenerateToken("", passwordSize)
+	if err != nil {
+		return nil, err
+	}
+	name := identity.Name
+	if name == "" {
+		name = identity.Email
+	}
+	// 用户、邮箱验证状态与第三方身份一起提交，避免失败时留下无法登录的半成品账号
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.userService.CreateUserTx(ctx, tx, model.UserCreateReq{
+		Email:    identity.Email,
+		Name:     limit(name, 255),
+		Password: password,
+		RoleID:   r.ID,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.User.UpdateOne(u).SetEmailVerified(true).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	linked, err := tx.UserIdentity.Create().
+		SetUserID(u.ID).
+		SetProvider(cfg.Name).
+		SetSubject(identity.Subject).
+		SetProvisioned(true).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	event.Publish(ctx, event.UserRegistered, model.UserEventData{
+		ID:       u.ID,
+		Email:    u.Email,
+		Name:     u.Name,
+		Nickname: u.Nickname,
+		Source:   "social",
+	})
+	return linked, nil
+}
+
+// Link 为当前用户绑定第三方账号，同一登录方式只能绑定一个账号
+func (s *SocialServiceImpl) Link(ctx context.Context, userId int, stateToken string, req model.SocialCallbackReq) (*ent.UserIdentity, error) {
+	_, cfg, identity, err := s.exchange(ctx, stateToken, req, userId)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.client.UserIdentity.Query().
+		Where(useridentity.Provider(cfg.Name), useridentity.Subject(identity.Subject)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil && existing.UserID != userId {
+		return nil, errors.New("该第三方账号已绑定其他用户")
+	}
+	if existing == nil {
+		bound, err := s.client.UserIdentity.Query().
+			Where(useridentity.UserID(userId), useridentity.Provider(cfg.Name)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if bound {
+			return nil, fmt.Errorf("已绑定%s账号，请先解绑", displayName(cfg))
+		}
+		if existing, err = s.client.UserIdentity.Create().
+			SetUserID(userId).
+			SetProvider(cfg.Name).
+			SetSubject(identity.Subject).
+			Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	update := s.client.UserIdentity.UpdateOne(existing)
+	if err := updateIdentity(ctx, update, identity); err != nil {
+		return nil, err
+	}
+	return s.client.UserIdentity.Get(ctx, existing.ID)
+}
+
+// ListIdentities 列出当前用户绑定的第三方账号
+func (s *SocialServiceImpl) ListIdentities(ctx context.Context, userId int) ([]model.UserIdentityResp, error) {
+	identities, err := s.client.UserIdentity.Query().
+		Where(useridentity.UserID(userId)).
+		Order(ent.Asc(useridentity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ss, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(ss.Providers))
+	for _, cfg := range ss.Providers {
+		names[cfg.Name] = displayName(&cfg)
+	}
+	resp := make([]model.UserIdentityResp, 0, len(identities))
+	for _, ui := range identities {
+		item := model.UserIdentityResp{
+			ID:           ui.ID,
+			Provider:     ui.Provider,
+			ProviderName: ui.Provider,
+			Email:        ui.Email,
+			Name:         ui.Name,
+			Avatar:       ui.Avatar,
+			CreatedAt:    model.ParseTime(ui.CreatedAt),
+		}
+		if name, ok := names[ui.Provider]; ok {
+			item.ProviderName = name
+		}
+		if ui.LastLoginAt != nil {
+			item.LastLoginAt = model.ParseTime(*ui.LastLoginAt)
+		}
+		resp = append(resp, item)
+	}
+	return resp, nil
+}
+
+// Unlink 解绑当前用户的第三方账号，通过第三方登录创建的用户不能解绑唯一的第三方账号
+func (s *SocialServiceImpl) Unlink(ctx context.Context, userId, id int) error {
+	ui, err := s.client.UserIdentity.Query().
+		Where(useridentity.ID(id), useridentity.UserID(userId)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("第三方账号不存在")
+		}
+		return err
+	}
+	count, err := s.client.UserIdentity.Query().Where(useridentity.UserID(userId)).Count(ctx)
+	if err != nil {
+		return err
+	}
+	if ui.Provisioned && count == 1 {
+		return errors.New("账号通过该第三方账号注册且没有其他登录方式，不能解绑")
+	}
+	return s.client.UserIdentity.DeleteOne(ui).Exec(ctx)
+}
+
+// updateIdentity 记录第三方账号的最新资料与登录时间
+func updateIdentity(ctx context.Context, update *ent.UserIdentityUpdateOne, identity *social_infra.Identity) error {
+	return update.
+		SetEmail(limit(identity.Email, 255)).
+		SetName(limit(identity.Name, 255)).
+		SetAvatar(limit(identity.Avatar, 1024)).
+		SetLastLoginAt(time.Now()).
+		Exec(ctx)
+}
+
+// stateKey 授权上下文签名密钥，由登录令牌密钥派生，避免与登录令牌互相冒用
+func stateKey() []byte {
+	sum := sha256.Sum256([]byte("social-state:" + config.GetString(config.AUTH_TOKEN_SECRET)))
+	return sum[:]
+}
+
+func displayName(cfg *providerConfig) string {
+	if cfg.DisplayName != "" {
+		return cfg.DisplayName
+	}
+	return cfg.Name
+}
+
+// limit 超出字段长度的第三方资料不记录
+func limit(s string, n int) string {
+	if len(s) > n {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,285 @@
+package twofactor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/internal/infra/totp"
+	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet 去掉了容易混淆的 0/o、1/l/i
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	defaultIssuer        = "Hoshikuzu"
+)
+
+var (
+	ErrInvalidCode = errors.New("验证码错误或已使用")
+	ErrNotEnabled  = errors.New("尚未启用两步验证")
+)
+
+type TwoFactorService interface {
+	Status(ctx context.Context, userId int) (*model.TwoFactorStatusResp, error)
+	Setup(ctx context.Context, userId int) (*model.TwoFactorSetupResp, error)
+	Enable(ctx context.Context, userId int, code string) ([]string, error)
+	Disable(ctx context.Context, userId int, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userId int, code string) ([]string, error)
+	Reset(ctx context.Context, userId int) error
+	Verify(ctx context.Context, u *ent.User, code string) error
+	Required(ctx context.Context, u *ent.User) (bool, error)
+}
+
+type TwoFactorServiceImpl struct {
+	client         *ent.Client
+	settingService setting_service.SettingService
+}
+
+func NewTwoFactorServiceImpl(client *ent.Client, settingService setting_service.SettingService) *TwoFactorServiceImpl {
+	return &TwoFactorServiceImpl{client: client, settingService: settingService}
+}
+
+func (s *TwoFactorServiceImpl) Status(ctx context.Context, userId int) (*model.TwoFactorStatusResp, error) {
+	u, err := s.client.User.Get(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.Required(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return &model.TwoFactorStatusResp{
+		Enabled:                u.TotpEnabled,
+		Required:               required,
+		RecoveryCodesRemaining: len(u.TotpRecoveryCodes),
+	}, nil
+}
+
+// Setup 生成待验证的密钥，使用验证器应用中的验证码调用 Enable 后生效
+func (s *TwoFactorServiceImpl) Setup(ctx context.Context, userId int) (*model.TwoFactorSetupResp, error) {
+	u, err := s.client.User.Get(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabled {
+		return nil, errors.New("已启用两步验证，如需更换设备请先关闭")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.User.UpdateOne(u).
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &model.TwoFactorSetupResp{
+		Secret:     secret,
+		OtpauthURI: totp.URI(s.issuer(ctx), u.Email, secret),
+	}, nil
+}
+
+// Enable 校验待验证密钥的验证码后启用两步验证，返回新生成的恢复码
+func (s *TwoFactorServiceImpl) Enable(ctx context.Context, userId int, code string) ([]string, error) {
+	u, err := s.client.User.Get(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabled {
+		return nil, errors.New("已启用两步验证")
+	}
+	if u.TotpSecret == "" {
+		return nil, errors.New("请先获取密钥并添加到验证器应用")
+	}
+	step, ok := totp.Validate(u.TotpSecret, code, time.Now(), u.TotpLastStep)
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.client.User.Update().
+		Where(user.ID(u.ID), user.TotpEnabled(false)).
+		SetTotpEnabled(true).
+		SetTotpLastStep(step).
+		SetTotpRecoveryCodes(hashes).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errors.New("已启用两步验证")
+	}
+	return codes, nil
+}
+
+// Disable 校验验证码后关闭两步验证，角色要求两步验证时不能关闭
+func (s *TwoFactorServiceImpl) Disable(ctx context.Context, userId int, code string) error {
+	u, err := s.client.User.Get(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !u.TotpEnabled {
+		return ErrNotEnabled
+	}
+	required, err := s.Required(ctx, u)
+	if err != nil {
+		return err
+	}
+	if required {
+		return errors.New("当前角色要求启用两步验证，不能关闭")
+	}
+	if err := s.Verify(ctx, u, code); err != nil {
+		return err
+	}
+	return s.Reset(ctx, u.ID)
+}
+
+// RegenerateRecoveryCodes 校验验证码后重新生成恢复码，旧恢复码全部失效
+func (s *TwoFactorServiceImpl) RegenerateRecoveryCodes(ctx context.Context, userId int, code string) ([]string, error) {
+	u, err := s.client.User.Get(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !u.TotpEnabled {
+		return nil, ErrNotEnabled
+	}
+	if err := s.Verify(ctx, u, code); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.User.UpdateOneID(u.ID).SetTotpRecoveryCodes(hashes).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Reset 清除两步验证，用于用户关闭或管理员为丢失设备的用户重置
+func (s *TwoFactorServiceImpl) Reset(ctx context.Context, userId int) error {
+	return s.client.User.UpdateOneID(userId).
+		ClearTotpSecret().
+		SetTotpEnabled(false).
+		SetTotpLastStep(0).
+		ClearTotpRecoveryCodes().
+		Exec(ctx)
+}
+
+// Verify 校验 TOTP 验证码或恢复码，验证码与恢复码都只能使用一次
+func (s *TwoFactorServiceImpl) Verify(ctx context.Context, u *ent.User, code string) error {
+	if !u.TotpEnabled {
+		return ErrNotEnabled
+	}
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) == recoveryCodeLength {
+		return s.useRecoveryCode(ctx, u.ID, normalized)
+	}
+	step, ok := totp.Validate(u.TotpSecret, code, time.Now(), u.TotpLastStep)
+	if !ok {
+		return ErrInvalidCode
+	}
+	// 条件更新保证同一验证码在并发请求中也只能使用一次
+	n, err := s.client.User.Update().
+		Where(user.ID(u.ID), user.TotpLastStepLT(step)).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+// Required 用户角色是否要求两步验证
+func (s *TwoFactorServiceImpl) Required(ctx context.Context, u *ent.User) (bool, error) {
+	r, err := u.QueryRole().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return r.RequireTwoFactor, nil
+}
+
+func (s *TwoFactorServiceImpl) useRecoveryCode(ctx context.Context, userId int, code string) error {
+	hash := utils.HashToken(code)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	u, err := tx.User.Get(ctx, userId)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	i := slices.Index(u.TotpRecoveryCodes, hash)
+	if i < 0 {
+		_ = tx.Rollback()
+		return ErrInvalidCode
+	}
+	remaining := slices.Delete(slices.Clone(u.TotpRecoveryCodes), i, i+1)
+	if err := tx.User.UpdateOne(u).SetTotpRecoveryCodes(remaining).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// issuer 验证器应用中显示的站点名称
+func (s *TwoFactorServiceImpl) issuer(ctx context.Context) string {
+	setting, err := s.settingService.GetSettingByKey(ctx, model.SettingKeyBasic)
+	if err != nil {
+		return defaultIssuer
+	}
+	var basic struct {
+		SiteName string `json:"siteName"`
+	}
+	if err := json.Unmarshal([]byte(setting.Value), &basic); err != nil || basic.SiteName == "" {
+		return defaultIssuer
+	}
+	return basic.SiteName
+}
+
+// generateRecoveryCodes 生成恢复码明文与哈希，明文以 xxxxx-xxxxx 形式展示
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for range recoveryCodeCount {
+		b := make([]byte, recoveryCodeLength)
+		for i := range b {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, nil, err
+			}
+			b[i] = recoveryCodeAlphabet[n.Int64()]
+		}
+		raw := string(b)
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+		hashes = append(hashes, utils.HashToken(raw))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+}
@@ -0,0 +1,51 @@
+package twofactor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+)
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("got %d codes, %d hashes", len(codes), len(hashes))
+	}
+	seen := map[string]bool{}
+	for i, code := range codes {
+		if len(code) != recoveryCodeLength+1 || code[5] != '-' {
+			t.Fatalf("unexpected format: %q", code)
+		}
+		normalized := normalizeRecoveryCode(code)
+		if strings.Trim(normalized, recoveryCodeAlphabet) != "" {
+			t.Fatalf("unexpected characters: %q", code)
+		}
+		if hashes[i] != utils.HashToken(normalized) {
+			t.Fatalf("hash mismatch for %q", code)
+		}
+		if seen[code] {
+			t.Fatalf("duplicate code %q", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"abcde-fghjk", "abcdefghjk"},
+		{" ABCDE-FGHJK ", "abcdefghjk"},
+		{"abcde fghjk", "abcdefghjk"},
+		{"123456", "123456"},
+	}
+	for _, tt := range tests {
+		if got := normalizeRecoveryCode(tt.in); got != tt.want {
+			t.Errorf("normalizeRecoveryCode(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
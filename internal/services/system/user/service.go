@@ -9,27 +9,38 @@ import (
 	"github.com/shuTwT/hoshikuzu/ent"
 	"github.com/shuTwT/hoshikuzu/ent/personalaccesstoken"
 	"github.com/shuTwT/hoshikuzu/ent/user"
+	"github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
 	"github.com/shuTwT/hoshikuzu/pkg/cache"
-	"github.com/shuTwT/hoshikuzu/pkg/config"
 	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	// patTokenSize 个人访问令牌随机部分的字节数
+	patTokenSize = 24
+	// patPrefixLen 个人访问令牌展示前缀的长度
+	patPrefixLen = 12
+)
+
 type UserService interface {
 	ListUser(c *fiber.Ctx) ([]*ent.User, error)
 	ListUserPage(c *fiber.Ctx, pageQuery model.PageQuery) (int, []*ent.User, error)
 	QueryUserById(ctx context.Context, id int) (*ent.User, error)
 	CreateUser(ctx context.Context, req model.UserCreateReq) (*ent.User, error)
+	// CreateUserTx 在调用方的事务内创建用户及其钱包、会员，便于与其他写入一起提交
+	CreateUserTx(ctx context.Context, tx *ent.Tx, req model.UserCreateReq) (*ent.User, error)
 	UpdateUser(ctx context.Context, id int, req model.UserUpdateReq) (*ent.User, error)
 	UpdateProfile(ctx context.Context, userId int, req model.UpdateProfileReq) error
 	GetUserCount(ctx context.Context) (int, error)
 	DeleteUser(ctx context.Context, id int) error
 	GetPersonalAccessTokenList(ctx context.Context, userId int) ([]*ent.PersonalAccessToken, error)
 	GetPersonalAccessToken(ctx context.Context, userId int, id int) (*ent.PersonalAccessToken, error)
-	CreatePersonalAccessToken(ctx context.Context, id int, req model.PersonalAccessTokenCreateReq) (*ent.PersonalAccessToken, error)
+	CreatePersonalAccessToken(ctx context.Context, id int, req model.PersonalAccessTokenCreateReq) (*ent.PersonalAccessToken, string, error)
+	DeletePersonalAccessToken(ctx context.Context, userId int, id int) error
+	HashLegacyPersonalAccessTokens(ctx context.Context) error
 	SearchUsers(ctx context.Context, req model.UserSearchReq) ([]*model.UserSearchResp, int, error)
 }
 
@@ -88,9 +99,36 @@ func (s *UserServiceImpl) QueryUserById(ctx context.Context, id int) (*ent.User,
 }
 
 func (s *UserServiceImpl) CreateUser(ctx context.Context, req model.UserCreateReq) (*ent.User, error) {
+	// 使用事务创建用户和钱包
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	createdUser, err := s.CreateUserTx(ctx, tx, req)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// 提交事务
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return createdUser, nil
+}
+
+func (s *UserServiceImpl) CreateUserTx(ctx context.Context, tx *ent.Tx, req model.UserCreateReq) (*ent.User, error) {
 	// 检查邮箱是否已存在
-	exists, err := s.client.User.Query().
+	exists, err := tx.User.Query().
 		Where(user.EmailEQ(req.Email)).
 		Exist(ctx)
 	if err != nil {
@@ -102,7 +140,7 @@ func (s *UserServiceImpl) CreateUser(ctx context.Context, req model.UserCreateRe
 
 	// 如果提供了手机号，检查手机号是否已存在
 	if req.PhoneNumber != "" {
-		exists, err = s.client.User.Query().
+		exists, err = tx.User.Query().
 			Where(user.PhoneNumberEQ(req.PhoneNumber)).
 			Exist(ctx)
 		if err != nil {
@@ -118,56 +156,34 @@ func (s *UserServiceImpl) CreateUser(ctx context.Context, req model.UserCreateRe
 		return nil, err
 	}
 
-	// 使用事务创建用户和钱包
-	tx, err := s.client.Tx(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		if p := recover(); p != nil {
-			_ = tx.Rollback()
-			panic(p)
-		}
-	}()
-
-	// 创建用户
-	createdUser, err := tx.User.Create().
+	// 创建用户，未填写手机号时不写入空字符串，避免与手机号唯一索引冲突
+	create := tx.User.Create().
 		SetName(req.Name).
 		SetPassword(string(hashedPassword)).
-		SetPhoneNumber(req.PhoneNumber).
 		SetEmail(req.Email).
-		SetRoleID(req.RoleID).
-		Save(ctx)
-
+		SetRoleID(req.RoleID)
+	if req.PhoneNumber != "" {
+		create.SetPhoneNumber(req.PhoneNumber)
+	}
+	createdUser, err := create.Save(ctx)
 	if err != nil {
-		_ = tx.Rollback()
 		return nil, err
 	}
 
 	// 为用户创建钱包
-	_, err = tx.Wallet.Create().
+	if _, err := tx.Wallet.Create().
 		SetUserID(createdUser.ID).
-		Save(ctx)
-	if err != nil {
-		_ = tx.Rollback()
+		Save(ctx); err != nil {
 		return nil, err
 	}
 
 	// 为用户创建会员
 	memberNo := fmt.Sprintf("M%06d", createdUser.ID)
-	_, err = tx.Member.Create().
+	if _, err := tx.Member.Create().
 		SetUserID(createdUser.ID).
 		SetMemberLevel(1).
 		SetMemberNo(memberNo).
-		Save(ctx)
-	if err != nil {
-		_ = tx.Rollback()
-		return nil, err
-	}
-
-	// 提交事务
-	if err = tx.Commit(); err != nil {
+		Save(ctx); err != nil {
 		return nil, err
 	}
 
@@ -251,7 +267,10 @@ func (s *UserServiceImpl) DeleteUser(ctx context.Context, id int) error {
 }
 
 func (s *UserServiceImpl) GetPersonalAccessTokenList(ctx context.Context, userId int) ([]*ent.PersonalAccessToken, error) {
-	tokens, err := s.client.PersonalAccessToken.Query().Where(personalaccesstoken.UserID(userId)).All(ctx)
+	tokens, err := s.client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.UserID(userId)).
+		Order(ent.Desc(personalaccesstoken.FieldID)).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -266,36 +285,95 @@ func (s *UserServiceImpl) GetPersonalAccessToken(ctx context.Context, userId int
 	return token, nil
 }
 
-func (s *UserServiceImpl) CreatePersonalAccessToken(ctx context.Context, userId int, req model.PersonalAccessTokenCreateReq) (*ent.PersonalAccessToken, error) {
-	u, _ := s.client.User.Query().
+// CreatePersonalAccessToken 创建个人访问令牌，返回的明文令牌只在创建时可见，库中仅保存哈希与前缀
+func (s *UserServiceImpl) CreatePersonalAccessToken(ctx context.Context, userId int, req model.PersonalAccessTokenCreateReq) (*ent.PersonalAccessToken, string, error) {
+	u, err := s.client.User.Query().
 		Where(user.IDEQ(userId)).
+		WithRole().
 		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
 
-	claims := jwt.MapClaims{
-		"id":    u.ID,
-		"email": u.Email,
-		"name":  u.Name,
-		"exp":   req.Expires,
+	if len(req.Scopes) == 0 {
+		return nil, "", fmt.Errorf("请至少选择一个权限")
+	}
+	if err := permission.ValidateScopes(req.Scopes); err != nil {
+		return nil, "", err
+	}
+	var roleScopes []string
+	if u.Edges.Role != nil {
+		roleScopes = u.Edges.Role.Permissions
+	}
+	for _, scope := range req.Scopes {
+		if !permission.HasScope(roleScopes, scope) {
+			return nil, "", fmt.Errorf("令牌权限不能超出当前角色的权限: %s", scope)
+		}
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	secret := config.GetString(config.AUTH_PAT_SECRET)
+	expires := req.Expires.Time()
+	if expires.UnixMilli() > 0 && !expires.After(time.Now()) {
+		return nil, "", fmt.Errorf("过期时间必须晚于当前时间")
+	}
 
-	t, err := token.SignedString([]byte(secret))
+	raw, err := utils.GenerateToken(model.PersonalAccessTokenPrefix, patTokenSize)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	pat, err := s.client.PersonalAccessToken.Create().
+	create := s.client.PersonalAccessToken.Create().
 		SetName(req.Name).
 		SetDescription(req.Description).
-		SetExpires(req.Expires.Time()).
-		SetToken(t).
-		SetUserID(userId).Save(ctx)
+		SetTokenHash(utils.HashToken(raw)).
+		SetTokenPrefix(raw[:patPrefixLen]).
+		SetScopes(req.Scopes).
+		SetUserID(userId)
+	if expires.UnixMilli() > 0 {
+		create.SetExpires(expires)
+	}
+	pat, err := create.Save(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	return pat, raw, nil
+}
+
+// DeletePersonalAccessToken 吊销当前用户的个人访问令牌
+func (s *UserServiceImpl) DeletePersonalAccessToken(ctx context.Context, userId int, id int) error {
+	n, err := s.client.PersonalAccessToken.Delete().
+		Where(personalaccesstoken.UserIDEQ(userId), personalaccesstoken.IDEQ(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("令牌不存在")
+	}
+	return nil
+}
+
+// HashLegacyPersonalAccessTokens 将旧版明文保存的令牌迁移为哈希，旧令牌仍可继续使用
+func (s *UserServiceImpl) HashLegacyPersonalAccessTokens(ctx context.Context) error {
+	tokens, err := s.client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.TokenNEQ(""), personalaccesstoken.TokenHashIsNil()).
+		All(ctx)
+	if err != nil {
+		return err
 	}
-	return pat, nil
+	for _, t := range tokens {
+		prefix := t.Token
+		if len(prefix) > patPrefixLen {
+			prefix = prefix[:patPrefixLen]
+		}
+		if err := s.client.PersonalAccessToken.UpdateOne(t).
+			SetTokenHash(utils.HashToken(t.Token)).
+			SetTokenPrefix(prefix).
+			SetToken("").
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *UserServiceImpl) SearchUsers(ctx context.Context, req model.UserSearchReq) ([]*model.UserSearchResp, int, error) {
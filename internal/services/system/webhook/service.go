@@ -0,0 +1,416 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/ent/webhook"
+	"github.com/shuTwT/hoshikuzu/ent/webhookdelivery"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
+	"github.com/shuTwT/hoshikuzu/pkg/domain/model"
+	"github.com/shuTwT/hoshikuzu/pkg/utils"
+)
+
+const (
+	secretPrefix = "whsec_"
+	secretSize   = 24
+	// deliveryTimeout 单次投递的超时时间
+	deliveryTimeout = 10 * time.Second
+	// claimLease 投递中的记录暂不参与重试扫描的时长，进程中断后到期的记录会被重新投递
+	claimLease = 2 * time.Minute
+	// retryInterval 重试扫描周期
+	retryInterval  = 30 * time.Second
+	retryBatchSize = 50
+	// maxResponseBody 记录的响应内容上限
+	maxResponseBody = 2048
+)
+
+// retryBackoff 第 N 次投递失败后等待 retryBackoff[N-1] 再重试，全部用完后标记为失败
+var retryBackoff = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	6 * time.Hour,
+}
+
+type WebHookService interface {
+	QueryWebHookPage(ctx context.Context, req model.PageQuery) ([]*ent.WebHook, int, error)
+	QueryWebHook(ctx context.Context, id int) (*ent.WebHook, error)
+	CreateWebHook(ctx context.Context, req model.WebHookCreateReq) (*ent.WebHook, string, error)
+	UpdateWebHook(ctx context.Context, id int, req model.WebHookUpdateReq) (*ent.WebHook, error)
+	DeleteWebHook(ctx context.Context, id int) error
+	ResetWebHookSecret(ctx context.Context, id int) (*ent.WebHook, string, error)
+
+	QueryDeliveryPage(ctx context.Context, webhookId int, req model.WebHookDeliveryPageQuery) ([]*ent.WebHookDelivery, int, error)
+	Redeliver(ctx context.Context, deliveryId int) (*ent.WebHookDelivery, error)
+	SendTestEvent(ctx context.Context, id int) (*ent.WebHookDelivery, error)
+	HandleEvent(ctx context.Context, e event.Event) error
+	RetryDueDeliveries(ctx context.Context) error
+	StartRetryWorker(ctx context.Context)
+}
+
+type WebHookServiceImpl struct {
+	client     *ent.Client
+	httpClient *http.Client
+}
+
+func NewWebHookServiceImpl(client *ent.Client) *WebHookServiceImpl {
+	return &WebHookServiceImpl{
+		client: client,
+		httpClient: &http.Client{
+			Timeout: deliveryTimeout,
+			// 重定向视为投递失败，避免 POST 被改写为 GET
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *WebHookServiceImpl) QueryWebHookPage(ctx context.Context, req model.PageQuery) ([]*ent.WebHook, int, error) {
+	count, err := s.client.WebHook.Query().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	hooks, err := s.client.WebHook.Query().
+		Order(ent.Desc(webhook.FieldID)).
+		Limit(req.Size).
+		Offset((req.Page - 1) * req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return hooks, count, nil
+}
+
+func (s *WebHookServiceImpl) QueryWebHook(ctx context.Context, id int) (*ent.WebHook, error) {
+	return s.client.WebHook.Get(ctx, id)
+}
+
+// CreateWebHook 创建 Webhook 并生成签名密钥
+func (s *WebHookServiceImpl) CreateWebHook(ctx context.Context, req model.WebHookCreateReq) (*ent.WebHook, string, error) {
+	if err := validateWebHook(req.URL, req.Events); err != nil {
+		return nil, "", err
+	}
+	secret, err := utils.GenerateToken(secretPrefix, secretSize)
+	if err != nil {
+		return nil, "", err
+	}
+	hook, err := s.client.WebHook.Create().
+		SetName(req.Name).
+		SetURL(req.URL).
+		SetEvents(req.Events).
+		SetSecret(secret).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return hook, secret, nil
+}
+
+// UpdateWebHook 更新 Webhook，停用期间待重试的投递暂停，重新启用后继续
+func (s *WebHookServiceImpl) UpdateWebHook(ctx context.Context, id int, req model.WebHookUpdateReq) (*ent.WebHook, error) {
+	if err := validateWebHook(req.URL, req.Events); err != nil {
+		return nil, err
+	}
+	return s.client.WebHook.UpdateOneID(id).
+		SetName(req.Name).
+		SetURL(req.URL).
+		SetEvents(req.Events).
+		SetEnabled(req.Enabled).
+		Save(ctx)
+}
+
+// DeleteWebHook 删除 Webhook 及其投递记录
+func (s *WebHookServiceImpl) DeleteWebHook(ctx context.Context, id int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.WebHookDelivery.Delete().Where(webhookdelivery.WebhookID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.WebHook.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ResetWebHookSecret 重置签名密钥，旧密钥立即失效
+func (s *WebHookServiceImpl) ResetWebHookSecret(ctx context.Context, id int) (*ent.WebHook, string, error) {
+	secret, err := utils.GenerateToken(secretPrefix, secretSize)
+	if err != nil {
+		return nil, "", err
+	}
+	hook, err := s.client.WebHook.UpdateOneID(id).
+		SetSecret(secret).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return hook, secret, nil
+}
+
+func (s *WebHookServiceImpl) QueryDeliveryPage(ctx context.Context, webhookId int, req model.WebHookDeliveryPageQuery) ([]*ent.WebHookDelivery, int, error) {
+	query := s.client.WebHookDelivery.Query().Where(webhookdelivery.WebhookID(webhookId))
+	if req.Status != "" {
+		query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(req.Status)))
+	}
+	if req.Event != "" {
+		query.Where(webhookdelivery.Event(req.Event))
+	}
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Limit(req.Size).
+		Offset((req.Page - 1) * req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return deliveries, count, nil
+}
+
+// Redeliver 以相同的事件ID与请求体重新投递，立即投递一次并返回结果
+func (s *WebHookServiceImpl) Redeliver(ctx context.Context, deliveryId int) (*ent.WebHookDelivery, error) {
+	origin, err := s.client.WebHookDelivery.Get(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.client.WebHookDelivery.Create().
+		SetWebhookID(origin.WebhookID).
+		SetEvent(origin.Event).
+		SetEventID(origin.EventID).
+		SetPayload(origin.Payload).
+		SetNextRetryAt(time.Now().Add(claimLease)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.deliver(ctx, d)
+}
+
+// SendTestEvent 向 Webhook 发送 ping 测试事件，不受订阅与启用状态限制，失败不重试
+func (s *WebHookServiceImpl) SendTestEvent(ctx context.Context, id int) (*ent.WebHookDelivery, error) {
+	hook, err := s.client.WebHook.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	e := event.NewEvent(event.Ping, map[string]any{
+		"webhook_id": hook.ID,
+		"message":    "这是一条测试事件",
+	})
+	d, err := s.enqueue(ctx, hook, e)
+	if err != nil {
+		return nil, err
+	}
+	return s.deliver(ctx, d)
+}
+
+// HandleEvent 为订阅了该事件的 Webhook 写入投递记录并在后台投递
+func (s *WebHookServiceImpl) HandleEvent(ctx context.Context, e event.Event) error {
+	hooks, err := s.client.WebHook.Query().
+		Where(webhook.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, hook := range hooks {
+		if !slices.Contains(hook.Events, e.Name) {
+			continue
+		}
+		d, err := s.enqueue(ctx, hook, e)
+		if err != nil {
+			return err
+		}
+		go func() {
+			if _, err := s.deliver(context.Background(), d); err != nil {
+				slog.Error("投递 Webhook 失败", "webhook_id", d.WebhookID, "delivery_id", d.ID, "error", err.Error())
+			}
+		}()
+	}
+	return nil
+}
+
+// RetryDueDeliveries 投递到期的待重试记录，已停用的 Webhook 暂不重试
+func (s *WebHookServiceImpl) RetryDueDeliveries(ctx context.Context) error {
+	now := time.Now()
+	due, err := s.client.WebHookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextRetryAtLTE(now),
+			webhookdelivery.HasWebhookWith(webhook.Enabled(true)),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextRetryAt)).
+		Limit(retryBatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range due {
+		// 条件更新认领记录，避免与其他扫描或首次投递重复发送
+		n, err := s.client.WebHookDelivery.Update().
+			Where(
+				webhookdelivery.ID(d.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextRetryAtEQ(*d.NextRetryAt),
+			).
+			SetNextRetryAt(now.Add(claimLease)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+		if _, err := s.deliver(ctx, d); err != nil {
+			slog.Error("重试 Webhook 投递失败", "webhook_id", d.WebhookID, "delivery_id", d.ID, "error", err.Error())
+		}
+	}
+	return nil
+}
+
+// StartRetryWorker 启动投递重试协程，随宿主进程常驻
+func (s *WebHookServiceImpl) StartRetryWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(retryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.RetryDueDeliveries(ctx); err != nil {
+					slog.Error("扫描 Webhook 待重试投递失败", "error", err.Error())
+				}
+			}
+		}
+	}()
+}
+
+// enqueue 写入投递记录，首次投递完成前由认领时长保护，不会被重试扫描重复投递
+func (s *WebHookServiceImpl) enqueue(ctx context.Context, hook *ent.WebHook, e event.Event) (*ent.WebHookDelivery, error) {
+	payload, err := json.Marshal(model.WebHookPayload{
+		ID:        e.ID,
+		Event:     e.Name,
+		CreatedAt: e.OccurredAt,
+		Data:      e.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.WebHookDelivery.Create().
+		SetWebhookID(hook.ID).
+		SetEvent(e.Name).
+		SetEventID(e.ID).
+		SetPayload(string(payload)).
+		SetNextRetryAt(time.Now().Add(claimLease)).
+		Save(ctx)
+}
+
+// deliver 投递一次并记录结果，失败时按退避时间安排重试
+func (s *WebHookServiceImpl) deliver(ctx context.Context, d *ent.WebHookDelivery) (*ent.WebHookDelivery, error) {
+	hook, err := s.client.WebHook.Get(ctx, d.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	code, body, sendErr := s.send(ctx, hook, d)
+	attempts := d.Attempts + 1
+	update := s.client.WebHookDelivery.UpdateOne(d).
+		SetAttempts(attempts).
+		SetResponseCode(code).
+		SetResponseBody(body).
+		SetDuration(int(time.Since(start).Milliseconds())).
+		SetDeliveredAt(start)
+	if sendErr == nil {
+		update.SetStatus(webhookdelivery.StatusSuccess).SetError("").ClearNextRetryAt()
+	} else if delay, ok := retryDelay(attempts); ok && d.Event != event.Ping {
+		update.SetStatus(webhookdelivery.StatusPending).SetError(sendErr.Error()).SetNextRetryAt(time.Now().Add(delay))
+	} else {
+		update.SetStatus(webhookdelivery.StatusFailed).SetError(sendErr.Error()).ClearNextRetryAt()
+	}
+	return update.Save(ctx)
+}
+
+// send 发送签名请求，返回状态码与截断后的响应内容，非 2xx 响应视为失败
+func (s *WebHookServiceImpl) send(ctx context.Context, hook *ent.WebHook, d *ent.WebHookDelivery) (int, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	body := []byte(d.Payload)
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Hoshikuzu-Webhook/1.0")
+	req.Header.Set(model.WebHookHeaderEvent, d.Event)
+	req.Header.Set(model.WebHookHeaderDelivery, strconv.Itoa(d.ID))
+	req.Header.Set(model.WebHookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(model.WebHookHeaderSignature, Sign(hook.Secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, string(respBody), fmt.Errorf("响应状态码 %d", resp.StatusCode)
+	}
+	return resp.StatusCode, string(respBody), nil
+}
+
+// Sign 计算投递签名 sha256=HMAC-SHA256(密钥, 时间戳 + "." + 请求体)，接收方按相同方式校验
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryDelay 第 attempts 次投递失败后的重试等待时间，重试次数用完时返回 false
+func retryDelay(attempts int) (time.Duration, bool) {
+	if attempts < 1 || attempts > len(retryBackoff) {
+		return 0, false
+	}
+	return retryBackoff[attempts-1], true
+}
+
+// validateWebHook 回调地址必须是 http(s) 绝对地址，订阅的事件必须已定义
+func validateWebHook(rawURL string, events []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("无效的回调地址: %s", rawURL)
+	}
+	if len(events) == 0 {
+		return fmt.Errorf("请至少订阅一个事件")
+	}
+	for _, name := range events {
+		if !event.IsDefined(name) {
+			return fmt.Errorf("未定义的事件: %s", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"id":"evt_1"}' | openssl dgst -sha256 -hmac whsec_test
+	want := "sha256=c89214b5b5da833daed6f0b8c5bb6bd58cea9022bd80ccc78230f3942d632925"
+	got := Sign("whsec_test", 1700000000, []byte(`{"id":"evt_1"}`))
+	if got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+	if Sign("whsec_other", 1700000000, []byte(`{"id":"evt_1"}`)) == got {
+		t.Error("不同密钥的签名相同")
+	}
+	if Sign("whsec_test", 1700000001, []byte(`{"id":"evt_1"}`)) == got {
+		t.Error("不同时间戳的签名相同")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+		ok       bool
+	}{
+		{1, time.Minute, true},
+		{2, 5 * time.Minute, true},
+		{5, 6 * time.Hour, true},
+		{6, 0, false},
+		{0, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := retryDelay(tt.attempts)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("retryDelay(%d) = %v, %v, want %v, %v", tt.attempts, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestValidateWebHook(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		events  []string
+		wantErr bool
+	}{
+		{"有效", "https://example.com/hook", []string{"comment.created"}, false},
+		{"非 http 地址", "ftp://example.com/hook", []string{"comment.created"}, true},
+		{"相对地址", "/hook", []string{"comment.created"}, true},
+		{"未订阅事件", "https://example.com/hook", nil, true},
+		{"未定义的事件", "https://example.com/hook", []string{"post.archived"}, true},
+		{"测试事件不可订阅", "https://example.com/hook", []string{"ping"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateWebHook(tt.url, tt.events); (err != nil) != tt.wantErr {
+				t.Errorf("validateWebHook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
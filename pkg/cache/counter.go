@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Counter 带过期时间的计数器，启用 Redis 时多个实例共享计数
+type Counter interface {
+	// Incr 计数加一并返回新值，计数不存在或已过期时从 1 开始并设置过期时间
+	Incr(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// IncrBy 计数加 delta（可为负）并返回新值，过期规则同 Incr
+	IncrBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
+	// Get 返回计数与剩余有效期，不存在时返回 0
+	Get(ctx context.Context, key string) (int64, time.Duration, error)
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// NewCounter 传入 Redis 客户端时使用 Redis 计数，否则使用进程内缓存
+func NewCounter(rdb *redis.Client) Counter {
+	if rdb != nil {
+		return &redisCounter{rdb: rdb}
+	}
+	return &memoryCounter{cache: GetCache()}
+}
+
+type memoryCounter struct {
+	cache *MemoryCache
+}
+
+func (m *memoryCounter) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	return m.IncrBy(ctx, key, 1, ttl)
+}
+
+func (m *memoryCounter) IncrBy(_ context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	m.cache.mu.Lock()
+	defer m.cache.mu.Unlock()
+
+	now := time.Now()
+	item, found := m.cache.items[key]
+	n, _ := item.Value.(int64)
+	if !found || item.expired(now) {
+		n = 0
+		item.Expiration = now.Add(ttl).Unix()
+	}
+	n += delta
+	item.Value = n
+	m.cache.items[key] = item
+	return n, nil
+}
+
+func (m *memoryCounter) Get(_ context.Context, key string) (int64, time.Duration, error) {
+	m.cache.mu.RLock()
+	defer m.cache.mu.RUnlock()
+
+	now := time.Now()
+	item, found := m.cache.items[key]
+	if !found || item.expired(now) {
+		return 0, 0, nil
+	}
+	n, _ := item.Value.(int64)
+	return n, max(time.Unix(item.Expiration, 0).Sub(now), 0), nil
+}
+
+func (m *memoryCounter) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		m.cache.Delete(key)
+	}
+	return nil
+}
+
+type redisCounter struct {
+	rdb *redis.Client
+}
+
+func (r *redisCounter) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	return r.IncrBy(ctx, key, 1, ttl)
+}
+
+func (r *redisCounter) IncrBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.IncrBy(ctx, key, delta)
+		pipe.ExpireNX(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (r *redisCounter) Get(ctx context.Context, key string) (int64, time.Duration, error) {
+	var get *redis.StringCmd
+	var pttl *redis.DurationCmd
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, key)
+		pttl = pipe.PTTL(ctx, key)
+		return nil
+	})
+	if err == redis.Nil {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	n, err := get.Int64()
+	if err != nil {
+		return 0, 0, err
+	}
+	return n, pttl.Val(), nil
+}
+
+func (r *redisCounter) Delete(ctx context.Context, keys ...string) error {
+	return r.rdb.Del(ctx, keys...).Err()
+}
@@ -10,6 +10,11 @@ type CacheItem struct {
 	Expiration int64
 }
 
+// expired 是否已过期，Expiration 为 0 时永不过期
+func (i CacheItem) expired(now time.Time) bool {
+	return i.Expiration > 0 && now.Unix() > i.Expiration
+}
+
 type MemoryCache struct {
 	items map[string]CacheItem
 	mu    sync.RWMutex
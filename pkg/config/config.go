@@ -2,9 +2,13 @@ package config
 
 import (
 	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/shuTwT/hoshikuzu/internal/infra/logger"
 
@@ -27,6 +31,10 @@ const (
 	SWAGGER_ENABLE         = "swagger.enable"
 	AUTH_TOKEN_SECRET      = "auth.token_secret"
 	AUTH_PAT_SECRET        = "auth.pat_secret"
+	// 登录失败保护：同一账号或同一 IP 在锁定时长内连续失败达到次数后暂时锁定
+	AUTH_LOGIN_MAX_FAILURES    = "auth.login_max_failures"
+	AUTH_LOGIN_IP_MAX_FAILURES = "auth.login_ip_max_failures"
+	AUTH_LOGIN_LOCK_DURATION   = "auth.login_lock_duration"
 	// Redis 相关
 	Redis_Enable   = "redis.enable"
 	REDIS_ADDR     = "redis.addr"
@@ -34,6 +42,14 @@ const (
 	REDIS_DB       = "redis.db"
 	// AI 配置加密密钥（Base64 编码的 32 字节 AES-256 密钥）
 	AI_CONFIG_ENCRYPTION_KEY = "ai.config_encryption_key"
+	// 站点知识库向量数据目录，为空时仅保存在内存中
+	AI_KNOWLEDGE_PATH = "ai.knowledge_path"
+	// 主题开发模式：监听主题模板目录，文件变化时自动重新加载
+	THEME_DEV = "theme.dev"
+	// OAuth2/OIDC 签发者地址，留空时按请求地址推断
+	OAUTH2_ISSUER = "oauth2.issuer"
+	// OIDC id_token 签名私钥（PEM 编码的 RSA 私钥）
+	OAUTH2_SIGNING_KEY = "oauth2.signing_key"
 )
 
 func Init() {
@@ -46,6 +62,9 @@ func Init() {
 	viper.SetDefault(SWAGGER_ENABLE, true)
 	viper.SetDefault(AUTH_TOKEN_SECRET, "your-secret-key")
 	viper.SetDefault(AUTH_PAT_SECRET, "your-pat-secret")
+	viper.SetDefault(AUTH_LOGIN_MAX_FAILURES, 5)
+	viper.SetDefault(AUTH_LOGIN_IP_MAX_FAILURES, 20)
+	viper.SetDefault(AUTH_LOGIN_LOCK_DURATION, "15m")
 	// Redis 相关
 	viper.SetDefault(Redis_Enable, false)
 	viper.SetDefault(REDIS_ADDR, "localhost:6379")
@@ -53,6 +72,11 @@ func Init() {
 	viper.SetDefault(REDIS_DB, 0)
 	// AI 配置加密密钥：空则首次启动自动生成并持久化，保证 AI 功能开箱即用
 	viper.SetDefault(AI_CONFIG_ENCRYPTION_KEY, "")
+	viper.SetDefault(AI_KNOWLEDGE_PATH, "./data/knowledge")
+	viper.SetDefault(THEME_DEV, false)
+	viper.SetDefault(OAUTH2_ISSUER, "")
+	// OIDC 签名私钥：空则首次启动自动生成并持久化，保证已签发的 id_token 重启后仍可验证
+	viper.SetDefault(OAUTH2_SIGNING_KEY, "")
 
 	viper.SetConfigName("config")
 	viper.SetConfigType("toml")
@@ -71,6 +95,7 @@ func Init() {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
 			// 配置文件不存在，在工作空间/data创建默认配置文件
 			ensureAIEncryptionKey()
+			ensureOAuth2SigningKey()
 			createDefaultConfig()
 		} else {
 			// 配置文件存在但读取失败
@@ -79,6 +104,7 @@ func Init() {
 	} else {
 		logger.Info("已加载配置文件", "config_file", viper.ConfigFileUsed())
 		ensureAIEncryptionKey()
+		ensureOAuth2SigningKey()
 	}
 }
 
@@ -95,14 +121,38 @@ func ensureAIEncryptionKey() {
 	}
 	key := base64.StdEncoding.EncodeToString(raw)
 	viper.Set(AI_CONFIG_ENCRYPTION_KEY, key)
+	persistGeneratedKey("AI 配置加密密钥")
+}
+
+// ensureOAuth2SigningKey 确保 OIDC 签名私钥存在：缺失时生成 RSA 私钥并持久化到配置文件。
+func ensureOAuth2SigningKey() {
+	if viper.GetString(OAUTH2_SIGNING_KEY) != "" {
+		return
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		logger.Warn("生成 OIDC 签名私钥失败", "error", err.Error())
+		return
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		logger.Warn("生成 OIDC 签名私钥失败", "error", err.Error())
+		return
+	}
+	viper.Set(OAUTH2_SIGNING_KEY, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	persistGeneratedKey("OIDC 签名私钥")
+}
+
+// persistGeneratedKey 将自动生成的密钥写回配置文件
+func persistGeneratedKey(name string) {
 	if viper.ConfigFileUsed() == "" {
 		// 配置文件尚未存在，由 createDefaultConfig 负责写盘
 		return
 	}
 	if err := viper.WriteConfig(); err != nil {
-		logger.Warn("无法持久化 AI 配置加密密钥", "error", err.Error())
+		logger.Warn("无法持久化"+name, "error", err.Error())
 	} else {
-		logger.Info("已生成 AI 配置加密密钥并写入配置文件", "config_file", viper.ConfigFileUsed())
+		logger.Info("已生成"+name+"并写入配置文件", "config_file", viper.ConfigFileUsed())
 	}
 }
 
@@ -148,6 +198,10 @@ func GetBool(key string) bool {
 	return viper.GetBool(key)
 }
 
+func GetDuration(key string) time.Duration {
+	return viper.GetDuration(key)
+}
+
 func GetTrustedProxies() []string {
 	return viper.GetStringSlice(SERVER_TRUSTED_PROXIES)
 }
@@ -0,0 +1,40 @@
+package model
+
+// 自助注册与找回密码相关的设置键
+const (
+	// SettingKeyBasic 站点基本信息，站点地址用于生成邮件中的链接
+	SettingKeyBasic = "basic"
+	// SettingKeySite 站点设置，包含是否允许注册与注册是否需要验证邮箱
+	SettingKeySite = "site"
+	// SettingKeyEmail 邮件配置，值包含 SMTP 密码，不通过公开设置接口返回
+	SettingKeyEmail = "email"
+)
+
+// RegisterReq 自助注册请求
+type RegisterReq struct {
+	Email    string `json:"email" validate:"required,email"`
+	Name     string `json:"name" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+// RegisterResp 注册结果
+type RegisterResp struct {
+	// 是否需要验证邮箱后才能登录
+	EmailVerificationRequired bool `json:"emailVerificationRequired"`
+}
+
+// EmailReq 重新发送验证邮件与找回密码请求
+type EmailReq struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// VerifyEmailReq 验证邮箱请求
+type VerifyEmailReq struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ResetPasswordReq 重置密码请求
+type ResetPasswordReq struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
@@ -9,6 +9,7 @@ type AIProviderReq struct {
 	ProviderType     string  `json:"provider_type" validate:"required,max=50"`
 	BaseURL          string  `json:"base_url" validate:"required"`
 	APIKey           string  `json:"api_key"`
+	EmbeddingModel   string  `json:"embedding_model" validate:"max=255"`
 	Temperature      float64 `json:"temperature"`
 	MaxTokens        int     `json:"max_tokens"`
 	TopP             float64 `json:"top_p"`
@@ -36,6 +37,7 @@ type AIProviderResp struct {
 	ProviderType     string        `json:"provider_type"`
 	BaseURL          string        `json:"base_url"`
 	APIKeyConfigured bool          `json:"api_key_configured"`
+	EmbeddingModel   string        `json:"embedding_model,omitempty"`
 	Temperature      float64       `json:"temperature"`
 	MaxTokens        int           `json:"max_tokens"`
 	TopP             float64       `json:"top_p"`
@@ -56,44 +58,312 @@ type AIModelReq struct {
 	DisplayName string `json:"display_name"`
 	IsEnabled   bool   `json:"is_enabled"`
 	Sort        int    `json:"sort"`
+	// ContextWindow is the model's context size in tokens. It caps the chat
+	// context budget together with the reply's max_tokens; 0 means unknown.
+	ContextWindow int `json:"context_window"`
 }
 
 type AIModelResp struct {
-	ID          int    `json:"id"`
-	ModelName   string `json:"model_name"`
-	DisplayName string `json:"display_name,omitempty"`
-	IsEnabled   bool   `json:"is_enabled"`
-	Sort        int    `json:"sort"`
+	ID            int    `json:"id"`
+	ModelName     string `json:"model_name"`
+	DisplayName   string `json:"display_name,omitempty"`
+	IsEnabled     bool   `json:"is_enabled"`
+	Sort          int    `json:"sort"`
+	ContextWindow int    `json:"context_window"`
 }
 
 type AIChatSessionResp struct {
-	ID        int       `json:"id"`
-	Title     string    `json:"title"`
-	CreatedAt LocalTime `json:"created_at"`
-	UpdatedAt LocalTime `json:"updated_at"`
+	ID           int       `json:"id"`
+	Title        string    `json:"title"`
+	ModelID      *int      `json:"model_id,omitempty"`
+	PersonaID    *int      `json:"persona_id,omitempty"`
+	SystemPrompt string    `json:"system_prompt,omitempty"`
+	Temperature  *float64  `json:"temperature,omitempty"`
+	MaxTokens    *int      `json:"max_tokens,omitempty"`
+	CreatedAt    LocalTime `json:"created_at"`
+	UpdatedAt    LocalTime `json:"updated_at"`
+}
+
+// AIChatSessionSettingsReq replaces the per-session settings. Nil fields and
+// an empty system prompt fall back to the persona, then to the default
+// provider and model.
+type AIChatSessionSettingsReq struct {
+	ModelID      *int     `json:"model_id"`
+	PersonaID    *int     `json:"persona_id"`
+	SystemPrompt string   `json:"system_prompt"`
+	Temperature  *float64 `json:"temperature"`
+	MaxTokens    *int     `json:"max_tokens"`
+}
+
+// AIChatModelOption is an enabled model that a chat session can select.
+type AIChatModelOption struct {
+	ID           int    `json:"id"`
+	ProviderID   int    `json:"provider_id"`
+	ProviderName string `json:"provider_name"`
+	ModelName    string `json:"model_name"`
+	DisplayName  string `json:"display_name,omitempty"`
+	// IsDefault marks the model used by sessions without a selection.
+	IsDefault bool `json:"is_default"`
+}
+
+type AIPersonaReq struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	SystemPrompt string   `json:"system_prompt"`
+	Temperature  *float64 `json:"temperature"`
+	MaxTokens    *int     `json:"max_tokens"`
 }
 
+type AIPersonaResp struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	SystemPrompt string    `json:"system_prompt"`
+	Temperature  *float64  `json:"temperature,omitempty"`
+	MaxTokens    *int      `json:"max_tokens,omitempty"`
+	CreatedAt    LocalTime `json:"created_at"`
+	UpdatedAt    LocalTime `json:"updated_at"`
+}
+
+// AIChatMessageResp is a user, assistant or summary message. A summary is
+// listed right after the last message it covers.
 type AIChatMessageResp struct {
-	ID        int       `json:"id"`
-	Role      string    `json:"role"`
-	Content   string    `json:"content"`
-	Model     string    `json:"model,omitempty"`
-	CreatedAt LocalTime `json:"created_at"`
+	ID        int                   `json:"id"`
+	Role      string                `json:"role"`
+	Content   string                `json:"content"`
+	Model     string                `json:"model,omitempty"`
+	Citations []AIKnowledgeCitation `json:"citations,omitempty"`
+	// Token usage of an assistant reply.
+	PromptTokens     int `json:"prompt_tokens,omitempty"`
+	CompletionTokens int `json:"completion_tokens,omitempty"`
+	// ID of the last message covered by a summary.
+	SummaryUntil *int      `json:"summary_until,omitempty"`
+	CreatedAt    LocalTime `json:"created_at"`
 }
 
 type AIChatStreamReq struct {
 	Content string `json:"content"`
+	// Knowledge enables site knowledge mode: relevant site content is
+	// retrieved and given to the model, and the answer cites its sources.
+	Knowledge bool `json:"knowledge"`
 }
 
+// SettingKeyAIChatContext is the settings key of the chat context budget and
+// summarisation configuration.
+const SettingKeyAIChatContext = "ai_chat_context"
+
 // AIStreamEvent is encoded as the data field of an SSE event.
 type AIStreamEvent struct {
-	Content   string `json:"content,omitempty"`
-	MessageID int    `json:"message_id,omitempty"`
-	Code      string `json:"code,omitempty"`
-	Message   string `json:"message,omitempty"`
+	Content   string                `json:"content,omitempty"`
+	MessageID int                   `json:"message_id,omitempty"`
+	Code      string                `json:"code,omitempty"`
+	Message   string                `json:"message,omitempty"`
+	Citations []AIKnowledgeCitation `json:"citations,omitempty"`
+}
+
+// AIEmbeddingResult holds one vector per input, in input order, together
+// with the embedding model that produced them.
+type AIEmbeddingResult struct {
+	Model   string
+	Vectors [][]float32
+}
+
+// AIKnowledgeChunk is one piece of site content retrieved from the
+// knowledge base.
+type AIKnowledgeChunk struct {
+	Kind       string  `json:"kind"`
+	SourceID   int     `json:"source_id"`
+	Title      string  `json:"title"`
+	Slug       string  `json:"slug,omitempty"`
+	Content    string  `json:"content"`
+	Similarity float32 `json:"similarity"`
+}
+
+// AIKnowledgeContext carries the retrieved chunks into a knowledge-mode chat
+// turn. An empty context still tells the model that nothing was found.
+type AIKnowledgeContext struct {
+	Chunks []AIKnowledgeChunk
+}
+
+// AIKnowledgeCitation is a content source referenced by a knowledge-mode answer.
+type AIKnowledgeCitation struct {
+	Kind     string `json:"kind"`
+	SourceID int    `json:"source_id"`
+	Title    string `json:"title"`
+	Slug     string `json:"slug,omitempty"`
 }
 
 type AIResponse struct {
 	Content string `json:"content"`
 	Done    bool   `json:"done"`
 }
+
+// SettingKeyAIKnowledge is the settings key of the site knowledge base
+// configuration.
+const SettingKeyAIKnowledge = "ai_knowledge"
+
+// 站点知识库内容类型
+const (
+	KnowledgeKindPost    = "post"
+	KnowledgeKindEssay   = "essay"
+	KnowledgeKindProduct = "product"
+)
+
+// AIKnowledgeStatusResp describes the state of the site knowledge base.
+type AIKnowledgeStatusResp struct {
+	// Number of indexed chunks.
+	Chunks int `json:"chunks"`
+	// Whether a full rebuild is running.
+	Rebuilding bool `json:"rebuilding"`
+	// Number of sources indexed by the last finished rebuild.
+	LastRebuildSources int        `json:"last_rebuild_sources"`
+	LastRebuildAt      *LocalTime `json:"last_rebuild_at,omitempty"`
+	LastRebuildError   string     `json:"last_rebuild_error,omitempty"`
+}
+
+// AIKnowledgeSearchReq previews what knowledge-mode chat would retrieve.
+type AIKnowledgeSearchReq struct {
+	Query string `json:"query" query:"query"`
+	TopK  int    `json:"top_k" query:"top_k"`
+}
+
+// AIAnswerResult is a completed stateless knowledge-mode answer.
+type AIAnswerResult struct {
+	Content   string
+	Model     string
+	Citations []AIKnowledgeCitation
+	// Token usage as reported by the provider, or estimated when the
+	// provider does not report it.
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// SettingKeyAIAsk is the settings key of the public "ask this blog" endpoint.
+const SettingKeyAIAsk = "ai_ask"
+
+// AIAskReq is a visitor question to the public ask endpoint.
+type AIAskReq struct {
+	Question string `json:"question"`
+}
+
+// AIAskConfigResp tells the site frontend whether and how to offer the ask box.
+type AIAskConfigResp struct {
+	Enabled           bool `json:"enabled"`
+	MaxQuestionLength int  `json:"max_question_length"`
+}
+
+// AIAskStreamEvent is encoded as the data field of a public ask SSE event.
+type AIAskStreamEvent struct {
+	Content   string                `json:"content,omitempty"`
+	Citations []AIKnowledgeCitation `json:"citations,omitempty"`
+	Code      string                `json:"code,omitempty"`
+	Message   string                `json:"message,omitempty"`
+}
+
+type AIAskLogPageQuery struct {
+	Page    int    `json:"page" query:"page"`
+	Size    int    `json:"page_size" query:"page_size"`
+	IP      string `json:"ip" query:"ip"`
+	Keyword string `json:"keyword" query:"keyword"`
+	// success or failed
+	Status string `json:"status" query:"status"`
+}
+
+type AIAskLogResp struct {
+	ID               int                   `json:"id"`
+	CreatedAt        LocalTime             `json:"created_at"`
+	Question         string                `json:"question"`
+	Answer           string                `json:"answer"`
+	Citations        []AIKnowledgeCitation `json:"citations"`
+	Status           string                `json:"status"`
+	Error            string                `json:"error,omitempty"`
+	Model            string                `json:"model"`
+	PromptTokens     int                   `json:"prompt_tokens"`
+	CompletionTokens int                   `json:"completion_tokens"`
+	TotalTokens      int                   `json:"total_tokens"`
+	IP               string                `json:"ip"`
+	UserAgent        string                `json:"user_agent"`
+}
+
+type AIAskLogBatchDeleteReq struct {
+	IDs []int `json:"ids"`
+}
+
+// AIAskUsageResp summarises today's token usage against the budgets.
+type AIAskUsageResp struct {
+	TodayQuestions int `json:"today_questions"`
+	TodayTokens    int `json:"today_tokens"`
+	DailyTokens    int `json:"daily_tokens"`
+}
+
+// SettingKeyAIWritingPrompts is the settings key of the customised post
+// authoring prompt templates, keyed by action.
+const SettingKeyAIWritingPrompts = "ai_writing_prompts"
+
+// AIWritingReq carries the editor state for a post authoring action. Content
+// and Title override the saved post, so unsaved edits or a selection can be
+// sent instead.
+type AIWritingReq struct {
+	Title   *string `json:"title,omitempty"`
+	Content *string `json:"content,omitempty"`
+	// Language is the target language of translate.
+	Language string `json:"language,omitempty"`
+	// Cursor is the rune offset in content where continue inserts text;
+	// nil means the end.
+	Cursor *int `json:"cursor,omitempty"`
+}
+
+type AIWritingTerm struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// AIWritingDiffLine is one line of a proofreading diff.
+type AIWritingDiffLine struct {
+	// equal, insert or delete
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// AIWritingResult is the parsed outcome of an authoring action. Only the
+// fields of the requested action are set.
+type AIWritingResult struct {
+	Action     string              `json:"action"`
+	Model      string              `json:"model"`
+	Content    string              `json:"content"`
+	Titles     []string            `json:"titles,omitempty"`
+	Keywords   []string            `json:"keywords,omitempty"`
+	Slug       string              `json:"slug,omitempty"`
+	Tags       []AIWritingTerm     `json:"tags,omitempty"`
+	Categories []AIWritingTerm     `json:"categories,omitempty"`
+	Diff       []AIWritingDiffLine `json:"diff,omitempty"`
+}
+
+// AIWritingStreamEvent is encoded as the data field of an SSE event.
+type AIWritingStreamEvent struct {
+	Content string           `json:"content,omitempty"`
+	Result  *AIWritingResult `json:"result,omitempty"`
+	Code    string           `json:"code,omitempty"`
+	Message string           `json:"message,omitempty"`
+}
+
+// AIWritingPrompt is a pair of text/template prompts. An empty User falls
+// back to the built-in default.
+type AIWritingPrompt struct {
+	System string `json:"system"`
+	User   string `json:"user"`
+}
+
+type AIWritingPromptResp struct {
+	Action        string `json:"action"`
+	Name          string `json:"name"`
+	System        string `json:"system"`
+	User          string `json:"user"`
+	DefaultSystem string `json:"default_system"`
+	DefaultUser   string `json:"default_user"`
+	Customized    bool   `json:"customized"`
+}
+
+type AIWritingPromptsReq struct {
+	Prompts map[string]AIWritingPrompt `json:"prompts"`
+}
@@ -12,6 +12,13 @@ type LoginResp struct {
 	Expires      int64    `json:"expires"`
 	Username     string   `json:"username"`
 	Roles        []string `json:"roles"`
+	// 需要两步验证时不签发令牌，使用挑战令牌与验证码完成登录
+	TwoFactorRequired bool `json:"twoFactorRequired,omitempty"`
+	// 角色要求两步验证但尚未启用，需要先完成绑定
+	TwoFactorSetupRequired bool   `json:"twoFactorSetupRequired,omitempty"`
+	ChallengeToken         string `json:"challengeToken,omitempty"`
+	// 登录时完成绑定生成的恢复码，只返回一次
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
 }
 
 // RefreshTokenRequest 刷新令牌请求
@@ -28,13 +35,18 @@ type RefreshTokenResp struct {
 	Roles        []string `json:"roles"`
 }
 
+// PersonalAccessTokenPrefix 个人访问令牌前缀，用于区分登录令牌
+const PersonalAccessTokenPrefix = "hsk_"
+
 type PersonalAccessTokenCreateReq struct {
 	// 令牌名称
-	Name string `json:"name"`
+	Name string `json:"name" validate:"required"`
 	// 过期时间
 	Expires LocalTime `json:"expires"`
 	// 描述
 	Description string `json:"description"`
+	// 权限范围,不能超出当前用户角色的权限
+	Scopes []string `json:"scopes" validate:"required,min=1"`
 }
 
 type PersonalAccessTokenListResp struct {
@@ -45,16 +57,19 @@ type PersonalAccessTokenListResp struct {
 	Expires *LocalTime `json:"expires"`
 	// 描述
 	Description string `json:"description"`
+	// 令牌前缀
+	TokenPrefix string `json:"token_prefix"`
+	// 权限范围,为空表示继承角色权限的旧版令牌
+	Scopes []string `json:"scopes"`
+	// 最后使用时间
+	LastUsedAt *LocalTime `json:"last_used_at"`
+	// 最后使用 IP
+	LastUsedIP string `json:"last_used_ip"`
 }
 
-type PersonalAccessTokenResp struct {
-	ID int `json:"id"`
-	// 令牌名称
-	Name string `json:"name"`
-	// 过期时间
-	Expires *LocalTime `json:"expires"`
-	// 描述
-	Description string `json:"description"`
+// PersonalAccessTokenCreateResp 创建令牌结果，明文令牌仅在创建时返回一次
+type PersonalAccessTokenCreateResp struct {
+	PersonalAccessTokenListResp
 	// 令牌
 	Token string `json:"token"`
 }
@@ -0,0 +1,34 @@
+package model
+
+// CartItemAddReq 加入购物车请求，商品已在购物车中时累加数量
+type CartItemAddReq struct {
+	ProductId int `json:"product_id" validate:"required"`
+	Quantity  int `json:"quantity" validate:"min=1"`
+}
+
+// CartItemUpdateReq 修改购物车商品数量请求
+type CartItemUpdateReq struct {
+	Quantity int `json:"quantity" validate:"min=1"`
+}
+
+// CartItemResp 购物车商品
+type CartItemResp struct {
+	ID        int    `json:"id"`
+	ProductId int    `json:"product_id"`
+	Name      string `json:"name"`
+	Image     string `json:"image,omitempty"`
+	Price     int    `json:"price"`
+	Quantity  int    `json:"quantity"`
+	Stock     int    `json:"stock"`
+	// 商品已下架或库存不足时不可结算
+	Available bool `json:"available"`
+}
+
+// CartResp 购物车
+type CartResp struct {
+	Items []CartItemResp `json:"items"`
+	// 可结算商品总数量
+	TotalQuantity int `json:"total_quantity"`
+	// 可结算商品总金额,单位分
+	TotalAmount int `json:"total_amount"`
+}
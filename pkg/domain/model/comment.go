@@ -61,3 +61,40 @@ type TwikooReqBody struct {
 	Nick         *string   `json:"nick"`
 	UA           *string   `json:"ua"`
 }
+
+// SettingKeyCommentModeration 评论审核设置的键，值包含屏蔽名单，不通过公开设置接口返回
+const SettingKeyCommentModeration = "comment_moderation"
+
+// 评论状态
+const (
+	CommentStatusPending  = 1 // 待审核
+	CommentStatusApproved = 2 // 已通过
+	CommentStatusRejected = 3 // 已拒绝
+)
+
+// CommentClassification AI 对评论的垃圾评分
+type CommentClassification struct {
+	// 垃圾评论概率 0-1
+	Score float64 `json:"score"`
+	// 判定理由
+	Reason string `json:"reason"`
+}
+
+// CommentModerationPageQuery 审核队列分页查询
+type CommentModerationPageQuery struct {
+	Page int `json:"page" query:"page" form:"page" validate:"required,min=1"`
+	Size int `json:"page_size" query:"page_size" form:"page_size" validate:"required,min=1,max=100"`
+	// 评论状态 1待审核 3已拒绝，默认待审核
+	Status int `json:"status" query:"status" form:"status"`
+}
+
+// CommentBatchReq 批量审核评论
+type CommentBatchReq struct {
+	IDs []int `json:"ids"`
+}
+
+// CommentBatchResp 批量审核结果
+type CommentBatchResp struct {
+	// 实际更新的评论数
+	Updated int `json:"updated"`
+}
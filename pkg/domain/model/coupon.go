@@ -73,3 +73,17 @@ type CouponResp struct {
 	ProductIds   []int     `json:"product_ids,omitempty"`
 	CategoryIds  []int     `json:"category_ids,omitempty"`
 }
+
+// 优惠券类型
+const (
+	CouponTypeFullReduction = 1 // 满减：满 min_amount 减 value 分
+	CouponTypeDiscount      = 2 // 折扣：按 value 百分比支付，max_discount 大于 0 时限制最大优惠
+	CouponTypeNoThreshold   = 3 // 无门槛：直接减 value 分
+)
+
+// 优惠券使用状态
+const (
+	CouponUsageStatusUnused  = 0
+	CouponUsageStatusUsed    = 1
+	CouponUsageStatusExpired = 2
+)
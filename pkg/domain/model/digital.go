@@ -0,0 +1,70 @@
+package model
+
+const (
+	// 数字商品交付类型
+	DeliverableTypeFile = "file"
+	DeliverableTypeKey  = "key"
+	DeliverableTypeText = "text"
+
+	// 卡密状态
+	ProductKeyStatusAvailable = 0
+	ProductKeyStatusAssigned  = 1
+	ProductKeyStatusRevoked   = 2
+
+	// 交付状态
+	DeliveryStatusDelivered = 1
+	DeliveryStatusPending   = 2
+	DeliveryStatusRevoked   = 3
+)
+
+// ProductDeliverableCreateReq 新增数字商品交付内容请求
+type ProductDeliverableCreateReq struct {
+	// 交付类型 file 文件 key 卡密 text 文本
+	Type string `json:"type" validate:"required"`
+	// 交付名称
+	Name string `json:"name" validate:"required"`
+	// 文件 id，文件交付时必填
+	FileId int `json:"file_id"`
+	// 文本内容，文本交付时必填
+	Content   string `json:"content"`
+	SortOrder int    `json:"sort_order"`
+}
+
+// ProductKeyImportReq 导入卡密请求
+type ProductKeyImportReq struct {
+	// 卡密列表，重复的卡密会被忽略
+	Codes []string `json:"codes" validate:"required,min=1"`
+}
+
+// ProductKeyImportResp 导入卡密结果
+type ProductKeyImportResp struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+	// 导入后补发的待补货交付数量
+	Fulfilled int `json:"fulfilled"`
+}
+
+// ProductKeyPageQuery 卡密分页查询
+type ProductKeyPageQuery struct {
+	Page   int  `json:"page" query:"page" form:"page" validate:"required,min=1"`
+	Size   int  `json:"page_size" query:"page_size" form:"page_size" validate:"required,min=1,max=100"`
+	Status *int `json:"status" query:"status" form:"status"`
+}
+
+// OrderDeliveryResp 买家可见的交付内容
+type OrderDeliveryResp struct {
+	ID        int    `json:"id"`
+	OrderID   int    `json:"order_id"`
+	ProductID int    `json:"product_id"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	// 状态 1 已交付 2 待补货 3 已撤销
+	Status int `json:"status"`
+	// 卡密或文本内容，待补货或已撤销时为空
+	Content string `json:"content,omitempty"`
+	// 带签名的限时下载链接，文件交付且已交付时返回
+	DownloadURL string `json:"download_url,omitempty"`
+	// 下载链接过期时间
+	DownloadExpireAt *LocalTime `json:"download_expire_at,omitempty"`
+	CreatedAt        LocalTime  `json:"created_at"`
+}
@@ -0,0 +1,27 @@
+package model
+
+type LoginLogPageQuery struct {
+	Page   int    `json:"page" query:"page" form:"page" validate:"required,min=1"`
+	Size   int    `json:"page_size" query:"page_size" form:"page_size" validate:"required,min=1,max=100"`
+	UserID int    `json:"user_id" query:"user_id" form:"user_id"`
+	Email  string `json:"email" query:"email" form:"email"`
+	IP     string `json:"ip" query:"ip" form:"ip"`
+	// 登录结果 success、failure、locked、challenge
+	Outcome string `json:"outcome" query:"outcome" form:"outcome"`
+}
+
+type LoginLogResp struct {
+	ID        int       `json:"id"`
+	CreatedAt LocalTime `json:"created_at"`
+	UserID    *int      `json:"user_id,omitempty"`
+	Email     string    `json:"email"`
+	// 登录方式 password、social、two_factor
+	Method string `json:"method"`
+	// 登录结果 success、failure、locked、challenge
+	Outcome   string `json:"outcome"`
+	Reason    string `json:"reason"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+	Browser   string `json:"browser"`
+	OS        string `json:"os"`
+}
@@ -14,3 +14,15 @@ type ModuleDefMetaPermission struct {
 	Scope string
 	Title string
 }
+
+// PermissionGroupResp 按模块分组的权限
+type PermissionGroupResp struct {
+	Module      string           `json:"module"`
+	Description string           `json:"description"`
+	Permissions []PermissionResp `json:"permissions"`
+}
+
+type PermissionResp struct {
+	Scope string `json:"scope"`
+	Title string `json:"title"`
+}
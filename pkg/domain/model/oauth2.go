@@ -0,0 +1,204 @@
+package model
+
+const (
+	// OAuth2AccessTokenPrefix OAuth2 访问令牌前缀
+	OAuth2AccessTokenPrefix = "hsoa_"
+	// OAuth2RefreshTokenPrefix OAuth2 刷新令牌前缀
+	OAuth2RefreshTokenPrefix = "hsor_"
+)
+
+// OIDC 标准授权范围，其余授权范围为 hoshikuzu 接口权限
+const (
+	OIDCScopeOpenID  = "openid"
+	OIDCScopeProfile = "profile"
+	OIDCScopeEmail   = "email"
+)
+
+// OIDCScopes OIDC 标准授权范围及说明
+var OIDCScopes = map[string]string{
+	OIDCScopeOpenID:  "使用账号登录",
+	OIDCScopeProfile: "读取用户名与昵称",
+	OIDCScopeEmail:   "读取邮箱地址",
+}
+
+type Oauth2ClientCreateReq struct {
+	// 名称
+	Name string `json:"name" validate:"required"`
+	// 描述
+	Description string `json:"description"`
+	// 图标地址
+	Logo string `json:"logo"`
+	// 允许的回调地址
+	RedirectURIs []string `json:"redirect_uris" validate:"required,min=1"`
+	// 允许申请的授权范围
+	Scopes []string `json:"scopes" validate:"required,min=1"`
+	// 是否公开客户端,公开客户端没有密钥且必须使用 PKCE
+	Public bool `json:"public"`
+}
+
+type Oauth2ClientUpdateReq struct {
+	// 名称
+	Name string `json:"name" validate:"required"`
+	// 描述
+	Description string `json:"description"`
+	// 图标地址
+	Logo string `json:"logo"`
+	// 允许的回调地址
+	RedirectURIs []string `json:"redirect_uris" validate:"required,min=1"`
+	// 允许申请的授权范围
+	Scopes []string `json:"scopes" validate:"required,min=1"`
+	// 是否启用
+	Enabled bool `json:"enabled"`
+}
+
+type Oauth2ClientResp struct {
+	ID           int       `json:"id"`
+	CreatedAt    LocalTime `json:"created_at"`
+	ClientID     string    `json:"client_id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Logo         string    `json:"logo"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	Scopes       []string  `json:"scopes"`
+	Public       bool      `json:"public"`
+	Enabled      bool      `json:"enabled"`
+}
+
+// Oauth2ClientSecretResp 客户端密钥，明文仅在创建或重置时返回一次
+type Oauth2ClientSecretResp struct {
+	Oauth2ClientResp
+	ClientSecret string `json:"client_secret"`
+}
+
+// OAuth2AuthorizeReq 授权请求参数
+type OAuth2AuthorizeReq struct {
+	ResponseType        string `json:"response_type" query:"response_type"`
+	ClientID            string `json:"client_id" query:"client_id"`
+	RedirectURI         string `json:"redirect_uri" query:"redirect_uri"`
+	Scope               string `json:"scope" query:"scope"`
+	State               string `json:"state" query:"state"`
+	CodeChallenge       string `json:"code_challenge" query:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method" query:"code_challenge_method"`
+	Nonce               string `json:"nonce" query:"nonce"`
+}
+
+// OAuth2ConsentReq 用户确认授权请求
+type OAuth2ConsentReq struct {
+	OAuth2AuthorizeReq
+	// 是否同意授权
+	Approve bool `json:"approve"`
+}
+
+// OAuth2ScopeResp 授权范围及说明
+type OAuth2ScopeResp struct {
+	Scope string `json:"scope"`
+	Title string `json:"title"`
+}
+
+// OAuth2ConsentResp 授权确认页展示的客户端信息
+type OAuth2ConsentResp struct {
+	ClientName  string            `json:"client_name"`
+	Description string            `json:"description"`
+	Logo        string            `json:"logo"`
+	RedirectURI string            `json:"redirect_uri"`
+	Scopes      []OAuth2ScopeResp `json:"scopes"`
+}
+
+// OAuth2AuthorizeResp 授权结果，前端跳转到该地址将授权码或错误带回客户端
+type OAuth2AuthorizeResp struct {
+	RedirectURI string `json:"redirect_uri"`
+}
+
+// OAuth2TokenReq 令牌端点请求
+type OAuth2TokenReq struct {
+	GrantType    string `form:"grant_type"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+// OAuth2TokenResp 令牌端点响应
+type OAuth2TokenResp struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+// OAuth2TokenActionReq 吊销与内省端点请求
+type OAuth2TokenActionReq struct {
+	Token         string `form:"token"`
+	TokenTypeHint string `form:"token_type_hint"`
+	ClientID      string `form:"client_id"`
+	ClientSecret  string `form:"client_secret"`
+}
+
+// OAuth2IntrospectResp 令牌内省响应，令牌无效时只返回 active=false
+type OAuth2IntrospectResp struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+}
+
+// OAuth2ErrorResp OAuth2 协议错误响应
+type OAuth2ErrorResp struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// OIDCUserInfoResp userinfo 端点响应，按授权范围返回字段
+type OIDCUserInfoResp struct {
+	Sub               string `json:"sub"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Nickname          string `json:"nickname,omitempty"`
+	Profile           string `json:"profile,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     *bool  `json:"email_verified,omitempty"`
+}
+
+// OIDCDiscoveryResp OIDC 发现文档
+type OIDCDiscoveryResp struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksURI                           string   `json:"jwks_uri"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// JWK RSA 公钥
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKSResp JWKS 端点响应
+type JWKSResp struct {
+	Keys []JWK `json:"keys"`
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/shuTwT/hoshikuzu/ent/schema"
+
 // PayOrderCreateReq represents the request body for creating a payment order.
 type PayOrderCreateReq struct {
 	ChannelType string `json:"channel_id" validate:"required"`
@@ -61,6 +63,10 @@ const (
 
 	// 支付渠道
 	PayChannelBalance = "balance"
+	PayChannelAlipay  = "alipay"
+	PayChannelWechat  = "wxpay"
+	PayChannelQQ      = "qqpay"
+	PayChannelStripe  = "stripe"
 )
 
 // PayMethodResp 可用支付方式查询响应
@@ -69,6 +75,8 @@ type PayMethodResp struct {
 	Alipay bool `json:"alipay"`
 	// 微信支付（易支付或直连微信支付启用）
 	Wechat bool `json:"wechat"`
+	// Stripe 银行卡支付
+	Stripe bool `json:"stripe"`
 	// 余额支付（钱包余额大于 0）
 	Balance bool `json:"balance"`
 	// 钱包余额,单位分
@@ -76,7 +84,7 @@ type PayMethodResp struct {
 }
 
 type PayOrderSubmitReq struct {
-	// 渠道类型 1 支付宝 2 微信 3 银联
+	// 渠道类型 alipay 支付宝 wxpay 微信 qqpay QQ钱包 stripe Stripe balance 余额
 	ChannelType string `json:"channel_type" validate:"required"`
 	// 返回地址
 	ReturnUrl string `json:"return_url" validate:"required,url"`
@@ -84,12 +92,40 @@ type PayOrderSubmitReq struct {
 	OrderType string `json:"order_type" validate:"required"`
 	// 商品名称
 	Name string `json:"name"`
-	// 金额
+	// 前端展示的应付金额,单位分,与服务端计算结果不一致时拒绝下单
 	Money int `json:"money"`
 	// 文章 id，可选
 	PostId int `json:"post_id"`
-	// 商品 id，可选
+	// 商品 id，可选，单件购买时使用
 	ProductId int `json:"product_id"`
+	// 商品明细，可选，购买多件或多种商品时使用
+	Items []PayOrderItemReq `json:"items"`
+	// 优惠券代码，可选
+	CouponCode string `json:"coupon_code"`
+	// 是否从购物车结算，下单成功后从购物车移除对应商品
+	FromCart bool `json:"from_cart"`
+}
+
+// PayOrderItemReq 订单商品明细
+type PayOrderItemReq struct {
+	// 商品 id
+	ProductId int `json:"product_id"`
+	// 数量
+	Quantity int `json:"quantity"`
+}
+
+// PayOrderQuoteReq 订单询价请求
+type PayOrderQuoteReq struct {
+	// 订单类型 1 文章付费 2 商品购买
+	OrderType string `json:"order_type" validate:"required"`
+	// 文章 id，可选
+	PostId int `json:"post_id"`
+	// 商品 id，可选，单件购买时使用
+	ProductId int `json:"product_id"`
+	// 商品明细，可选，购买多件或多种商品时使用
+	Items []PayOrderItemReq `json:"items"`
+	// 优惠券代码，可选
+	CouponCode string `json:"coupon_code"`
 }
 
 type PayOrderTodayStats struct {
@@ -107,6 +143,36 @@ type PayOrderSubmitResp struct {
 	TradeNO    string `json:"trade_no"`     // 易支付订单号
 }
 
+// MyPayOrderResp 买家订单
+type MyPayOrderResp struct {
+	ID         int    `json:"id"`
+	OutTradeNo string `json:"out_trade_no"`
+	// 订单类型 1 文章付费 2 商品购买 3 余额充值
+	OrderType string `json:"order_type"`
+	Subject   string `json:"subject"`
+	// 支付状态 0 已关闭 1 待支付 2 已支付 3 失败 4 已退款
+	State string `json:"state"`
+	// 订单原价,单位分
+	OrderPrice int `json:"order_price"`
+	// 实付金额,单位分
+	Price       int                         `json:"price"`
+	PostId      int                         `json:"post_id,omitempty"`
+	PriceDetail *schema.PayOrderPriceDetail `json:"price_detail,omitempty"`
+	Items       []PayOrderItemResp          `json:"items,omitempty"`
+	// 数字商品交付内容
+	Deliveries []OrderDeliveryResp `json:"deliveries,omitempty"`
+	CreatedAt  LocalTime           `json:"created_at"`
+}
+
+// PayOrderItemResp 订单商品明细
+type PayOrderItemResp struct {
+	ProductId   int    `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Quantity    int    `json:"quantity"`
+	UnitPrice   int    `json:"unit_price"`
+	TotalPrice  int    `json:"total_price"`
+}
+
 // PayOrderStatusResp 订单状态查询响应
 type PayOrderStatusResp struct {
 	ID         int    `json:"id"`
@@ -132,7 +198,7 @@ type PayOrderRefundResp struct {
 
 // PayOrderRechargeReq 余额充值请求
 type PayOrderRechargeReq struct {
-	// 渠道类型 1 支付宝 2 微信 3 银联
+	// 渠道类型 alipay 支付宝 wxpay 微信 qqpay QQ钱包 stripe Stripe balance 余额
 	ChannelType string `json:"channel_type" validate:"required"`
 	// 返回地址
 	ReturnUrl string `json:"return_url" validate:"required,url"`
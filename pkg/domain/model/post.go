@@ -12,6 +12,7 @@ type PostPageReq struct {
 	Title        string     `json:"title" query:"title" form:"title"`
 	CategoryName string     `json:"category_name" query:"category_name" form:"category_name"`
 	TagName      string     `json:"tag_name" query:"tag_name" form:"tag_name"`
+	Author       string     `json:"author" query:"author" form:"author"`
 	Year         *int       `json:"year" query:"year" form:"year"`
 	Month        *int       `json:"month" query:"month" form:"month"`
 	Status       *string    `json:"status" query:"status" form:"status"`
@@ -111,6 +112,23 @@ type PostResp struct {
 	CategoryIds           []int           `json:"category_ids,omitempty"`   //分类ID列表
 	Tags                  []*ent.Tag      `json:"tags"`                     //标签ID列表
 	TagIds                []int           `json:"tag_ids,omitempty"`        //标签ID列表
+	Lock                  *PostLock       `json:"lock,omitempty"`           //内容锁定信息，无权查看全文时返回
+	HiddenLock            *PostLock       `json:"hidden_lock,omitempty"`    //隐藏内容块锁定信息，无权查看隐藏内容时返回
+}
+
+// 解锁文章需要的操作
+const (
+	PostLockActionLogin   = "login"   //登录
+	PostLockActionPay     = "pay"     //购买
+	PostLockActionComment = "comment" //评论并通过审核
+)
+
+// PostLock 文章内容锁定信息
+type PostLock struct {
+	RequiredAction string  `json:"required_action"` //解锁需要的操作 login/pay/comment
+	Price          float32 `json:"price,omitempty"` //文章价格，需要购买时返回
+	NeedPay        bool    `json:"need_pay"`        //是否需要购买
+	NeedComment    bool    `json:"need_comment"`    //是否需要评论
 }
 
 type PostMonthStat struct {
@@ -139,6 +157,7 @@ type PostSearchResp struct {
 	PublishedAt *LocalTime `json:"published_at"`
 	ViewCount   int        `json:"view_count"`
 	Relevance   float64    `json:"relevance"`
+	Lock        *PostLock  `json:"lock,omitempty"`
 }
 
 // PostRandomReq 随机获取文章请求
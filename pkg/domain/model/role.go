@@ -1,5 +1,27 @@
 package model
 
+// ScopeAll 全部权限
+const ScopeAll = "*"
+
+// 内置角色代码
+const (
+	RoleCodeSuperAdmin = "superAdmin"
+	RoleCodeGuest      = "guest"
+	RoleCodeCommon     = "common"
+)
+
+// DefaultRoleScopes 内置角色的默认权限，初始化系统或升级后角色未配置权限时写入
+var DefaultRoleScopes = map[string][]string{
+	RoleCodeSuperAdmin: {ScopeAll},
+	RoleCodeGuest:      {"hoshikuzu:account:profile"},
+	RoleCodeCommon: {
+		"hoshikuzu:account:profile",
+		"hoshikuzu:account:token",
+		"hoshikuzu:shop:cart",
+		"hoshikuzu:shop:order",
+	},
+}
+
 // RoleCreateReq represents the request body for creating a role.
 
 type RoleCreateReq struct {
@@ -7,6 +29,10 @@ type RoleCreateReq struct {
 	Code        string `json:"code" validate:"required"`
 	Description string `json:"description,omitempty"`
 	IsDefault   bool   `json:"is_default"`
+	// 权限范围
+	Permissions []string `json:"permissions"`
+	// 是否要求两步验证
+	RequireTwoFactor bool `json:"require_two_factor"`
 }
 
 // RoleUpdateReq represents the request body for updating a role.
@@ -16,6 +42,10 @@ type RoleUpdateReq struct {
 	Code        string `json:"code"`
 	Description string `json:"description,omitempty"`
 	IsDefault   bool   `json:"is_default,omitempty"`
+	// 权限范围,为 nil 时不修改
+	Permissions []string `json:"permissions"`
+	// 是否要求两步验证,为 nil 时不修改
+	RequireTwoFactor *bool `json:"require_two_factor"`
 }
 
 // RoleResp represents the response body for a role.
@@ -27,4 +57,7 @@ type RoleResp struct {
 	Code        string    `json:"code"`
 	Description string    `json:"description,omitempty"`
 	IsDefault   bool      `json:"is_default"`
+	Permissions []string  `json:"permissions"`
+	// 是否要求两步验证
+	RequireTwoFactor bool `json:"require_two_factor"`
 }
@@ -0,0 +1,26 @@
+package model
+
+// SessionResp 登录会话，同一次登录轮换出的 refresh token 属于同一会话
+type SessionResp struct {
+	// 会话当前 refresh token 的ID，吊销会话时使用
+	ID int `json:"id"`
+	// 浏览器及版本
+	Browser string `json:"browser"`
+	// 操作系统
+	OS string `json:"os"`
+	// 设备类型 Desktop、Mobile、Tablet 等
+	Device    string    `json:"device"`
+	UserAgent string    `json:"userAgent"`
+	IP        string    `json:"ip"`
+	LoginAt   LocalTime `json:"loginAt"`
+	// 最近一次刷新令牌的时间
+	LastActiveAt LocalTime `json:"lastActiveAt"`
+	ExpiresAt    LocalTime `json:"expiresAt"`
+	// 是否为发起请求的当前会话
+	Current bool `json:"current"`
+}
+
+// SessionRevokeResp 吊销的会话数量
+type SessionRevokeResp struct {
+	Revoked int `json:"revoked"`
+}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"github.com/shuTwT/hoshikuzu/ent"
+)
+
+// SiteMeta 站点元信息，来自 basic 设置
+type SiteMeta struct {
+	Name         string `json:"siteName"`         //站点名称
+	Description  string `json:"siteDescription"`  //站点描述
+	Logo         string `json:"siteLogo"`         //站点logo
+	Favicon      string `json:"siteFavicon"`      //站点favicon
+	URL          string `json:"siteUrl"`          //站点地址
+	Keywords     string `json:"keywords"`         //站点关键词
+	Author       string `json:"author"`           //站点作者
+	Language     string `json:"language"`         //站点语言
+	IcpBeian     string `json:"icpBeian"`         //icp备案号
+	GonganBeian  string `json:"gonganBeian"`      //公网安备号
+	Announcement string `json:"siteAnnouncement"` //站点公告
+}
+
+// SitePage 所有主题页面共有的数据
+type SitePage struct {
+	Title       string            //页面标题
+	Theme       *ent.Theme        //当前主题
+	ThemeConfig ThemeConfigValues //主题配置值
+	Path        string            //请求路径
+	Params      map[string]string //路由参数
+	Site        SiteMeta          //站点信息
+	Menus       []MenuResp        //可见菜单
+	CurrentUser *LoginUser        //当前登录用户，匿名访问时为 nil
+}
+
+// Pagination 主题页面分页信息
+type Pagination struct {
+	Page       int    //当前页
+	Size       int    //每页数量
+	Total      int    //总条数
+	TotalPages int    //总页数
+	BasePath   string //分页链接基础路径
+}
+
+// NewPagination 根据总数计算分页信息
+func NewPagination(page, size, total int, basePath string) Pagination {
+	totalPages := 0
+	if size > 0 {
+		totalPages = (total + size - 1) / size
+	}
+	return Pagination{
+		Page:       page,
+		Size:       size,
+		Total:      total,
+		TotalPages: totalPages,
+		BasePath:   basePath,
+	}
+}
+
+func (p Pagination) HasPrev() bool {
+	return p.Page > 1
+}
+
+func (p Pagination) HasNext() bool {
+	return p.Page < p.TotalPages
+}
+
+func (p Pagination) PrevPage() int {
+	return p.Page - 1
+}
+
+func (p Pagination) NextPage() int {
+	return p.Page + 1
+}
+
+// IndexPage 首页
+type IndexPage struct {
+	SitePage
+	Posts      []PostResp
+	Pagination Pagination
+	Categories []*ent.Category
+	Tags       []TagResp
+}
+
+// PostPage 文章详情页
+type PostPage struct {
+	SitePage
+	Post    PostResp
+	Related []*PostRelatedResp
+}
+
+// ArchiveGroup 按年月分组的归档
+type ArchiveGroup struct {
+	Year  int
+	Month int
+	Posts []PostResp
+}
+
+// ArchivesPage 归档页
+type ArchivesPage struct {
+	SitePage
+	Groups []ArchiveGroup
+	Total  int
+}
+
+// CategoriesPage 分类列表页
+type CategoriesPage struct {
+	SitePage
+	Categories []CategoryResp
+}
+
+// CategoryPage 分类文章页
+type CategoryPage struct {
+	SitePage
+	Category   CategoryResp
+	Posts      []PostResp
+	Pagination Pagination
+}
+
+// TagsPage 标签列表页
+type TagsPage struct {
+	SitePage
+	Tags []TagResp
+}
+
+// TagPage 标签文章页
+type TagPage struct {
+	SitePage
+	Tag        TagResp
+	Posts      []PostResp
+	Pagination Pagination
+}
+
+// SiteAuthor 作者页展示的用户公开信息
+type SiteAuthor struct {
+	ID       int
+	Name     string
+	Nickname string
+	Bio      string
+}
+
+// AuthorPage 作者文章页
+type AuthorPage struct {
+	SitePage
+	Author     SiteAuthor
+	Posts      []PostResp
+	Pagination Pagination
+}
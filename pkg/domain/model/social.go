@@ -0,0 +1,51 @@
+package model
+
+// SettingKeySocialLogin 社交登录设置的键，值包含客户端密钥，不通过公开设置接口返回
+const SettingKeySocialLogin = "social_login"
+
+// 社交登录提供方类型
+const (
+	SocialProviderGitHub = "github"
+	SocialProviderOIDC   = "oidc"
+)
+
+// SocialProviderResp 登录页展示的社交登录方式
+type SocialProviderResp struct {
+	// 登录方式标识
+	Name string `json:"name"`
+	// 显示名称
+	DisplayName string `json:"displayName"`
+	// 提供方类型 github、oidc
+	Type string `json:"type"`
+}
+
+// SocialAuthorizeReq 发起第三方授权请求
+type SocialAuthorizeReq struct {
+	// 第三方授权完成后的回调地址，需与第三方应用中登记的地址一致
+	RedirectURI string `json:"redirectUri" validate:"required"`
+}
+
+// SocialAuthorizeResp 第三方授权页地址
+type SocialAuthorizeResp struct {
+	AuthorizeURL string `json:"authorizeUrl"`
+}
+
+// SocialCallbackReq 第三方授权回调参数
+type SocialCallbackReq struct {
+	Code  string `json:"code" validate:"required"`
+	State string `json:"state" validate:"required"`
+}
+
+// UserIdentityResp 当前用户关联的第三方账号
+type UserIdentityResp struct {
+	ID int `json:"id"`
+	// 登录方式标识
+	Provider string `json:"provider"`
+	// 登录方式显示名称，登录方式已被删除时为标识本身
+	ProviderName string     `json:"providerName"`
+	Email        string     `json:"email"`
+	Name         string     `json:"name"`
+	Avatar       string     `json:"avatar"`
+	CreatedAt    *LocalTime `json:"createdAt"`
+	LastLoginAt  *LocalTime `json:"lastLoginAt"`
+}
@@ -63,3 +63,61 @@ type ThemeResp struct {
 	ExternalURL   string    `json:"external_url"`
 	Enabled       bool      `json:"enabled"`
 }
+
+// ThemeSettingSchema 主题配置表单声明，来自主题包中的 config.yaml
+type ThemeSettingSchema struct {
+	Type  string              `yaml:"type" json:"type"`
+	Forms []ThemeSettingGroup `yaml:"forms" json:"forms"`
+}
+
+// ThemeSettingGroup 配置表单分组
+type ThemeSettingGroup struct {
+	Group  string              `yaml:"group" json:"group"`
+	Label  string              `yaml:"label" json:"label"`
+	Fields []ThemeSettingField `yaml:"fields" json:"fields"`
+}
+
+// ThemeSettingField 配置表单字段
+// 类型: text textarea number switch select color image url array，array 的子字段由 Fields 声明
+type ThemeSettingField struct {
+	Name        string                  `yaml:"name" json:"name"`
+	Label       string                  `yaml:"label" json:"label"`
+	Type        string                  `yaml:"type" json:"type"`
+	Default     any                     `yaml:"default,omitempty" json:"default,omitempty"`
+	Placeholder string                  `yaml:"placeholder,omitempty" json:"placeholder,omitempty"`
+	Help        string                  `yaml:"help,omitempty" json:"help,omitempty"`
+	Options     []ThemeSettingOption    `yaml:"options,omitempty" json:"options,omitempty"`
+	Fields      []ThemeSettingField     `yaml:"fields,omitempty" json:"fields,omitempty"`
+	Validation  *ThemeSettingValidation `yaml:"validation,omitempty" json:"validation,omitempty"`
+}
+
+// ThemeSettingOption select 字段的选项
+type ThemeSettingOption struct {
+	Label string `yaml:"label" json:"label"`
+	Value string `yaml:"value" json:"value"`
+}
+
+// ThemeSettingValidation 字段校验规则
+type ThemeSettingValidation struct {
+	Required  bool     `yaml:"required,omitempty" json:"required,omitempty"`
+	Min       *float64 `yaml:"min,omitempty" json:"min,omitempty"`               //number 最小值 / array 最少条数
+	Max       *float64 `yaml:"max,omitempty" json:"max,omitempty"`               //number 最大值 / array 最多条数
+	MinLength *int     `yaml:"min-length,omitempty" json:"min_length,omitempty"` //字符串最小长度
+	MaxLength *int     `yaml:"max-length,omitempty" json:"max_length,omitempty"` //字符串最大长度
+	Pattern   string   `yaml:"pattern,omitempty" json:"pattern,omitempty"`       //字符串正则
+}
+
+// ThemeConfigValues 主题配置值，按 分组 -> 字段 组织
+type ThemeConfigValues map[string]map[string]any
+
+// ThemeConfigResp 主题配置表单及当前值
+type ThemeConfigResp struct {
+	ThemeID int                 `json:"theme_id"`
+	Schema  *ThemeSettingSchema `json:"schema"`
+	Values  ThemeConfigValues   `json:"values"`
+}
+
+// ThemeConfigSaveReq 保存主题配置请求
+type ThemeConfigSaveReq struct {
+	Values ThemeConfigValues `json:"values" validate:"required"`
+}
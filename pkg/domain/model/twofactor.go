@@ -0,0 +1,39 @@
+package model
+
+// TwoFactorStatusResp 当前用户的两步验证状态
+type TwoFactorStatusResp struct {
+	// 是否已启用
+	Enabled bool `json:"enabled"`
+	// 角色是否要求启用，要求时不能关闭
+	Required bool `json:"required"`
+	// 剩余可用的恢复码数量
+	RecoveryCodesRemaining int `json:"recoveryCodesRemaining"`
+}
+
+// TwoFactorSetupResp 待验证的 TOTP 密钥，otpauthUri 用于生成二维码
+type TwoFactorSetupResp struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauthUri"`
+}
+
+// TwoFactorCodeReq 验证码请求，可以是 TOTP 验证码或恢复码
+type TwoFactorCodeReq struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// TwoFactorRecoveryCodesResp 新生成的恢复码，只在生成时返回一次
+type TwoFactorRecoveryCodesResp struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// TwoFactorChallengeReq 登录第二步的挑战令牌
+type TwoFactorChallengeReq struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+}
+
+// TwoFactorLoginReq 使用挑战令牌与验证码完成登录
+type TwoFactorLoginReq struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	// TOTP 验证码或恢复码
+	Code string `json:"code" validate:"required"`
+}
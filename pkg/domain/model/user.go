@@ -36,6 +36,7 @@ type UserResp struct {
 	Name                string    `json:"name"`
 	PhoneNumber         string    `json:"phone_number"`
 	PhoneNumberVerified bool      `json:"phone_number_verified"`
+	TotpEnabled         bool      `json:"totp_enabled"`
 	RoleID              *int      `json:"role_id,omitempty"`
 	Role                *ent.Role `json:"role,omitempty"`
 }
@@ -1,17 +1,36 @@
 package model
 
+import "time"
+
+// Webhook 投递请求头
+const (
+	WebHookHeaderEvent     = "X-Hoshikuzu-Event"
+	WebHookHeaderDelivery  = "X-Hoshikuzu-Delivery"
+	WebHookHeaderTimestamp = "X-Hoshikuzu-Timestamp"
+	// 签名为 sha256=HMAC-SHA256(密钥, 时间戳 + "." + 请求体) 的十六进制
+	WebHookHeaderSignature = "X-Hoshikuzu-Signature"
+)
+
 // WebHookCreateReq represents the request body for creating a webhook.
 type WebHookCreateReq struct {
-	Name  string `json:"name" validate:"required"`
-	URL   string `json:"url" validate:"required,url"`
-	Event string `json:"event" validate:"required"`
+	// 名称
+	Name string `json:"name" validate:"required"`
+	// 回调地址
+	URL string `json:"url" validate:"required,url"`
+	// 订阅的事件
+	Events []string `json:"events" validate:"required,min=1"`
 }
 
 // WebHookUpdateReq represents the request body for updating a webhook.
 type WebHookUpdateReq struct {
-	Name  *string `json:"name,omitempty"`
-	URL   *string `json:"url,omitempty" validate:"url"`
-	Event *string `json:"event,omitempty"`
+	// 名称
+	Name string `json:"name" validate:"required"`
+	// 回调地址
+	URL string `json:"url" validate:"required,url"`
+	// 订阅的事件
+	Events []string `json:"events" validate:"required,min=1"`
+	// 是否启用
+	Enabled bool `json:"enabled"`
 }
 
 // WebHookResp represents the response body for a webhook.
@@ -20,5 +39,129 @@ type WebHookResp struct {
 	CreatedAt LocalTime `json:"created_at"`
 	Name      string    `json:"name"`
 	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Enabled   bool      `json:"enabled"`
+}
+
+// WebHookSecretResp Webhook 签名密钥，明文仅在创建或重置时返回
+type WebHookSecretResp struct {
+	WebHookResp
+	Secret string `json:"secret"`
+}
+
+// WebHookEventResp 可订阅的事件
+type WebHookEventResp struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+type WebHookDeliveryPageQuery struct {
+	Page int `json:"page" query:"page" form:"page" validate:"required,min=1"`
+	Size int `json:"page_size" query:"page_size" form:"page_size" validate:"required,min=1,max=100"`
+	// 投递状态 pending、success、failed
+	Status string `json:"status" query:"status" form:"status"`
+	Event  string `json:"event" query:"event" form:"event"`
+}
+
+type WebHookDeliveryResp struct {
+	ID        int       `json:"id"`
+	CreatedAt LocalTime `json:"created_at"`
+	WebhookID int       `json:"webhook_id"`
+	Event     string    `json:"event"`
+	EventID   string    `json:"event_id"`
+	Payload   string    `json:"payload"`
+	// 投递状态 pending、success、failed
+	Status       string     `json:"status"`
+	Attempts     int        `json:"attempts"`
+	NextRetryAt  *LocalTime `json:"next_retry_at,omitempty"`
+	ResponseCode int        `json:"response_code"`
+	ResponseBody string     `json:"response_body"`
+	Error        string     `json:"error"`
+	// 请求耗时（毫秒）
+	Duration    int        `json:"duration"`
+	DeliveredAt *LocalTime `json:"delivered_at,omitempty"`
+}
+
+// WebHookPayload 投递的请求体
+type WebHookPayload struct {
+	// 事件ID，同一事件重试与重新投递时不变，可用于去重
+	ID        string    `json:"id"`
 	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// PostEventData post.* 事件数据，post.deleted 仅包含 ID
+type PostEventData struct {
+	ID          int        `json:"id"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug"`
+	Author      string     `json:"author"`
+	Summary     string     `json:"summary"`
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+// CommentEventData comment.created 事件数据
+type CommentEventData struct {
+	ID       int    `json:"id"`
+	PostID   *int   `json:"post_id"`
+	ParentID *int   `json:"parent_id"`
+	UserID   *int   `json:"user_id"`
+	URL      string `json:"url"`
+	Content  string `json:"content"`
+	// 状态(1待审核,2已通过,3已拒绝)
+	Status int `json:"status"`
+}
+
+// EssayEventData essay.* 事件数据，essay.deleted 仅包含 ID
+type EssayEventData struct {
+	ID      int    `json:"id"`
+	UserID  int    `json:"user_id,omitempty"`
+	Content string `json:"content,omitempty"`
+	Draft   bool   `json:"draft"`
+	Public  bool   `json:"public"`
+}
+
+// ProductEventData product.* 事件数据，product.deleted 仅包含 ID，金额单位为分
+type ProductEventData struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name,omitempty"`
+	SKU    string `json:"sku,omitempty"`
+	Price  int    `json:"price"`
+	Active bool   `json:"active"`
+}
+
+// PayOrderEventData pay_order.paid、pay_order.refunded 事件数据，金额单位为分
+type PayOrderEventData struct {
+	ID           int        `json:"id"`
+	UserID       int        `json:"user_id"`
+	OrderType    string     `json:"order_type"`
+	ChannelType  string     `json:"channel_type"`
+	OutTradeNo   string     `json:"out_trade_no"`
+	Subject      string     `json:"subject"`
+	Price        int        `json:"price"`
+	RefundNo     string     `json:"refund_no,omitempty"`
+	RefundAmount int        `json:"refund_amount,omitempty"`
+	RefundAt     *time.Time `json:"refund_at,omitempty"`
+}
+
+// FlinkApplicationEventData flink_application.created 事件数据
+type FlinkApplicationEventData struct {
+	ID                 int    `json:"id"`
+	ApplicationType    string `json:"application_type"`
+	WebsiteName        string `json:"website_name"`
+	WebsiteURL         string `json:"website_url"`
+	WebsiteLogo        string `json:"website_logo"`
+	WebsiteDescription string `json:"website_description"`
+	ContactEmail       string `json:"contact_email"`
+}
+
+// UserEventData user.registered 事件数据
+type UserEventData struct {
+	ID       int    `json:"id"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Nickname string `json:"nickname"`
+	// 注册方式 email、social
+	Source string `json:"source"`
 }
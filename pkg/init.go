@@ -9,9 +9,13 @@ import (
 	"path/filepath"
 
 	"github.com/shuTwT/hoshikuzu/ent"
+	"github.com/shuTwT/hoshikuzu/internal/infra/event"
 	plugin_infra "github.com/shuTwT/hoshikuzu/internal/infra/plugin"
 	"github.com/shuTwT/hoshikuzu/internal/infra/schedule/manager"
+	ask_service "github.com/shuTwT/hoshikuzu/internal/services/ai/ask"
 	ai_service "github.com/shuTwT/hoshikuzu/internal/services/ai/chat"
+	knowledge_service "github.com/shuTwT/hoshikuzu/internal/services/ai/knowledge"
+	writing_service "github.com/shuTwT/hoshikuzu/internal/services/ai/writing"
 	album_service "github.com/shuTwT/hoshikuzu/internal/services/content/album"
 	albumphoto_service "github.com/shuTwT/hoshikuzu/internal/services/content/albumphoto"
 	category_service "github.com/shuTwT/hoshikuzu/internal/services/content/category"
@@ -21,10 +25,14 @@ import (
 	flinkapplication_service "github.com/shuTwT/hoshikuzu/internal/services/content/flinkapplication"
 	friend_circle_service "github.com/shuTwT/hoshikuzu/internal/services/content/friendcircle"
 	menu_service "github.com/shuTwT/hoshikuzu/internal/services/content/menu"
+	moderation_service "github.com/shuTwT/hoshikuzu/internal/services/content/moderation"
 	post_service "github.com/shuTwT/hoshikuzu/internal/services/content/post"
+	postaccess_service "github.com/shuTwT/hoshikuzu/internal/services/content/postaccess"
+	site_service "github.com/shuTwT/hoshikuzu/internal/services/content/site"
 	tag_service "github.com/shuTwT/hoshikuzu/internal/services/content/tag"
 	file_service "github.com/shuTwT/hoshikuzu/internal/services/infra/file"
 	license_service "github.com/shuTwT/hoshikuzu/internal/services/infra/license"
+	mail_service "github.com/shuTwT/hoshikuzu/internal/services/infra/mail"
 	migration_service "github.com/shuTwT/hoshikuzu/internal/services/infra/migration"
 	permission_service "github.com/shuTwT/hoshikuzu/internal/services/infra/permission"
 	plugin_service "github.com/shuTwT/hoshikuzu/internal/services/infra/plugin"
@@ -32,19 +40,32 @@ import (
 	storagestrategy_service "github.com/shuTwT/hoshikuzu/internal/services/infra/storagestrategy"
 	theme_service "github.com/shuTwT/hoshikuzu/internal/services/infra/theme"
 	visit_service "github.com/shuTwT/hoshikuzu/internal/services/infra/visit"
+	cart_service "github.com/shuTwT/hoshikuzu/internal/services/mall/cart"
 	coupon_service "github.com/shuTwT/hoshikuzu/internal/services/mall/coupon"
 	couponusage_service "github.com/shuTwT/hoshikuzu/internal/services/mall/couponusage"
+	digital_service "github.com/shuTwT/hoshikuzu/internal/services/mall/digital"
 	member_service "github.com/shuTwT/hoshikuzu/internal/services/mall/member"
 	memberlevel_service "github.com/shuTwT/hoshikuzu/internal/services/mall/memberlevel"
 	payorder_service "github.com/shuTwT/hoshikuzu/internal/services/mall/payorder"
 	product_service "github.com/shuTwT/hoshikuzu/internal/services/mall/product"
 	wallet_service "github.com/shuTwT/hoshikuzu/internal/services/mall/wallet"
+	account_service "github.com/shuTwT/hoshikuzu/internal/services/system/account"
 	auth_service "github.com/shuTwT/hoshikuzu/internal/services/system/auth"
 	common_service "github.com/shuTwT/hoshikuzu/internal/services/system/common"
+	loginguard_service "github.com/shuTwT/hoshikuzu/internal/services/system/loginguard"
+	loginlog_service "github.com/shuTwT/hoshikuzu/internal/services/system/loginlog"
 	notification_service "github.com/shuTwT/hoshikuzu/internal/services/system/notification"
+	oauth2_service "github.com/shuTwT/hoshikuzu/internal/services/system/oauth2"
 	role_service "github.com/shuTwT/hoshikuzu/internal/services/system/role"
+	session_service "github.com/shuTwT/hoshikuzu/internal/services/system/session"
 	setting_service "github.com/shuTwT/hoshikuzu/internal/services/system/setting"
+	social_service "github.com/shuTwT/hoshikuzu/internal/services/system/social"
+	twofactor_service "github.com/shuTwT/hoshikuzu/internal/services/system/twofactor"
 	user_service "github.com/shuTwT/hoshikuzu/internal/services/system/user"
+	webhook_service "github.com/shuTwT/hoshikuzu/internal/services/system/webhook"
+	"github.com/shuTwT/hoshikuzu/pkg/cache"
+
+	"github.com/redis/go-redis/v9"
 )
 
 func ExtractDefaultTheme(assetsRes embed.FS) {
@@ -109,13 +130,18 @@ func ExtractDefaultTheme(assetsRes embed.FS) {
 }
 
 type ServiceMap struct {
+	AccountService          account_service.AccountService
 	AIService               ai_service.AIService
+	AskService              ask_service.AskService
+	WritingService          writing_service.WritingService
 	AlbumService            album_service.AlbumService
 	AlbumPhotoService       albumphoto_service.AlbumPhotoService
 	AuthService             auth_service.AuthService
 	CategoryService         category_service.CategoryService
 	CommentService          comment_service.CommentService
 	CommonService           common_service.CommonService
+	CartService             cart_service.CartService
+	DigitalService          digital_service.DigitalService
 	CouponService           coupon_service.CouponService
 	CouponUsageService      couponusage_service.CouponUsageService
 	EssayService            essay_service.EssayService
@@ -123,35 +149,44 @@ type ServiceMap struct {
 	FlinkService            flink_service.FlinkService
 	FlinkApplicationService flinkapplication_service.FlinkApplicationService
 	LicenseService          license_service.LicenseService
+	MailService             mail_service.MailService
 	FriendCircleService     friend_circle_service.FriendCircleService
+	KnowledgeService        knowledge_service.KnowledgeService
 	MenuService             menu_service.MenuService
 	MemberLevelService      memberlevel_service.MemberLevelService
 	MemberService           member_service.MemberService
 	MigrationService        migration_service.MigrationService
 	NotificationService     notification_service.NotificationService
+	OAuth2Service           oauth2_service.OAuth2Service
+	SocialService           social_service.SocialService
+	TwoFactorService        twofactor_service.TwoFactorService
+	SessionService          session_service.SessionService
+	LoginGuardService       loginguard_service.LoginGuardService
+	LoginLogService         loginlog_service.LoginLogService
 	PayOrderService         payorder_service.PayOrderService
 	PermissionService       permission_service.PermissionService
 	PluginService           plugin_service.PluginService
 	PostService             post_service.PostService
+	PostAccessService       postaccess_service.PostAccessService
 	ProductService          product_service.ProductService
 	RoleService             role_service.RoleService
 	ScheduleJobService      schedulejob_service.ScheduleJobService
 	SettingService          setting_service.SettingService
+	SiteService             site_service.SiteService
 	StorageStrategyService  storagestrategy_service.StorageStrategyService
 	TagService              tag_service.TagService
 	ThemeService            theme_service.ThemeService
 	UserService             user_service.UserService
 	VisitService            visit_service.VisitService
 	WalletService           wallet_service.WalletService
+	WebHookService          webhook_service.WebHookService
 }
 
-func InitializeServices(assetsRes embed.FS, db *ent.Client, scheduleManager *manager.ScheduleManager) ServiceMap {
+func InitializeServices(assetsRes embed.FS, db *ent.Client, rdb *redis.Client, scheduleManager *manager.ScheduleManager) ServiceMap {
 
 	albumService := album_service.NewAlbumServiceImpl(db)
 	albumPhotoService := albumphoto_service.NewAlbumPhotoServiceImpl(db)
-	authService := auth_service.NewAuthServiceImpl(db)
 	categoryService := category_service.NewCategoryServiceImpl(db)
-	commentService := comment_service.NewCommentServiceImpl(db)
 	essayService := essay_service.NewEssayServiceImpl(db)
 	fileService := file_service.NewFileServiceImpl(db)
 	licenseService := license_service.NewLicenseServiceImpl(db)
@@ -162,8 +197,10 @@ func InitializeServices(assetsRes embed.FS, db *ent.Client, scheduleManager *man
 	memberLevelService := memberlevel_service.NewMemberLevelServiceImpl(db)
 	memberService := member_service.NewMemberServiceImpl(db)
 	settingService := setting_service.NewSettingServiceImpl(db)
-	payOderService := payorder_service.NewPayOrderServiceImpl(db, settingService)
+	digitalService := digital_service.NewDigitalServiceImpl(db)
+	payOderService := payorder_service.NewPayOrderServiceImpl(db, settingService, digitalService)
 	permissionService := permission_service.NewPermissionServiceImpl(db)
+	oauth2Service := oauth2_service.NewOAuth2ServiceImpl(db)
 	pluginManager := plugin_infra.NewPluginManager(db)
 	pluginService := plugin_service.NewPluginServiceImpl(db, pluginManager)
 	productService := product_service.NewProductServiceImpl(db)
@@ -176,29 +213,53 @@ func InitializeServices(assetsRes embed.FS, db *ent.Client, scheduleManager *man
 		panic("failed migrating legacy AI config: " + err.Error())
 	}
 	postService := post_service.NewPostServiceImpl(db, aiService)
-	commonService := common_service.NewCommonServiceImpl(db, user_service.NewUserServiceImpl(db), postService, comment_service.NewCommentServiceImpl(db))
+	moderationService := moderation_service.NewModerationServiceImpl(db, settingService, aiService)
+	commentService := comment_service.NewCommentServiceImpl(db, moderationService)
+	postAccessService := postaccess_service.NewPostAccessServiceImpl(db)
+	commonService := common_service.NewCommonServiceImpl(db, user_service.NewUserServiceImpl(db), postService, commentService)
+	cartService := cart_service.NewCartServiceImpl(db)
 	couponService := coupon_service.NewCouponServiceImpl(db)
 	couponUsageService := couponusage_service.NewCouponUsageServiceImpl(db)
 	storageStrategyService := storagestrategy_service.NewStorageStrategyServiceImpl(db)
-	themeService := theme_service.NewThemeServiceImpl(db)
+	themeService := theme_service.NewThemeServiceImpl(db, settingService)
 	tagService := tag_service.NewTagServiceImpl(db)
 	userService := user_service.NewUserServiceImpl(db)
+	twoFactorService := twofactor_service.NewTwoFactorServiceImpl(db, settingService)
+	sessionService := session_service.NewSessionServiceImpl(db)
+	loginGuardService := loginguard_service.NewLoginGuardServiceImpl(db, cache.NewCounter(rdb))
+	loginLogService := loginlog_service.NewLoginLogServiceImpl(db)
+	authService := auth_service.NewAuthServiceImpl(db, twoFactorService, loginGuardService, loginLogService)
+	socialService := social_service.NewSocialServiceImpl(db, settingService, authService, userService)
+	mailService := mail_service.NewMailServiceImpl(settingService)
+	accountService := account_service.NewAccountServiceImpl(db, settingService, userService, mailService)
 	visitService := visit_service.NewVisitServiceImpl(db)
 	walletService := wallet_service.NewWalletServiceImpl(db)
 	migrationService := migration_service.NewMigrationServiceImpl(db)
 	notificationService := notification_service.NewNotificationServiceImpl(db)
 	scheduleJobService := schedulejob_service.NewScheduleJobServiceImpl(db, scheduleManager)
+	webHookService := webhook_service.NewWebHookServiceImpl(db)
+	event.GetBus().Subscribe(webHookService.HandleEvent)
+	knowledgeService := knowledge_service.NewKnowledgeServiceImpl(db, settingService, aiService)
+	event.GetBus().Subscribe(knowledgeService.HandleEvent)
+	askService := ask_service.NewAskServiceImpl(db, settingService, aiService, knowledgeService, cache.NewCounter(rdb))
+	writingService := writing_service.NewWritingServiceImpl(db, settingService, aiService)
+	siteService := site_service.NewSiteServiceImpl(postService, postAccessService, categoryService, tagService, menuService, settingService, userService)
 
 	permissionService.LoadPermissionsFromDef(assetsRes)
 
 	serviceMap := ServiceMap{
+		AccountService:          accountService,
 		AIService:               aiService,
+		AskService:              askService,
+		WritingService:          writingService,
 		AlbumService:            albumService,
 		AlbumPhotoService:       albumPhotoService,
 		AuthService:             authService,
 		CategoryService:         categoryService,
 		CommentService:          commentService,
 		CommonService:           commonService,
+		CartService:             cartService,
+		DigitalService:          digitalService,
 		CouponService:           couponService,
 		CouponUsageService:      couponUsageService,
 		EssayService:            essayService,
@@ -206,26 +267,37 @@ func InitializeServices(assetsRes embed.FS, db *ent.Client, scheduleManager *man
 		FlinkService:            flinkService,
 		FlinkApplicationService: flinkApplicationService,
 		FriendCircleService:     friendCircleService,
+		KnowledgeService:        knowledgeService,
 		LicenseService:          licenseService,
+		MailService:             mailService,
 		MenuService:             menuService,
 		MemberLevelService:      memberLevelService,
 		MemberService:           memberService,
 		MigrationService:        migrationService,
 		NotificationService:     notificationService,
+		OAuth2Service:           oauth2Service,
+		SocialService:           socialService,
+		TwoFactorService:        twoFactorService,
+		SessionService:          sessionService,
+		LoginGuardService:       loginGuardService,
+		LoginLogService:         loginLogService,
 		PayOrderService:         payOderService,
 		PermissionService:       permissionService,
 		PluginService:           pluginService,
 		PostService:             postService,
+		PostAccessService:       postAccessService,
 		ProductService:          productService,
 		RoleService:             roleService,
 		ScheduleJobService:      scheduleJobService,
 		SettingService:          settingService,
+		SiteService:             siteService,
 		StorageStrategyService:  storageStrategyService,
 		TagService:              tagService,
 		ThemeService:            themeService,
 		UserService:             userService,
 		VisitService:            visitService,
 		WalletService:           walletService,
+		WebHookService:          webHookService,
 	}
 
 	return serviceMap
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/medama-io/go-useragent"
+)
+
+// uaParser 用户代理解析器，初始化开销较大，全局共用
+var uaParser = useragent.NewParser()
+
+// ParseUserAgent 解析浏览器及主版本、操作系统与设备类型，无法识别时返回空字符串
+func ParseUserAgent(ua string) (browser, os, device string) {
+	if ua == "" {
+		return "", "", ""
+	}
+	agent := uaParser.Parse(ua)
+	browser = strings.TrimSpace(agent.Browser().String() + " " + agent.BrowserVersionMajor())
+	return browser, agent.OS().String(), agent.Device().String()
+}
@@ -0,0 +1,39 @@
+package utils
+
+import "testing"
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name    string
+		ua      string
+		browser string
+		os      string
+		device  string
+	}{
+		{
+			name:    "desktop chrome",
+			ua:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			browser: "Chrome 120",
+			os:      "Windows",
+			device:  "Desktop",
+		},
+		{
+			name:    "iphone safari",
+			ua:      "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+			browser: "Safari 17",
+			os:      "iOS",
+			device:  "Mobile",
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			browser, os, device := ParseUserAgent(tt.ua)
+			if browser != tt.browser || os != tt.os || device != tt.device {
+				t.Fatalf("ParseUserAgent() = %q, %q, %q, want %q, %q, %q", browser, os, device, tt.browser, tt.os, tt.device)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ export interface AIModelItem {
   display_name?: string
   is_enabled: boolean
   sort: number
+  context_window: number
 }
 
 export interface AIProvider {
@@ -15,6 +16,7 @@ export interface AIProvider {
   name: string
   provider_type: string
   base_url: string
+  embedding_model?: string
   api_key_configured: boolean
   temperature: number
   max_tokens: number
@@ -35,6 +37,7 @@ export interface AIProviderInput {
   provider_type: string
   base_url: string
   api_key: string
+  embedding_model: string
   temperature: number
   max_tokens: number
   top_p: number
@@ -51,33 +54,78 @@ export interface AIModelInput {
   display_name: string
   is_enabled: boolean
   sort: number
+  context_window: number
 }
 
-export interface ChatSession {
+export interface ChatSessionSettings {
+  model_id?: number | null
+  persona_id?: number | null
+  system_prompt: string
+  temperature?: number | null
+  max_tokens?: number | null
+}
+
+export interface ChatSession extends ChatSessionSettings {
   id: number
   title: string
   created_at: number
   updated_at: number
 }
 
+export interface ChatModelOption {
+  id: number
+  provider_id: number
+  provider_name: string
+  model_name: string
+  display_name?: string
+  is_default: boolean
+}
+
+export interface AIPersonaInput {
+  name: string
+  description: string
+  system_prompt: string
+  temperature?: number | null
+  max_tokens?: number | null
+}
+
+export interface AIPersona extends AIPersonaInput {
+  id: number
+  created_at: number
+  updated_at: number
+}
+
+export interface KnowledgeCitation {
+  kind: 'post' | 'essay' | 'product'
+  source_id: number
+  title: string
+  slug?: string
+}
+
 export interface ChatMessage {
   id: number
-  role: 'user' | 'assistant'
+  // summary 为较早消息的摘要，紧跟在其覆盖的最后一条消息之后
+  role: 'user' | 'assistant' | 'summary'
   content: string
   model?: string
+  citations?: KnowledgeCitation[]
+  prompt_tokens?: number
+  completion_tokens?: number
+  summary_until?: number
   created_at: number
 }
 
 export interface AIStreamEvent {
   content?: string
   message_id?: number
+  citations?: KnowledgeCitation[]
   code?: string
   message?: string
 }
 
 type StreamEventName = 'delta' | 'done' | 'error'
 
-function parseStreamBlock(block: string): { event: StreamEventName; data: AIStreamEvent } | null {
+function parseStreamBlock(block: string): { event: StreamEventName; data: unknown } | null {
   let event: StreamEventName = 'delta'
   const dataLines: string[] = []
   for (const line of block.split(/\r?\n/)) {
@@ -90,7 +138,7 @@ function parseStreamBlock(block: string): { event: StreamEventName; data: AIStre
   }
   if (dataLines.length === 0) return null
   try {
-    return { event, data: JSON.parse(dataLines.join('\n')) as AIStreamEvent }
+    return { event, data: JSON.parse(dataLines.join('\n')) }
   } catch {
     throw new Error('无法解析 AI 流响应')
   }
@@ -105,21 +153,21 @@ async function responseError(response: Response): Promise<Error> {
   }
 }
 
-export async function streamChat(
-  sessionId: number,
-  content: string,
-  onEvent: (event: StreamEventName, data: AIStreamEvent) => void,
+async function readEventStream<T>(
+  url: string,
+  body: unknown,
+  onEvent: (event: StreamEventName, data: T) => void,
   signal: AbortSignal,
 ): Promise<void> {
   const token = getToken()?.accessToken
-  const response = await fetch(`${AI_BASE_URL}/chat/sessions/${sessionId}/stream`, {
+  const response = await fetch(url, {
     method: 'POST',
     headers: {
       Accept: 'text/event-stream',
       'Content-Type': 'application/json',
       ...(token ? { Authorization: `Bearer ${token}` } : {}),
     },
-    body: JSON.stringify({ content }),
+    body: JSON.stringify(body),
     signal,
   })
   if (!response.ok) throw await responseError(response)
@@ -134,7 +182,7 @@ export async function streamChat(
     buffer = blocks.pop() || ''
     for (const block of blocks) {
       const parsed = parseStreamBlock(block)
-      if (parsed) onEvent(parsed.event, parsed.data)
+      if (parsed) onEvent(parsed.event, parsed.data as T)
     }
   }
 
@@ -151,3 +199,78 @@ export async function streamChat(
     reader.releaseLock()
   }
 }
+
+export function streamChat(
+  sessionId: number,
+  content: string,
+  onEvent: (event: StreamEventName, data: AIStreamEvent) => void,
+  signal: AbortSignal,
+  knowledge = false,
+): Promise<void> {
+  return readEventStream(`${AI_BASE_URL}/chat/sessions/${sessionId}/stream`, { content, knowledge }, onEvent, signal)
+}
+
+// 从指定的用户消息处重新生成回复，其后的消息会被删除
+export function streamRegenerate(
+  sessionId: number,
+  messageId: number,
+  content: string,
+  onEvent: (event: StreamEventName, data: AIStreamEvent) => void,
+  signal: AbortSignal,
+  knowledge = false,
+): Promise<void> {
+  return readEventStream(
+    `${AI_BASE_URL}/chat/sessions/${sessionId}/messages/${messageId}/regenerate`,
+    { content, knowledge },
+    onEvent,
+    signal,
+  )
+}
+
+export type WritingAction = 'titles' | 'seo' | 'taxonomy' | 'translate' | 'proofread' | 'continue'
+
+export interface WritingTerm {
+  id: number
+  name: string
+}
+
+export interface WritingDiffLine {
+  op: 'equal' | 'insert' | 'delete'
+  text: string
+}
+
+export interface WritingResult {
+  action: WritingAction
+  model: string
+  content: string
+  titles?: string[]
+  keywords?: string[]
+  slug?: string
+  tags?: WritingTerm[]
+  categories?: WritingTerm[]
+  diff?: WritingDiffLine[]
+}
+
+export interface WritingStreamEvent {
+  content?: string
+  result?: WritingResult
+  code?: string
+  message?: string
+}
+
+export interface WritingInput {
+  title?: string
+  content?: string
+  language?: string
+  cursor?: number
+}
+
+export function streamWriting(
+  postId: number,
+  action: WritingAction,
+  input: WritingInput,
+  onEvent: (event: StreamEventName, data: WritingStreamEvent) => void,
+  signal: AbortSignal,
+): Promise<void> {
+  return readEventStream(`${AI_BASE_URL}/post/${postId}/${action}`, input, onEvent, signal)
+}
@@ -20,6 +20,6 @@ useApi(apiClient.api.v1SettingsList).then(async(res) => {
   if (!res.data.initialized) {
     router.push('/initialize')
   } else {
-    useSettingsStoreHook().initialize()
+    useSettingsStoreHook().initialize(res.data.settings)
   }
 })
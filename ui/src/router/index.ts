@@ -41,7 +41,7 @@ const router = createRouter({
   routes: constantRoutes.concat(...(remainingRouter as any)),
 })
 
-const whiteList = ['/login','/social-login','/redirect','/register', '/initialize']
+const whiteList = ['/login','/social-login','/redirect','/register','/verify-email','/forgot-password','/reset-password','/two-factor', '/initialize']
 
 router.beforeEach((to, _from, next) => {
   const userInfo = useStorageLocal().getItem<DataInfo<number>>(userKey)
@@ -98,7 +98,8 @@ router.beforeEach((to, _from, next) => {
         next()
       } else {
         removeToken()
-        next({ path: '/login' })
+        // 登录后回到原页面，如第三方应用的授权确认页
+        next({ path: '/login', query: to.path === '/' ? undefined : { redirect: to.fullPath } })
       }
     } else {
       next()
@@ -32,6 +32,16 @@ export default [
           roles: ['admin', 'common', 'guest'],
         },
       },
+      {
+        path: '/ai/ask',
+        name: 'AiAsk',
+        component: () => import('@/views/ai/ask/index.vue'),
+        meta: {
+          title: '站点问答日志',
+          showLink: true,
+          roles: ['admin', 'common', 'guest'],
+        },
+      },
     ]
   }
 ] satisfies RouteRecordRaw[]
@@ -13,6 +13,44 @@ export default [
     component: () => import('@/views/login/socialLogin.vue'),
     meta: {},
   },
+  {
+    path: '/register',
+    name: 'Register',
+    component: () => import('@/views/login/register.vue'),
+    meta: {},
+  },
+  {
+    path: '/verify-email',
+    name: 'VerifyEmail',
+    component: () => import('@/views/login/verifyEmail.vue'),
+    meta: {},
+  },
+  {
+    path: '/forgot-password',
+    name: 'ForgotPassword',
+    component: () => import('@/views/login/forgotPassword.vue'),
+    meta: {},
+  },
+  {
+    path: '/reset-password',
+    name: 'ResetPassword',
+    component: () => import('@/views/login/resetPassword.vue'),
+    meta: {},
+  },
+  {
+    path: '/two-factor',
+    name: 'TwoFactor',
+    component: () => import('@/views/login/twoFactor.vue'),
+    meta: {},
+  },
+  {
+    path: '/oauth2/authorize',
+    name: 'OAuth2Authorize',
+    component: () => import('@/views/oauth2/authorize.vue'),
+    meta: {
+      title: '授权确认',
+    },
+  },
   {
     path: '/error',
     meta: {},
@@ -42,6 +42,36 @@ export default [
           roles: ['admin'],
         },
       },
+      {
+        path: '/system/login-log',
+        name: 'LoginLogManagement',
+        component: () => import('@/views/system/login-log/index.vue'),
+        meta: {
+          title: '登录日志',
+          showLink: true,
+          roles: ['admin'],
+        },
+      },
+      {
+        path: '/system/oauth2-client',
+        name: 'OAuth2ClientManagement',
+        component: () => import('@/views/system/oauth2-client/index.vue'),
+        meta: {
+          title: 'OAuth2 客户端',
+          showLink: true,
+          roles: ['admin'],
+        },
+      },
+      {
+        path: '/system/webhook',
+        name: 'WebHookManagement',
+        component: () => import('@/views/system/webhook/index.vue'),
+        meta: {
+          title: 'Webhook',
+          showLink: true,
+          roles: ['admin'],
+        },
+      },
       {
         path: '/system/notification',
         name: 'NotificationManagement',
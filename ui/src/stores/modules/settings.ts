@@ -7,15 +7,26 @@ export const useSettingsStore = defineStore("settings",()=> {
    */
   const initialized = ref(false)
 
+  /**
+   * 是否开放注册
+   */
+  const allowRegistration = ref(false)
+
   /**
    * 初始化设置
    */
-  function initialize() {
+  function initialize(settings: Record<string, string> = {}) {
     initialized.value = true
+    try {
+      allowRegistration.value = JSON.parse(settings.site ?? '{}').allowRegistration === true
+    } catch {
+      allowRegistration.value = false
+    }
   }
 
   return {
     initialized,
+    allowRegistration,
     initialize,
   }
 })
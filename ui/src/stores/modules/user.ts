@@ -79,7 +79,10 @@ export const useUserStore = defineStore('user', () => {
   async function loginByUsername(data:any){
     return new Promise<any>((resolve, reject) => {
       useApi(apiClient.api.authLoginPasswordCreate, data).then(({data}) => {
-        setToken(data)
+        // 需要两步验证时由调用方继续完成第二步
+        if (!data.twoFactorRequired) {
+          setToken(data)
+        }
         resolve(data)
       }).catch(err => {
         reject(err)
@@ -87,6 +90,21 @@ export const useUserStore = defineStore('user', () => {
     })
   }
 
+  async function loginBySocial(data:any){
+    const res = await useApi(apiClient.api.authSocialLoginCreate, data)
+    if (!res.data.twoFactorRequired) {
+      setToken(res.data)
+    }
+    return res.data
+  }
+
+  /** 登录第二步：校验两步验证码，首次设置时返回恢复码 */
+  async function loginByTwoFactor(data:any){
+    const res = await useApi(apiClient.api.authLoginTwoFactorCreate, data)
+    setToken(res.data)
+    return res.data
+  }
+
   async function logOut() {
     // 尽力通知后端吊销 refresh token，失败不阻塞登出
     const refreshTokenValue = getToken()?.refreshToken
@@ -130,6 +148,8 @@ export const useUserStore = defineStore('user', () => {
     SET_ISREMEMBERED,
     SET_LOGINDAY,
     loginByUsername,
+    loginBySocial,
+    loginByTwoFactor,
     logOut,
     handleRefreshToken,
   }
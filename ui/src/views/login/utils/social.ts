@@ -0,0 +1,31 @@
+import { apiClient, useApi } from '@/api'
+import router from '@/router'
+
+/** 回调页根据该标记区分登录与绑定 */
+export const socialActionKey = 'social-login-action'
+/** 登录成功后跳转的页面 */
+export const socialRedirectKey = 'social-login-redirect'
+
+export type SocialAction = 'login' | 'link'
+
+/** 第三方授权完成后的回调地址，需在第三方应用中登记 */
+export function socialRedirectUri() {
+  return new URL(router.resolve('/social-login').href, window.location.origin).href
+}
+
+/**
+ * 发起第三方授权并跳转到授权页
+ * @param action 登录或绑定
+ * @param provider 登录方式标识
+ * @param redirect 登录成功后跳转的页面
+ */
+export async function startSocialAuth(action: SocialAction, provider: string, redirect = '/') {
+  const req = { redirectUri: socialRedirectUri() }
+  const res =
+    action === 'link'
+      ? await useApi(apiClient.api.v1UserIdentityAuthorizeCreate, provider, req)
+      : await useApi(apiClient.api.authSocialAuthorizeCreate, provider, req)
+  sessionStorage.setItem(socialActionKey, action)
+  sessionStorage.setItem(socialRedirectKey, redirect)
+  window.location.href = res.data.authorizeUrl
+}
@@ -0,0 +1,40 @@
+import router from '@/router'
+
+/** 登录第二步所需的挑战信息 */
+export const twoFactorChallengeKey = 'two-factor-challenge'
+
+export interface TwoFactorChallenge {
+  challengeToken: string
+  setupRequired: boolean
+  redirect: string
+}
+
+/**
+ * 保存挑战信息并跳转到两步验证页
+ * @param data 登录接口返回的数据
+ * @param redirect 登录成功后跳转的页面
+ */
+export function startTwoFactor(data: any, redirect = '/') {
+  const challenge: TwoFactorChallenge = {
+    challengeToken: data.challengeToken,
+    setupRequired: !!data.twoFactorSetupRequired,
+    redirect,
+  }
+  sessionStorage.setItem(twoFactorChallengeKey, JSON.stringify(challenge))
+  return router.replace('/two-factor')
+}
+
+/** 读取挑战信息，不存在时返回 null */
+export function getTwoFactorChallenge(): TwoFactorChallenge | null {
+  const raw = sessionStorage.getItem(twoFactorChallengeKey)
+  if (!raw) return null
+  try {
+    return JSON.parse(raw) as TwoFactorChallenge
+  } catch {
+    return null
+  }
+}
+
+export function clearTwoFactorChallenge() {
+  sessionStorage.removeItem(twoFactorChallengeKey)
+}
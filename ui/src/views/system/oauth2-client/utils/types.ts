@@ -0,0 +1,14 @@
+export type FormItemProps = {
+  id?: number
+  name: string
+  description: string
+  logo: string
+  redirect_uris: string[]
+  scopes: string[]
+  public: boolean
+  enabled: boolean
+}
+
+export type FormProps={
+  formInline: FormItemProps
+}
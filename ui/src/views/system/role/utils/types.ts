@@ -3,6 +3,8 @@ export type FormItemProps = {
   name: string
   code: string
   description: string
+  permissions: string[]
+  require_two_factor: boolean
 }
 
 export type FormProps={
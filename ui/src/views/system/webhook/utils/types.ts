@@ -0,0 +1,12 @@
+export type FormItemProps = {
+  id?: number
+  name: string
+  url: string
+  events: string[]
+  enabled: boolean
+}
+
+export type FormProps={
+  formInline: FormItemProps
+  eventOptions: { label: string, value: string }[]
+}